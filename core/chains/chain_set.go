@@ -49,6 +49,9 @@ type ChainSet[I ID, C Config, N Node, S ChainService[C]] interface {
 
 	// Chain returns the ChainService for this ID (if a configuration is available), creating one if necessary.
 	Chain(context.Context, I) (S, error)
+
+	// Chains returns the live ChainService instances.
+	Chains() []S
 }
 
 // ChainService is a live, runtime chain instance, with supporting services.
@@ -236,6 +239,16 @@ func (c *chainSet[I, C, N, S]) Remove(id I) error {
 	return chain.Close()
 }
 
+func (c *chainSet[I, C, N, S]) Chains() []S {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+	chains := make([]S, 0, len(c.chains))
+	for _, ch := range c.chains {
+		chains = append(chains, ch)
+	}
+	return chains
+}
+
 func (c *chainSet[I, C, N, S]) Index(offset, limit int) ([]DBChain[I, C], int, error) {
 	return c.orm.Chains(offset, limit)
 }
@@ -292,8 +305,8 @@ func (c *chainSet[I, C, N, S]) Ready() (err error) {
 	err = c.StartStopOnce.Ready()
 	c.chainsMu.RLock()
 	defer c.chainsMu.RUnlock()
-	for _, c := range c.chains {
-		err = multierr.Combine(err, c.Ready())
+	for id, c := range c.chains {
+		err = multierr.Combine(err, errors.Wrapf(c.Ready(), "chain %s", id))
 	}
 	return
 }
@@ -302,8 +315,8 @@ func (c *chainSet[I, C, N, S]) Healthy() (err error) {
 	err = c.StartStopOnce.Healthy()
 	c.chainsMu.RLock()
 	defer c.chainsMu.RUnlock()
-	for _, c := range c.chains {
-		err = multierr.Combine(err, c.Healthy())
+	for id, c := range c.chains {
+		err = multierr.Combine(err, errors.Wrapf(c.Healthy(), "chain %s", id))
 	}
 	return
 }
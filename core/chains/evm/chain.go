@@ -2,6 +2,7 @@ package evm
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/url"
@@ -23,6 +24,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -57,6 +60,11 @@ type chain struct {
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
 	keyStore        keystore.Eth
+
+	nodeStateNotifier *nodeStateNotifier
+
+	dialMu sync.Mutex
+	dialed bool
 }
 
 func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*chain, error) {
@@ -70,14 +78,20 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		return nil, errors.Wrapf(err, "cannot create new chain with ID %s, config validation failed", dbchain.ID.String())
 	}
 	var client evmclient.Client
+	var stateNotifier *nodeStateNotifier
 	if !cfg.EVMRPCEnabled() {
 		client = evmclient.NewNullClient(chainID, l)
 	} else if opts.GenEthClient == nil {
 		var err2 error
-		client, err2 = newEthClientFromChain(cfg, l, dbchain, nodes)
+		stateNotifier = newNodeStateNotifier(opts.EventBroadcaster, l)
+		client, err2 = newEthClientFromChain(cfg, l, dbchain, nodes, stateNotifier.Observer())
 		if err2 != nil {
+			stateNotifier.Close()
 			return nil, errors.Wrapf(err2, "failed to instantiate eth client for chain with ID %s", dbchain.ID.String())
 		}
+		evmclient.SetNoLiveNodesObserver(client, func(chainID *big.Int, nodeStates map[string]string) {
+			opts.Notifier.Notify(notifications.NewNoLiveNodesEvent(chainID, nodeStates))
+		})
 	} else {
 		client = opts.GenEthClient(dbchain)
 	}
@@ -106,7 +120,9 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		txm = &txmgr.NullTxManager{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenTxManager == nil {
 		checker := &txmgr.CheckerFactory{Client: client}
-		txm = txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		t := txmgr.NewTxm(db, client, cfg, opts.KeyStore, opts.EventBroadcaster, l, checker, logPoller)
+		t.SetNotifier(opts.Notifier)
+		txm = t
 	} else {
 		txm = opts.GenTxManager(dbchain)
 	}
@@ -116,6 +132,7 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
 	highestSeenHead, err := headSaver.LatestHeadFromDB(context.Background())
 	if err != nil {
+		stateNotifier.Close()
 		return nil, err
 	}
 
@@ -130,7 +147,14 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
 	} else if opts.GenLogBroadcaster == nil {
 		logORM := log.NewORM(db, l, cfg, *chainID)
-		logBroadcaster = log.NewBroadcaster(logORM, client, cfg, l, highestSeenHead)
+		if cfg.EvmLogBroadcasterUseLogPoller() {
+			logBroadcaster = log.NewLogPollerBroadcaster(logORM, logPoller, cfg, l, *chainID, highestSeenHead)
+		} else {
+			if hasHTTPOnlyPrimary(nodes) {
+				l.Warn("Some primary nodes have no websocket URL and cannot subscribe to logs, so jobs reading logs will only work while a node with a websocket URL is selected. Set LogBroadcasterUseLogPoller (ETH_LOG_BROADCASTER_USE_LOG_POLLER) to read logs from the log poller instead.")
+			}
+			logBroadcaster = log.NewBroadcaster(logORM, client, cfg, l, highestSeenHead)
+		}
 	} else {
 		logBroadcaster = opts.GenLogBroadcaster(dbchain)
 	}
@@ -154,6 +178,8 @@ func newChain(dbchain types.DBChain, nodes []types.Node, opts ChainSetOpts) (*ch
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
 		keyStore:        opts.KeyStore,
+
+		nodeStateNotifier: stateNotifier,
 	}, nil
 }
 
@@ -162,8 +188,8 @@ func (c *chain) Start(ctx context.Context) error {
 		c.logger.Debugf("Chain: starting with ID %s", c.ID().String())
 		// Must ensure that EthClient is dialed first because subsequent
 		// services may make eth calls on startup
-		if err := c.client.Dial(ctx); err != nil {
-			return errors.Wrap(err, "failed to dial ethclient")
+		if err := c.dial(ctx); err != nil {
+			return err
 		}
 		// We do not start the log poller here, it gets
 		// started after the jobs so they have a chance to apply their filters.
@@ -188,6 +214,32 @@ func (c *chain) Start(ctx context.Context) error {
 	})
 }
 
+// Warm dials the RPC nodes of the chain and subscribes to new heads ahead of
+// Start, so that a standby node has live connections and is tracking heads by
+// the time it takes over.
+func (c *chain) Warm(ctx context.Context) error {
+	if err := c.dial(ctx); err != nil {
+		return err
+	}
+	if warmer, ok := c.headTracker.(services.Warmer); ok {
+		return warmer.Warm(ctx)
+	}
+	return nil
+}
+
+func (c *chain) dial(ctx context.Context) error {
+	c.dialMu.Lock()
+	defer c.dialMu.Unlock()
+	if c.dialed {
+		return nil
+	}
+	if err := c.client.Dial(ctx); err != nil {
+		return errors.Wrap(err, "failed to dial ethclient")
+	}
+	c.dialed = true
+	return nil
+}
+
 func (c *chain) checkKeys(ctx context.Context) error {
 	fundingKeys, err := c.keyStore.FundingKeys()
 	if err != nil {
@@ -233,6 +285,7 @@ func (c *chain) Close() error {
 		merr = multierr.Combine(merr, c.txm.Close())
 		c.logger.Debug("Chain: stopping client")
 		c.client.Close()
+		c.nodeStateNotifier.Close()
 		c.logger.Debug("Chain: stopped")
 		return merr
 	})
@@ -278,7 +331,7 @@ func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
 
-func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain types.DBChain, nodes []types.Node) (evmclient.Client, error) {
+func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain types.DBChain, nodes []types.Node, observer evmclient.NodeStateObserver) (evmclient.Client, error) {
 	chainID := big.Int(chain.ID)
 	var primaries []evmclient.Node
 	var sendonlys []evmclient.SendOnlyNode
@@ -294,22 +347,133 @@ func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chain t
 			if err != nil {
 				return nil, err
 			}
+			if observer != nil {
+				evmclient.SetNodeStateObserver(primary, observer)
+			}
 			primaries = append(primaries, primary)
 		}
 	}
 	return evmclient.NewClientWithNodes(lggr, primaries, sendonlys, &chainID)
 }
 
+// NodeStateChangedPayload is the payload of pg.ChannelEVMNodeStateChanged
+// notifications.
+type NodeStateChangedPayload struct {
+	NodeID        int32      `json:"nodeID"`
+	EVMChainID    *utils.Big `json:"evmChainID"`
+	State         string     `json:"state"`
+	PreviousState string     `json:"previousState"`
+}
+
+// nodeStateNotifier notifies the node state changes on
+// pg.ChannelEVMNodeStateChanged, for GraphQL subscriptions. Changes are queued
+// so that observing them never blocks state transitions, and notified in order
+// by a single worker per chain.
+type nodeStateNotifier struct {
+	eventBroadcaster pg.EventBroadcaster
+	lggr             logger.Logger
+	payloads         *utils.Mailbox[string]
+	chStop           chan struct{}
+	wg               sync.WaitGroup
+}
+
+// newNodeStateNotifier starts the worker of a nodeStateNotifier, which must be
+// closed. It returns nil if eventBroadcaster is nil.
+func newNodeStateNotifier(eventBroadcaster pg.EventBroadcaster, lggr logger.Logger) *nodeStateNotifier {
+	if eventBroadcaster == nil {
+		return nil
+	}
+	n := &nodeStateNotifier{
+		eventBroadcaster: eventBroadcaster,
+		lggr:             lggr,
+		payloads:         utils.NewMailbox[string](1000),
+		chStop:           make(chan struct{}),
+	}
+	n.wg.Add(1)
+	go n.run()
+	return n
+}
+
+// Observer returns the evmclient.NodeStateObserver queueing the changes, or nil
+// if n is nil.
+func (n *nodeStateNotifier) Observer() evmclient.NodeStateObserver {
+	if n == nil {
+		return nil
+	}
+	return n.observe
+}
+
+func (n *nodeStateNotifier) observe(change evmclient.NodeStateChange) {
+	payload, err := json.Marshal(NodeStateChangedPayload{
+		NodeID:        change.NodeID,
+		EVMChainID:    utils.NewBig(change.EVMChainID),
+		State:         change.To.String(),
+		PreviousState: change.From.String(),
+	})
+	if err != nil {
+		n.lggr.Errorw("Failed to marshal node state change", "err", err)
+		return
+	}
+	if wasOverCapacity := n.payloads.Deliver(string(payload)); wasOverCapacity {
+		n.lggr.Warnw("Node state change queue is over capacity, dropped the oldest change", "nodeName", change.NodeName)
+	}
+}
+
+func (n *nodeStateNotifier) run() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case <-n.payloads.Notify():
+			for {
+				payload, exists := n.payloads.Retrieve()
+				if !exists {
+					break
+				}
+				if err := n.eventBroadcaster.Notify(pg.ChannelEVMNodeStateChanged, payload); err != nil {
+					n.lggr.Debugw("Failed to notify node state change", "err", err)
+				}
+			}
+		}
+	}
+}
+
+// Close stops the worker. Queued changes which have not been notified yet are
+// dropped. It is a no-op if n is nil.
+func (n *nodeStateNotifier) Close() {
+	if n == nil {
+		return
+	}
+	close(n.chStop)
+	n.wg.Wait()
+}
+
+// hasHTTPOnlyPrimary reports whether any of the primary nodes has no websocket
+// URL, and so polls for new heads and cannot subscribe to logs.
+func hasHTTPOnlyPrimary(nodes []types.Node) bool {
+	for _, n := range nodes {
+		if !n.SendOnly && !n.WSURL.Valid {
+			return true
+		}
+	}
+	return false
+}
+
 func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n types.Node) (evmclient.Node, error) {
 	if n.SendOnly {
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
-	if !n.WSURL.Valid {
-		return nil, errors.New("primary node was missing WS url")
+	if !n.WSURL.Valid && !n.HTTPURL.Valid {
+		return nil, errors.New("primary node was missing both WS and HTTP urls")
 	}
-	wsuri, err := url.Parse(n.WSURL.String)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid websocket uri")
+	var wsuri *url.URL
+	if n.WSURL.Valid {
+		u, err := url.Parse(n.WSURL.String)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid websocket uri")
+		}
+		wsuri = u
 	}
 	var httpuri *url.URL
 	if n.HTTPURL.Valid {
@@ -320,7 +484,7 @@ func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n types.Node) (evm
 		httpuri = u
 	}
 
-	return evmclient.NewNode(cfg, lggr, *wsuri, httpuri, n.Name, n.ID, (*big.Int)(&n.EVMChainID)), nil
+	return evmclient.NewNode(cfg, lggr, wsuri, httpuri, n.Name, n.ID, (*big.Int)(&n.EVMChainID)), nil
 }
 
 func newSendOnly(lggr logger.Logger, n types.Node) (evmclient.SendOnlyNode, error) {
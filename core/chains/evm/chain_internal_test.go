@@ -0,0 +1,76 @@
+package evm
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestNodeStateNotifier_NotifiesInOrder(t *testing.T) {
+	t.Parallel()
+
+	eventBroadcaster := pgmocks.NewEventBroadcaster(t)
+	var mu sync.Mutex
+	var notified []NodeStateChangedPayload
+	eventBroadcaster.On("Notify", pg.ChannelEVMNodeStateChanged, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		var payload NodeStateChangedPayload
+		require.NoError(t, json.Unmarshal([]byte(args.String(1)), &payload))
+		mu.Lock()
+		defer mu.Unlock()
+		notified = append(notified, payload)
+	})
+
+	n := newNodeStateNotifier(eventBroadcaster, logger.TestLogger(t))
+	t.Cleanup(n.Close)
+	observe := n.Observer()
+
+	states := []evmclient.NodeState{
+		evmclient.NodeStateUndialed,
+		evmclient.NodeStateDialed,
+		evmclient.NodeStateAlive,
+		evmclient.NodeStateUnreachable,
+		evmclient.NodeStateDialed,
+		evmclient.NodeStateAlive,
+	}
+	for i := 1; i < len(states); i++ {
+		observe(evmclient.NodeStateChange{NodeID: 1, NodeName: "primary", EVMChainID: big.NewInt(42), From: states[i-1], To: states[i]})
+	}
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(notified) == len(states)-1
+	}, testutils.WaitTimeout(t), testutils.TestInterval)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i, payload := range notified {
+		assert.Equal(t, NodeStateChangedPayload{
+			NodeID:        1,
+			EVMChainID:    utils.NewBigI(42),
+			State:         states[i+1].String(),
+			PreviousState: states[i].String(),
+		}, payload)
+	}
+}
+
+func TestNodeStateNotifier_NilEventBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	n := newNodeStateNotifier(nil, logger.TestLogger(t))
+	assert.Nil(t, n)
+	assert.Nil(t, n.Observer())
+	n.Close()
+}
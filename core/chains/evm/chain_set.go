@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -89,6 +90,22 @@ func (cll *chainSet) Start(ctx context.Context) error {
 	cll.logger.Infow(fmt.Sprintf("EVM: Started %d/%d chains, default chain ID is %s", len(cll.startedChains), len(cll.Chains()), cll.defaultID.String()), "startedEvmChainIDs", evmChainIDs)
 	return nil
 }
+
+// Warm dials the RPC nodes of all chains, see services.Warmer.
+func (cll *chainSet) Warm(ctx context.Context) error {
+	if !cll.opts.Config.EVMEnabled() {
+		return nil
+	}
+	cll.chainsMu.RLock()
+	defer cll.chainsMu.RUnlock()
+	for _, c := range cll.chains {
+		if err := c.Warm(ctx); err != nil {
+			cll.logger.Errorw("EVM: failed to warm up chain", "evmChainID", c.ID(), "err", err)
+		}
+	}
+	return nil
+}
+
 func (cll *chainSet) Close() (err error) {
 	cll.logger.Debug("EVM: stopping")
 	for _, c := range cll.startedChains {
@@ -368,6 +385,7 @@ type ChainSetOpts struct {
 	KeyStore         keystore.Eth
 	EventBroadcaster pg.EventBroadcaster
 	ORM              types.ORM
+	Notifier         notifications.Notifier
 
 	// Gen-functions are useful for dependency injection by tests
 	GenEthClient      func(types.DBChain) evmclient.Client
@@ -437,6 +455,9 @@ func checkOpts(opts *ChainSetOpts) error {
 	if opts.ORM == nil {
 		opts.ORM = NewORM(opts.DB, opts.Logger, opts.Config)
 	}
+	if opts.Notifier == nil {
+		opts.Notifier = notifications.NullNotifier{}
+	}
 	return nil
 }
 
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var _ ethereum.Subscription = &httpPollingSub{}
+
+// errPollFilterLogs is returned for log subscriptions on nodes without a
+// websocket URL. Polling eth_getLogs would not notice logs removed by re-orgs,
+// so logs must be read from the LogPoller instead, which handles them.
+var errPollFilterLogs = errors.New("log subscriptions are not supported on nodes without a websocket URL, set LogBroadcasterUseLogPoller (ETH_LOG_BROADCASTER_USE_LOG_POLLER) to read logs from the log poller instead")
+
+// pollMaxSkippedHeads is the maximum number of heads of blocks mined in
+// between polls which are fetched on a poll. Heads beyond that are left to the
+// head tracker's backfill.
+const pollMaxSkippedHeads = 10
+
+// httpPollingSub is a subscription fed by polling the HTTP endpoint of a node
+// which has no websocket URL. Polling errors are logged and retried on the
+// next poll, so the error channel only closes on Unsubscribe; the liveness
+// checks of the node lifecycle take care of endpoints which stop responding.
+type httpPollingSub struct {
+	chStop chan struct{}
+	err    chan error
+	once   sync.Once
+}
+
+func newHTTPPollingSub() *httpPollingSub {
+	return &httpPollingSub{
+		chStop: make(chan struct{}),
+		err:    make(chan error),
+	}
+}
+
+func (s *httpPollingSub) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.chStop)
+		// the error channel must be closed when unsubscribing
+		close(s.err)
+	})
+}
+
+func (s *httpPollingSub) Err() <-chan error {
+	return s.err
+}
+
+// pollSubscribe synthesizes the eth_subscribe subscriptions supported by
+// polling, which is only newHeads.
+func (n *node) pollSubscribe(ch chan<- *evmtypes.Head, args ...interface{}) (ethereum.Subscription, error) {
+	if len(args) != 1 || args[0] != "newHeads" {
+		return nil, errors.Errorf("unsupported subscription %v: only newHeads can be polled on nodes without a websocket URL", args)
+	}
+	return n.pollNewHeads(ch), nil
+}
+
+// pollNewHeads polls eth_blockNumber every NodeHTTPPollInterval, and sends
+// the head of each new block to ch, including the heads of up to
+// pollMaxSkippedHeads blocks mined in between polls.
+func (n *node) pollNewHeads(ch chan<- *evmtypes.Head) ethereum.Subscription {
+	sub := newHTTPPollingSub()
+	lggr := n.rpcLog.Named("HTTPPoller").With("subscription", "newHeads")
+	// copied, since redialling replaces the clients while a poll of an
+	// unsubscribed subscription may still be in flight
+	http := *n.http
+	var latest *big.Int
+	go n.poll(sub, lggr, func(ctx context.Context) error {
+		heads, err := n.pollHeadsAfter(ctx, http, latest)
+		for _, head := range heads {
+			select {
+			case ch <- head:
+			case <-sub.chStop:
+				return nil
+			case <-n.chStop:
+				return nil
+			}
+			latest = big.NewInt(head.Number)
+		}
+		return err
+	})
+	return sub
+}
+
+// poll calls pollFn every NodeHTTPPollInterval until sub is unsubscribed or
+// the node is closed.
+func (n *node) poll(sub *httpPollingSub, lggr logger.Logger, pollFn func(ctx context.Context) error) {
+	ticker := time.NewTicker(n.cfg.NodeHTTPPollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sub.chStop:
+			return
+		case <-n.chStop:
+			return
+		case <-ticker.C:
+			ctx, cancel := n.makeQueryCtx(context.Background())
+			err := pollFn(ctx)
+			cancel()
+			if err != nil {
+				lggr.Debugw("HTTP poll failed, retrying on next poll", "err", err)
+			}
+		}
+	}
+}
+
+// pollBlockNumber returns the latest block number. It calls the HTTP endpoint
+// directly, so that out-of-sync nodes can be polled as well.
+func (n *node) pollBlockNumber(ctx context.Context, http rawclient) (*big.Int, error) {
+	var num hexutil.Big
+	if err := http.rpc.CallContext(ctx, &num, "eth_blockNumber"); err != nil {
+		return nil, n.wrapHTTP(err)
+	}
+	return num.ToInt(), nil
+}
+
+// pollHeadsAfter returns the heads of the blocks after after up to the
+// latest block in ascending order, or only the head of the latest block if
+// after is nil. If a head is not available on this endpoint yet, the heads
+// before it are returned and the rest is left to the next poll.
+func (n *node) pollHeadsAfter(ctx context.Context, http rawclient, after *big.Int) (heads []*evmtypes.Head, err error) {
+	latest, err := n.pollBlockNumber(ctx, http)
+	if err != nil {
+		return nil, err
+	}
+	from := latest
+	if after != nil {
+		if latest.Cmp(after) <= 0 {
+			return nil, nil
+		}
+		from = new(big.Int).Add(after, big.NewInt(1))
+		if oldest := new(big.Int).Sub(latest, big.NewInt(pollMaxSkippedHeads)); from.Cmp(oldest) < 0 {
+			from = oldest
+		}
+	}
+	for num := from; num.Cmp(latest) <= 0; num = new(big.Int).Add(num, big.NewInt(1)) {
+		var head *evmtypes.Head
+		if err = http.rpc.CallContext(ctx, &head, "eth_getBlockByNumber", hexutil.EncodeBig(num), false); err != nil {
+			return heads, n.wrapHTTP(err)
+		}
+		if head == nil {
+			break
+		}
+		heads = append(heads, head)
+	}
+	return heads, nil
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// httpTestChain is the chain served to nodes from newHTTPOnlyTestNode.
+type httpTestChain struct {
+	latest atomic.Int64
+}
+
+// newHTTPOnlyTestNode returns a node without a websocket URL, backed by an
+// HTTP server serving chain.
+func newHTTPOnlyTestNode(t *testing.T, cfg NodeConfig, chain *httpTestChain) *node {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		var result string
+		switch req.Method {
+		case "eth_chainId":
+			result = fmt.Sprintf(`"%s"`, testutils.IntToHex(int(testutils.FixtureChainID.Int64())))
+		case "eth_blockNumber":
+			result = fmt.Sprintf(`"%s"`, testutils.IntToHex(int(chain.latest.Load())))
+		case "eth_getBlockByNumber":
+			var num hexutil.Uint64
+			require.NoError(t, json.Unmarshal(req.Params[0], &num))
+			result = makeHeadResult(int(num))
+		case "web3_clientVersion":
+			result = `"test"`
+		default:
+			t.Errorf("unexpected RPC method: %s", req.Method)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result)
+		assert.NoError(t, err)
+	}))
+	t.Cleanup(ts.Close)
+
+	httpURL, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+	iN := NewNode(cfg, logger.TestLogger(t), nil, httpURL, "test node", 42, testutils.FixtureChainID)
+	return iN.(*node)
+}
+
+func TestNode_HTTPOnly(t *testing.T) {
+	t.Parallel()
+
+	cfg := TestNodeConfig{HTTPPollInterval: 10 * time.Millisecond}
+
+	t.Run("polls new heads", func(t *testing.T) {
+		chain := &httpTestChain{}
+		chain.latest.Store(10)
+		n := newHTTPOnlyTestNode(t, cfg, chain)
+		require.NoError(t, n.Start(testutils.Context(t)))
+		defer n.Close()
+		require.Equal(t, NodeStateAlive, n.State())
+
+		ch := make(chan *evmtypes.Head)
+		sub, err := n.EthSubscribe(testutils.Context(t), ch, "newHeads")
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		select {
+		case head := <-ch:
+			assert.Equal(t, int64(10), head.Number)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for head")
+		}
+
+		// heads of blocks mined in between polls are sent as well
+		chain.latest.Store(12)
+		for _, expected := range []int64{11, 12} {
+			select {
+			case head := <-ch:
+				assert.Equal(t, expected, head.Number)
+			case <-time.After(testutils.WaitTimeout(t)):
+				t.Fatal("timed out waiting for head")
+			}
+		}
+
+		// but only the latest ones, if many were
+		chain.latest.Store(30)
+		select {
+		case head := <-ch:
+			assert.Equal(t, int64(30-pollMaxSkippedHeads), head.Number)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for head")
+		}
+
+		_, err = n.EthSubscribe(testutils.Context(t), ch, "newPendingTransactions")
+		require.EqualError(t, err, "unsupported subscription [newPendingTransactions]: only newHeads can be polled on nodes without a websocket URL")
+	})
+
+	t.Run("refuses log subscriptions", func(t *testing.T) {
+		chain := &httpTestChain{}
+		chain.latest.Store(10)
+		n := newHTTPOnlyTestNode(t, cfg, chain)
+		require.NoError(t, n.Start(testutils.Context(t)))
+		defer n.Close()
+
+		_, err := n.SubscribeFilterLogs(testutils.Context(t), ethereum.FilterQuery{}, make(chan types.Log))
+		require.ErrorIs(t, err, errPollFilterLogs)
+	})
+
+	t.Run("goes out of sync and back without websocket", func(t *testing.T) {
+		chain := &httpTestChain{}
+		chain.latest.Store(10)
+		n := newHTTPOnlyTestNode(t, TestNodeConfig{
+			NoNewHeadsThreshold: 20 * cfg.HTTPPollInterval,
+			HTTPPollInterval:    cfg.HTTPPollInterval,
+		}, chain)
+		require.NoError(t, n.Start(testutils.Context(t)))
+		defer n.Close()
+
+		testutils.AssertEventually(t, func() bool {
+			return n.State() == NodeStateOutOfSync
+		})
+
+		chain.latest.Store(11)
+		testutils.AssertEventually(t, func() bool {
+			return n.State() == NodeStateAlive
+		})
+	})
+}
@@ -120,10 +120,11 @@ type rawclient struct {
 }
 
 // Node represents one ethereum node.
-// It must have a ws url and may have a http url
+// It must have a ws url, a http url, or both. Nodes without a ws url poll the
+// http url in place of subscriptions.
 type node struct {
 	utils.StartStopOnce
-	ws      rawclient
+	ws      *rawclient
 	http    *rawclient
 	lfcLog  logger.Logger
 	rpcLog  logger.Logger
@@ -154,6 +155,31 @@ type node struct {
 	// moved to out-of-sync state. It is better to have one out-of-sync node
 	// than no nodes at all.
 	nLiveNodes func() int
+
+	// stateObserver is called on every state transition, if set
+	stateObserver NodeStateObserver
+}
+
+// NodeStateChange is a state transition of a primary node
+type NodeStateChange struct {
+	NodeID     int32
+	NodeName   string
+	EVMChainID *big.Int
+	From       NodeState
+	To         NodeState
+}
+
+// NodeStateObserver is called on every state transition of a node. It is
+// called while the node's state is locked, so it must not block.
+type NodeStateObserver func(NodeStateChange)
+
+// SetNodeStateObserver sets the observer of a node's state transitions. It
+// must be called before the node is started, and has no effect on nodes which
+// were not created by NewNode.
+func SetNodeStateObserver(n Node, observer NodeStateObserver) {
+	if rawNode, ok := n.(*node); ok {
+		rawNode.stateObserver = observer
+	}
 }
 
 // NodeConfig allows configuration of the node
@@ -161,16 +187,20 @@ type NodeConfig interface {
 	NodeNoNewHeadsThreshold() time.Duration
 	NodePollFailureThreshold() uint32
 	NodePollInterval() time.Duration
+	NodeHTTPPollInterval() time.Duration
 }
 
-// NewNode returns a new *node as Node
-func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
+// NewNode returns a new *node as Node. If wsuri is nil, heads and logs are
+// polled from httpuri every NodeHTTPPollInterval instead of subscribed to.
+func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri *url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
 	n := new(node)
 	n.name = name
 	n.id = id
 	n.chainID = chainID
 	n.cfg = nodeCfg
-	n.ws.uri = wsuri
+	if wsuri != nil {
+		n.ws = &rawclient{uri: *wsuri}
+	}
 	if httpuri != nil {
 		n.http = &rawclient{uri: *httpuri}
 	}
@@ -239,16 +269,23 @@ func (n *node) dial(callerCtx context.Context) error {
 	defer cancel()
 
 	promEVMPoolRPCNodeDials.WithLabelValues(n.chainID.String(), n.name).Inc()
-	lggr := n.lfcLog.With("wsuri", n.ws.uri.Redacted())
+	lggr := n.lfcLog
+	if n.ws != nil {
+		lggr = lggr.With("wsuri", n.ws.uri.Redacted())
+	}
 	if n.http != nil {
 		lggr = lggr.With("httpuri", n.http.uri.Redacted())
 	}
 	lggr.Debugw("RPC dial: evmclient.Client#dial")
 
-	wsrpc, err := rpc.DialWebsocket(ctx, n.ws.uri.String(), "")
-	if err != nil {
-		promEVMPoolRPCNodeDialsFailed.WithLabelValues(n.chainID.String(), n.name).Inc()
-		return errors.Wrapf(err, "error while dialing websocket: %v", n.ws.uri.Redacted())
+	var wsrpc *rpc.Client
+	var err error
+	if n.ws != nil {
+		wsrpc, err = rpc.DialWebsocket(ctx, n.ws.uri.String(), "")
+		if err != nil {
+			promEVMPoolRPCNodeDialsFailed.WithLabelValues(n.chainID.String(), n.name).Inc()
+			return errors.Wrapf(err, "error while dialing websocket: %v", n.ws.uri.Redacted())
+		}
 	}
 
 	var httprpc *rpc.Client
@@ -260,8 +297,10 @@ func (n *node) dial(callerCtx context.Context) error {
 		}
 	}
 
-	n.ws.rpc = wsrpc
-	n.ws.geth = ethclient.NewClient(wsrpc)
+	if n.ws != nil {
+		n.ws.rpc = wsrpc
+		n.ws.geth = ethclient.NewClient(wsrpc)
+	}
 
 	if n.http != nil {
 		n.http.rpc = httprpc
@@ -296,18 +335,20 @@ func (n *node) verify(callerCtx context.Context) (err error) {
 	}
 
 	var chainID *big.Int
-	if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
-		promFailed()
-		return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
-	} else if chainID.Cmp(n.chainID) != 0 {
-		promFailed()
-		return errors.Wrapf(
-			errInvalidChainID,
-			"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
-			chainID.String(),
-			n.chainID.String(),
-			n.name,
-		)
+	if n.ws != nil {
+		if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
+			promFailed()
+			return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
+		} else if chainID.Cmp(n.chainID) != 0 {
+			promFailed()
+			return errors.Wrapf(
+				errInvalidChainID,
+				"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
+				chainID.String(),
+				n.chainID.String(),
+				n.name,
+			)
+		}
 	}
 	if n.http != nil {
 		if chainID, err = n.http.geth.ChainID(ctx); err != nil {
@@ -339,8 +380,8 @@ func (n *node) Close() {
 
 		close(n.chStop)
 		n.cancelInflightRequests()
-		n.state = NodeStateClosed
-		if n.ws.rpc != nil {
+		n.changeState(NodeStateClosed)
+		if n.ws != nil && n.ws.rpc != nil {
 			n.ws.rpc.Close()
 		}
 		return nil
@@ -361,7 +402,7 @@ func (n *node) registerSub(sub ethereum.Subscription) {
 // WARNING: NOT THREAD-SAFE
 // This must be called from within the n.stateMu lock
 func (n *node) disconnectAll() {
-	if n.ws.rpc != nil {
+	if n.ws != nil && n.ws.rpc != nil {
 		n.ws.rpc.Close()
 	}
 	n.cancelInflightRequests()
@@ -457,11 +498,16 @@ func (n *node) EthSubscribe(ctx context.Context, channel chan<- *evmtypes.Head,
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr("websocket").With("args", args)
+	lggr := n.newRqLggr(subscriptionMode(n)).With("args", args)
 
 	lggr.Debug("RPC call: evmclient.Client#EthSubscribe")
 	start := time.Now()
-	sub, err := n.ws.rpc.EthSubscribe(ctx, channel, args...)
+	var sub ethereum.Subscription
+	if n.ws == nil {
+		sub, err = n.pollSubscribe(channel, args...)
+	} else {
+		sub, err = n.ws.rpc.EthSubscribe(ctx, channel, args...)
+	}
 	if err == nil {
 		n.registerSub(sub)
 	}
@@ -846,15 +892,19 @@ func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery,
 		return nil, err
 	}
 	defer cancel()
-	lggr := n.newRqLggr("websocket").With("q", q)
+	lggr := n.newRqLggr(subscriptionMode(n)).With("q", q)
 
 	lggr.Debug("RPC call: evmclient.Client#SubscribeFilterLogs")
 	start := time.Now()
-	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
+	if n.ws == nil {
+		err = errPollFilterLogs
+	} else {
+		sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
+		err = n.wrapWS(err)
+	}
 	if err == nil {
 		n.registerSub(sub)
 	}
-	err = n.wrapWS(err)
 	duration := time.Since(start)
 
 	n.logResult(lggr, err, duration, n.getRPCDomain(), "SubscribeFilterLogs")
@@ -1001,8 +1051,18 @@ func switching(n *node) string {
 	return "websocket"
 }
 
+func subscriptionMode(n *node) string {
+	if n.ws == nil {
+		return "http"
+	}
+	return "websocket"
+}
+
 func (n *node) String() string {
-	s := fmt.Sprintf("(primary)%s:%s", n.name, n.ws.uri.Redacted())
+	s := fmt.Sprintf("(primary)%s", n.name)
+	if n.ws != nil {
+		s = s + fmt.Sprintf(":%s", n.ws.uri.Redacted())
+	}
 	if n.http != nil {
 		s = s + fmt.Sprintf(":%s", n.http.uri.Redacted())
 	}
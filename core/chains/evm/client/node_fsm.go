@@ -108,7 +108,23 @@ func (n *node) State() NodeState {
 func (n *node) setState(s NodeState) {
 	n.stateMu.Lock()
 	defer n.stateMu.Unlock()
+	n.changeState(s)
+}
+
+// changeState sets the state and reports the transition to the state observer.
+// This must be called from within the n.stateMu lock
+func (n *node) changeState(s NodeState) {
+	from := n.state
 	n.state = s
+	if n.stateObserver != nil && from != s {
+		n.stateObserver(NodeStateChange{
+			NodeID:     n.id,
+			NodeName:   n.name,
+			EVMChainID: n.chainID,
+			From:       from,
+			To:         s,
+		})
+	}
 }
 
 // declareXXX methods change the state and pass conrol off the new state
@@ -131,7 +147,7 @@ func (n *node) transitionToAlive(fn func()) {
 	}
 	switch n.state {
 	case NodeStateDialed, NodeStateInvalidChainID:
-		n.state = NodeStateAlive
+		n.changeState(NodeStateAlive)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -158,7 +174,7 @@ func (n *node) transitionToInSync(fn func()) {
 	}
 	switch n.state {
 	case NodeStateOutOfSync:
-		n.state = NodeStateAlive
+		n.changeState(NodeStateAlive)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -185,7 +201,7 @@ func (n *node) transitionToOutOfSync(fn func()) {
 	switch n.state {
 	case NodeStateAlive:
 		n.disconnectAll()
-		n.state = NodeStateOutOfSync
+		n.changeState(NodeStateOutOfSync)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateOutOfSync))
 	}
@@ -210,7 +226,7 @@ func (n *node) transitionToUnreachable(fn func()) {
 	switch n.state {
 	case NodeStateUndialed, NodeStateDialed, NodeStateAlive, NodeStateOutOfSync, NodeStateInvalidChainID:
 		n.disconnectAll()
-		n.state = NodeStateUnreachable
+		n.changeState(NodeStateUnreachable)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateUnreachable))
 	}
@@ -235,7 +251,7 @@ func (n *node) transitionToInvalidChainID(fn func()) {
 	switch n.state {
 	case NodeStateDialed, NodeStateOutOfSync:
 		n.disconnectAll()
-		n.state = NodeStateInvalidChainID
+		n.changeState(NodeStateInvalidChainID)
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateInvalidChainID))
 	}
@@ -44,7 +44,7 @@ func TestUnit_Node_StateTransitions(t *testing.T) {
 	s := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (string, string) {
 		return "", ""
 	})
-	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, nil)
+	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, nil)
 	n := iN.(*node)
 
 	assert.Equal(t, NodeStateUndialed, n.State())
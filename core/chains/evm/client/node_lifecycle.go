@@ -237,7 +237,12 @@ func (n *node) outOfSyncLoop(stuckAtBlockNumber int64) {
 	ch := make(chan *evmtypes.Head)
 	subCtx, cancel := n.makeQueryCtx(context.Background())
 	// raw call here to bypass node state checking
-	sub, err := n.ws.rpc.EthSubscribe(subCtx, ch, "newHeads")
+	var sub ethereum.Subscription
+	if n.ws == nil {
+		sub = n.pollNewHeads(ch)
+	} else {
+		sub, err = n.ws.rpc.EthSubscribe(subCtx, ch, "newHeads")
+	}
 	cancel()
 	if err != nil {
 		lggr.Errorw("Failed to subscribe heads on out-of-sync RPC node", "nodeState", n.State(), "err", err)
@@ -27,7 +27,7 @@ func newTestNode(t *testing.T, cfg NodeConfig) *node {
 
 func newTestNodeWithCallback(t *testing.T, cfg NodeConfig, callback testutils.JSONRPCHandler) *node {
 	s := testutils.NewWSServer(t, testutils.FixtureChainID, callback)
-	iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+	iN := NewNode(cfg, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 	n := iN.(*node)
 	return n
 }
@@ -196,7 +196,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -239,7 +239,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -272,7 +272,7 @@ func TestUnit_NodeLifecycle_aliveLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(pollDisabledCfg, lggr, *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(pollDisabledCfg, lggr, s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 		n := iN.(*node)
 		n.nLiveNodes = func() int { return 1 }
 		dial(t, n)
@@ -338,7 +338,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 		n := iN.(*node)
 
 		dial(t, n)
@@ -384,7 +384,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, testutils.FixtureChainID)
+		iN := NewNode(cfg, lggr, s.WSURL(), nil, "test node", 0, testutils.FixtureChainID)
 		n := iN.(*node)
 
 		start(t, n)
@@ -434,7 +434,7 @@ func TestUnit_NodeLifecycle_outOfSyncLoop(t *testing.T) {
 				return "", ""
 			})
 
-		iN := NewNode(cfg, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
+		iN := NewNode(cfg, logger.TestLogger(t), s.WSURL(), nil, "test node", 42, testutils.FixtureChainID)
 		n := iN.(*node)
 		n.nLiveNodes = func() int { return 0 }
 
@@ -488,7 +488,7 @@ func TestUnit_NodeLifecycle_unreachableLoop(t *testing.T) {
 		cfg := TestNodeConfig{}
 		s := testutils.NewWSServer(t, testutils.FixtureChainID, standardHandler)
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, s.WSURL(), nil, "test node", 0, big.NewInt(42))
 		n := iN.(*node)
 		defer n.Close()
 		start(t, n)
@@ -507,7 +507,7 @@ func TestUnit_NodeLifecycle_unreachableLoop(t *testing.T) {
 	t.Run("on failed redial, keeps trying to redial", func(t *testing.T) {
 		cfg := TestNodeConfig{}
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.DebugLevel)
-		iN := NewNode(cfg, lggr, *testutils.MustParseURL(t, "ws://test.invalid"), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, testutils.MustParseURL(t, "ws://test.invalid"), nil, "test node", 0, big.NewInt(42))
 		n := iN.(*node)
 		defer n.Close()
 		start(t, n)
@@ -557,7 +557,7 @@ func TestUnit_NodeLifecycle_invalidChainIDLoop(t *testing.T) {
 		cfg := TestNodeConfig{}
 		s := testutils.NewWSServer(t, testutils.FixtureChainID, standardHandler)
 		lggr, observedLogs := logger.TestLoggerObserved(t, zap.ErrorLevel)
-		iN := NewNode(cfg, lggr, *s.WSURL(), nil, "test node", 0, big.NewInt(42))
+		iN := NewNode(cfg, lggr, s.WSURL(), nil, "test node", 0, big.NewInt(42))
 		n := iN.(*node)
 		defer n.Close()
 		dial(t, n)
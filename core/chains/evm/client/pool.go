@@ -41,6 +41,23 @@ type Pool struct {
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
+
+	// noLiveNodesObserver is called when no primary nodes are alive, if set
+	noLiveNodesObserver NoLiveNodesObserver
+}
+
+// NoLiveNodesObserver is called whenever the pool reports that none of its
+// primary nodes are alive, with the state of each node by name. It must not
+// block.
+type NoLiveNodesObserver func(chainID *big.Int, nodeStates map[string]string)
+
+// SetNoLiveNodesObserver sets the observer called when none of the client's
+// primary nodes are alive. It must be called before the client is dialed, and
+// has no effect on clients which were not created by NewClientWithNodes.
+func SetNoLiveNodesObserver(c Client, observer NoLiveNodesObserver) {
+	if cl, ok := c.(*client); ok {
+		cl.pool.noLiveNodesObserver = observer
+	}
 }
 
 func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
@@ -56,6 +73,7 @@ func NewPool(logger logger.Logger, nodes []Node, sendonlys []SendOnlyNode, chain
 		logger.Named("Pool").With("evmChainID", chainID.String()),
 		make(chan struct{}),
 		sync.WaitGroup{},
+		nil,
 	}
 	return p
 }
@@ -157,6 +175,13 @@ func (p *Pool) report() {
 	p.logger.Tracew(fmt.Sprintf("Pool state: %d/%d nodes are alive", live, total), "nodeStates", nodeStates)
 	if total == dead {
 		p.logger.Criticalw(fmt.Sprintf("No EVM primary nodes available: 0/%d nodes are alive", total), "nodeStates", nodeStates)
+		if p.noLiveNodesObserver != nil {
+			states := make(map[string]string, len(nodeStates))
+			for _, ns := range nodeStates {
+				states[ns.Node] = ns.State
+			}
+			p.noLiveNodesObserver(p.chainID, states)
+		}
 	} else if dead > 0 {
 		p.logger.Errorw(fmt.Sprintf("At least one EVM primary node is dead: %d/%d nodes are alive", live, total), "nodeStates", nodeStates)
 	}
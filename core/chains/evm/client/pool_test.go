@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -197,7 +198,7 @@ func (r *chainIDResps) newNode(t *testing.T, nodeChainID int64) evmclient.Node {
 	}
 
 	defer func() { r.id++ }()
-	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID))
+	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID))
 }
 
 type chainIDService struct {
@@ -275,6 +276,36 @@ func TestUnit_Pool_RunLoop(t *testing.T) {
 		})
 	})
 
+	t.Run("notifies the observer when no nodes are alive", func(t *testing.T) {
+		n1 := new(evmmocks.Node)
+		n1.Test(t)
+		n1.On("String").Maybe().Return("n1")
+		n1.On("Close").Maybe()
+		n1.On("Start", mock.Anything).Return(nil).Once()
+		n1.On("State").Return(evmclient.NodeStateUnreachable)
+		n1.On("ChainID").Return(testutils.FixtureChainID).Once()
+
+		c, err := evmclient.NewClientWithNodes(logger.TestLogger(t), []evmclient.Node{n1}, nil, &cltest.FixtureChainID)
+		require.NoError(t, err)
+		observed := make(chan map[string]string, 1)
+		evmclient.SetNoLiveNodesObserver(c, func(chainID *big.Int, nodeStates map[string]string) {
+			assert.Equal(t, &cltest.FixtureChainID, chainID)
+			select {
+			case observed <- nodeStates:
+			default:
+			}
+		})
+
+		require.NoError(t, c.Dial(context.Background()))
+		defer c.Close()
+
+		select {
+		case nodeStates := <-observed:
+			assert.Equal(t, map[string]string{"n1": "Unreachable"}, nodeStates)
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the observer")
+		}
+	})
 }
 
 func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
@@ -34,6 +34,9 @@ type (
 		ethTxReaperInterval                            time.Duration
 		ethTxReaperThreshold                           time.Duration
 		ethTxResendAfterThreshold                      time.Duration
+		feeHistoryEstimatorBaseFeeMultiplier           float32
+		feeHistoryEstimatorBlockCount                  uint16
+		feeHistoryEstimatorRewardPercentile            uint16
 		finalityDepth                                  uint32
 		flagsContractAddress                           string
 		gasBumpPercent                                 uint16
@@ -54,6 +57,7 @@ type (
 		linkContractAddress                            string
 		operatorFactoryAddress                         string
 		logBackfillBatchSize                           uint32
+		logBroadcasterUseLogPoller                     bool
 		logPollInterval                                time.Duration
 		maxGasPriceWei                                 big.Int
 		maxInFlightTransactions                        uint32
@@ -62,6 +66,7 @@ type (
 		minIncomingConfirmations                       uint32
 		minimumContractPayment                         *assets.Link
 		nodeDeadAfterNoNewHeadersThreshold             time.Duration
+		nodeHTTPPollInterval                           time.Duration
 		nodePollFailureThreshold                       uint32
 		nodePollInterval                               time.Duration
 
@@ -112,6 +117,9 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperInterval:                   1 * time.Hour,
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
+		feeHistoryEstimatorBaseFeeMultiplier:  1.25,
+		feeHistoryEstimatorBlockCount:         8,
+		feeHistoryEstimatorRewardPercentile:   60,
 		finalityDepth:                         50,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
@@ -131,6 +139,7 @@ func setChainSpecificConfigDefaultSets() {
 		linkContractAddress:                   "",
 		operatorFactoryAddress:                "",
 		logBackfillBatchSize:                  100,
+		logBroadcasterUseLogPoller:            false,
 		logPollInterval:                       15 * time.Second,
 		maxGasPriceWei:                        *assets.GWei(100000),
 		maxInFlightTransactions:               16,
@@ -139,6 +148,7 @@ func setChainSpecificConfigDefaultSets() {
 		minIncomingConfirmations:              3,
 		minimumContractPayment:                DefaultMinimumContractPayment,
 		nodeDeadAfterNoNewHeadersThreshold:    3 * time.Minute,
+		nodeHTTPPollInterval:                  time.Second,
 		nodePollFailureThreshold:              5,
 		nodePollInterval:                      10 * time.Second,
 		nonceAutoSync:                         true,
@@ -56,6 +56,7 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
+	EvmLogBroadcasterUseLogPoller() bool
 	EvmLogPollInterval() time.Duration
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
@@ -63,7 +64,11 @@ type ChainScopedOnlyConfig interface {
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
+	EvmForwarderOwners() []gethcommon.Address
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBaseFeeMultiplier() float32
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
 	GasEstimatorMode() string
 	ChainType() config.ChainType
@@ -180,9 +185,23 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.EvmHeadTrackerHistoryDepth() < c.EvmFinalityDepth() {
 		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_HISTORY_DEPTH must be equal to or greater than ETH_FINALITY_DEPTH"))
 	}
+	if c.NodeHTTPPollInterval() <= 0 {
+		err = multierr.Combine(err, errors.Errorf("NODE_HTTP_POLL_INTERVAL must be greater than zero, got: %s", c.NodeHTTPPollInterval()))
+	}
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" {
+		if n := c.FeeHistoryEstimatorBlockCount(); n < 1 || n > 1024 {
+			err = multierr.Combine(err, errors.Errorf("FEE_HISTORY_ESTIMATOR_BLOCK_COUNT must be between 1 and 1024 if fee history estimator is enabled, got: %d", n))
+		}
+		if p := c.FeeHistoryEstimatorRewardPercentile(); p > 100 {
+			err = multierr.Combine(err, errors.Errorf("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be less than or equal to 100, got: %d", p))
+		}
+		if m := c.FeeHistoryEstimatorBaseFeeMultiplier(); m < 1 {
+			err = multierr.Combine(err, errors.Errorf("FEE_HISTORY_ESTIMATOR_BASE_FEE_MULTIPLIER must be greater than or equal to 1, got: %v", m))
+		}
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
@@ -674,6 +693,41 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// FeeHistoryEstimatorBaseFeeMultiplier is the factor by which the FeeHistory
+// estimator multiplies the base fee of the next block, to allow for base fee
+// increases before the transaction is included
+func (c *chainScopedConfig) FeeHistoryEstimatorBaseFeeMultiplier() float32 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBaseFeeMultiplier()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBaseFeeMultiplier", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBaseFeeMultiplier
+}
+
+// FeeHistoryEstimatorBlockCount is the number of most recent blocks the
+// FeeHistory estimator requests with eth_feeHistory
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockCount()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockCount", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockCount
+}
+
+// FeeHistoryEstimatorRewardPercentile is the percentile of the priority fees
+// paid in each block that the FeeHistory estimator requests, and the
+// percentile of those values across blocks that it uses as tip cap
+func (c *chainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorRewardPercentile()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorRewardPercentile", val)
+		return val
+	}
+	return c.defaultSet.feeHistoryEstimatorRewardPercentile
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -763,7 +817,7 @@ func (c *chainScopedConfig) OperatorFactoryAddress() string {
 		c.logPersistedOverrideOnce("OperatorFactoryAddress", p.String)
 		return p.String
 	}
-	return c.defaultSet.linkContractAddress
+	return c.defaultSet.operatorFactoryAddress
 }
 
 // MinIncomingConfirmations represents the minimum number of block
@@ -875,6 +929,25 @@ func (c *chainScopedConfig) EvmUseForwarders() bool {
 	return c.defaultSet.useForwarders
 }
 
+// EvmForwarderOwners are the addresses, besides the keys of this node, whose
+// forwarders deployed by the operator factory are discovered. Typically the
+// operator contracts of the node operator.
+func (c *chainScopedConfig) EvmForwarderOwners() []gethcommon.Address {
+	val, ok := c.GeneralConfig.GlobalEvmForwarderOwners()
+	if ok {
+		c.logEnvOverrideOnce("EvmForwarderOwners", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmForwarderOwners
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmForwarderOwners", p)
+		return p
+	}
+	return nil
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -986,6 +1059,24 @@ func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return c.defaultSet.logBackfillBatchSize
 }
 
+// EvmLogBroadcasterUseLogPoller makes the log broadcaster read logs from the
+// log poller, instead of subscribing to and backfilling logs itself
+func (c *chainScopedConfig) EvmLogBroadcasterUseLogPoller() bool {
+	val, ok := c.GeneralConfig.GlobalEvmLogBroadcasterUseLogPoller()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogBroadcasterUseLogPoller", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmLogBroadcasterUseLogPoller
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmLogBroadcasterUseLogPoller", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.logBroadcasterUseLogPoller
+}
+
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EthConfirmer
 func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
@@ -1137,6 +1228,17 @@ func (c *chainScopedConfig) NodePollInterval() time.Duration {
 	return c.defaultSet.nodePollInterval
 }
 
+// NodeHTTPPollInterval controls how often nodes without a websocket URL are
+// polled for new heads and logs, in place of subscriptions.
+func (c *chainScopedConfig) NodeHTTPPollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalNodeHTTPPollInterval()
+	if ok {
+		c.logEnvOverrideOnce("NodeHTTPPollInterval", val)
+		return val
+	}
+	return c.defaultSet.nodeHTTPPollInterval
+}
+
 func lookupEnv[T any](c *chainScopedConfig, k string, parse func(string) (T, error)) (t T, ok bool) {
 	s, ok := os.LookupEnv(k)
 	if !ok {
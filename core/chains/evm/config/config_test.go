@@ -308,6 +308,39 @@ func Test_chainScopedConfig_Validate(t *testing.T) {
 			assert.Error(t, cfg.Validate())
 		})
 	})
+
+	t.Run("fee-history-estimator", func(t *testing.T) {
+		newConfig := func(t *testing.T) evmconfig.ChainScopedConfig {
+			gcfg := cltest.NewTestGeneralConfig(t)
+			lggr := logger.TestLogger(t)
+			return evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{
+				GasEstimatorMode: null.StringFrom("FeeHistory"),
+			}, nil, lggr, gcfg)
+		}
+		t.Run("defaults", func(t *testing.T) {
+			assert.NoError(t, newConfig(t).Validate())
+		})
+		t.Run("block count", func(t *testing.T) {
+			t.Setenv("FEE_HISTORY_ESTIMATOR_BLOCK_COUNT", "0")
+			assert.Error(t, newConfig(t).Validate())
+		})
+		t.Run("reward percentile", func(t *testing.T) {
+			t.Setenv("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE", "101")
+			assert.Error(t, newConfig(t).Validate())
+		})
+		t.Run("base fee multiplier", func(t *testing.T) {
+			t.Setenv("FEE_HISTORY_ESTIMATOR_BASE_FEE_MULTIPLIER", "0.9")
+			assert.Error(t, newConfig(t).Validate())
+		})
+	})
+
+	t.Run("node-http-poll-interval", func(t *testing.T) {
+		t.Setenv("NODE_HTTP_POLL_INTERVAL", "0s")
+		gcfg := cltest.NewTestGeneralConfig(t)
+		lggr := logger.TestLogger(t)
+		cfg := evmconfig.NewChainScopedConfig(big.NewInt(0), evmtypes.ChainCfg{}, nil, lggr, gcfg)
+		assert.EqualError(t, cfg.Validate(), "NODE_HTTP_POLL_INTERVAL must be greater than zero, got: 0s")
+	})
 }
 
 type fakeChainConfigORM map[string]map[string]string
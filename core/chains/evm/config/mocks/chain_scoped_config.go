@@ -506,6 +506,34 @@ func (_m *ChainScopedConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncrypted provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupEncrypted() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -548,6 +576,34 @@ func (_m *ChainScopedConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupRetentionCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupRetentionCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// DatabaseBackupRetentionMaxAge provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseBackupRetentionMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -564,6 +620,20 @@ func (_m *ChainScopedConfig) DatabaseBackupURL() *url.URL {
 	return r0
 }
 
+// DatabaseHAEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) DatabaseHAEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseListenerMaxReconnectDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) DatabaseListenerMaxReconnectDuration() time.Duration {
 	ret := _m.Called()
@@ -720,6 +790,50 @@ func (_m *ChainScopedConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EthRemoteSignerProtocol provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerProtocol() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerTimeout provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthRemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -762,6 +876,20 @@ func (_m *ChainScopedConfig) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
+// EthTxStuckThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxStuckThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EthereumHTTPURL provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
@@ -850,6 +978,22 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmForwarderOwners provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmForwarderOwners() []common.Address {
+	ret := _m.Called()
+
+	var r0 []common.Address
+	if rf, ok := ret.Get(0).(func() []common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1070,6 +1214,20 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogBroadcasterUseLogPoller provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogBroadcasterUseLogPoller() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmLogPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
 	ret := _m.Called()
@@ -1342,6 +1500,48 @@ func (_m *ChainScopedConfig) FeatureUICSAKeys() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBaseFeeMultiplier() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1671,6 +1871,29 @@ func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmForwarderOwners provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmForwarderOwners() ([]common.Address, bool) {
+	ret := _m.Called()
+
+	var r0 []common.Address
+	if rf, ok := ret.Get(0).(func() []common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -1996,6 +2219,27 @@ func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogBroadcasterUseLogPoller provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmLogPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -2168,6 +2412,69 @@ func (_m *ChainScopedConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return r0, r1
 }
 
+// GlobalFeeHistoryEstimatorBaseFeeMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorBaseFeeMultiplier() (float32, bool) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalFeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalFeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalFlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
@@ -2275,6 +2582,27 @@ func (_m *ChainScopedConfig) GlobalMinimumContractPayment() (*assets.Link, bool)
 	return r0, r1
 }
 
+// GlobalNodeHTTPPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalNodeHTTPPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalNodeNoNewHeadsThreshold provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalNodeNoNewHeadsThreshold() (time.Duration, bool) {
 	ret := _m.Called()
@@ -2457,6 +2785,52 @@ func (_m *ChainScopedConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HTTPTaskOAuth2Providers provides a mock function with given fields:
+func (_m *ChainScopedConfig) HTTPTaskOAuth2Providers() ([]coreconfig.HTTPTaskOAuth2Provider, error) {
+	ret := _m.Called()
+
+	var r0 []coreconfig.HTTPTaskOAuth2Provider
+	if rf, ok := ret.Get(0).(func() []coreconfig.HTTPTaskOAuth2Provider); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coreconfig.HTTPTaskOAuth2Provider)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HTTPTaskTLSIdentities provides a mock function with given fields:
+func (_m *ChainScopedConfig) HTTPTaskTLSIdentities() ([]coreconfig.HTTPTaskTLSIdentity, error) {
+	ret := _m.Called()
+
+	var r0 []coreconfig.HTTPTaskTLSIdentity
+	if rf, ok := ret.Get(0).(func() []coreconfig.HTTPTaskTLSIdentity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coreconfig.HTTPTaskTLSIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *ChainScopedConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -2865,6 +3239,34 @@ func (_m *ChainScopedConfig) LogSQL() bool {
 	return r0
 }
 
+// LogSamplingDebugFirst provides a mock function with given fields:
+func (_m *ChainScopedConfig) LogSamplingDebugFirst() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// LogSamplingDebugThereafter provides a mock function with given fields:
+func (_m *ChainScopedConfig) LogSamplingDebugThereafter() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // LogUnixTimestamps provides a mock function with given fields:
 func (_m *ChainScopedConfig) LogUnixTimestamps() bool {
 	ret := _m.Called()
@@ -2923,6 +3325,20 @@ func (_m *ChainScopedConfig) MinimumContractPayment() *assets.Link {
 	return r0
 }
 
+// NodeHTTPPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeHTTPPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // NodeNoNewHeadsThreshold provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeNoNewHeadsThreshold() time.Duration {
 	ret := _m.Called()
@@ -2965,6 +3381,20 @@ func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
 	return r0
 }
 
+// NotificationsConfigPath provides a mock function with given fields:
+func (_m *ChainScopedConfig) NotificationsConfigPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2BlockchainTimeout provides a mock function with given fields:
 func (_m *ChainScopedConfig) OCR2BlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -3084,6 +3514,20 @@ func (_m *ChainScopedConfig) OCR2MonitoringEndpoint() string {
 	return r0
 }
 
+// OCR2PluginsDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) OCR2PluginsDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2TraceLogging provides a mock function with given fields:
 func (_m *ChainScopedConfig) OCR2TraceLogging() bool {
 	ret := _m.Called()
@@ -15,24 +15,26 @@ import (
 )
 
 type Chain struct {
-	BlockBackfillDepth       *uint32
-	BlockBackfillSkip        *bool
-	ChainType                *string
-	FinalityDepth            *uint32
-	FlagsContractAddress     *ethkey.EIP55Address
-	LinkContractAddress      *ethkey.EIP55Address
-	LogBackfillBatchSize     *uint32
-	LogPollInterval          *models.Duration
-	MaxInFlightTransactions  *uint32
-	MaxQueuedTransactions    *uint32
-	MinIncomingConfirmations *uint32
-	MinimumContractPayment   *assets.Link
-	NonceAutoSync            *bool
-	OperatorFactoryAddress   *ethkey.EIP55Address
-	RPCDefaultBatchSize      *uint32
-	TxReaperInterval         *models.Duration
-	TxReaperThreshold        *models.Duration
-	TxResendAfterThreshold   *models.Duration
+	BlockBackfillDepth         *uint32
+	BlockBackfillSkip          *bool
+	ChainType                  *string
+	FinalityDepth              *uint32
+	FlagsContractAddress       *ethkey.EIP55Address
+	ForwarderOwners            *[]ethkey.EIP55Address
+	LinkContractAddress        *ethkey.EIP55Address
+	LogBackfillBatchSize       *uint32
+	LogBroadcasterUseLogPoller *bool
+	LogPollInterval            *models.Duration
+	MaxInFlightTransactions    *uint32
+	MaxQueuedTransactions      *uint32
+	MinIncomingConfirmations   *uint32
+	MinimumContractPayment     *assets.Link
+	NonceAutoSync              *bool
+	OperatorFactoryAddress     *ethkey.EIP55Address
+	RPCDefaultBatchSize        *uint32
+	TxReaperInterval           *models.Duration
+	TxReaperThreshold          *models.Duration
+	TxResendAfterThreshold     *models.Duration
 
 	UseForwarders *bool
 
@@ -77,6 +79,7 @@ type GasEstimator struct {
 	TipCapMinimum *utils.Wei
 
 	BlockHistory *BlockHistoryEstimator
+	FeeHistory   *FeeHistoryEstimator
 }
 
 type BlockHistoryEstimator struct {
@@ -87,6 +90,12 @@ type BlockHistoryEstimator struct {
 	TransactionPercentile     *uint16
 }
 
+type FeeHistoryEstimator struct {
+	BaseFeeMultiplier *decimal.Decimal
+	BlockCount        *uint16
+	RewardPercentile  *uint16
+}
+
 type KeySpecific struct {
 	Key          *ethkey.EIP55Address
 	GasEstimator *KeySpecificGasEstimator
@@ -107,6 +116,7 @@ type NodePool struct {
 	NoNewHeadsThreshold  *models.Duration
 	PollFailureThreshold *uint32
 	PollInterval         *models.Duration
+	HTTPPollInterval     *models.Duration
 }
 
 type OCR struct {
@@ -154,6 +164,9 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 		v := uint32(cfg.EvmLogBackfillBatchSize.Int64)
 		c.LogBackfillBatchSize = &v
 	}
+	if cfg.EvmLogBroadcasterUseLogPoller.Valid {
+		c.LogBroadcasterUseLogPoller = &cfg.EvmLogBroadcasterUseLogPoller.Bool
+	}
 	c.LogPollInterval = cfg.EvmLogPollInterval
 	if cfg.EvmNonceAutoSync.Valid {
 		c.NonceAutoSync = &cfg.EvmNonceAutoSync.Bool
@@ -161,6 +174,13 @@ func (c *Chain) SetFromDB(cfg *types.ChainCfg) error {
 	if cfg.EvmUseForwarders.Valid {
 		c.UseForwarders = &cfg.EvmUseForwarders.Bool
 	}
+	if cfg.EvmForwarderOwners != nil {
+		v := make([]ethkey.EIP55Address, len(cfg.EvmForwarderOwners))
+		for i, a := range cfg.EvmForwarderOwners {
+			v[i] = ethkey.EIP55AddressFromAddress(a)
+		}
+		c.ForwarderOwners = &v
+	}
 	if cfg.EvmRPCDefaultBatchSize.Valid {
 		v := uint32(cfg.EvmRPCDefaultBatchSize.Int64)
 		c.RPCDefaultBatchSize = &v
@@ -102,6 +102,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogBackfillBatchSize; v != nil {
 		c.LogBackfillBatchSize = v
 	}
+	if v := f.LogBroadcasterUseLogPoller; v != nil {
+		c.LogBroadcasterUseLogPoller = v
+	}
 	if v := f.LogPollInterval; v != nil {
 		c.LogPollInterval = v
 	}
@@ -120,6 +123,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.NonceAutoSync; v != nil {
 		c.NonceAutoSync = v
 	}
+	if v := f.ForwarderOwners; v != nil {
+		c.ForwarderOwners = v
+	}
 	if v := f.OperatorFactoryAddress; v != nil {
 		c.OperatorFactoryAddress = v
 	}
@@ -218,6 +224,20 @@ func (c *Chain) SetFrom(f *Chain) {
 				c.GasEstimator.BlockHistory.TransactionPercentile = v
 			}
 		}
+		if h := g.FeeHistory; h != nil {
+			if c.GasEstimator.FeeHistory == nil {
+				c.GasEstimator.FeeHistory = &FeeHistoryEstimator{}
+			}
+			if v := h.BaseFeeMultiplier; v != nil {
+				c.GasEstimator.FeeHistory.BaseFeeMultiplier = v
+			}
+			if v := h.BlockCount; v != nil {
+				c.GasEstimator.FeeHistory.BlockCount = v
+			}
+			if v := h.RewardPercentile; v != nil {
+				c.GasEstimator.FeeHistory.RewardPercentile = v
+			}
+		}
 	}
 	// skip KeySpecific
 	if h := f.HeadTracker; h != nil {
@@ -250,6 +270,9 @@ func (c *Chain) SetFrom(f *Chain) {
 		if v := n.PollInterval; v != nil {
 			c.NodePool.PollInterval = v
 		}
+		if v := n.HTTPPollInterval; v != nil {
+			c.NodePool.HTTPPollInterval = v
+		}
 	}
 	if o := f.OCR; o != nil {
 		if c.OCR == nil {
@@ -24,25 +24,26 @@ func FallbackDefaultsAsV2() v2.Chain {
 
 func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 	c := v2.Chain{
-		BlockBackfillDepth:       nil,
-		BlockBackfillSkip:        nil,
-		ChainType:                ptr(string(set.chainType)),
-		FinalityDepth:            ptr(set.finalityDepth),
-		FlagsContractAddress:     asEIP155Address(set.flagsContractAddress),
-		LinkContractAddress:      asEIP155Address(set.linkContractAddress),
-		LogBackfillBatchSize:     ptr(set.logBackfillBatchSize),
-		LogPollInterval:          models.MustNewDuration(set.logPollInterval),
-		MaxInFlightTransactions:  ptr(set.maxInFlightTransactions),
-		MaxQueuedTransactions:    ptr(uint32(set.maxQueuedTransactions)),
-		MinIncomingConfirmations: ptr(set.minIncomingConfirmations),
-		MinimumContractPayment:   set.minimumContractPayment,
-		NonceAutoSync:            ptr(set.nonceAutoSync),
-		OperatorFactoryAddress:   asEIP155Address(set.operatorFactoryAddress),
-		RPCDefaultBatchSize:      ptr(set.rpcDefaultBatchSize),
-		TxReaperInterval:         models.MustNewDuration(set.ethTxReaperInterval),
-		TxReaperThreshold:        models.MustNewDuration(set.ethTxReaperThreshold),
-		TxResendAfterThreshold:   models.MustNewDuration(set.ethTxResendAfterThreshold),
-		UseForwarders:            ptr(set.useForwarders),
+		BlockBackfillDepth:         nil,
+		BlockBackfillSkip:          nil,
+		ChainType:                  ptr(string(set.chainType)),
+		FinalityDepth:              ptr(set.finalityDepth),
+		FlagsContractAddress:       asEIP155Address(set.flagsContractAddress),
+		LinkContractAddress:        asEIP155Address(set.linkContractAddress),
+		LogBackfillBatchSize:       ptr(set.logBackfillBatchSize),
+		LogBroadcasterUseLogPoller: ptr(set.logBroadcasterUseLogPoller),
+		LogPollInterval:            models.MustNewDuration(set.logPollInterval),
+		MaxInFlightTransactions:    ptr(set.maxInFlightTransactions),
+		MaxQueuedTransactions:      ptr(uint32(set.maxQueuedTransactions)),
+		MinIncomingConfirmations:   ptr(set.minIncomingConfirmations),
+		MinimumContractPayment:     set.minimumContractPayment,
+		NonceAutoSync:              ptr(set.nonceAutoSync),
+		OperatorFactoryAddress:     asEIP155Address(set.operatorFactoryAddress),
+		RPCDefaultBatchSize:        ptr(set.rpcDefaultBatchSize),
+		TxReaperInterval:           models.MustNewDuration(set.ethTxReaperInterval),
+		TxReaperThreshold:          models.MustNewDuration(set.ethTxReaperThreshold),
+		TxResendAfterThreshold:     models.MustNewDuration(set.ethTxResendAfterThreshold),
+		UseForwarders:              ptr(set.useForwarders),
 		BalanceMonitor: &v2.BalanceMonitor{
 			Enabled:    ptr(set.balanceMonitorEnabled),
 			BlockDelay: ptr(set.balanceMonitorBlockDelay),
@@ -70,6 +71,11 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 				EIP1559FeeCapBufferBlocks: set.blockHistoryEstimatorEIP1559FeeCapBufferBlocks,
 				TransactionPercentile:     ptr(set.blockHistoryEstimatorTransactionPercentile),
 			},
+			FeeHistory: &v2.FeeHistoryEstimator{
+				BaseFeeMultiplier: ptr(decimal.NewFromFloat32(set.feeHistoryEstimatorBaseFeeMultiplier)),
+				BlockCount:        ptr(set.feeHistoryEstimatorBlockCount),
+				RewardPercentile:  ptr(set.feeHistoryEstimatorRewardPercentile),
+			},
 		},
 		HeadTracker: &v2.HeadTracker{
 			BlockEmissionIdleWarningThreshold: models.MustNewDuration(set.blockEmissionIdleWarningThreshold),
@@ -82,6 +88,7 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 			NoNewHeadsThreshold:  models.MustNewDuration(set.nodeDeadAfterNoNewHeadersThreshold),
 			PollFailureThreshold: ptr(set.nodePollFailureThreshold),
 			PollInterval:         models.MustNewDuration(set.nodePollInterval),
+			HTTPPollInterval:     models.MustNewDuration(set.nodeHTTPPollInterval),
 		},
 		OCR: &v2.OCR{
 			ContractConfirmations:              ptr(set.ocrContractConfirmations),
@@ -100,6 +107,9 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 	if isZeroPtr(c.GasEstimator.BlockHistory) {
 		c.GasEstimator.BlockHistory = nil
 	}
+	if isZeroPtr(c.GasEstimator.FeeHistory) {
+		c.GasEstimator.FeeHistory = nil
+	}
 	if isZeroPtr(c.GasEstimator) {
 		c.GasEstimator = nil
 	}
@@ -2,6 +2,10 @@ package forwarders
 
 import (
 	"context"
+	"database/sql"
+	"math/big"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,13 +21,29 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/authorized_forwarder"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/authorized_receiver"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/offchain_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_factory"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 var forwardABI = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["forward"]
 var authChangedTopic = authorized_receiver.AuthorizedReceiverAuthorizedSendersChanged{}.Topic()
+var ownershipTransferredTopic = authorized_forwarder.AuthorizedForwarderOwnershipTransferred{}.Topic()
+var forwarderCreatedTopic = operator_factory.OperatorFactoryAuthorizedForwarderCreated{}.Topic()
+
+// Config encompasses the config used by the forwarder manager.
+type Config interface {
+	pg.LogConfig
+	OperatorFactoryAddress() string
+	EvmForwarderOwners() []common.Address
+}
+
+// KeyStore encompasses the subset of keystore used by the forwarder manager.
+type KeyStore interface {
+	GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
+}
 
 type FwdMgr struct {
 	utils.StartStopOnce
@@ -31,13 +51,39 @@ type FwdMgr struct {
 	evmClient evmclient.Client
 	logger    logger.SugaredLogger
 	logpoller evmlogpoller.LogPoller
+	keyStore  KeyStore
 
 	// TODO(samhassan): sendersCache should be an LRU capped cache
 	// https://app.shortcut.com/chainlinklabs/story/37884/forwarder-manager-uses-lru-for-caching-dest-addresses
 	sendersCache map[common.Address][]common.Address
 	latestBlock  int64
 
+	// factory is the OperatorFactory new forwarders are discovered from, nil
+	// if discovery is disabled.
+	factory              *common.Address
+	latestDiscoveryBlock int64
+	// owners are the configured addresses, besides the keys of this node,
+	// trusted to own forwarders.
+	owners []common.Address
+	// candidates are forwarders deployed by the factory which do not
+	// authorize any key of this node yet, or are not owned by a trusted
+	// address. They are tracked once they are.
+	candidates map[common.Address]struct{}
+	// unauthorized are tracked forwarders which do not authorize any key of
+	// this node, and are reported by Healthy.
+	unauthorized map[common.Address]struct{}
+	// transmittersCache holds the transmitters of destinations, or nil for
+	// destinations which accept any forwarder. It is cleared every poll.
+	transmittersCache map[common.Address][]common.Address
+
+	// nextFwdr is the round robin position of each sender across its valid
+	// forwarders.
+	nextFwdr map[common.Address]int
+	rrMu     sync.Mutex
+
 	authRcvr    authorized_receiver.AuthorizedReceiverInterface
+	authFwdr    authorized_forwarder.AuthorizedForwarderInterface
+	opFactory   operator_factory.OperatorFactoryInterface
 	offchainAgg offchain_aggregator_wrapper.OffchainAggregatorInterface
 
 	ctx    context.Context
@@ -48,18 +94,32 @@ type FwdMgr struct {
 	wg      sync.WaitGroup
 }
 
-func NewFwdMgr(db *sqlx.DB, client evmclient.Client, logpoller evmlogpoller.LogPoller, l logger.Logger, cfg pg.LogConfig) *FwdMgr {
+func NewFwdMgr(db *sqlx.DB, client evmclient.Client, logpoller evmlogpoller.LogPoller, keyStore KeyStore, l logger.Logger, cfg Config) *FwdMgr {
 	lggr := logger.Sugared(l.Named("EVMForwarderManager"))
 	fwdMgr := FwdMgr{
-		logger:       lggr,
-		evmClient:    client,
-		ORM:          NewORM(db, lggr, cfg),
-		logpoller:    logpoller,
-		sendersCache: make(map[common.Address][]common.Address),
-		chStop:       make(chan struct{}),
-		cacheMu:      sync.RWMutex{},
-		wg:           sync.WaitGroup{},
-		latestBlock:  0,
+		logger:            lggr,
+		evmClient:         client,
+		ORM:               NewORM(db, lggr, cfg),
+		logpoller:         logpoller,
+		keyStore:          keyStore,
+		owners:            cfg.EvmForwarderOwners(),
+		sendersCache:      make(map[common.Address][]common.Address),
+		candidates:        make(map[common.Address]struct{}),
+		unauthorized:      make(map[common.Address]struct{}),
+		transmittersCache: make(map[common.Address][]common.Address),
+		nextFwdr:          make(map[common.Address]int),
+		chStop:            make(chan struct{}),
+		cacheMu:           sync.RWMutex{},
+		wg:                sync.WaitGroup{},
+		latestBlock:       0,
+	}
+	if factory := cfg.OperatorFactoryAddress(); factory != "" {
+		if common.IsHexAddress(factory) {
+			addr := common.HexToAddress(factory)
+			fwdMgr.factory = &addr
+		} else {
+			lggr.Warnw("Invalid operator factory address, forwarder discovery is disabled", "address", factory)
+		}
 	}
 	return &fwdMgr
 }
@@ -84,17 +144,37 @@ func (f *FwdMgr) Start() error {
 			return errors.Wrap(err, "Failed to init AuthorizedReceiver")
 		}
 
+		f.authFwdr, err = authorized_forwarder.NewAuthorizedForwarder(common.Address{}, f.evmClient)
+		if err != nil {
+			return errors.Wrap(err, "Failed to init AuthorizedForwarder")
+		}
+
 		f.offchainAgg, err = offchain_aggregator_wrapper.NewOffchainAggregator(common.Address{}, f.evmClient)
 		if err != nil {
 			return errors.Wrap(err, "Failed to init OffchainAggregator")
 		}
 
+		if f.factory != nil {
+			f.opFactory, err = operator_factory.NewOperatorFactory(*f.factory, f.evmClient)
+			if err != nil {
+				return errors.Wrap(err, "Failed to init OperatorFactory")
+			}
+			f.logpoller.MergeFilter([]common.Hash{forwarderCreatedTopic}, *f.factory)
+			f.logger.Infow("Discovering forwarders deployed by operator factory", "factory", *f.factory)
+		}
+
 		f.wg.Add(1)
 		go f.runLoop()
 		return nil
 	})
 }
 
+// MaybeForwardTransaction returns the forwarder to send a transaction from
+// the given sender to the destination through, along with the forwarded
+// payload. Forwarders must authorize the sender, and destinations exposing a
+// list of transmitters, like OCR aggregators, must list the forwarder. When
+// several forwarders are valid, transactions are spread across them round
+// robin.
 func (f *FwdMgr) MaybeForwardTransaction(from common.Address, to common.Address, encodedPayload []byte) (fwdAddr common.Address, fwdPayload []byte, err error) {
 	// Gets forwarders for current chain.
 	fwdrs, err := f.ORM.FindForwardersByChain(utils.Big(*f.evmClient.ChainID()))
@@ -102,27 +182,114 @@ func (f *FwdMgr) MaybeForwardTransaction(from common.Address, to common.Address,
 		return to, encodedPayload, errors.Wrap(err, "Skipping forwarding transaction")
 	}
 
+	var valid []common.Address
 	for _, fwdr := range fwdrs {
 		eoas, err := f.getContractSenders(fwdr.Address)
 		if err != nil {
 			f.logger.Errorw("Failed to get forwarder senders", "err", err)
 			continue
 		}
-		for _, eoa := range eoas {
-			if eoa != from {
-				continue
-			}
-			forwardedPayload, err := f.getForwardedPayload(to, encodedPayload)
-			if err != nil {
-				f.logger.AssumptionViolationw("Forwarder encoding failed, this should never happen",
-					"err", err, "to", to, "payload", encodedPayload)
-				continue
-			}
-			return fwdr.Address, forwardedPayload, nil
+		if containsAddress(eoas, from) {
+			valid = append(valid, fwdr.Address)
+		}
+	}
+	if len(valid) == 0 {
+		return to, encodedPayload, errors.New("Skipping forwarding transaction")
+	}
+
+	valid, err = f.acceptedByDestination(to, valid)
+	if err != nil {
+		return to, encodedPayload, errors.Wrap(err, "Skipping forwarding transaction")
+	}
+	if len(valid) == 0 {
+		return to, encodedPayload, errors.Errorf("Skipping forwarding transaction, no forwarder of %s is a transmitter of %s", from, to)
+	}
+
+	forwardedPayload, err := f.getForwardedPayload(to, encodedPayload)
+	if err != nil {
+		f.logger.AssumptionViolationw("Forwarder encoding failed, this should never happen",
+			"err", err, "to", to, "payload", encodedPayload)
+		return to, encodedPayload, errors.Wrap(err, "Skipping forwarding transaction")
+	}
+	return f.nextForwarder(from, valid), forwardedPayload, nil
+}
+
+// nextForwarder picks the next of the given forwarders for the sender, round
+// robin.
+func (f *FwdMgr) nextForwarder(from common.Address, fwdrs []common.Address) common.Address {
+	f.rrMu.Lock()
+	defer f.rrMu.Unlock()
+	i := f.nextFwdr[from] % len(fwdrs)
+	f.nextFwdr[from] = i + 1
+	return fwdrs[i]
+}
+
+// acceptedByDestination filters the forwarders down to those the destination
+// accepts transactions from. Destinations which do not expose a list of
+// transmitters accept any forwarder.
+func (f *FwdMgr) acceptedByDestination(to common.Address, fwdrs []common.Address) ([]common.Address, error) {
+	transmitters, err := f.getTransmitters(to)
+	if err != nil {
+		return nil, err
+	}
+	if transmitters == nil {
+		return fwdrs, nil
+	}
+	var accepted []common.Address
+	for _, fwdr := range fwdrs {
+		if containsAddress(transmitters, fwdr) {
+			accepted = append(accepted, fwdr)
+		}
+	}
+	return accepted, nil
+}
+
+// getTransmitters returns the transmitters of a destination, or nil if it
+// does not have any. Only definitive answers are cached, failures to reach
+// the destination are returned so that the next transaction asks again.
+func (f *FwdMgr) getTransmitters(to common.Address) ([]common.Address, error) {
+	f.cacheMu.RLock()
+	transmitters, ok := f.transmittersCache[to]
+	f.cacheMu.RUnlock()
+	if ok {
+		return transmitters, nil
+	}
+
+	c, err := offchain_aggregator_wrapper.NewOffchainAggregatorCaller(to, f.evmClient)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to init OffchainAggregator caller")
+	}
+	transmitters, err = c.Transmitters(&bind.CallOpts{Context: f.ctx, Pending: false})
+	if err != nil {
+		if !isNotAggregator(err) {
+			return nil, errors.Wrapf(err, "Failed to call transmitters on %s", to)
 		}
+		transmitters = nil
+	} else if transmitters == nil {
+		transmitters = []common.Address{}
 	}
 
-	return to, encodedPayload, errors.New("Skipping forwarding transaction")
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	f.transmittersCache[to] = transmitters
+	return transmitters, nil
+}
+
+// isNotAggregator reports whether a failed transmitters call shows that the
+// destination does not list transmitters, as opposed to an RPC failure.
+func isNotAggregator(err error) bool {
+	switch {
+	case errors.Is(err, bind.ErrNoCode):
+		// Not a contract
+		return true
+	case strings.Contains(strings.ToLower(err.Error()), "execution reverted"):
+		// No transmitters function
+		return true
+	case strings.HasPrefix(err.Error(), "abi:"):
+		// The call returned nothing, or something else than addresses
+		return true
+	}
+	return false
 }
 
 func (f *FwdMgr) getForwardedPayload(dest common.Address, origPayload []byte) ([]byte, error) {
@@ -180,7 +347,7 @@ func (f *FwdMgr) subscribeForwardersLogs(fwdrs []Forwarder) {
 
 func (f *FwdMgr) subscribeSendersChangedLogs(addr common.Address) {
 	f.logpoller.MergeFilter(
-		[]common.Hash{authChangedTopic},
+		[]common.Hash{authChangedTopic, ownershipTransferredTopic},
 		addr)
 }
 
@@ -197,6 +364,13 @@ func (f *FwdMgr) getCachedSenders(addr common.Address) ([]common.Address, bool)
 	return addrs, ok
 }
 
+func (f *FwdMgr) isCandidate(addr common.Address) bool {
+	f.cacheMu.RLock()
+	defer f.cacheMu.RUnlock()
+	_, ok := f.candidates[addr]
+	return ok
+}
+
 func (f *FwdMgr) runLoop() {
 	defer f.wg.Done()
 	tick := time.After(0)
@@ -204,26 +378,13 @@ func (f *FwdMgr) runLoop() {
 	for ; ; tick = time.After(utils.WithJitter(time.Duration(time.Minute))) {
 		select {
 		case <-tick:
-			addrs := f.collectAddresses()
-			logs, err := f.logpoller.LatestLogEventSigsAddrs(
-				f.latestBlock,
-				[]common.Hash{authChangedTopic},
-				addrs,
-			)
-			if err != nil {
-				f.logger.Errorw("Failed to retrieve latest log round", "err", err)
-				continue
-			}
-			if len(logs) == 0 {
-				f.logger.Debugf("Empty auth update round for addrs: %s, skipping", addrs)
-				continue
-			}
-			f.logger.Debugf("Handling new %d auth updates", len(logs))
-			for _, log := range logs {
-				if err = f.handleAuthChange(log); err != nil {
-					f.logger.Warnw("Error handling auth change", "TxHash", log.TxHash, "err", err)
-				}
-			}
+			f.cacheMu.Lock()
+			f.transmittersCache = make(map[common.Address][]common.Address)
+			f.cacheMu.Unlock()
+
+			f.discoverForwarders()
+			f.pollAuthChanges()
+			f.checkAuthorization()
 
 		case <-f.chStop:
 			return
@@ -231,30 +392,292 @@ func (f *FwdMgr) runLoop() {
 	}
 }
 
+func (f *FwdMgr) pollAuthChanges() {
+	addrs := f.collectAddresses()
+	if len(addrs) == 0 {
+		return
+	}
+	logs, err := f.logpoller.LatestLogEventSigsAddrs(
+		f.latestBlock,
+		[]common.Hash{authChangedTopic, ownershipTransferredTopic},
+		addrs,
+		pg.WithParentCtx(f.ctx),
+	)
+	if err != nil {
+		f.logger.Errorw("Failed to retrieve latest log round", "err", err)
+		return
+	}
+	if len(logs) == 0 {
+		f.logger.Debugf("Empty auth update round for addrs: %s, skipping", addrs)
+		return
+	}
+	f.logger.Debugf("Handling new %d auth updates", len(logs))
+	for _, log := range logs {
+		if err = f.handleAuthChange(log); err != nil {
+			f.logger.Warnw("Error handling auth change", "TxHash", log.TxHash, "err", err)
+		}
+		if log.BlockNumber > f.latestBlock {
+			f.latestBlock = log.BlockNumber
+		}
+	}
+}
+
 func (f *FwdMgr) handleAuthChange(log evmlogpoller.Log) error {
-	if f.latestBlock >= log.BlockNumber {
+	ethLog := toEthLog(log)
+
+	switch ethLog.Topics[0] {
+	case authChangedTopic:
+		event, err := f.authRcvr.ParseAuthorizedSendersChanged(ethLog)
+		if err != nil {
+			return errors.New("Failed to parse senders change log")
+		}
+		return f.updateSenders(event.Raw.Address, event.Senders)
+	case ownershipTransferredTopic:
+		event, err := f.authFwdr.ParseOwnershipTransferred(ethLog)
+		if err != nil {
+			return errors.New("Failed to parse ownership transfer log")
+		}
+		keys, err := f.keyAddresses()
+		if err != nil {
+			return err
+		}
+		if !f.isTrustedOwner(event.To, keys) {
+			if !f.isCandidate(event.Raw.Address) {
+				f.untrackForwarder(event.Raw.Address, event.To)
+			}
+			return nil
+		}
+		// The new owner controls the authorized senders, re-read them
+		senders, err := f.getAuthorizedSenders(event.Raw.Address)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to call getAuthorizedSenders on %s", event.Raw.Address)
+		}
+		return f.updateSenders(event.Raw.Address, senders)
+	}
+
+	return nil
+}
+
+// updateSenders records the authorized senders of a forwarder, and starts
+// tracking discovered forwarders once they authorize a key of this node and
+// are owned by a trusted address.
+func (f *FwdMgr) updateSenders(addr common.Address, senders []common.Address) error {
+	if !f.isCandidate(addr) {
+		f.setCachedSenders(addr, senders)
 		return nil
 	}
+	keys, err := f.keyAddresses()
+	if err != nil {
+		return err
+	}
+	if !authorizesAny(senders, keys) {
+		return nil
+	}
+	owner, err := f.getOwner(addr)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to call owner on %s", addr)
+	}
+	if f.isTrustedOwner(owner, keys) {
+		f.trackForwarder(addr, senders)
+	}
+	return nil
+}
 
-	f.latestBlock = log.BlockNumber
+// isTrustedOwner reports whether forwarders owned by the address may be
+// tracked. Only keys of this node and the configured owners are trusted, as
+// the owner of a forwarder controls which keys it authorizes.
+func (f *FwdMgr) isTrustedOwner(owner common.Address, keys []common.Address) bool {
+	return containsAddress(keys, owner) || containsAddress(f.owners, owner)
+}
 
-	ethLog := types.Log{
-		Address:   log.Address,
-		Data:      log.Data,
-		Topics:    log.GetTopics(),
-		TxHash:    log.TxHash,
-		BlockHash: log.BlockHash,
+func (f *FwdMgr) getOwner(addr common.Address) (common.Address, error) {
+	c, err := authorized_forwarder.NewAuthorizedForwarderCaller(addr, f.evmClient)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "Failed to init forwarder caller")
 	}
+	return c.Owner(&bind.CallOpts{Context: f.ctx, Pending: false})
+}
 
-	if ethLog.Topics[0] == authChangedTopic {
-		event, err := f.authRcvr.ParseAuthorizedSendersChanged(ethLog)
+// discoverForwarders looks for forwarders deployed by the operator factory
+// since the last poll. Anyone can deploy forwarders through the factory, so
+// only forwarders owned by a trusted address and authorizing a key of this
+// node are tracked right away, others are watched until they are.
+func (f *FwdMgr) discoverForwarders() {
+	if f.factory == nil {
+		return
+	}
+	latest, err := f.logpoller.LatestBlock(pg.WithParentCtx(f.ctx))
+	if errors.Is(err, sql.ErrNoRows) {
+		f.logger.Debug("Log poller has not processed any blocks yet, skipping forwarder discovery")
+		return
+	} else if err != nil {
+		f.logger.Errorw("Failed to retrieve latest log poller block", "err", err)
+		return
+	}
+	if latest <= f.latestDiscoveryBlock {
+		return
+	}
+
+	logs, err := f.logpoller.Logs(f.latestDiscoveryBlock+1, latest, forwarderCreatedTopic, *f.factory, pg.WithParentCtx(f.ctx))
+	if err != nil {
+		f.logger.Errorw("Failed to retrieve forwarder deployments", "err", err)
+		return
+	}
+	keys, err := f.keyAddresses()
+	if err != nil {
+		f.logger.Errorw("Failed to load keys, skipping forwarder discovery", "err", err)
+		return
+	}
+	for _, log := range logs {
+		event, err := f.opFactory.ParseAuthorizedForwarderCreated(toEthLog(log))
 		if err != nil {
-			return errors.New("Failed to parse senders change log")
+			f.logger.Warnw("Failed to parse forwarder deployment log", "TxHash", log.TxHash, "err", err)
+			continue
 		}
-		f.setCachedSenders(event.Raw.Address, event.Senders)
+		f.discoverForwarder(event.Forwarder, keys)
 	}
+	f.latestDiscoveryBlock = latest
+}
 
-	return nil
+func (f *FwdMgr) discoverForwarder(addr common.Address, keys []common.Address) {
+	if _, tracked := f.getCachedSenders(addr); tracked || f.isCandidate(addr) {
+		return
+	}
+	existing, err := f.ORM.FindForwardersInListByChain(utils.Big(*f.evmClient.ChainID()), []common.Address{addr})
+	if err != nil {
+		f.logger.Errorw("Failed to look up discovered forwarder", "address", addr, "err", err)
+		return
+	}
+	if len(existing) > 0 {
+		return
+	}
+
+	senders, err := f.getAuthorizedSenders(addr)
+	if err != nil {
+		f.logger.Warnw("Failed to call getAuthorizedSenders on discovered forwarder", "address", addr, "err", err)
+	}
+	owner, err := f.getOwner(addr)
+	if err != nil {
+		f.logger.Warnw("Failed to call owner on discovered forwarder", "address", addr, "err", err)
+	} else if !f.isTrustedOwner(owner, keys) {
+		f.logger.Debugw("Watching discovered forwarder until it is owned by a trusted address", "address", addr, "owner", owner)
+	} else if authorizesAny(senders, keys) {
+		f.trackForwarder(addr, senders)
+		return
+	} else {
+		f.logger.Debugw("Watching discovered forwarder until it authorizes a key of this node", "address", addr)
+	}
+
+	f.cacheMu.Lock()
+	f.candidates[addr] = struct{}{}
+	f.cacheMu.Unlock()
+	f.subscribeSendersChangedLogs(addr)
+}
+
+// trackForwarder stores a discovered forwarder, so that transactions are
+// forwarded through it.
+func (f *FwdMgr) trackForwarder(addr common.Address, senders []common.Address) {
+	if _, err := f.ORM.CreateForwarder(addr, utils.Big(*f.evmClient.ChainID())); err != nil {
+		f.logger.Errorw("Failed to store discovered forwarder", "address", addr, "err", err)
+		return
+	}
+	f.logger.Infow("Discovered forwarder", "address", addr, "senders", senders)
+
+	f.cacheMu.Lock()
+	delete(f.candidates, addr)
+	f.sendersCache[addr] = senders
+	f.cacheMu.Unlock()
+	f.subscribeSendersChangedLogs(addr)
+}
+
+// untrackForwarder stops forwarding through a forwarder whose ownership was
+// transferred to an untrusted address. It is watched as a candidate, so that
+// it is tracked again if ownership is transferred back.
+func (f *FwdMgr) untrackForwarder(addr common.Address, owner common.Address) {
+	existing, err := f.ORM.FindForwardersInListByChain(utils.Big(*f.evmClient.ChainID()), []common.Address{addr})
+	if err != nil {
+		f.logger.Errorw("Failed to look up forwarder", "address", addr, "err", err)
+		return
+	}
+	for _, fwdr := range existing {
+		if err = f.ORM.DeleteForwarder(int32(fwdr.ID)); err != nil {
+			f.logger.Errorw("Failed to delete forwarder", "address", addr, "err", err)
+			return
+		}
+	}
+	f.logger.Warnw("Forwarder ownership was transferred to an untrusted address, transactions will not be forwarded through it",
+		"address", addr, "owner", owner)
+
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	delete(f.sendersCache, addr)
+	delete(f.unauthorized, addr)
+	f.candidates[addr] = struct{}{}
+}
+
+// checkAuthorization flags tracked forwarders which do not authorize any key
+// of this node, as transactions cannot be forwarded through them.
+func (f *FwdMgr) checkAuthorization() {
+	keys, err := f.keyAddresses()
+	if err != nil {
+		f.logger.Errorw("Failed to load keys, skipping forwarder authorization check", "err", err)
+		return
+	}
+	if len(keys) == 0 {
+		return
+	}
+	fwdrs, err := f.ORM.FindForwardersByChain(utils.Big(*f.evmClient.ChainID()))
+	if err != nil {
+		f.logger.Errorw("Failed to retrieve forwarders", "err", err)
+		return
+	}
+
+	f.cacheMu.RLock()
+	previous := f.unauthorized
+	f.cacheMu.RUnlock()
+
+	unauthorized := make(map[common.Address]struct{})
+	for _, fwdr := range fwdrs {
+		senders, err := f.getContractSenders(fwdr.Address)
+		if err != nil {
+			// Failing to read the senders does not mean authorization was lost
+			if _, ok := previous[fwdr.Address]; ok {
+				unauthorized[fwdr.Address] = struct{}{}
+			}
+			continue
+		}
+		_, wasUnauthorized := previous[fwdr.Address]
+		if authorizesAny(senders, keys) {
+			if wasUnauthorized {
+				f.logger.Infow("Forwarder authorizes keys of this node again", "address", fwdr.Address)
+			}
+			continue
+		}
+		unauthorized[fwdr.Address] = struct{}{}
+		if !wasUnauthorized {
+			f.logger.Warnw("Forwarder does not authorize any key of this node, transactions will not be forwarded through it",
+				"address", fwdr.Address, "senders", senders)
+		}
+	}
+
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	f.unauthorized = unauthorized
+}
+
+func (f *FwdMgr) keyAddresses() ([]common.Address, error) {
+	if f.keyStore == nil {
+		return nil, errors.New("no keystore")
+	}
+	states, err := f.keyStore.GetStatesForChain(f.evmClient.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]common.Address, len(states))
+	for i, s := range states {
+		addrs[i] = s.Address.Address()
+	}
+	return addrs, nil
 }
 
 func (f *FwdMgr) collectAddresses() (addrs []common.Address) {
@@ -263,9 +686,31 @@ func (f *FwdMgr) collectAddresses() (addrs []common.Address) {
 	for addr := range f.sendersCache {
 		addrs = append(addrs, addr)
 	}
+	for addr := range f.candidates {
+		addrs = append(addrs, addr)
+	}
 	return
 }
 
+// Healthy reports an error if tracked forwarders do not authorize any key of
+// this node.
+func (f *FwdMgr) Healthy() error {
+	if err := f.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	f.cacheMu.RLock()
+	defer f.cacheMu.RUnlock()
+	if len(f.unauthorized) == 0 {
+		return nil
+	}
+	var addrs []string
+	for addr := range f.unauthorized {
+		addrs = append(addrs, addr.Hex())
+	}
+	sort.Strings(addrs)
+	return errors.Errorf("forwarders do not authorize any key of this node: %s", strings.Join(addrs, ", "))
+}
+
 // Stop cancels all outgoings calls and stops internal ticker loop.
 func (f *FwdMgr) Stop() error {
 	return f.StopOnce("EVMForwarderManager", func() (err error) {
@@ -275,3 +720,31 @@ func (f *FwdMgr) Stop() error {
 		return nil
 	})
 }
+
+func toEthLog(log evmlogpoller.Log) types.Log {
+	return types.Log{
+		Address:   log.Address,
+		Data:      log.Data,
+		Topics:    log.GetTopics(),
+		TxHash:    log.TxHash,
+		BlockHash: log.BlockHash,
+	}
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func authorizesAny(senders []common.Address, keys []common.Address) bool {
+	for _, key := range keys {
+		if containsAddress(senders, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package forwarders_test
 
 import (
+	"bytes"
 	"math/big"
 	"testing"
 	"time"
@@ -9,15 +10,21 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/authorized_forwarder"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/authorized_receiver"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/offchain_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_factory"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
@@ -29,8 +36,12 @@ import (
 
 var GetAuthorisedSendersABI = evmtypes.MustGetABI(authorized_receiver.AuthorizedReceiverABI).Methods["getAuthorizedSenders"]
 
+var TransmittersABI = evmtypes.MustGetABI(offchain_aggregator_wrapper.OffchainAggregatorABI).Methods["transmitters"]
+
 var SimpleOracleCallABI = evmtypes.MustGetABI(operator_wrapper.OperatorABI).Methods["getChainlinkToken"]
 
+var OwnerABI = evmtypes.MustGetABI(authorized_forwarder.AuthorizedForwarderABI).Methods["owner"]
+
 func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	db := pgtest.NewSqlxDB(t)
@@ -55,7 +66,7 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		cltest.NewSimulatedBackendClient(t, ec, testutils.FixtureChainID), lggr, 100*time.Millisecond, 2, 3)
-	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, lggr, pgtest.NewPGCfg(true))
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, cltest.NewKeyStore(t, db, cfg).Eth(), lggr, evmtest.NewChainScopedConfig(t, cfg))
 	fwdMgr.ORM = forwarders.NewORM(db, logger.TestLogger(t), cfg)
 
 	_, err = fwdMgr.ORM.CreateForwarder(forwarderAddr, utils.Big(*testutils.FixtureChainID))
@@ -70,6 +81,8 @@ func TestFwdMgr_MaybeForwardTransaction(t *testing.T) {
 	ethClient.On("CallContract", mock.Anything,
 		ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &forwarderAddr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
 		mock.Anything).Return(genAuthorisedSenders(t, []common.Address{owner.From}), nil)
+	// Operator does not list transmitters, so any forwarder is accepted.
+	mockTransmittersCall(ethClient, operatorAddr, nil)
 	err = fwdMgr.Start()
 	require.NoError(t, err)
 	addr, _, err := fwdMgr.MaybeForwardTransaction(owner.From, operatorAddr, getSimpleOperatorCall(t))
@@ -103,7 +116,7 @@ func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
 
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
 		cltest.NewSimulatedBackendClient(t, ec, testutils.FixtureChainID), lggr, 100*time.Millisecond, 2, 3)
-	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, lggr, pgtest.NewPGCfg(true))
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, cltest.NewKeyStore(t, db, cfg).Eth(), lggr, evmtest.NewChainScopedConfig(t, cfg))
 	fwdMgr.ORM = forwarders.NewORM(db, logger.TestLogger(t), cfg)
 
 	_, err = fwdMgr.ORM.CreateForwarder(forwarderAddr, utils.Big(*testutils.FixtureChainID))
@@ -130,6 +143,310 @@ func TestFwdMgr_AccountUnauthorizedToForward_SkipsForwarding(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestFwdMgr_MaybeForwardTransaction_LoadBalancesAcrossForwarders(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	owner := testutils.MustNewSimTransactor(t)
+	ec := backends.NewSimulatedBackend(map[common.Address]core.GenesisAccount{
+		owner.From: {
+			Balance: big.NewInt(0).Mul(big.NewInt(10), big.NewInt(1e18)),
+		},
+	}, 10e6)
+	t.Cleanup(func() { ec.Close() })
+	linkAddr := common.HexToAddress("0x01BE23585060835E02B77ef475b0Cc51aA1e0709")
+	operatorAddr, _, _, err := operator_wrapper.DeployOperator(owner, ec, linkAddr, owner.From)
+	require.NoError(t, err)
+
+	fwdAddrs := make([]common.Address, 3)
+	for i := range fwdAddrs {
+		fwdAddrs[i], _, _, err = authorized_forwarder.DeployAuthorizedForwarder(owner, ec, linkAddr, owner.From, operatorAddr, []byte{})
+		require.NoError(t, err)
+		ec.Commit()
+	}
+
+	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
+		cltest.NewSimulatedBackendClient(t, ec, testutils.FixtureChainID), lggr, 100*time.Millisecond, 2, 3)
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, cltest.NewKeyStore(t, db, cfg).Eth(), lggr, evmtest.NewChainScopedConfig(t, cfg))
+	fwdMgr.ORM = forwarders.NewORM(db, logger.TestLogger(t), cfg)
+
+	for i := range fwdAddrs {
+		_, err = fwdMgr.ORM.CreateForwarder(fwdAddrs[i], utils.Big(*testutils.FixtureChainID))
+		require.NoError(t, err)
+		fwdAddr := fwdAddrs[i]
+		ethClient.On("CallContract", mock.Anything,
+			ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &fwdAddr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
+			mock.Anything).Return(genAuthorisedSenders(t, []common.Address{owner.From}), nil)
+	}
+	// Only the first two forwarders are transmitters of the destination.
+	mockTransmittersCall(ethClient, operatorAddr, fwdAddrs[:2])
+
+	require.NoError(t, fwdMgr.Start())
+	t.Cleanup(func() { require.NoError(t, fwdMgr.Stop()) })
+
+	used := make(map[common.Address]int)
+	for i := 0; i < 4; i++ {
+		addr, _, err := fwdMgr.MaybeForwardTransaction(owner.From, operatorAddr, getSimpleOperatorCall(t))
+		require.NoError(t, err)
+		used[addr]++
+	}
+	require.Equal(t, map[common.Address]int{fwdAddrs[0]: 2, fwdAddrs[1]: 2}, used)
+}
+
+func TestFwdMgr_MaybeForwardTransaction_RetriesTransmittersAfterRPCError(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	from := testutils.NewAddress()
+	dest := testutils.NewAddress()
+	fwdAddr := testutils.NewAddress()
+
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("MergeFilter", mock.Anything, mock.Anything).Maybe()
+	lp.On("LatestLogEventSigsAddrs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, cltest.NewKeyStore(t, db, cfg).Eth(), lggr, evmtest.NewChainScopedConfig(t, cfg))
+
+	_, err := fwdMgr.ORM.CreateForwarder(fwdAddr, utils.Big(*testutils.FixtureChainID))
+	require.NoError(t, err)
+	ethClient.On("CallContract", mock.Anything,
+		ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &fwdAddr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
+		mock.Anything).Return(genAuthorisedSenders(t, []common.Address{from}), nil)
+
+	// The destination cannot be reached at first
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == dest && bytes.Equal(msg.Data, TransmittersABI.ID)
+	}), mock.Anything).Return(nil, errors.New("connection refused")).Once()
+
+	require.NoError(t, fwdMgr.Start())
+	t.Cleanup(func() { require.NoError(t, fwdMgr.Stop()) })
+
+	addr, _, err := fwdMgr.MaybeForwardTransaction(from, dest, getSimpleOperatorCall(t))
+	require.ErrorContains(t, err, "connection refused")
+	require.Equal(t, dest, addr)
+
+	// The failure was not cached as the destination not being an aggregator
+	mockTransmittersCall(ethClient, dest, []common.Address{fwdAddr})
+	addr, _, err = fwdMgr.MaybeForwardTransaction(from, dest, getSimpleOperatorCall(t))
+	require.NoError(t, err)
+	require.Equal(t, fwdAddr, addr)
+
+	// A destination without transmitters is cached as accepting any forwarder
+	other := testutils.NewAddress()
+	mockTransmittersCall(ethClient, other, nil)
+	for i := 0; i < 2; i++ {
+		addr, _, err = fwdMgr.MaybeForwardTransaction(from, other, getSimpleOperatorCall(t))
+		require.NoError(t, err)
+		require.Equal(t, fwdAddr, addr)
+	}
+}
+
+type factoryConfig struct {
+	forwarders.Config
+	factory common.Address
+	owners  []common.Address
+}
+
+func (c factoryConfig) OperatorFactoryAddress() string { return c.factory.Hex() }
+
+func (c factoryConfig) EvmForwarderOwners() []common.Address { return c.owners }
+
+func TestFwdMgr_DiscoversForwardersFromOperatorFactory(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, key := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	factory := testutils.NewAddress()
+	operator := testutils.NewAddress()
+	authorizing := testutils.NewAddress()
+	operated := testutils.NewAddress()
+	other := testutils.NewAddress()
+	untrusted := testutils.NewAddress()
+
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("MergeFilter", mock.Anything, mock.Anything).Maybe()
+	lp.On("LatestLogEventSigsAddrs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	lp.On("LatestBlock", mock.Anything).Return(int64(10), nil).Maybe()
+	lp.On("Logs", int64(1), int64(10), operator_factory.OperatorFactoryAuthorizedForwarderCreated{}.Topic(), factory, mock.Anything).
+		Return([]logpoller.Log{
+			forwarderCreatedLog(factory, authorizing),
+			forwarderCreatedLog(factory, operated),
+			forwarderCreatedLog(factory, other),
+			forwarderCreatedLog(factory, untrusted),
+		}, nil).Once()
+	lp.On("Logs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	for fwdr, senders := range map[common.Address][]common.Address{
+		authorizing: {key},
+		operated:    {key},
+		other:       {testutils.NewAddress()},
+		untrusted:   {key},
+	} {
+		fwdr := fwdr
+		ethClient.On("CallContract", mock.Anything,
+			ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &fwdr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
+			mock.Anything).Return(genAuthorisedSenders(t, senders), nil)
+	}
+	mockOwnerCall(t, ethClient, authorizing, key)
+	mockOwnerCall(t, ethClient, operated, operator)
+	mockOwnerCall(t, ethClient, other, key)
+	// Anyone can deploy a forwarder authorizing the keys of this node
+	mockOwnerCall(t, ethClient, untrusted, testutils.NewAddress())
+
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, ethKeyStore, lggr,
+		factoryConfig{evmtest.NewChainScopedConfig(t, cfg), factory, []common.Address{operator}})
+	require.NoError(t, fwdMgr.Start())
+	t.Cleanup(func() { require.NoError(t, fwdMgr.Stop()) })
+
+	// Only forwarders owned by a trusted address and authorizing a key of
+	// this node are tracked
+	gomega.NewWithT(t).Eventually(func() []common.Address {
+		return trackedForwarders(t, fwdMgr)
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.ConsistOf(authorizing, operated))
+	gomega.NewWithT(t).Consistently(func() []common.Address {
+		return trackedForwarders(t, fwdMgr)
+	}, time.Second, 100*time.Millisecond).Should(gomega.ConsistOf(authorizing, operated))
+	require.NoError(t, fwdMgr.Healthy())
+}
+
+func TestFwdMgr_UntracksForwardersTransferredToUntrustedOwners(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, key := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	fwdAddr := testutils.NewAddress()
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("MergeFilter", mock.Anything, mock.Anything).Maybe()
+	lp.On("LatestLogEventSigsAddrs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]logpoller.Log{ownershipTransferredLog(fwdAddr, key, testutils.NewAddress())}, nil).Once()
+	lp.On("LatestLogEventSigsAddrs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, ethKeyStore, lggr, evmtest.NewChainScopedConfig(t, cfg))
+
+	_, err := fwdMgr.ORM.CreateForwarder(fwdAddr, utils.Big(*testutils.FixtureChainID))
+	require.NoError(t, err)
+	ethClient.On("CallContract", mock.Anything,
+		ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &fwdAddr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
+		mock.Anything).Return(genAuthorisedSenders(t, []common.Address{key}), nil)
+
+	require.NoError(t, fwdMgr.Start())
+	t.Cleanup(func() { require.NoError(t, fwdMgr.Stop()) })
+
+	gomega.NewWithT(t).Eventually(func() []common.Address {
+		return trackedForwarders(t, fwdMgr)
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.BeEmpty())
+	_, _, err = fwdMgr.MaybeForwardTransaction(key, testutils.NewAddress(), getSimpleOperatorCall(t))
+	require.ErrorContains(t, err, "Skipping forwarding transaction")
+}
+
+func trackedForwarders(t *testing.T, fwdMgr *forwarders.FwdMgr) []common.Address {
+	fwdrs, err := fwdMgr.ORM.FindForwardersByChain(utils.Big(*testutils.FixtureChainID))
+	require.NoError(t, err)
+	var addrs []common.Address
+	for _, fwdr := range fwdrs {
+		addrs = append(addrs, fwdr.Address)
+	}
+	return addrs
+}
+
+func TestFwdMgr_Healthy_ReportsUnauthorizedForwarders(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	fwdAddr := testutils.NewAddress()
+	lp := lpmocks.NewLogPoller(t)
+	lp.On("MergeFilter", mock.Anything, mock.Anything).Maybe()
+	lp.On("LatestLogEventSigsAddrs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	fwdMgr := forwarders.NewFwdMgr(db, ethClient, lp, ethKeyStore, lggr, evmtest.NewChainScopedConfig(t, cfg))
+
+	_, err := fwdMgr.ORM.CreateForwarder(fwdAddr, utils.Big(*testutils.FixtureChainID))
+	require.NoError(t, err)
+	ethClient.On("CallContract", mock.Anything,
+		ethereum.CallMsg{From: common.HexToAddress("0x0"), To: &fwdAddr, Data: []uint8{0x24, 0x8, 0xaf, 0xaa}},
+		mock.Anything).Return(genAuthorisedSenders(t, []common.Address{testutils.NewAddress()}), nil)
+
+	require.NoError(t, fwdMgr.Start())
+	t.Cleanup(func() { require.NoError(t, fwdMgr.Stop()) })
+
+	gomega.NewWithT(t).Eventually(func() error {
+		return fwdMgr.Healthy()
+	}, testutils.WaitTimeout(t), 100*time.Millisecond).Should(gomega.MatchError(gomega.ContainSubstring(fwdAddr.Hex())))
+}
+
+func forwarderCreatedLog(factory, forwarder common.Address) logpoller.Log {
+	topics := []common.Hash{
+		operator_factory.OperatorFactoryAuthorizedForwarderCreated{}.Topic(),
+		forwarder.Hash(),
+		testutils.NewAddress().Hash(),
+		testutils.NewAddress().Hash(),
+	}
+	var raw [][]byte
+	for _, topic := range topics {
+		raw = append(raw, topic.Bytes())
+	}
+	return logpoller.Log{
+		Address:     factory,
+		BlockNumber: 5,
+		EventSig:    topics[0].Bytes(),
+		Topics:      raw,
+		TxHash:      utils.NewHash(),
+	}
+}
+
+func ownershipTransferredLog(forwarder, from, to common.Address) logpoller.Log {
+	topics := []common.Hash{
+		authorized_forwarder.AuthorizedForwarderOwnershipTransferred{}.Topic(),
+		from.Hash(),
+		to.Hash(),
+	}
+	var raw [][]byte
+	for _, topic := range topics {
+		raw = append(raw, topic.Bytes())
+	}
+	return logpoller.Log{
+		Address:     forwarder,
+		BlockNumber: 5,
+		EventSig:    topics[0].Bytes(),
+		Topics:      raw,
+		TxHash:      utils.NewHash(),
+	}
+}
+
+func mockOwnerCall(t *testing.T, ethClient *evmmocks.Client, forwarder common.Address, owner common.Address) {
+	args, err := OwnerABI.Outputs.Pack(owner)
+	require.NoError(t, err)
+	ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == forwarder && bytes.Equal(msg.Data, OwnerABI.ID)
+	}), mock.Anything).Return(args, nil)
+}
+
+func mockTransmittersCall(ethClient *evmmocks.Client, dest common.Address, transmitters []common.Address) {
+	call := ethClient.On("CallContract", mock.Anything, mock.MatchedBy(func(msg ethereum.CallMsg) bool {
+		return msg.To != nil && *msg.To == dest && bytes.Equal(msg.Data, TransmittersABI.ID)
+	}), mock.Anything)
+	if transmitters == nil {
+		call.Return(nil, errors.New("execution reverted"))
+		return
+	}
+	args, err := TransmittersABI.Outputs.Pack(transmitters)
+	if err != nil {
+		panic(err)
+	}
+	call.Return(args, nil)
+}
+
 func genAuthorisedSenders(t *testing.T, addrs []common.Address) []byte {
 	args, err := GetAuthorisedSendersABI.Outputs.Pack(addrs)
 	require.NoError(t, err)
@@ -0,0 +1,443 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/shopspring/decimal"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promFeeHistoryEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_estimator_gas_price",
+		Help: "Gas price set by the fee history estimator (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+
+	promFeeHistoryEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_estimator_tip_cap",
+		Help: "Gas tip cap set by the fee history estimator (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+
+	promFeeHistoryEstimatorNextBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_fee_history_estimator_next_base_fee",
+		Help: "Base fee of the next block according to the fee history (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+var _ Estimator = &FeeHistoryEstimator{}
+
+// FeeHistory is the result of an eth_feeHistory call
+type FeeHistory struct {
+	OldestBlock *hexutil.Big `json:"oldestBlock"`
+	// Reward holds one value per requested percentile for every block
+	Reward [][]*hexutil.Big `json:"reward"`
+	// BaseFee holds one more value than the number of blocks, which is the
+	// base fee of the block after the newest one
+	BaseFee      []*hexutil.Big `json:"baseFeePerGas"`
+	GasUsedRatio []float64      `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator is an Estimator which sets prices from the eth_feeHistory
+// of the most recent blocks. Unlike BlockHistoryEstimator, it needs a single
+// RPC call per head rather than one per block in the history.
+//
+// The tip cap is the configured percentile of the per-block reward
+// percentiles, and the gas price and fee cap are the base fee of the next
+// block, scaled by the configured multiplier, plus the tip cap.
+//
+// If the RPC node does not support eth_feeHistory, it falls back to a
+// BlockHistoryEstimator for good.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	ethClient evmclient.Client
+	chainID   big.Int
+	config    Config
+	mb        *utils.Mailbox[*evmtypes.Head]
+	wg        *sync.WaitGroup
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	gasPrice    *big.Int
+	tipCap      *big.Int
+	nextBaseFee *big.Int
+	fallback    Estimator
+	mu          sync.RWMutex
+
+	lggr   logger.Logger
+	logger logger.SugaredLogger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator that recalculates
+// prices from eth_feeHistory on every new head
+func NewFeeHistoryEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config, chainID big.Int) Estimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		utils.StartStopOnce{},
+		ethClient,
+		chainID,
+		cfg,
+		utils.NewMailbox[*evmtypes.Head](1),
+		new(sync.WaitGroup),
+		ctx,
+		cancel,
+		nil,
+		nil,
+		nil,
+		nil,
+		sync.RWMutex{},
+		lggr,
+		logger.Sugared(lggr.Named("FeeHistoryEstimator")),
+	}
+}
+
+// Start starts FeeHistoryEstimator service.
+// The provided context can be used to terminate Start sequence.
+func (f *FeeHistoryEstimator) Start(ctx context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		f.logger.Trace("Starting")
+
+		fetchCtx, cancel := context.WithTimeout(ctx, MaxStartTime)
+		defer cancel()
+		if err := f.FetchFeeHistoryAndRecalculate(fetchCtx); isFeeHistoryUnsupported(err) {
+			if err = f.startFallback(ctx, err); err != nil {
+				return err
+			}
+		} else if err != nil {
+			f.logger.Warnw("Initial fee history fetch failed", "err", err)
+		}
+
+		// NOTE: This only checks the start context, not the fetch context
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "failed to start FeeHistoryEstimator due to main context error")
+		}
+
+		if f.getFallback() == nil {
+			f.wg.Add(1)
+			go f.runLoop()
+		}
+
+		f.logger.Trace("Started")
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.ctxCancel()
+		f.wg.Wait()
+		if fallback := f.getFallback(); fallback != nil {
+			return fallback.Close()
+		}
+		return nil
+	})
+}
+
+// OnNewLongestChain triggers a recalculation, or passes the head on to the
+// fallback estimator if eth_feeHistory is not supported
+func (f *FeeHistoryEstimator) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	if fallback := f.getFallback(); fallback != nil {
+		fallback.OnNewLongestChain(ctx, head)
+		return
+	}
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			head, exists := f.mb.Retrieve()
+			if !exists {
+				f.logger.Debug("No head to retrieve")
+				continue
+			}
+			err := f.FetchFeeHistoryAndRecalculate(f.ctx)
+			if isFeeHistoryUnsupported(err) {
+				if err = f.startFallback(f.ctx, err); err != nil {
+					f.logger.Errorw("Failed to start fallback BlockHistoryEstimator", "err", err)
+					continue
+				}
+				f.getFallback().OnNewLongestChain(f.ctx, head)
+				return
+			} else if err != nil {
+				f.logger.Warnw("Error fetching fee history", "head", head, "err", err)
+			}
+		}
+	}
+}
+
+// startFallback switches to a BlockHistoryEstimator, since the RPC node does
+// not support eth_feeHistory
+func (f *FeeHistoryEstimator) startFallback(ctx context.Context, cause error) error {
+	f.logger.Warnw("RPC node does not support eth_feeHistory, falling back to BlockHistoryEstimator", "err", cause)
+	fallback := NewBlockHistoryEstimator(f.lggr, f.ethClient, f.config, f.chainID)
+	if err := fallback.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start fallback BlockHistoryEstimator")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fallback = fallback
+	return nil
+}
+
+func (f *FeeHistoryEstimator) getFallback() Estimator {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.fallback
+}
+
+// isFeeHistoryUnsupported returns true if err means the RPC node does not
+// implement eth_feeHistory
+func isFeeHistoryUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+	msg := strings.ToLower(errors.Cause(err).Error())
+	if strings.Contains(msg, "method not found") {
+		return true
+	}
+	// e.g. geth: "the method eth_feeHistory does not exist/is not available"
+	if !strings.Contains(msg, "eth_feehistory") {
+		return false
+	}
+	for _, s := range []string{"does not exist", "not supported", "not available"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchFeeHistoryAndRecalculate fetches the fee history of the latest blocks
+// and recalculates prices from it.
+func (f *FeeHistoryEstimator) FetchFeeHistoryAndRecalculate(ctx context.Context) error {
+	blockCount := f.config.FeeHistoryEstimatorBlockCount()
+	percentile := f.config.FeeHistoryEstimatorRewardPercentile()
+
+	var history FeeHistory
+	err := f.ethClient.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint(blockCount), "latest", []float64{float64(percentile)})
+	if err != nil {
+		return errors.Wrap(err, "eth_feeHistory failed")
+	}
+
+	f.Recalculate(history)
+	return nil
+}
+
+// Recalculate sets prices from the given fee history.
+func (f *FeeHistoryEstimator) Recalculate(history FeeHistory) {
+	percentile := int(f.config.FeeHistoryEstimatorRewardPercentile())
+	multiplier := f.config.FeeHistoryEstimatorBaseFeeMultiplier()
+
+	tipCap, nextBaseFee, err := feeHistoryPrices(history, percentile)
+	if err != nil {
+		if errors.Is(err, ErrNoSuitableTransactions) {
+			f.logger.Debug("No suitable blocks, skipping")
+		} else {
+			f.logger.Warnw("Cannot calculate prices from fee history", "err", err)
+		}
+		return
+	}
+	projectedBaseFee := applyBaseFeeMultiplier(nextBaseFee, multiplier)
+	gasPrice := new(big.Int).Add(projectedBaseFee, tipCap)
+
+	lggrFields := []interface{}{
+		"gasPriceWei", gasPrice,
+		"tipCapWei", tipCap,
+		"nextBaseFeeWei", nextBaseFee,
+		"baseFeeMultiplier", multiplier,
+		"maxGasPriceWei", f.config.EvmMaxGasPriceWei(),
+		"oldestBlock", history.OldestBlock,
+		"blocks", len(history.GasUsedRatio),
+	}
+	f.logger.Debugw(fmt.Sprintf("Setting new default prices, GasPrice: %v Wei, TipCap: %v Wei", gasPrice, tipCap), lggrFields...)
+
+	f.setNextBaseFee(nextBaseFee)
+	f.setGasPrice(gasPrice)
+	f.setTipCap(tipCap)
+	promFeeHistoryEstimatorNextBaseFee.WithLabelValues(f.chainID.String()).Set(float64(nextBaseFee.Int64()))
+	promFeeHistoryEstimatorGasPrice.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(gasPrice.Int64()))
+	promFeeHistoryEstimatorTipCap.WithLabelValues(fmt.Sprintf("%v%%", percentile), f.chainID.String()).Set(float64(tipCap.Int64()))
+}
+
+// feeHistoryPrices returns the given percentile of the block rewards in
+// history, ignoring empty blocks, and the base fee of the next block.
+func feeHistoryPrices(history FeeHistory, percentile int) (tipCap, nextBaseFee *big.Int, err error) {
+	if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil {
+		return nil, nil, errors.New("fee history is missing the base fee of the next block")
+	}
+	nextBaseFee = history.BaseFee[len(history.BaseFee)-1].ToInt()
+
+	rewards := make([]*big.Int, 0, len(history.Reward))
+	for i, r := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			// Empty blocks report a reward of 0, which says nothing about
+			// the price needed for inclusion
+			continue
+		}
+		if len(r) == 0 || r[0] == nil {
+			continue
+		}
+		rewards = append(rewards, r[0].ToInt())
+	}
+	if len(rewards) == 0 {
+		return nil, nil, ErrNoSuitableTransactions
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tipCap = rewards[((len(rewards)-1)*percentile)/100]
+	return
+}
+
+func applyBaseFeeMultiplier(baseFee *big.Int, multiplier float32) *big.Int {
+	return decimal.NewFromBigInt(baseFee, 0).Mul(decimal.NewFromFloat32(multiplier)).BigInt()
+}
+
+func (f *FeeHistoryEstimator) setNextBaseFee(baseFee *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextBaseFee = baseFee
+}
+
+func (f *FeeHistoryEstimator) setGasPrice(gasPrice *big.Int) {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmMinGasPriceWei()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if gasPrice.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		f.gasPrice = max
+	} else if gasPrice.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "minGasPriceWei", min)
+		f.gasPrice = min
+	} else {
+		f.gasPrice = gasPrice
+	}
+}
+
+func (f *FeeHistoryEstimator) setTipCap(tipCap *big.Int) {
+	min := f.config.EvmGasTipCapMinimum()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if tipCap.Cmp(min) < 0 {
+		f.logger.Debugw(fmt.Sprintf("Calculated gas tip cap of %s Wei falls below EVM_GAS_TIP_CAP_MINIMUM=%[2]s, setting gas tip cap to the minimum allowed value of %[2]s Wei instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min)
+		f.tipCap = min
+	} else {
+		f.tipCap = tipCap
+	}
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getNextBaseFee() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.nextBaseFee
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(calldata []byte, gasLimit uint64, maxGasPriceWei *big.Int, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	if fallback := f.getFallback(); fallback != nil {
+		return fallback.GetLegacyGas(calldata, gasLimit, maxGasPriceWei, opts...)
+	}
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.config)
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint64, maxGasPriceWei *big.Int) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	if fallback := f.getFallback(); fallback != nil {
+		return fallback.BumpLegacyGas(originalGasPrice, gasLimit, maxGasPriceWei)
+	}
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(gasLimit uint64, maxGasPriceWei *big.Int) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if fallback := f.getFallback(); fallback != nil {
+		return fallback.GetDynamicFee(gasLimit, maxGasPriceWei)
+	}
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		if f.tipCap == nil || f.nextBaseFee == nil {
+			err = errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+			return
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.config)
+		fee.TipCap = f.tipCap
+		if f.config.EvmGasBumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+			return
+		}
+		fee.FeeCap = new(big.Int).Add(applyBaseFeeMultiplier(f.nextBaseFee, f.config.FeeHistoryEstimatorBaseFeeMultiplier()), f.tipCap)
+		if fee.FeeCap.Cmp(maxGasPrice) > 0 {
+			fee.FeeCap = maxGasPrice
+		}
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if err != nil {
+		return DynamicFee{}, 0, err
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64, maxGasPriceWei *big.Int) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	if fallback := f.getFallback(); fallback != nil {
+		return fallback.BumpDynamicFee(originalFee, originalGasLimit, maxGasPriceWei)
+	}
+	return BumpDynamicFeeOnly(f.config, f.logger, f.getTipCap(), f.getNextBaseFee(), originalFee, originalGasLimit, maxGasPriceWei)
+}
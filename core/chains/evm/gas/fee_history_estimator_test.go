@@ -0,0 +1,156 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	gumocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type methodNotFoundError struct{}
+
+func (methodNotFoundError) Error() string {
+	return "the method eth_feeHistory does not exist/is not available"
+}
+func (methodNotFoundError) ErrorCode() int { return -32601 }
+
+func newFeeHistoryConfig(t *testing.T) *gumocks.Config {
+	config := newConfigWithEIP1559DynamicFeesEnabled(t)
+	config.On("FeeHistoryEstimatorBlockCount").Maybe().Return(uint16(4))
+	config.On("FeeHistoryEstimatorRewardPercentile").Maybe().Return(uint16(60))
+	config.On("FeeHistoryEstimatorBaseFeeMultiplier").Maybe().Return(float32(1.25))
+	config.On("EvmMinGasPriceWei").Maybe().Return(big.NewInt(1))
+	config.On("EvmMaxGasPriceWei").Maybe().Return(big.NewInt(1000))
+	config.On("EvmGasTipCapMinimum").Maybe().Return(big.NewInt(1))
+	config.On("EvmGasLimitMultiplier").Maybe().Return(float32(1))
+	config.On("EvmGasBumpThreshold").Maybe().Return(uint64(3))
+	return config
+}
+
+func hexBigs(ints ...int64) []*hexutil.Big {
+	bigs := make([]*hexutil.Big, len(ints))
+	for i, n := range ints {
+		bigs[i] = (*hexutil.Big)(big.NewInt(n))
+	}
+	return bigs
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	history := gas.FeeHistory{
+		OldestBlock: (*hexutil.Big)(big.NewInt(39)),
+		Reward:      [][]*hexutil.Big{hexBigs(10), hexBigs(0), hexBigs(30), hexBigs(20)},
+		// the last value is the base fee of block 43
+		BaseFee:      hexBigs(100, 100, 100, 100, 200),
+		GasUsedRatio: []float64{0.5, 0, 0.9, 0.3},
+	}
+	expectFeeHistory := func(ethClient *evmmocks.Client) *mock.Call {
+		return ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*gas.FeeHistory"), "eth_feeHistory", hexutil.Uint(4), "latest", []float64{60})
+	}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, newFeeHistoryConfig(t), *big.NewInt(0))
+
+		_, _, err := f.GetLegacyGas(nil, 100000, big.NewInt(1000))
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("sets prices from the fee history on start, ignoring empty blocks", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, newFeeHistoryConfig(t), *big.NewInt(0))
+
+		expectFeeHistory(ethClient).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*gas.FeeHistory) = history
+		}).Once()
+
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		// 60th percentile of [10, 20, 30] is 20, and 200 * 1.25 + 20 = 270
+		gasPrice, gasLimit, err := f.GetLegacyGas(nil, 100000, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(270), gasPrice)
+		assert.Equal(t, uint64(100000), gasLimit)
+
+		fee, _, err := f.GetDynamicFee(100000, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(20), fee.TipCap)
+		assert.Equal(t, big.NewInt(270), fee.FeeCap)
+
+		fee, _, err = f.GetDynamicFee(100000, big.NewInt(100))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(100), fee.FeeCap)
+
+		chainID := "0"
+		assert.Equal(t, float64(270), promtestutil.ToFloat64(gas.PromFeeHistoryEstimatorGasPrice.WithLabelValues("60%", chainID)))
+		assert.Equal(t, float64(20), promtestutil.ToFloat64(gas.PromFeeHistoryEstimatorTipCap.WithLabelValues("60%", chainID)))
+		assert.Equal(t, float64(200), promtestutil.ToFloat64(gas.PromFeeHistoryEstimatorNextBaseFee.WithLabelValues(chainID)))
+
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("keeps the previous prices if every block is empty", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, newFeeHistoryConfig(t), *big.NewInt(0)).(*gas.FeeHistoryEstimator)
+
+		expectFeeHistory(ethClient).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).(*gas.FeeHistory) = history
+		}).Once()
+
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		f.Recalculate(gas.FeeHistory{
+			Reward:       [][]*hexutil.Big{hexBigs(0), hexBigs(0)},
+			BaseFee:      hexBigs(100, 100, 500),
+			GasUsedRatio: []float64{0, 0},
+		})
+
+		gasPrice, _, err := f.GetLegacyGas(nil, 100000, big.NewInt(1000))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(270), gasPrice)
+	})
+
+	t.Run("starts without prices if the initial fetch fails", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, newFeeHistoryConfig(t), *big.NewInt(0))
+
+		expectFeeHistory(ethClient).Return(errors.New("kaboom")).Once()
+
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(nil, 100000, big.NewInt(1000))
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+
+	t.Run("falls back to BlockHistoryEstimator if eth_feeHistory is not supported", func(t *testing.T) {
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), ethClient, newFeeHistoryConfig(t), *big.NewInt(0))
+
+		expectFeeHistory(ethClient).Return(methodNotFoundError{}).Once()
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, errors.New("kaboom")).Once()
+
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(nil, 100000, big.NewInt(1000))
+		assert.EqualError(t, err, "BlockHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+
+		ethClient.AssertExpectations(t)
+	})
+}
@@ -51,3 +51,9 @@ func GetLatestBaseFee(b *BlockHistoryEstimator) *big.Int {
 func SimulateStart(b *BlockHistoryEstimator) {
 	b.StartOnce("BlockHistoryEstimatorSimulatedStart", func() error { return nil })
 }
+
+var (
+	PromFeeHistoryEstimatorGasPrice    = promFeeHistoryEstimatorGasPrice
+	PromFeeHistoryEstimatorTipCap      = promFeeHistoryEstimatorTipCap
+	PromFeeHistoryEstimatorNextBaseFee = promFeeHistoryEstimatorNextBaseFee
+)
@@ -41,6 +41,9 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 		"blockHistorySize", cfg.BlockHistoryEstimatorBlockHistorySize(),
 		"eip1559FeeCapBufferBlocks", cfg.BlockHistoryEstimatorEIP1559FeeCapBufferBlocks(),
 		"transactionPercentile", cfg.BlockHistoryEstimatorTransactionPercentile(),
+		"feeHistoryBlockCount", cfg.FeeHistoryEstimatorBlockCount(),
+		"feeHistoryRewardPercentile", cfg.FeeHistoryEstimatorRewardPercentile(),
+		"feeHistoryBaseFeeMultiplier", cfg.FeeHistoryEstimatorBaseFeeMultiplier(),
 		"eip1559DynamicFees", cfg.EvmEIP1559DynamicFees(),
 		"gasBumpPercent", cfg.EvmGasBumpPercent(),
 		"gasBumpThreshold", cfg.EvmGasBumpThreshold(),
@@ -56,6 +59,8 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 	switch s {
 	case "BlockHistory":
 		return NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
+	case "FeeHistory":
+		return NewFeeHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
 	case "FixedPrice":
 		return NewFixedPriceEstimator(cfg, lggr)
 	case "Optimism2", "L2Suggested":
@@ -125,6 +130,9 @@ type Config interface {
 	EvmGasTipCapMinimum() *big.Int
 	EvmMaxGasPriceWei() *big.Int
 	EvmMinGasPriceWei() *big.Int
+	FeeHistoryEstimatorBaseFeeMultiplier() float32
+	FeeHistoryEstimatorBlockCount() uint16
+	FeeHistoryEstimatorRewardPercentile() uint16
 	GasEstimatorMode() string
 }
 
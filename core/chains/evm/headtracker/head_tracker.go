@@ -49,6 +49,13 @@ type headTracker struct {
 	chStop       chan struct{}
 	wgDone       sync.WaitGroup
 	utils.StartStopOnce
+
+	// warmMu guards the state of the head listener started by Warm, and
+	// serializes the heads it receives with the initial head of Start.
+	warmMu   sync.Mutex
+	warm     bool
+	live     bool
+	warmHead *evmtypes.Head
 }
 
 // NewHeadTracker instantiates a new HeadTracker using HeadSaver to persist new block numbers.
@@ -95,6 +102,10 @@ func (ht *headTracker) Start(ctx context.Context) error {
 			)
 		}
 
+		ht.warmMu.Lock()
+		defer ht.warmMu.Unlock()
+		ht.live = true
+
 		// NOTE: Always try to start the head tracker off with whatever the
 		// latest head is, without waiting for the subscription to send us one.
 		//
@@ -102,7 +113,10 @@ func (ht *headTracker) Start(ctx context.Context) error {
 		// anyway when we connect (but we should not rely on this because it is
 		// not specced). If it happens this is fine, and the head will be
 		// ignored as a duplicate.
-		initialHead, err := ht.getInitialHead(ctx)
+		initialHead := ht.warmHead
+		if initialHead == nil {
+			initialHead, err = ht.getInitialHead(ctx)
+		}
 		if err != nil {
 			if errors.Is(err, ctx.Err()) {
 				return nil
@@ -116,8 +130,11 @@ func (ht *headTracker) Start(ctx context.Context) error {
 			ht.log.Debug("Got nil initial head")
 		}
 
-		ht.wgDone.Add(3)
-		go ht.headListener.ListenForNewHeads(ht.handleNewHead, ht.wgDone.Done)
+		if !ht.warm {
+			ht.wgDone.Add(1)
+			go ht.headListener.ListenForNewHeads(ht.onNewHead, ht.wgDone.Done)
+		}
+		ht.wgDone.Add(2)
 		go ht.backfillLoop()
 		go ht.broadcastLoop()
 
@@ -125,8 +142,46 @@ func (ht *headTracker) Start(ctx context.Context) error {
 	})
 }
 
+// Warm subscribes to new heads ahead of Start, so that a standby node is
+// already receiving heads by the time it takes over. Heads received before
+// Start are neither saved nor broadcast, only the latest one is kept to
+// start off with. See services.Warmer.
+func (ht *headTracker) Warm(ctx context.Context) error {
+	ht.warmMu.Lock()
+	defer ht.warmMu.Unlock()
+	if ht.warm || ht.live {
+		return nil
+	}
+	ht.warm = true
+	ht.wgDone.Add(1)
+	go ht.headListener.ListenForNewHeads(ht.onNewHead, ht.wgDone.Done)
+	return nil
+}
+
+func (ht *headTracker) onNewHead(ctx context.Context, head *evmtypes.Head) error {
+	ht.warmMu.Lock()
+	defer ht.warmMu.Unlock()
+	if !ht.live {
+		if ht.warmHead == nil || head.Number >= ht.warmHead.Number {
+			ht.warmHead = head
+		}
+		return nil
+	}
+	return ht.handleNewHead(ctx, head)
+}
+
 // Close stops HeadTracker service.
 func (ht *headTracker) Close() error {
+	ht.warmMu.Lock()
+	warmOnly := ht.warm && !ht.live
+	ht.live = true // so that closing twice does not close chStop again
+	ht.warmMu.Unlock()
+	if warmOnly {
+		// Never started, only the head listener of Warm is running
+		close(ht.chStop)
+		ht.wgDone.Wait()
+		return nil
+	}
 	return ht.StopOnce("HeadTracker", func() error {
 		close(ht.chStop)
 		ht.wgDone.Wait()
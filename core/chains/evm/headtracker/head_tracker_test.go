@@ -32,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -196,6 +197,54 @@ func TestHeadTracker_Start_NewHeads(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestHeadTracker_Warm_SubscribesBeforeStart(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	config := newCfg(t)
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	chHeads := make(chan chan<- *evmtypes.Head, 1)
+	mockEth := &evmtest.MockEth{EthClient: ethClient}
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).
+		Return(
+			func(ctx context.Context, ch chan<- *evmtypes.Head) ethereum.Subscription {
+				chHeads <- ch
+				return mockEth.NewSub(t)
+			},
+			func(ctx context.Context, ch chan<- *evmtypes.Head) error { return nil },
+		).Once()
+	// backfill of the heads before the initial head
+	fnCall := ethClient.On("HeadByNumber", mock.Anything, mock.MatchedBy(func(num *big.Int) bool { return num != nil })).Maybe()
+	fnCall.RunFn = func(args mock.Arguments) {
+		num := args.Get(1).(*big.Int)
+		fnCall.ReturnArguments = mock.Arguments{cltest.Head(num.Int64()), nil}
+	}
+
+	ht := createHeadTracker(t, ethClient, config, orm)
+	warmer, ok := ht.headTracker.(services.Warmer)
+	require.True(t, ok)
+	require.NoError(t, warmer.Warm(testutils.Context(t)))
+
+	ch := <-chHeads
+	// the listener only receives 5 once it has handled 4
+	ch <- cltest.Head(4)
+	ch <- cltest.Head(5)
+
+	// Heads received while warm are not saved
+	latest, err := orm.LatestHead(testutils.Context(t))
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	// The latest warm head is the initial head, and there is no second subscription
+	ht.Start(t)
+	require.Eventually(t, func() bool { return ht.headSaver.LatestChain().Number == 5 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	ch <- cltest.Head(6)
+	require.Eventually(t, func() bool { return ht.headSaver.LatestChain().Number == 6 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+}
+
 func TestHeadTracker_Start_CancelContext(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,445 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Broadcaster = (*logPollerBroadcaster)(nil)
+
+// logPollerBroadcaster is a Broadcaster which reads logs from the LogPoller
+// instead of subscribing to them, so that it shares the LogPoller's RPC
+// calls, reorg handling and backfill.
+//
+// Registering a listener merges its contract and topics into the LogPoller's
+// filter, and unsubscribing removes them again. On every new head, the logs of
+// all registered contracts and topics in the last max(EvmFinalityDepth,
+// highest MinIncomingConfirmations) blocks are read from the LogPoller and sent
+// to listeners exactly like the subscription based broadcaster does: logs are
+// only sent once they have enough confirmations, consumption is tracked in the
+// log_broadcasts table, and logs removed by a reorg are never sent again since
+// the LogPoller deletes them.
+type logPollerBroadcaster struct {
+	orm        ORM
+	lp         logpoller.LogPoller
+	config     Config
+	evmChainID big.Int
+	logger     logger.Logger
+
+	registrations *registrations
+
+	// a block number to read logs from on the next heads, in addition to the
+	// usual window, until a head has been processed after all the LogPoller
+	// replays of the backfill have completed
+	backfillBlockNumber null.Int64
+	replaysInProgress   int
+	replayDone          chan error
+
+	changeSubscriberStatus *utils.Mailbox[changeSubscriberStatus]
+	newHeads               *utils.Mailbox[*evmtypes.Head]
+	replayChannel          chan replayRequest
+	highestSavedHead       *evmtypes.Head
+	lastSeenHeadNumber     atomic.Int64
+
+	utils.StartStopOnce
+	utils.DependentAwaiter
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+// NewLogPollerBroadcaster creates a Broadcaster which reads logs from lp. The
+// LogPoller must be started separately.
+func NewLogPollerBroadcaster(orm ORM, lp logpoller.LogPoller, config Config, lggr logger.Logger, evmChainID big.Int, highestSavedHead *evmtypes.Head) *logPollerBroadcaster {
+	lggr = lggr.Named("LogPollerBroadcaster")
+	return &logPollerBroadcaster{
+		orm:                    orm,
+		lp:                     lp,
+		config:                 config,
+		evmChainID:             evmChainID,
+		logger:                 lggr,
+		registrations:          newRegistrations(lggr, evmChainID),
+		changeSubscriberStatus: utils.NewMailbox[changeSubscriberStatus](100000),
+		newHeads:               utils.NewMailbox[*evmtypes.Head](1),
+		replayChannel:          make(chan replayRequest, 1),
+		replayDone:             make(chan error),
+		highestSavedHead:       highestSavedHead,
+		DependentAwaiter:       utils.NewDependentAwaiter(),
+		chStop:                 make(chan struct{}),
+	}
+}
+
+func (b *logPollerBroadcaster) Start(context.Context) error {
+	return b.StartOnce("LogPollerBroadcaster", func() error {
+		b.wgDone.Add(1)
+		go b.run()
+		return nil
+	})
+}
+
+func (b *logPollerBroadcaster) Close() error {
+	return b.StopOnce("LogPollerBroadcaster", func() error {
+		close(b.chStop)
+		b.wgDone.Wait()
+		return nil
+	})
+}
+
+// IsConnected reports whether the LogPoller is running.
+func (b *logPollerBroadcaster) IsConnected() bool {
+	return b.lp.Healthy() == nil
+}
+
+// ReplayFromBlock implements the Broadcaster interface.
+func (b *logPollerBroadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
+	b.logger.Infow("Replay requested", "block number", number, "force", forceBroadcast)
+	select {
+	case b.replayChannel <- replayRequest{
+		fromBlock:      number,
+		forceBroadcast: forceBroadcast,
+	}:
+	default:
+	}
+}
+
+func (b *logPollerBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubscribe func()) {
+	// Registering before start is fine, the registrations are applied once
+	// all dependents are ready
+	ok := b.IfNotStopped(func() {
+		if len(opts.LogsWithTopics) == 0 {
+			b.logger.Panic("Must supply at least 1 LogsWithTopics element to Register")
+		}
+		if opts.MinIncomingConfirmations <= 0 {
+			b.logger.Warnw(fmt.Sprintf("LogBroadcaster requires that MinIncomingConfirmations must be at least 1 (got %v). Logs must have been confirmed in at least 1 block, it does not support reading logs from the mempool before they have been mined. MinIncomingConfirmations will be set to 1.", opts.MinIncomingConfirmations), "addr", opts.Contract.Hex(), "jobID", listener.JobID())
+			opts.MinIncomingConfirmations = 1
+		}
+
+		// The LogPoller must start collecting the logs right away, so that
+		// logs emitted before the registration is applied are not missed
+		for topic := range opts.LogsWithTopics {
+			b.lp.MergeFilter([]common.Hash{topic}, opts.Contract)
+		}
+
+		sub := &subscriber{listener, opts}
+		b.logger.Debugf("Registering subscriber %p with job ID %v", sub, sub.listener.JobID())
+		if wasOverCapacity := b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusSubscribe, sub}); wasOverCapacity {
+			b.logger.Panicf("LogPollerBroadcaster subscribe: cannot subscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+		}
+
+		unsubscribe = func() {
+			b.logger.Debugf("Unregistering subscriber %p with job ID %v", sub, sub.listener.JobID())
+			if wasOverCapacity := b.changeSubscriberStatus.Deliver(changeSubscriberStatus{subscriberStatusUnsubscribe, sub}); wasOverCapacity {
+				b.logger.Panicf("LogPollerBroadcaster unsubscribe: cannot unsubscribe %p with job ID %v; changeSubscriberStatus channel was full", sub, sub.listener.JobID())
+			}
+			for topic := range opts.LogsWithTopics {
+				b.lp.RemoveFilter([]common.Hash{topic}, opts.Contract)
+			}
+		}
+	})
+	if !ok {
+		b.logger.Panic("Register cannot be called on a stopped log broadcaster (this is an invariant violation because all dependent services should have unregistered themselves before logbroadcaster.Close was called)")
+	}
+	return
+}
+
+func (b *logPollerBroadcaster) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
+	if wasOverCapacity := b.newHeads.Deliver(head); wasOverCapacity {
+		b.logger.Debugw("Dropped the older head in the mailbox, while inserting latest (which is fine)", "latestBlockNumber", head.Number)
+	}
+}
+
+func (b *logPollerBroadcaster) run() {
+	defer b.wgDone.Done()
+
+	b.logger.Debug("Starting to await initial subscribers until all dependents are ready...")
+	for awaiting := true; awaiting; {
+		select {
+		case <-b.changeSubscriberStatus.Notify():
+			b.onChangeSubscriberStatus()
+		case <-b.DependentAwaiter.AwaitDependents():
+			b.onChangeSubscriberStatus()
+			awaiting = false
+		case <-b.chStop:
+			return
+		}
+	}
+
+	b.initialBackfill()
+
+	for {
+		select {
+		case req := <-b.replayChannel:
+			b.onReplayRequest(req)
+		case err := <-b.replayDone:
+			b.onReplayDone(err)
+		case <-b.newHeads.Notify():
+			b.onNewHeads()
+		case <-b.changeSubscriberStatus.Notify():
+			b.onChangeSubscriberStatus()
+		case <-b.chStop:
+			return
+		}
+	}
+}
+
+// initialBackfill makes the first head read logs from as far back as the
+// subscription based broadcaster would backfill on start, and asks the
+// LogPoller to replay from there in case it was not watching the registered
+// contracts before.
+func (b *logPollerBroadcaster) initialBackfill() {
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	var from null.Int64
+	if b.config.BlockBackfillSkip() && b.highestSavedHead != nil {
+		b.logger.Warn("BlockBackfillSkip is set to true, preventing a deep backfill - some earlier chain events might be missed.")
+	} else if b.highestSavedHead != nil {
+		n := b.highestSavedHead.Number -
+			int64(b.registrations.highestNumConfirmations) -
+			int64(b.config.BlockBackfillDepth())
+		if n < 1 {
+			n = 1
+		}
+		from = null.Int64From(n)
+	}
+
+	// Remove leftover unconsumed broadcasts, and backfill sooner if there were any
+	backfillStart, err := b.orm.Reinitialize(pg.WithParentCtx(ctx))
+	if err != nil {
+		b.logger.Errorw("Failed to reinitialize database", "err", err)
+	} else if backfillStart != nil && (!from.Valid || *backfillStart < from.Int64) {
+		from = null.Int64From(*backfillStart)
+	}
+
+	if from.Valid && b.highestSavedHead != nil {
+		b.backfillFrom(from.Int64)
+	}
+}
+
+// backfillFrom replays the LogPoller from fromBlock in the background, and
+// reads logs from fromBlock on the next heads until the replay has completed
+// and its logs have been read.
+func (b *logPollerBroadcaster) backfillFrom(fromBlock int64) {
+	if fromBlock < 1 {
+		fromBlock = 1
+	}
+	if !b.backfillBlockNumber.Valid || fromBlock < b.backfillBlockNumber.Int64 {
+		b.backfillBlockNumber.SetValid(fromBlock)
+	}
+	b.logger.Debugw("Backfilling logs", "fromBlock", fromBlock)
+
+	b.replaysInProgress++
+	b.wgDone.Add(1)
+	go func() {
+		defer b.wgDone.Done()
+		ctx, cancel := utils.ContextFromChan(b.chStop)
+		defer cancel()
+		err := b.lp.Replay(ctx, fromBlock)
+		if err != nil {
+			err = fmt.Errorf("failed to replay LogPoller from block %d: %w", fromBlock, err)
+		}
+		select {
+		case b.replayDone <- err:
+		case <-b.chStop:
+		}
+	}()
+}
+
+func (b *logPollerBroadcaster) onReplayDone(err error) {
+	b.replaysInProgress--
+	if err != nil {
+		b.logger.Errorw("Backfill failed, some logs may be missed", "err", err)
+	}
+}
+
+// onReplayRequest notifies the subscribers and backfills from the requested block.
+func (b *logPollerBroadcaster) onReplayRequest(req replayRequest) {
+	for sub := range b.registrations.registeredSubs {
+		if sub.opts.ReplayStartedCallback != nil {
+			sub.opts.ReplayStartedCallback()
+		}
+	}
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+	if req.forceBroadcast {
+		if err := b.orm.MarkBroadcastsUnconsumed(req.fromBlock, pg.WithParentCtx(ctx)); err != nil {
+			b.logger.Errorw("Error marking broadcasts as unconsumed", "error", err, "fromBlock", req.fromBlock)
+		}
+	}
+	b.backfillFrom(req.fromBlock)
+}
+
+func (b *logPollerBroadcaster) onChangeSubscriberStatus() {
+	for {
+		change, exists := b.changeSubscriberStatus.Retrieve()
+		if !exists {
+			return
+		}
+		sub := change.sub
+
+		if change.newStatus == subscriberStatusSubscribe {
+			b.logger.Debugw("Subscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			// Logs of a contract or topic nobody listened to before may be
+			// missing from the LogPoller, so backfill them like a resubscribe would
+			if needsBackfill := b.registrations.addSubscriber(sub); needsBackfill {
+				if latest := b.lastSeenHeadNumber.Load(); latest > 0 {
+					b.backfillFrom(latest - int64(b.config.BlockBackfillDepth()))
+				}
+			}
+		} else {
+			b.logger.Debugw("Unsubscribing listener", "requiredBlockConfirmations", sub.opts.MinIncomingConfirmations, "address", sub.opts.Contract, "jobID", sub.listener.JobID())
+			b.registrations.removeSubscriber(sub)
+		}
+	}
+}
+
+func (b *logPollerBroadcaster) onNewHeads() {
+	latestHead := b.newHeads.RetrieveLatestAndClear()
+	if latestHead == nil {
+		return
+	}
+	b.logger.Debugw("Received head", "blockNumber", latestHead.Number,
+		"blockHash", latestHead.Hash, "parentHash", latestHead.ParentHash, "chainLen", latestHead.ChainLength())
+	b.lastSeenHeadNumber.Store(latestHead.Number)
+
+	if len(b.registrations.registeredSubs) == 0 {
+		return
+	}
+
+	keptLogsDepth := b.config.EvmFinalityDepth()
+	if b.registrations.highestNumConfirmations > keptLogsDepth {
+		keptLogsDepth = b.registrations.highestNumConfirmations
+	}
+	fromBlock := latestHead.Number - int64(keptLogsDepth)
+	if fromBlock < 0 {
+		fromBlock = 0
+	}
+	// The replays have saved the backfilled logs, so once this head's logs
+	// have been read the backfill is done
+	backfillDone := b.backfillBlockNumber.Valid && b.replaysInProgress == 0
+	if b.backfillBlockNumber.Valid && b.backfillBlockNumber.Int64 < fromBlock {
+		fromBlock = b.backfillBlockNumber.Int64
+	}
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	logs, err := b.findLogs(ctx, fromBlock, latestHead.Number)
+	if err != nil {
+		b.logger.Errorw("Failed to read logs from LogPoller", "fromBlock", fromBlock, "toBlock", latestHead.Number, "err", err)
+		return
+	}
+	if len(logs) > 0 {
+		broadcasts, err := b.orm.FindBroadcasts(fromBlock, latestHead.Number)
+		if err != nil {
+			b.logger.Errorf("Failed to query for log broadcasts, %v", err)
+			return
+		}
+		b.registrations.sendLogs(logs, *latestHead, broadcasts, b.orm)
+	}
+
+	if backfillDone {
+		b.logger.Debugw("Backfill complete", "fromBlock", b.backfillBlockNumber.Int64, "toBlock", latestHead.Number)
+		b.backfillBlockNumber.Valid = false
+	}
+}
+
+// findLogs returns the logs of all registered contracts and topics between
+// fromBlock and toBlock, grouped by block in ascending order.
+func (b *logPollerBroadcaster) findLogs(ctx context.Context, fromBlock, toBlock int64) ([]logsOnBlock, error) {
+	topicsByAddress := b.registrations.topicsByAddress()
+	var addresses []common.Address
+	topicSet := make(map[common.Hash]struct{})
+	for addr, topics := range topicsByAddress {
+		addresses = append(addresses, addr)
+		for _, topic := range topics {
+			topicSet[topic] = struct{}{}
+		}
+	}
+	topics := make([]common.Hash, 0, len(topicSet))
+	for topic := range topicSet {
+		topics = append(topics, topic)
+	}
+
+	// A single query for all contracts, which also matches topics registered
+	// for other contracts, so those are filtered out here
+	lgs, err := b.lp.LogsWithSigsAddrs(fromBlock, toBlock, topics, addresses, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var blocks []logsOnBlock
+	for _, l := range lgs {
+		if !containsTopic(topicsByAddress[l.Address], l.EventSig) {
+			continue
+		}
+		if len(blocks) == 0 || blocks[len(blocks)-1].BlockNumber != uint64(l.BlockNumber) {
+			blocks = append(blocks, logsOnBlock{BlockNumber: uint64(l.BlockNumber)})
+		}
+		block := &blocks[len(blocks)-1]
+		block.Logs = append(block.Logs, toGethLog(l))
+	}
+	return blocks, nil
+}
+
+func containsTopic(topics []common.Hash, eventSig []byte) bool {
+	for _, topic := range topics {
+		if bytes.Equal(topic[:], eventSig) {
+			return true
+		}
+	}
+	return false
+}
+
+func toGethLog(l logpoller.Log) types.Log {
+	return types.Log{
+		Address:     l.Address,
+		Topics:      l.GetTopics(),
+		Data:        l.Data,
+		BlockNumber: uint64(l.BlockNumber),
+		TxHash:      l.TxHash,
+		BlockHash:   l.BlockHash,
+		Index:       uint(l.LogIndex),
+	}
+}
+
+// WasAlreadyConsumed reports whether the given consumer had already consumed the given log
+func (b *logPollerBroadcaster) WasAlreadyConsumed(lb Broadcast, qopts ...pg.QOpt) (bool, error) {
+	return b.orm.WasBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().Index, lb.JobID(), qopts...)
+}
+
+// MarkConsumed marks the log as having been successfully consumed by the subscriber
+func (b *logPollerBroadcaster) MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error {
+	return b.orm.MarkBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().BlockNumber, lb.RawLog().Index, lb.JobID(), qopts...)
+}
+
+// MarkManyConsumed marks the logs as having been successfully consumed by the subscriber
+func (b *logPollerBroadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) error {
+	var (
+		blockHashes  = make([]common.Hash, len(lbs))
+		blockNumbers = make([]uint64, len(lbs))
+		logIndexes   = make([]uint, len(lbs))
+		jobIDs       = make([]int32, len(lbs))
+	)
+	for i := range lbs {
+		blockHashes[i] = lbs[i].RawLog().BlockHash
+		blockNumbers[i] = lbs[i].RawLog().BlockNumber
+		logIndexes[i] = lbs[i].RawLog().Index
+		jobIDs[i] = lbs[i].JobID()
+	}
+	return b.orm.MarkBroadcastsConsumed(blockHashes, blockNumbers, logIndexes, jobIDs, qopts...)
+}
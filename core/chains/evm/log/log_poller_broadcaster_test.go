@@ -0,0 +1,214 @@
+package log_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	lpmocks "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type topicLog struct{ topic common.Hash }
+
+func (l topicLog) Topic() common.Hash { return l.topic }
+
+type recordingListener struct {
+	jobID int32
+
+	mu         sync.Mutex
+	broadcasts []log.Broadcast
+}
+
+func (l *recordingListener) HandleLog(b log.Broadcast) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.broadcasts = append(l.broadcasts, b)
+}
+
+func (l *recordingListener) JobID() int32 { return l.jobID }
+
+func (l *recordingListener) blockNumbers() (nums []uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, b := range l.broadcasts {
+		nums = append(nums, b.RawLog().BlockNumber)
+	}
+	return
+}
+
+func TestLogPollerBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	contract := testutils.NewAddress()
+	topic := utils.NewHash()
+	contractLog := func(contract common.Address, topic common.Hash, blockNumber int64) logpoller.Log {
+		return logpoller.Log{
+			EvmChainId:  utils.NewBig(&cltest.FixtureChainID),
+			LogIndex:    1,
+			BlockHash:   common.BigToHash(common.Big1.SetInt64(blockNumber)),
+			BlockNumber: blockNumber,
+			Topics:      [][]byte{topic[:]},
+			EventSig:    topic[:],
+			Address:     contract,
+			Data:        []byte("hello"),
+		}
+	}
+	lpLog := func(blockNumber int64) logpoller.Log {
+		return contractLog(contract, topic, blockNumber)
+	}
+	head := func(n int64) *evmtypes.Head {
+		return &evmtypes.Head{Number: n, Hash: utils.NewHash()}
+	}
+
+	setup := func(t *testing.T) (log.Broadcaster, *logmocks.ORM, *lpmocks.LogPoller, *recordingListener) {
+		orm := logmocks.NewORM(t)
+		lp := lpmocks.NewLogPoller(t)
+		cfg := logmocks.NewConfig(t)
+		cfg.On("BlockBackfillSkip").Maybe().Return(false)
+		cfg.On("BlockBackfillDepth").Maybe().Return(uint64(10))
+		cfg.On("EvmFinalityDepth").Maybe().Return(uint32(5))
+		orm.On("Reinitialize", mock.Anything).Return(nil, nil).Once()
+		orm.On("CreateBroadcast", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
+
+		lb := log.NewLogPollerBroadcaster(orm, lp, cfg, logger.TestLogger(t), cltest.FixtureChainID, nil)
+		lb.AddDependents(1)
+		require.NoError(t, lb.Start(testutils.Context(t)))
+		t.Cleanup(func() { require.NoError(t, lb.Close()) })
+
+		listener := &recordingListener{jobID: 1}
+		lp.On("MergeFilter", []common.Hash{topic}, contract).Once()
+		lp.On("RemoveFilter", []common.Hash{topic}, contract).Once()
+		unsubscribe := lb.Register(listener, log.ListenerOpts{
+			Contract:                 contract,
+			LogsWithTopics:           map[common.Hash][][]log.Topic{topic: {}},
+			ParseLog:                 func(types.Log) (generated.AbigenLog, error) { return topicLog{topic}, nil },
+			MinIncomingConfirmations: 3,
+		})
+		t.Cleanup(unsubscribe)
+		lb.DependentReady()
+
+		return lb, orm, lp, listener
+	}
+
+	t.Run("sends logs from the log poller once they have enough confirmations", func(t *testing.T) {
+		lb, orm, lp, listener := setup(t)
+
+		// The window is max(EvmFinalityDepth, MinIncomingConfirmations) blocks deep
+		lp.On("LogsWithSigsAddrs", int64(5), int64(10), []common.Hash{topic}, []common.Address{contract}, mock.Anything).
+			Return([]logpoller.Log{lpLog(7), lpLog(9)}, nil).Once()
+		orm.On("FindBroadcasts", int64(5), int64(10)).Return(nil, nil).Once()
+
+		lb.OnNewLongestChain(testutils.Context(t), head(10))
+		require.Eventually(t, func() bool { return len(listener.blockNumbers()) == 1 }, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+		assert.Equal(t, []uint64{7}, listener.blockNumbers())
+
+		// The log at 7 has been consumed in the meantime
+		lp.On("LogsWithSigsAddrs", int64(6), int64(11), []common.Hash{topic}, []common.Address{contract}, mock.Anything).
+			Return([]logpoller.Log{lpLog(7), lpLog(9)}, nil).Once()
+		orm.On("FindBroadcasts", int64(6), int64(11)).Return([]log.LogBroadcast{
+			{BlockHash: lpLog(7).BlockHash, LogIndex: 1, JobID: 1, Consumed: true},
+		}, nil).Once()
+
+		lb.OnNewLongestChain(testutils.Context(t), head(11))
+		require.Eventually(t, func() bool { return len(listener.blockNumbers()) == 2 }, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+		assert.Equal(t, []uint64{7, 9}, listener.blockNumbers())
+	})
+
+	t.Run("reads the logs of all contracts at once", func(t *testing.T) {
+		lb, orm, lp, listener := setup(t)
+
+		contract2 := testutils.NewAddress()
+		topic2 := utils.NewHash()
+		listener2 := &recordingListener{jobID: 2}
+		lp.On("MergeFilter", []common.Hash{topic2}, contract2).Once()
+		lp.On("RemoveFilter", []common.Hash{topic2}, contract2).Once()
+		unsubscribe := lb.Register(listener2, log.ListenerOpts{
+			Contract:                 contract2,
+			LogsWithTopics:           map[common.Hash][][]log.Topic{topic2: {}},
+			ParseLog:                 func(types.Log) (generated.AbigenLog, error) { return topicLog{topic2}, nil },
+			MinIncomingConfirmations: 3,
+		})
+		t.Cleanup(unsubscribe)
+
+		// The log of contract2 with topic was not registered for
+		lp.On("LogsWithSigsAddrs", int64(5), int64(10), mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				assert.ElementsMatch(t, []common.Hash{topic, topic2}, args.Get(2))
+				assert.ElementsMatch(t, []common.Address{contract, contract2}, args.Get(3))
+			}).
+			Return([]logpoller.Log{lpLog(6), contractLog(contract2, topic, 7), contractLog(contract2, topic2, 8)}, nil).Once()
+		orm.On("FindBroadcasts", int64(5), int64(10)).Return(nil, nil).Once()
+
+		lb.OnNewLongestChain(testutils.Context(t), head(10))
+		require.Eventually(t, func() bool {
+			return len(listener.blockNumbers()) == 1 && len(listener2.blockNumbers()) == 1
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+		assert.Equal(t, []uint64{6}, listener.blockNumbers())
+		assert.Equal(t, []uint64{8}, listener2.blockNumbers())
+	})
+
+	t.Run("replays the log poller and reads logs from the replayed block until the replay is complete", func(t *testing.T) {
+		lb, orm, lp, listener := setup(t)
+
+		processed := make(chan struct{})
+		lp.On("LogsWithSigsAddrs", int64(5), int64(10), []common.Hash{topic}, []common.Address{contract}, mock.Anything).Return(nil, nil).Once().
+			Run(func(mock.Arguments) { close(processed) })
+		lb.OnNewLongestChain(testutils.Context(t), head(10))
+		select {
+		case <-processed:
+		case <-testutils.Context(t).Done():
+			t.Fatal("timed out waiting for head")
+		}
+
+		replayed := make(chan struct{})
+		complete := make(chan struct{})
+		orm.On("MarkBroadcastsUnconsumed", int64(3), mock.Anything).Return(nil).Once()
+		lp.On("Replay", mock.Anything, int64(3)).Return(nil).Once().Run(func(mock.Arguments) {
+			close(replayed)
+			<-complete
+		})
+		lb.ReplayFromBlock(3, true)
+		select {
+		case <-replayed:
+		case <-testutils.Context(t).Done():
+			t.Fatal("timed out waiting for replay")
+		}
+
+		// The replay is still in progress
+		lp.On("LogsWithSigsAddrs", int64(3), int64(12), []common.Hash{topic}, []common.Address{contract}, mock.Anything).
+			Return([]logpoller.Log{lpLog(4)}, nil).Once()
+		orm.On("FindBroadcasts", int64(3), int64(12)).Return(nil, nil).Once()
+
+		lb.OnNewLongestChain(testutils.Context(t), head(12))
+		require.Eventually(t, func() bool { return len(listener.blockNumbers()) == 1 }, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+		assert.Equal(t, []uint64{4}, listener.blockNumbers())
+
+		// Once the replay is complete, its logs are read one last time
+		close(complete)
+		lp.On("LogsWithSigsAddrs", int64(3), int64(13), []common.Hash{topic}, []common.Address{contract}, mock.Anything).
+			Return(nil, nil)
+		var windowRead atomic.Bool
+		lp.On("LogsWithSigsAddrs", int64(8), int64(13), []common.Hash{topic}, []common.Address{contract}, mock.Anything).
+			Return(nil, nil).Run(func(mock.Arguments) { windowRead.Store(true) })
+		require.Eventually(t, func() bool {
+			lb.OnNewLongestChain(testutils.Context(t), head(13))
+			return windowRead.Load()
+		}, testutils.WaitTimeout(t), cltest.DBPollingInterval)
+		lp.AssertCalled(t, "LogsWithSigsAddrs", int64(3), int64(13), []common.Hash{topic}, []common.Address{contract}, mock.Anything)
+	})
+}
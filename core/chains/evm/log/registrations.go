@@ -212,6 +212,29 @@ func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 	return addresses, topics
 }
 
+// topicsByAddress returns the topics registered for each contract address,
+// across all confirmation depths
+func (r *registrations) topicsByAddress() map[common.Address][]common.Hash {
+	topicSets := make(map[common.Address]map[common.Hash]struct{})
+	for _, handler := range r.handlersByConfs {
+		for addr, topics := range handler.lookupSubs {
+			if _, exists := topicSets[addr]; !exists {
+				topicSets[addr] = make(map[common.Hash]struct{})
+			}
+			for topic := range topics {
+				topicSets[addr][topic] = struct{}{}
+			}
+		}
+	}
+	topicsByAddress := make(map[common.Address][]common.Hash, len(topicSets))
+	for addr, topics := range topicSets {
+		for topic := range topics {
+			topicsByAddress[addr] = append(topicsByAddress[addr], topic)
+		}
+	}
+	return topicsByAddress
+}
+
 func (r *registrations) isAddressRegistered(address common.Address) bool {
 	for _, sub := range r.handlersByConfs {
 		if sub.isAddressRegistered(address) {
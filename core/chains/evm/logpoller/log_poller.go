@@ -28,10 +28,12 @@ type LogPoller interface {
 	services.ServiceCtx
 	Replay(ctx context.Context, fromBlock int64) error
 	MergeFilter(topics []common.Hash, address common.Address)
+	RemoveFilter(topics []common.Hash, address common.Address)
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
 
 	// General queries
 	Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
+	LogsWithSigsAddrs(start, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]Log, error)
 	LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error)
 	LatestLogEventSigsAddrs(fromBlock int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]Log, error)
 
@@ -54,11 +56,12 @@ type logPoller struct {
 	finalityDepth     int64         // finality depth is taken to mean that block (head - finality) is finalized
 	backfillBatchSize int64         // batch size to use when backfilling finalized logs
 
+	// The number of MergeFilter calls which added each address and topic
 	filterMu  sync.Mutex
-	addresses map[common.Address]struct{}
-	topics    map[int]map[common.Hash]struct{}
+	addresses map[common.Address]int
+	topics    map[int]map[common.Hash]int
 
-	replay chan int64
+	replay chan replayRequest
 	ctx    context.Context
 	cancel context.CancelFunc
 	done   chan struct{}
@@ -69,13 +72,13 @@ func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod tim
 		ec:                ec,
 		orm:               orm,
 		lggr:              lggr,
-		replay:            make(chan int64),
+		replay:            make(chan replayRequest),
 		done:              make(chan struct{}),
 		pollPeriod:        pollPeriod,
 		finalityDepth:     finalityDepth,
 		backfillBatchSize: backfillBatchSize,
-		addresses:         make(map[common.Address]struct{}),
-		topics:            make(map[int]map[common.Hash]struct{}),
+		addresses:         make(map[common.Address]int),
+		topics:            make(map[int]map[common.Hash]int),
 	}
 }
 
@@ -84,13 +87,39 @@ func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod tim
 func (lp *logPoller) MergeFilter(topics []common.Hash, address common.Address) {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
-	lp.addresses[address] = struct{}{}
+	lp.addresses[address]++
 	// [[A, B], [C]] + [[D], [], [E]] = [[A, B, D], [C], [E]]
 	for i := 0; i < len(topics); i++ {
 		if lp.topics[i] == nil {
-			lp.topics[i] = make(map[common.Hash]struct{})
+			lp.topics[i] = make(map[common.Hash]int)
 		}
-		lp.topics[i][topics[i]] = struct{}{}
+		lp.topics[i][topics[i]]++
+	}
+}
+
+// RemoveFilter undoes a MergeFilter call with the same topics and address.
+// An address or topic stays in the filter until every MergeFilter call which
+// added it has been undone, so that clients sharing the LogPoller do not
+// remove each other's filters.
+func (lp *logPoller) RemoveFilter(topics []common.Hash, address common.Address) {
+	lp.filterMu.Lock()
+	defer lp.filterMu.Unlock()
+	if lp.addresses[address] <= 1 {
+		delete(lp.addresses, address)
+	} else {
+		lp.addresses[address]--
+	}
+	for i := 0; i < len(topics); i++ {
+		if lp.topics[i][topics[i]] <= 1 {
+			delete(lp.topics[i], topics[i])
+		} else {
+			lp.topics[i][topics[i]]--
+		}
+	}
+	// Only trailing topic positions can be dropped, since filterTopics
+	// expects the positions to be contiguous
+	for i := len(lp.topics) - 1; i >= 0 && len(lp.topics[i]) == 0; i-- {
+		delete(lp.topics, i)
 	}
 }
 
@@ -136,8 +165,15 @@ func (lp *logPoller) filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Topics: topics, Addresses: addresses}
 }
 
+type replayRequest struct {
+	fromBlock int64
+	toBlock   int64
+	done      chan error
+}
+
 // Replay signals that the poller should resume from a new block.
-// Blocks until the replay starts.
+// Blocks until the logs up to the latest block at the time of the call have
+// been saved.
 func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
 	latest, err := lp.ec.BlockByNumber(ctx, nil)
 	if err != nil {
@@ -146,8 +182,18 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
 	if fromBlock < 1 || uint64(fromBlock) > latest.NumberU64() {
 		return errors.Errorf("Invalid replay block number %v, acceptable range [1, %v]", fromBlock, latest.NumberU64())
 	}
-	lp.replay <- fromBlock
-	return nil
+	req := replayRequest{fromBlock: fromBlock, toBlock: latest.Number().Int64(), done: make(chan error, 1)}
+	select {
+	case lp.replay <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err = <-req.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func (lp *logPoller) Start(parentCtx context.Context) error {
@@ -172,17 +218,26 @@ func (lp *logPoller) run() {
 	defer close(lp.done)
 	tick := time.After(0)
 	var start int64
+	var replays []replayRequest
 	for {
 		select {
 		case <-lp.ctx.Done():
+			for _, req := range replays {
+				req.done <- lp.ctx.Err()
+			}
 			return
-		case fromBlock := <-lp.replay:
-			lp.lggr.Warnw("Replay requested", "from", fromBlock)
-			start = fromBlock
+		case req := <-lp.replay:
+			lp.lggr.Warnw("Replay requested", "from", req.fromBlock)
+			// Replays in progress must still cover their own blocks
+			if len(replays) == 0 || req.fromBlock < start {
+				start = req.fromBlock
+			}
+			replays = append(replays, req)
 		case <-tick:
 			tick = time.After(utils.WithJitter(lp.pollPeriod))
 			if start != 0 {
 				start = lp.pollAndSaveLogs(lp.ctx, start)
+				replays = completeReplays(replays, start)
 				continue
 			}
 			// Otherwise, still need initial start
@@ -213,6 +268,20 @@ func (lp *logPoller) run() {
 	}
 }
 
+// completeReplays notifies the replays which have been saved up to their
+// latest block, and returns the ones still in progress.
+func completeReplays(replays []replayRequest, nextBlock int64) []replayRequest {
+	var pending []replayRequest
+	for _, req := range replays {
+		if nextBlock > req.toBlock {
+			req.done <- nil
+		} else {
+			pending = append(pending, req)
+		}
+	}
+	return pending
+}
+
 func convertLogs(chainID *big.Int, logs []types.Log) []Log {
 	var lgs []Log
 	for _, l := range logs {
@@ -440,6 +509,13 @@ func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common
 	return lp.orm.SelectLogsByBlockRangeFilter(start, end, address, eventSig[:], qopts...)
 }
 
+// LogsWithSigsAddrs returns logs of any of the given addresses with any of the
+// given event signatures in the given block range, ordered by block number and
+// log index, which are canonical at time of query.
+func (lp *logPoller) LogsWithSigsAddrs(start, end int64, eventSigs []common.Hash, addresses []common.Address, qopts ...pg.QOpt) ([]Log, error) {
+	return lp.orm.SelectLogsWithSigsAddrsByBlockRangeFilter(start, end, addresses, eventSigs, qopts...)
+}
+
 // IndexedLogs finds all the logs that have a topic value in topicValues at index topicIndex.
 func (lp *logPoller) IndexedLogs(eventSig common.Hash, address common.Address, topicIndex int, topicValues []common.Hash, confs int, qopts ...pg.QOpt) ([]Log, error) {
 	return lp.orm.SelectIndexedLogs(address, eventSig[:], topicIndex, topicValues, confs, qopts...)
@@ -346,3 +346,25 @@ func TestLogPoller_MergeFilter(t *testing.T) {
 	assert.Equal(t, []common.Address{a1, a2}, lp.FilterAddresses())
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}, {EmitterABI.Events["Log2"].ID}}, lp.FilterTopics())
 }
+
+func TestLogPoller_RemoveFilter(t *testing.T) {
+	lp := logpoller.NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1)
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	lp.MergeFilter([]common.Hash{EmitterABI.Events["Log1"].ID}, a1)
+	lp.MergeFilter([]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, a2)
+	lp.MergeFilter([]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, a2)
+
+	// Should keep what another MergeFilter call still needs
+	lp.RemoveFilter([]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, a2)
+	assert.Equal(t, []common.Address{a1, a2}, lp.FilterAddresses())
+	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}, {EmitterABI.Events["Log2"].ID}}, lp.FilterTopics())
+
+	lp.RemoveFilter([]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, a2)
+	assert.Equal(t, []common.Address{a1}, lp.FilterAddresses())
+	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.FilterTopics())
+
+	lp.RemoveFilter([]common.Hash{EmitterABI.Events["Log1"].ID}, a1)
+	assert.Empty(t, lp.FilterAddresses())
+	assert.Empty(t, lp.FilterTopics())
+}
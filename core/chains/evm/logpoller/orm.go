@@ -131,6 +131,30 @@ func (o *ORM) SelectLogsByBlockRangeFilter(start, end int64, address common.Addr
 	return logs, nil
 }
 
+// SelectLogsWithSigsAddrsByBlockRangeFilter finds the logs in a given block
+// range of any of the given addresses with any of the given event signatures.
+func (o *ORM) SelectLogsWithSigsAddrsByBlockRangeFilter(start, end int64, addresses []common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
+	var logs []Log
+	sigs := [][]byte{}
+	for _, sig := range eventSigs {
+		sigs = append(sigs, sig.Bytes())
+	}
+	addrs := [][]byte{}
+	for _, addr := range addresses {
+		addrs = append(addrs, addr.Bytes())
+	}
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
+		SELECT * FROM logs 
+			WHERE logs.block_number >= $1 AND logs.block_number <= $2 AND logs.evm_chain_id = $3 
+			AND address = ANY($4) AND event_sig = ANY($5) 
+			ORDER BY (logs.block_number, logs.log_index)`, start, end, utils.NewBig(o.chainID), pq.Array(addrs), pq.Array(sigs))
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 // LatestLogEventSigsAddrs finds the latest log by (address, event) combination that matches a list of addresses and list of events
 func (o *ORM) LatestLogEventSigsAddrs(fromBlock int64, addresses []common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
 	var logs []Log
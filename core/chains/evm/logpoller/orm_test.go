@@ -195,6 +195,25 @@ func TestORM(t *testing.T) {
 	lgs, err = o1.LatestLogEventSigsAddrs(0 /* startBlock */, []common.Address{common.HexToAddress("0x1234"), common.HexToAddress("0x1235")}, []common.Hash{topic, topic2})
 	require.NoError(t, err)
 	require.Equal(t, 4, len(lgs))
+
+	// addr 0x1234 has topic logs @ blocks 10 and 11, and a topic2 log @ block 14
+	lgs, err = o1.SelectLogsWithSigsAddrsByBlockRangeFilter(10, 14, []common.Address{common.HexToAddress("0x1234")}, []common.Hash{topic, topic2})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(lgs))
+	assert.Equal(t, int64(10), lgs[0].BlockNumber)
+	assert.Equal(t, int64(14), lgs[2].BlockNumber)
+
+	lgs, err = o1.SelectLogsWithSigsAddrsByBlockRangeFilter(11, 14, []common.Address{common.HexToAddress("0x1234")}, []common.Hash{topic2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lgs))
+	assert.Equal(t, []byte("hello2"), lgs[0].Data)
+
+	// addr 0x1235 adds topic logs @ blocks 12 and 13
+	lgs, err = o1.SelectLogsWithSigsAddrsByBlockRangeFilter(10, 14, []common.Address{common.HexToAddress("0x1234"), common.HexToAddress("0x1235")}, []common.Hash{topic, topic2})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(lgs))
+	assert.Equal(t, common.HexToAddress("0x1235"), lgs[2].Address)
+	assert.Equal(t, int64(14), lgs[4].BlockNumber)
 }
 
 func insertLogsTopicValueRange(t *testing.T, o *ORM, addr common.Address, blockNumber int, eventSig []byte, start, stop int) {
@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/label"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -229,7 +230,9 @@ func (eb *EthBroadcaster) monitorEthTxs(k ethkey.State, triggerCh chan struct{})
 		pollDBTimer := time.NewTimer(utils.WithJitter(eb.config.TriggerFallbackDBPollInterval()))
 
 		err, retryable := eb.ProcessUnstartedEthTxs(ctx, k)
-		if err != nil {
+		if errors.Is(err, keystore.ErrRemoteSignerUnavailable) {
+			eb.logger.Errorw("Remote signer is unavailable; transactions from this address will stay unstarted and be retried with backoff until it is back", "address", k.Address, "err", err)
+		} else if err != nil {
 			eb.logger.Errorw("Error occurred while handling eth_tx queue in ProcessUnstartedEthTxs", "err", err)
 		}
 		// On retryable errors we implement exponential backoff retries. This
@@ -321,7 +324,12 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 				return errors.Wrap(err, "failed to get dynamic gas fee"), true
 			}
 			a, err = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
-			if err != nil {
+			if errors.Is(err, keystore.ErrRemoteSignerRejected) {
+				if err = eb.saveRejectedTransaction(etx, err); err != nil {
+					return errors.Wrap(err, "processUnstartedEthTxs failed on saveRejectedTransaction"), true
+				}
+				continue
+			} else if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewDynamicFeeAttempt"), true
 			}
 		} else {
@@ -330,7 +338,12 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 				return errors.Wrap(err, "failed to estimate gas"), true
 			}
 			a, err = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
-			if err != nil {
+			if errors.Is(err, keystore.ErrRemoteSignerRejected) {
+				if err = eb.saveRejectedTransaction(etx, err); err != nil {
+					return errors.Wrap(err, "processUnstartedEthTxs failed on saveRejectedTransaction"), true
+				}
+				continue
+			} else if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed on NewLegacyAttempt"), true
 			}
 		}
@@ -752,9 +765,19 @@ func (eb *EthBroadcaster) tryAgainWithNewDynamicFeeGas(ctx context.Context, lgr
 	return eb.handleInProgressEthTx(ctx, etx, replacementAttempt, initialBroadcastAt)
 }
 
+// saveRejectedTransaction marks an unstarted transaction, which the remote
+// signer refused to sign, as fatally errored. Retrying would only block the
+// transactions queued behind it.
+func (eb *EthBroadcaster) saveRejectedTransaction(etx *EthTx, err error) error {
+	lgr := etx.GetLogger(eb.logger)
+	lgr.Errorw("Remote signer rejected transaction; it will not be sent", "err", err)
+	etx.Error = null.StringFrom(err.Error())
+	return eb.saveFatallyErroredTransaction(lgr, etx)
+}
+
 func (eb *EthBroadcaster) saveFatallyErroredTransaction(lgr logger.Logger, etx *EthTx) error {
-	if etx.State != EthTxInProgress {
-		return errors.Errorf("can only transition to fatal_error from in_progress, transaction is currently %s", etx.State)
+	if etx.State != EthTxInProgress && etx.State != EthTxUnstarted {
+		return errors.Errorf("can only transition to fatal_error from unstarted or in_progress, transaction is currently %s", etx.State)
 	}
 	if !etx.Error.Valid {
 		return errors.New("expected error field to be set")
@@ -31,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	ksmocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -1828,6 +1829,72 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_KeystoreErrors(t *testing.T) {
 		kst.AssertExpectations(t)
 	})
 
+	t.Run("remote signer is unavailable", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          txmgr.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		kst.On("SignTx", fromAddress, mock.AnythingOfType("*types.Transaction"), mock.Anything).
+			Return(nil, fmt.Errorf("%w: connection refused", keystore.ErrRemoteSignerUnavailable)).Once()
+
+		err, retryable := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+		assert.True(t, retryable)
+
+		// Check that the transaction is left in unstarted state, to be retried
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnstarted, etx.State)
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		var keyState ethkey.State
+		require.NoError(t, db.Get(&keyState, `SELECT * FROM eth_key_states`))
+		require.Equal(t, int64(localNonce), keyState.NextNonce)
+
+		kst.AssertExpectations(t)
+	})
+
+	t.Run("remote signer rejects the transaction", func(t *testing.T) {
+		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: encodedPayload,
+			Value:          value,
+			GasLimit:       gasLimit,
+			State:          txmgr.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+
+		kst.On("SignTx", fromAddress, mock.AnythingOfType("*types.Transaction"), mock.Anything).
+			Return(nil, fmt.Errorf("%w: policy denied", keystore.ErrRemoteSignerRejected)).Once()
+
+		err, retryable := eb.ProcessUnstartedEthTxs(context.Background(), keyState)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		// Check that the transaction was marked fatally errored, so that it does not block the queue
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+		assert.Nil(t, etx.Nonce)
+		assert.Contains(t, etx.Error.String, "policy denied")
+		assert.Len(t, etx.EthTxAttempts, 0)
+
+		var keyState ethkey.State
+		require.NoError(t, db.Get(&keyState, `SELECT * FROM eth_key_states`))
+		require.Equal(t, int64(localNonce), keyState.NextNonce)
+
+		kst.AssertExpectations(t)
+	})
+
 	// Should have done nothing
 	ethClient.AssertExpectations(t)
 }
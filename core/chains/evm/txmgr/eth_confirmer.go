@@ -30,6 +30,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -85,6 +86,7 @@ var (
 // Step 2: Check pending transactions for receipts
 // Step 3: See if any transactions have exceeded the gas bumping block threshold and, if so, bump them
 // Step 4: Check confirmed transactions to make sure they are still in the longest chain (reorg protection)
+// Separately, it periodically reports transactions which are stuck.
 type EthConfirmer struct {
 	utils.StartStopOnce
 
@@ -104,6 +106,14 @@ type EthConfirmer struct {
 	wg        sync.WaitGroup
 
 	nConsecutiveBlocksChainTooShort int
+
+	// processMu serializes head processing with remediation of stuck
+	// transactions
+	processMu      sync.Mutex
+	latestBlockNum int64
+	notifier       notifications.Notifier
+	stuckMu        sync.Mutex
+	stuckReported  map[int64]StuckTxDiagnosis
 }
 
 // NewEthConfirmer instantiates a new eth confirmer
@@ -133,6 +143,11 @@ func NewEthConfirmer(db *sqlx.DB, ethClient evmclient.Client, config Config, key
 		cancel,
 		sync.WaitGroup{},
 		0,
+		sync.Mutex{},
+		0,
+		notifications.NullNotifier{},
+		sync.Mutex{},
+		make(map[int64]StuckTxDiagnosis),
 	}
 }
 
@@ -145,8 +160,9 @@ func (ec *EthConfirmer) Start() error {
 			ec.lggr.Infow(fmt.Sprintf("Gas bumping is enabled, unconfirmed transactions will have their gas price bumped every %d blocks", ec.config.EvmGasBumpThreshold()), "ethGasBumpThreshold", ec.config.EvmGasBumpThreshold())
 		}
 
-		ec.wg.Add(1)
+		ec.wg.Add(2)
 		go ec.runLoop()
+		go ec.runStuckTxChecker()
 
 		return nil
 	})
@@ -191,6 +207,8 @@ func (ec *EthConfirmer) ProcessHead(ctx context.Context, head *evmtypes.Head) er
 	ctx, cancel := context.WithTimeout(ctx, processHeadTimeout)
 	defer cancel()
 
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
 	return ec.processHead(ctx, head)
 }
 
@@ -227,6 +245,8 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head *evmtypes.Head) er
 
 	ec.lggr.Tracew("Finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 
+	ec.latestBlockNum = head.Number
+
 	if ec.resumeCallback != nil {
 		mark = time.Now()
 		if err := ec.ResumePendingTaskRuns(ctx, head); err != nil {
@@ -1,6 +1,10 @@
 package txmgr
 
-import evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+import (
+	"math/big"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+)
 
 func SetEthClientOnEthConfirmer(ethClient evmclient.Client, ethConfirmer *EthConfirmer) {
 	ethConfirmer.ethClient = ethClient
@@ -9,3 +13,11 @@ func SetEthClientOnEthConfirmer(ethClient evmclient.Client, ethConfirmer *EthCon
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+func FindStuckEthTxs(etxs []*EthTx, blockNum int64, stuckThreshold, gasBumpThreshold uint64, maxGasPrice *big.Int) []StuckTx {
+	return findStuckEthTxs(etxs, blockNum, stuckThreshold, gasBumpThreshold, maxGasPrice)
+}
+
+func DiagnoseStuckEthTxs(stuck []StuckTx, unconfirmed []*EthTx, chainNonce int64) {
+	diagnoseStuckEthTxs(stuck, unconfirmed, chainNonce)
+}
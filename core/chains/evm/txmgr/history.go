@@ -0,0 +1,174 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ chains.TxHistory = (*orm)(nil)
+
+// historyTx is an eth_tx with the hash of its latest attempt, preferring one
+// with a receipt, and the job run which created it.
+type historyTx struct {
+	ID            int64
+	EVMChainID    utils.Big
+	FromAddress   common.Address
+	ToAddress     common.Address
+	State         EthTxState
+	Error         null.String
+	CreatedAt     time.Time
+	Hash          *common.Hash
+	JobID         *int32
+	PipelineRunID *int64
+}
+
+const historyTxSelect = `SELECT eth_txes.id, eth_txes.evm_chain_id, eth_txes.from_address, eth_txes.to_address, eth_txes.state, eth_txes.error, eth_txes.created_at,
+	(SELECT a.hash FROM eth_tx_attempts a LEFT JOIN eth_receipts r ON r.tx_hash = a.hash WHERE a.eth_tx_id = eth_txes.id ORDER BY r.id IS NULL, a.id DESC LIMIT 1) AS hash,
+	COALESCE(job_pipeline_specs.job_id, (eth_txes.meta->>'JobID')::int) AS job_id,
+	pipeline_runs.id AS pipeline_run_id
+FROM eth_txes
+LEFT JOIN pipeline_task_runs ON pipeline_task_runs.id = eth_txes.pipeline_task_run_id
+LEFT JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+LEFT JOIN job_pipeline_specs ON job_pipeline_specs.pipeline_spec_id = pipeline_runs.pipeline_spec_id`
+
+// txStates maps chain agnostic states to eth_tx states.
+var txStates = map[chains.TxState][]EthTxState{
+	chains.TxUnstarted: {EthTxUnstarted},
+	chains.TxPending:   {EthTxInProgress, EthTxUnconfirmed, EthTxConfirmedMissingReceipt},
+	chains.TxConfirmed: {EthTxConfirmed},
+	chains.TxErrored:   {EthTxFatalError},
+}
+
+func txState(state EthTxState) chains.TxState {
+	for txState, states := range txStates {
+		for _, s := range states {
+			if s == state {
+				return txState
+			}
+		}
+	}
+	return chains.TxPending
+}
+
+func (t historyTx) tx() chains.Tx {
+	tx := chains.Tx{
+		ChainType:     relay.EVM,
+		ChainID:       t.EVMChainID.String(),
+		ID:            strconv.FormatInt(t.ID, 10),
+		From:          t.FromAddress.Hex(),
+		To:            t.ToAddress.Hex(),
+		State:         txState(t.State),
+		ChainState:    string(t.State),
+		Error:         t.Error.String,
+		JobID:         t.JobID,
+		PipelineRunID: t.PipelineRunID,
+		CreatedAt:     t.CreatedAt,
+	}
+	if t.Hash != nil {
+		tx.Hash = t.Hash.Hex()
+	}
+	return tx
+}
+
+// Txs returns the eth_txes matching filter, across all EVM chains.
+func (o *orm) Txs(ctx context.Context, filter chains.TxFilter) (txs []chains.Tx, count int, err error) {
+	if filter.ChainType != "" && filter.ChainType != relay.EVM {
+		return nil, 0, nil
+	}
+	var where []string
+	var args []interface{}
+	if filter.ChainID != "" {
+		var chainID utils.Big
+		if chainID.UnmarshalText([]byte(filter.ChainID)) != nil {
+			return nil, 0, nil
+		}
+		args = append(args, chainID)
+		where = append(where, fmt.Sprintf("eth_txes.evm_chain_id = $%d", len(args)))
+	}
+	if filter.From != "" {
+		if !common.IsHexAddress(filter.From) {
+			return nil, 0, nil
+		}
+		args = append(args, common.HexToAddress(filter.From))
+		where = append(where, fmt.Sprintf("eth_txes.from_address = $%d", len(args)))
+	}
+	if filter.State != "" {
+		var states []string
+		for _, s := range txStates[filter.State] {
+			states = append(states, string(s))
+		}
+		args = append(args, pq.Array(states))
+		where = append(where, fmt.Sprintf("eth_txes.state = ANY($%d)", len(args)))
+	}
+	var whereSQL string
+	if len(where) > 0 {
+		whereSQL = " WHERE " + strings.Join(where, " AND ")
+	}
+
+	q := o.q.WithOpts(pg.WithParentCtx(ctx))
+	if err = q.Get(&count, `SELECT count(*) FROM eth_txes`+whereSQL, args...); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count eth_txes")
+	}
+	query := historyTxSelect + whereSQL + ` ORDER BY eth_txes.created_at DESC, eth_txes.id DESC`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	args = append(args, filter.Offset)
+	query += fmt.Sprintf(" OFFSET $%d", len(args))
+	var htxs []historyTx
+	if err = q.Select(&htxs, query, args...); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to load eth_txes")
+	}
+	for _, htx := range htxs {
+		txs = append(txs, htx.tx())
+	}
+	return txs, count, nil
+}
+
+// FindTx returns the eth_tx with id, which is either its ID or the hash of
+// one of its attempts.
+func (o *orm) FindTx(ctx context.Context, chainType relay.Network, chainID, id string) (chains.Tx, error) {
+	if chainType != relay.EVM {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	var evmChainID utils.Big
+	if evmChainID.UnmarshalText([]byte(chainID)) != nil {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	query := historyTxSelect + ` WHERE eth_txes.evm_chain_id = $1 AND `
+	var arg interface{}
+	if strings.HasPrefix(id, "0x") {
+		query += `eth_txes.id = (SELECT eth_tx_id FROM eth_tx_attempts WHERE hash = $2)`
+		arg = common.HexToHash(id)
+	} else {
+		etxID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return chains.Tx{}, chains.ErrTxNotFound
+		}
+		query += `eth_txes.id = $2`
+		arg = etxID
+	}
+	var htx historyTx
+	err := o.q.WithOpts(pg.WithParentCtx(ctx)).Get(&htx, query, evmChainID, arg)
+	if errors.Is(err, sql.ErrNoRows) {
+		return chains.Tx{}, chains.ErrTxNotFound
+	} else if err != nil {
+		return chains.Tx{}, errors.Wrap(err, "failed to load eth_tx")
+	}
+	return htx.tx(), nil
+}
@@ -144,6 +144,20 @@ func (_m *Config) EthTxResendAfterThreshold() time.Duration {
 	return r0
 }
 
+// EthTxStuckThreshold provides a mock function with given fields:
+func (_m *Config) EthTxStuckThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmEIP1559DynamicFees provides a mock function with given fields:
 func (_m *Config) EvmEIP1559DynamicFees() bool {
 	ret := _m.Called()
@@ -172,6 +186,22 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmForwarderOwners provides a mock function with given fields:
+func (_m *Config) EvmForwarderOwners() []common.Address {
+	ret := _m.Called()
+
+	var r0 []common.Address
+	if rf, ok := ret.Get(0).(func() []common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *Config) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -274,6 +304,20 @@ func (_m *Config) EvmGasLimitMultiplier() float32 {
 	return r0
 }
 
+// EvmGasLimitTransfer provides a mock function with given fields:
+func (_m *Config) EvmGasLimitTransfer() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EvmGasPriceDefault provides a mock function with given fields:
 func (_m *Config) EvmGasPriceDefault() *big.Int {
 	ret := _m.Called()
@@ -424,6 +468,48 @@ func (_m *Config) EvmUseForwarders() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeMultiplier provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBaseFeeMultiplier() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -468,6 +554,20 @@ func (_m *Config) LogSQL() bool {
 	return r0
 }
 
+// OperatorFactoryAddress provides a mock function with given fields:
+func (_m *Config) OperatorFactoryAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -4,8 +4,14 @@ package mocks
 
 import (
 	common "github.com/ethereum/go-ethereum/common"
+	chains "github.com/smartcontractkit/chainlink/core/chains"
+
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
+	relay "github.com/smartcontractkit/chainlink/core/services/relay"
+
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 )
 
@@ -194,6 +200,27 @@ func (_m *ORM) FindEthTxWithAttempts(etxID int64) (txmgr.EthTx, error) {
 	return r0, r1
 }
 
+// FindTx provides a mock function with given fields: ctx, chainType, chainID, id
+func (_m *ORM) FindTx(ctx context.Context, chainType relay.Network, chainID string, id string) (chains.Tx, error) {
+	ret := _m.Called(ctx, chainType, chainID, id)
+
+	var r0 chains.Tx
+	if rf, ok := ret.Get(0).(func(context.Context, relay.Network, string, string) chains.Tx); ok {
+		r0 = rf(ctx, chainType, chainID, id)
+	} else {
+		r0 = ret.Get(0).(chains.Tx)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, relay.Network, string, string) error); ok {
+		r1 = rf(ctx, chainType, chainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertEthReceipt provides a mock function with given fields: receipt
 func (_m *ORM) InsertEthReceipt(receipt *txmgr.EthReceipt) error {
 	ret := _m.Called(receipt)
@@ -236,6 +263,36 @@ func (_m *ORM) InsertEthTxAttempt(attempt *txmgr.EthTxAttempt) error {
 	return r0
 }
 
+// Txs provides a mock function with given fields: ctx, filter
+func (_m *ORM) Txs(ctx context.Context, filter chains.TxFilter) ([]chains.Tx, int, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []chains.Tx
+	if rf, ok := ret.Get(0).(func(context.Context, chains.TxFilter) []chains.Tx); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]chains.Tx)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, chains.TxFilter) int); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, chains.TxFilter) error); ok {
+		r2 = rf(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 type NewORMT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -27,6 +27,34 @@ type TxManager struct {
 	mock.Mock
 }
 
+// AbandonTransaction provides a mock function with given fields: ctx, etxID
+func (_m *TxManager) AbandonTransaction(ctx context.Context, etxID int64) error {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelTransaction provides a mock function with given fields: ctx, etxID
+func (_m *TxManager) CancelTransaction(ctx context.Context, etxID int64) error {
+	ret := _m.Called(ctx, etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -69,6 +97,29 @@ func (_m *TxManager) CreateEthTransaction(newTx txmgr.NewTx, qopts ...pg.QOpt) (
 	return r0, r1
 }
 
+// FindStuckTransactions provides a mock function with given fields: ctx
+func (_m *TxManager) FindStuckTransactions(ctx context.Context) ([]txmgr.StuckTx, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []txmgr.StuckTx
+	if rf, ok := ret.Get(0).(func(context.Context) []txmgr.StuckTx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]txmgr.StuckTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetGasEstimator provides a mock function with given fields:
 func (_m *TxManager) GetGasEstimator() gas.Estimator {
 	ret := _m.Called()
@@ -123,6 +174,20 @@ func (_m *TxManager) RegisterResumeCallback(fn txmgr.ResumeCallback) {
 	_m.Called(fn)
 }
 
+// ReplaceTransaction provides a mock function with given fields: ctx, etxID, fee
+func (_m *TxManager) ReplaceTransaction(ctx context.Context, etxID int64, fee txmgr.ReplacementFee) error {
+	ret := _m.Called(ctx, etxID, fee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, txmgr.ReplacementFee) error); ok {
+		r0 = rf(ctx, etxID, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendEther provides a mock function with given fields: chainID, from, to, value, gasLimit
 func (_m *TxManager) SendEther(chainID *big.Int, from common.Address, to common.Address, value assets.Eth, gasLimit uint64) (txmgr.EthTx, error) {
 	ret := _m.Called(chainID, from, to, value, gasLimit)
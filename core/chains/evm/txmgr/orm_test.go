@@ -2,11 +2,15 @@ package txmgr_test
 
 import (
 	"math/big"
+	"strconv"
 	"testing"
 
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -171,3 +175,62 @@ func TestORM(t *testing.T) {
 		assert.Equal(t, r.BlockHash, etx.EthTxAttempts[0].EthReceipts[0].BlockHash)
 	})
 }
+
+func TestORM_Txs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ctx := testutils.Context(t)
+
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, otherFrom := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	confirmed := cltest.MustInsertConfirmedEthTxWithReceipt(t, orm, from, 0, 1)
+	fatal := cltest.MustInsertFatalErrorEthTx(t, orm, from)
+	unstarted := cltest.MustInsertUnstartedEthTx(t, orm, otherFrom)
+
+	txs, count, err := orm.Txs(ctx, chains.TxFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, txs, 3)
+	assert.Equal(t, strconv.FormatInt(unstarted.ID, 10), txs[0].ID, "newest first")
+	assert.Equal(t, chains.TxUnstarted, txs[0].State)
+	assert.Empty(t, txs[0].Hash)
+
+	txs, count, err = orm.Txs(ctx, chains.TxFilter{ChainType: relay.EVM, ChainID: "0", From: from.Hex(), Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, txs, 1)
+	assert.Equal(t, strconv.FormatInt(fatal.ID, 10), txs[0].ID)
+	assert.Equal(t, chains.TxErrored, txs[0].State)
+	assert.Equal(t, "fatal_error", txs[0].ChainState)
+	assert.Equal(t, "something exploded", txs[0].Error)
+
+	txs, count, err = orm.Txs(ctx, chains.TxFilter{State: chains.TxConfirmed})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, txs, 1)
+	assert.Equal(t, confirmed.EthTxAttempts[0].Hash.Hex(), txs[0].Hash)
+	assert.Equal(t, from.Hex(), txs[0].From)
+	assert.Equal(t, confirmed.ToAddress.Hex(), txs[0].To)
+
+	txs, count, err = orm.Txs(ctx, chains.TxFilter{ChainType: relay.Terra})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, txs)
+
+	tx, err := orm.FindTx(ctx, relay.EVM, "0", strconv.FormatInt(confirmed.ID, 10))
+	require.NoError(t, err)
+	assert.Equal(t, chains.TxConfirmed, tx.State)
+	tx, err = orm.FindTx(ctx, relay.EVM, "0", confirmed.EthTxAttempts[0].Hash.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, strconv.FormatInt(confirmed.ID, 10), tx.ID)
+
+	_, err = orm.FindTx(ctx, relay.EVM, "1", strconv.FormatInt(confirmed.ID, 10))
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+	_, err = orm.FindTx(ctx, relay.Solana, "0", strconv.FormatInt(confirmed.ID, 10))
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+}
@@ -0,0 +1,488 @@
+package txmgr
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// StuckTxDiagnosis is the most likely reason why a transaction is stuck
+type StuckTxDiagnosis string
+
+const (
+	// StuckTxInsufficientFunds means the key does not hold enough eth to pay
+	// for the transaction
+	StuckTxInsufficientFunds = StuckTxDiagnosis("insufficient_funds")
+	// StuckTxNonceGap means the chain is waiting for a lower nonce which this
+	// node does not know of, e.g. because the nonce was set manually or a
+	// transaction was dropped
+	StuckTxNonceGap = StuckTxDiagnosis("nonce_gap")
+	// StuckTxBlocked means the transaction is waiting for a lower nonce
+	// transaction from the same key, which is stuck itself
+	StuckTxBlocked = StuckTxDiagnosis("blocked")
+	// StuckTxUnderpriced means the transaction was accepted by the network
+	// but its fee is too low to get it included
+	StuckTxUnderpriced = StuckTxDiagnosis("underpriced")
+)
+
+// stuckTxCheckInterval is how often unconfirmed transactions are checked for
+// being stuck
+const stuckTxCheckInterval = time.Minute
+
+var (
+	// ErrTxNotUnconfirmed is returned when trying to remediate a transaction
+	// which is not unconfirmed, e.g. because it has been confirmed meanwhile
+	ErrTxNotUnconfirmed = errors.New("transaction is not unconfirmed")
+
+	errTxCancelled = errors.New("transaction was cancelled by the node operator")
+	errTxAbandoned = errors.New("transaction was abandoned by the node operator")
+)
+
+// StuckTx is an unconfirmed transaction which has been pending for longer
+// than ETH_TX_STUCK_THRESHOLD blocks, or which can no longer be bumped
+// because it is at the maximum gas price
+type StuckTx struct {
+	// EthTx has its attempts loaded, highest priced first
+	EthTx EthTx
+	// BlocksPending is the number of blocks since the transaction was first
+	// broadcast
+	BlocksPending int64
+	AtMaxGasPrice bool
+	Diagnosis     StuckTxDiagnosis
+}
+
+// ReplacementFee is the fee of a replacement attempt. GasPrice must be set
+// for legacy transactions, and GasTipCap and GasFeeCap for EIP-1559
+// transactions.
+type ReplacementFee struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+// attemptFee returns the maximum price per gas an attempt pays
+func attemptFee(a EthTxAttempt) *big.Int {
+	if a.TxType == 0x2 {
+		if a.GasFeeCap == nil {
+			return nil
+		}
+		return a.GasFeeCap.ToInt()
+	}
+	if a.GasPrice == nil {
+		return nil
+	}
+	return a.GasPrice.ToInt()
+}
+
+// highestPricedAttempt returns the attempt of etx paying the highest fee
+func highestPricedAttempt(etx EthTx) (highest EthTxAttempt, ok bool) {
+	for _, a := range etx.EthTxAttempts {
+		fee := attemptFee(a)
+		if fee == nil {
+			continue
+		}
+		if !ok || fee.Cmp(attemptFee(highest)) > 0 {
+			highest, ok = a, true
+		}
+	}
+	return
+}
+
+// findStuckEthTxs returns the stuck transactions among etxs, which must be
+// the unconfirmed transactions of a single key with their attempts loaded.
+//
+// A transaction is stuck if it has been pending for at least stuckThreshold
+// blocks, if it was rejected for insufficient eth, or if it is due for a gas
+// bump but already pays maxGasPrice. Diagnoses are left empty.
+func findStuckEthTxs(etxs []*EthTx, blockNum int64, stuckThreshold, gasBumpThreshold uint64, maxGasPrice *big.Int) (stuck []StuckTx) {
+	for _, etx := range etxs {
+		s := StuckTx{EthTx: *etx}
+		var firstBroadcast *int64
+		insufficientEth := false
+		for _, a := range etx.EthTxAttempts {
+			if a.State == EthTxAttemptInsufficientEth {
+				insufficientEth = true
+			}
+			if a.BroadcastBeforeBlockNum != nil && (firstBroadcast == nil || *a.BroadcastBeforeBlockNum < *firstBroadcast) {
+				firstBroadcast = a.BroadcastBeforeBlockNum
+			}
+		}
+		if firstBroadcast != nil {
+			s.BlocksPending = blockNum - *firstBroadcast
+		}
+		if highest, ok := highestPricedAttempt(*etx); ok && maxGasPrice != nil {
+			s.AtMaxGasPrice = attemptFee(highest).Cmp(maxGasPrice) >= 0
+		}
+
+		overThreshold := stuckThreshold > 0 && s.BlocksPending >= int64(stuckThreshold)
+		cannotBump := s.AtMaxGasPrice && gasBumpThreshold > 0 && s.BlocksPending >= int64(gasBumpThreshold)
+		if insufficientEth || overThreshold || cannotBump {
+			stuck = append(stuck, s)
+		}
+	}
+	return
+}
+
+// diagnoseStuckEthTxs sets the diagnosis of every stuck transaction.
+// unconfirmed are all the unconfirmed transactions of the key, and chainNonce
+// is the number of transactions from the key mined on chain.
+func diagnoseStuckEthTxs(stuck []StuckTx, unconfirmed []*EthTx, chainNonce int64) {
+	known := make(map[int64]struct{}, len(unconfirmed))
+	for _, etx := range unconfirmed {
+		if etx.Nonce != nil {
+			known[*etx.Nonce] = struct{}{}
+		}
+	}
+	_, nextKnown := known[chainNonce]
+	for i, s := range stuck {
+		insufficientEth := false
+		for _, a := range s.EthTx.EthTxAttempts {
+			if a.State == EthTxAttemptInsufficientEth {
+				insufficientEth = true
+				break
+			}
+		}
+		switch {
+		case insufficientEth:
+			stuck[i].Diagnosis = StuckTxInsufficientFunds
+		case s.EthTx.Nonce != nil && *s.EthTx.Nonce > chainNonce && !nextKnown:
+			stuck[i].Diagnosis = StuckTxNonceGap
+		case s.EthTx.Nonce != nil && *s.EthTx.Nonce > chainNonce:
+			stuck[i].Diagnosis = StuckTxBlocked
+		default:
+			stuck[i].Diagnosis = StuckTxUnderpriced
+		}
+	}
+}
+
+// findUnconfirmedEthTxs returns the unconfirmed transactions of address with
+// their attempts, in nonce ASC order
+func findUnconfirmedEthTxs(ctx context.Context, q pg.Q, address gethCommon.Address, chainID big.Int) (etxs []*EthTx, err error) {
+	qq := q.WithOpts(pg.WithParentCtx(ctx))
+	err = qq.Transaction(func(tx pg.Queryer) error {
+		err = tx.Select(&etxs, `
+SELECT * FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state = 'unconfirmed'
+ORDER BY nonce ASC
+`, address, chainID.String())
+		if err != nil {
+			return errors.Wrap(err, "findUnconfirmedEthTxs failed to load eth_txes")
+		}
+		err = loadEthTxesAttempts(tx, etxs)
+		return errors.Wrap(err, "findUnconfirmedEthTxs failed to load eth_tx_attempts")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
+// FindStuckTransactions returns the stuck transactions of all keys, with their
+// diagnosis
+func (ec *EthConfirmer) FindStuckTransactions(ctx context.Context) ([]StuckTx, error) {
+	blockNum := ec.latestProcessedBlockNum()
+	if blockNum == 0 {
+		return nil, errors.New("no head has been processed yet")
+	}
+	return ec.findStuckTransactions(ctx, blockNum)
+}
+
+// latestProcessedBlockNum returns the number of the last head processed, or 0
+func (ec *EthConfirmer) latestProcessedBlockNum() int64 {
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
+	return ec.latestBlockNum
+}
+
+func (ec *EthConfirmer) findStuckTransactions(ctx context.Context, blockNum int64) (stuck []StuckTx, err error) {
+	for _, key := range ec.keyStates {
+		address := key.Address.Address()
+		etxs, err := findUnconfirmedEthTxs(ctx, ec.q, address, ec.chainID)
+		if err != nil {
+			return nil, err
+		}
+		keyStuck := findStuckEthTxs(etxs, blockNum, ec.config.EthTxStuckThreshold(), ec.config.EvmGasBumpThreshold(), ec.config.KeySpecificMaxGasPriceWei(address))
+		if len(keyStuck) == 0 {
+			continue
+		}
+		chainNonce, err := ec.ethClient.NonceAt(ctx, address, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch on-chain nonce for %s", address.Hex())
+		}
+		diagnoseStuckEthTxs(keyStuck, etxs, int64(chainNonce))
+		stuck = append(stuck, keyStuck...)
+	}
+	return stuck, nil
+}
+
+// CheckForStuckTransactions logs and notifies transactions which became stuck,
+// or whose diagnosis changed, since the last check. It runs every
+// stuckTxCheckInterval rather than on every head, since it queries the
+// on-chain nonce of every key with stuck transactions.
+func (ec *EthConfirmer) CheckForStuckTransactions(ctx context.Context) error {
+	blockNum := ec.latestProcessedBlockNum()
+	if blockNum == 0 {
+		return nil
+	}
+	stuck, err := ec.findStuckTransactions(ctx, blockNum)
+	if err != nil {
+		return err
+	}
+
+	ec.stuckMu.Lock()
+	defer ec.stuckMu.Unlock()
+	reported := make(map[int64]StuckTxDiagnosis, len(stuck))
+	for _, s := range stuck {
+		reported[s.EthTx.ID] = s.Diagnosis
+		if ec.stuckReported[s.EthTx.ID] == s.Diagnosis {
+			continue
+		}
+		nonce := *s.EthTx.Nonce
+		s.EthTx.GetLogger(ec.lggr).Warnw(fmt.Sprintf("Transaction is stuck: %s. It can be cancelled, replaced with a higher fee or abandoned with the remote API", s.Diagnosis),
+			"blocksPending", s.BlocksPending, "atMaxGasPrice", s.AtMaxGasPrice, "diagnosis", s.Diagnosis)
+		ec.notifier.Notify(notifications.NewStuckTransactionEvent(&ec.chainID, s.EthTx.FromAddress.Hex(), s.EthTx.ID, nonce, string(s.Diagnosis), s.BlocksPending, s.AtMaxGasPrice))
+	}
+	ec.stuckReported = reported
+	return nil
+}
+
+func (ec *EthConfirmer) runStuckTxChecker() {
+	defer ec.wg.Done()
+	ticker := time.NewTicker(utils.WithJitter(stuckTxCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// Failing to report stuck transactions must not hold up confirmations
+			if err := ec.CheckForStuckTransactions(ec.ctx); err != nil {
+				ec.lggr.Errorw("CheckForStuckTransactions failed", "err", err)
+			}
+		case <-ec.ctx.Done():
+			return
+		}
+	}
+}
+
+// loadRemediableEthTx returns the unconfirmed transaction etxID with its
+// attempts, if it was sent by a key of this confirmer
+func (ec *EthConfirmer) loadRemediableEthTx(etxID int64) (etx EthTx, err error) {
+	err = ec.q.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 AND evm_chain_id = $2`, etxID, ec.chainID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return etx, errors.Errorf("no transaction exists with ID %d on chain %s", etxID, ec.chainID.String())
+	} else if err != nil {
+		return etx, errors.Wrap(err, "failed to load eth_tx")
+	}
+	if etx.State != EthTxUnconfirmed {
+		return etx, errors.Wrapf(ErrTxNotUnconfirmed, "transaction %d is %s", etxID, etx.State)
+	}
+	found := false
+	for _, key := range ec.keyStates {
+		if key.Address.Address() == etx.FromAddress {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return etx, errors.Errorf("transaction %d was sent from %s, which is not an enabled key", etxID, etx.FromAddress.Hex())
+	}
+	if err = loadEthTxAttempts(ec.q, &etx); err != nil {
+		return etx, err
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		return etx, errors.Errorf("transaction %d has no attempts", etxID)
+	}
+	return etx, nil
+}
+
+// resumeWithError fails the pipeline run waiting for etx, if any
+func (ec *EthConfirmer) resumeWithError(etx EthTx, err error) error {
+	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil {
+		return nil
+	}
+	rerr := ec.resumeCallback(etx.PipelineTaskRunID.UUID, nil, err)
+	if errors.Is(rerr, sql.ErrNoRows) {
+		ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
+		return nil
+	}
+	return errors.Wrap(rerr, "failed to resume pipeline")
+}
+
+// CancelTransaction replaces the unconfirmed transaction etxID with a zero
+// value transaction from its key to itself, at the same nonce and with a
+// bumped fee. The pipeline run waiting for the transaction, if any, fails.
+func (ec *EthConfirmer) CancelTransaction(ctx context.Context, etxID int64) error {
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
+
+	etx, err := ec.loadRemediableEthTx(etxID)
+	if err != nil {
+		return err
+	}
+	previous, _ := highestPricedAttempt(etx)
+	etx.ToAddress = etx.FromAddress
+	etx.EncodedPayload = []byte{}
+	etx.Value = assets.NewEthValue(0)
+	etx.GasLimit = ec.config.EvmGasLimitTransfer()
+	etx.AccessList = NullableEIP2930AccessList{}
+	previous.EthTx = etx
+	attempt, err := ec.bumpGas(previous)
+	if err != nil {
+		return errors.Wrapf(err, "failed to bump gas to cancel transaction %d; replace it with a custom fee instead", etxID)
+	}
+
+	waiting := etx
+	etx.PipelineTaskRunID.Valid = false
+	err = ec.q.Transaction(func(tx pg.Queryer) error {
+		res, err2 := tx.Exec(`UPDATE eth_txes SET to_address = $1, encoded_payload = $2, value = $3, gas_limit = $4, access_list = NULL, pipeline_task_run_id = NULL WHERE id = $5 AND state = 'unconfirmed'`,
+			etx.ToAddress, etx.EncodedPayload, etx.Value, etx.GasLimit, etx.ID)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update eth_tx")
+		}
+		if rows, err2 := res.RowsAffected(); err2 != nil {
+			return errors.Wrap(err2, "failed to get RowsAffected")
+		} else if rows == 0 {
+			return errors.Wrapf(ErrTxNotUnconfirmed, "transaction %d", etx.ID)
+		}
+		return ec.insertInProgressAttempt(tx, &attempt)
+	})
+	if err != nil {
+		return errors.Wrap(err, "CancelTransaction failed")
+	}
+
+	lggr := etx.GetLogger(ec.lggr)
+	if err = ec.resumeWithError(waiting, errTxCancelled); err != nil {
+		lggr.Errorw("Failed to fail the pipeline run waiting for the cancelled transaction", "err", err)
+	}
+	lggr.Infow("Cancelling transaction", "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+	return ec.handleInProgressAttempt(ctx, lggr, etx, attempt, ec.latestBlockNum)
+}
+
+// ReplaceTransaction sends a new attempt for the unconfirmed transaction etxID
+// paying fee, which must be higher than the fee of every previous attempt. It
+// is not capped by the maximum gas price.
+func (ec *EthConfirmer) ReplaceTransaction(ctx context.Context, etxID int64, fee ReplacementFee) error {
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
+
+	etx, err := ec.loadRemediableEthTx(etxID)
+	if err != nil {
+		return err
+	}
+	previous, _ := highestPricedAttempt(etx)
+	gasLimit := previous.ChainSpecificGasLimit
+	if gasLimit == 0 {
+		gasLimit = etx.GasLimit
+	}
+	var attempt EthTxAttempt
+	switch previous.TxType {
+	case 0x0:
+		if fee.GasPrice == nil {
+			return errors.Errorf("transaction %d is a legacy transaction, a gas price is required", etxID)
+		}
+		if fee.GasPrice.Cmp(previous.GasPrice.ToInt()) <= 0 {
+			return errors.Errorf("gas price must be higher than %s wei, the price of the previous attempt", previous.GasPrice.String())
+		}
+		attempt, err = ec.NewLegacyAttempt(etx, fee.GasPrice, gasLimit)
+	case 0x2:
+		if fee.GasTipCap == nil || fee.GasFeeCap == nil {
+			return errors.Errorf("transaction %d is an EIP-1559 transaction, a gas tip cap and a gas fee cap are required", etxID)
+		}
+		if fee.GasFeeCap.Cmp(fee.GasTipCap) < 0 {
+			return errors.New("gas fee cap must not be lower than gas tip cap")
+		}
+		if fee.GasTipCap.Cmp(previous.GasTipCap.ToInt()) <= 0 || fee.GasFeeCap.Cmp(previous.GasFeeCap.ToInt()) <= 0 {
+			return errors.Errorf("gas tip cap and gas fee cap must be higher than %s and %s wei, the fees of the previous attempt", previous.GasTipCap.String(), previous.GasFeeCap.String())
+		}
+		attempt, err = ec.NewDynamicFeeAttempt(etx, gas.DynamicFee{TipCap: fee.GasTipCap, FeeCap: fee.GasFeeCap}, gasLimit)
+	default:
+		return errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v", previous.ID, previous.TxType)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create replacement attempt")
+	}
+	if err = ec.insertInProgressAttempt(ec.q, &attempt); err != nil {
+		return errors.Wrap(err, "ReplaceTransaction failed")
+	}
+
+	lggr := etx.GetLogger(ec.lggr)
+	lggr.Infow("Replacing transaction with a custom fee", "gasPrice", attempt.GasPrice, "gasTipCap", attempt.GasTipCap, "gasFeeCap", attempt.GasFeeCap)
+	return ec.handleInProgressAttempt(ctx, lggr, etx, attempt, ec.latestBlockNum)
+}
+
+func (ec *EthConfirmer) insertInProgressAttempt(q pg.Queryer, attempt *EthTxAttempt) error {
+	query, args, err := q.BindNamed(insertIntoEthTxAttemptsQuery, attempt)
+	if err != nil {
+		return errors.Wrap(err, "failed to BindNamed")
+	}
+	return errors.Wrap(q.Get(attempt, query, args...), "failed to insert into eth_tx_attempts")
+}
+
+// AbandonTransaction gives up on the unconfirmed transaction etxID and every
+// later transaction from the same key: they are marked as fatally errored,
+// and the key's next nonce is rewound to the nonce of etxID, to be reused by
+// the next transaction. Pipeline runs waiting for them fail.
+//
+// This is meant for transactions the network has dropped: a transaction
+// which is still in the mempool should be cancelled instead. The
+// EthBroadcaster must not be running.
+func (ec *EthConfirmer) AbandonTransaction(ctx context.Context, etxID int64) error {
+	ec.processMu.Lock()
+	defer ec.processMu.Unlock()
+
+	etx, err := ec.loadRemediableEthTx(etxID)
+	if err != nil {
+		return err
+	}
+	nonce := *etx.Nonce
+	chainNonce, err := ec.ethClient.NonceAt(ctx, etx.FromAddress, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch on-chain nonce")
+	}
+	if int64(chainNonce) > nonce {
+		return errors.Errorf("nonce %d of transaction %d was already used on chain; wait for it to be confirmed", nonce, etxID)
+	}
+
+	var etxs []EthTx
+	err = ec.q.Select(&etxs, `
+SELECT * FROM eth_txes WHERE from_address = $1 AND evm_chain_id = $2 AND state IN ('in_progress', 'unconfirmed') AND nonce >= $3
+ORDER BY nonce ASC
+`, etx.FromAddress, ec.chainID.String(), nonce)
+	if err != nil {
+		return errors.Wrap(err, "failed to load eth_txes")
+	}
+	ids := make([]int64, len(etxs))
+	for i, e := range etxs {
+		ids[i] = e.ID
+	}
+
+	err = ec.q.Transaction(func(tx pg.Queryer) error {
+		if _, err2 := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = ANY($1)`, pq.Array(ids)); err2 != nil {
+			return errors.Wrap(err2, "failed to delete eth_tx_attempts")
+		}
+		if _, err2 := tx.Exec(`UPDATE eth_txes SET state = 'fatal_error', error = $1, nonce = NULL, broadcast_at = NULL, initial_broadcast_at = NULL WHERE id = ANY($2)`, errTxAbandoned.Error(), pq.Array(ids)); err2 != nil {
+			return errors.Wrap(err2, "failed to update eth_txes")
+		}
+		return errors.Wrap(ec.keystore.ResetNextNonce(etx.FromAddress, &ec.chainID, nonce, pg.WithQueryer(tx)), "failed to reset next nonce")
+	})
+	if err != nil {
+		return errors.Wrap(err, "AbandonTransaction failed")
+	}
+	for _, e := range etxs {
+		if err = ec.resumeWithError(e, errTxAbandoned); err != nil {
+			e.GetLogger(ec.lggr).Errorw("Failed to fail the pipeline run waiting for an abandoned transaction", "err", err)
+		}
+	}
+	ec.lggr.Warnw(fmt.Sprintf("Abandoned %d transactions, next nonce was reset to %d", len(ids), nonce), "fromAddress", etx.FromAddress, "etxIDs", ids, "nonce", nonce)
+	ec.stuckMu.Lock()
+	delete(ec.stuckReported, etxID)
+	ec.stuckMu.Unlock()
+	return nil
+}
@@ -0,0 +1,106 @@
+package txmgr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func stuckTestEthTx(id, nonce, broadcastBeforeBlockNum int64, gasPrice int64, attemptState txmgr.EthTxAttemptState) *txmgr.EthTx {
+	return &txmgr.EthTx{
+		ID:    id,
+		Nonce: &nonce,
+		State: txmgr.EthTxUnconfirmed,
+		EthTxAttempts: []txmgr.EthTxAttempt{{
+			EthTxID:                 id,
+			GasPrice:                utils.NewBigI(gasPrice),
+			State:                   attemptState,
+			BroadcastBeforeBlockNum: &broadcastBeforeBlockNum,
+		}},
+	}
+}
+
+func TestEthConfirmer_FindStuckEthTxs(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := big.NewInt(100)
+
+	t.Run("returns nothing when no transaction is past the thresholds", func(t *testing.T) {
+		etxs := []*txmgr.EthTx{stuckTestEthTx(1, 0, 90, 10, txmgr.EthTxAttemptBroadcast)}
+		assert.Empty(t, txmgr.FindStuckEthTxs(etxs, 100, 50, 3, maxGasPrice))
+	})
+
+	t.Run("returns transactions pending for at least the stuck threshold", func(t *testing.T) {
+		etxs := []*txmgr.EthTx{
+			stuckTestEthTx(1, 0, 50, 10, txmgr.EthTxAttemptBroadcast),
+			stuckTestEthTx(2, 1, 51, 10, txmgr.EthTxAttemptBroadcast),
+		}
+		stuck := txmgr.FindStuckEthTxs(etxs, 100, 50, 3, maxGasPrice)
+		require.Len(t, stuck, 1)
+		assert.Equal(t, int64(1), stuck[0].EthTx.ID)
+		assert.Equal(t, int64(50), stuck[0].BlocksPending)
+		assert.False(t, stuck[0].AtMaxGasPrice)
+	})
+
+	t.Run("returns transactions at the max gas price past the gas bump threshold", func(t *testing.T) {
+		etxs := []*txmgr.EthTx{stuckTestEthTx(1, 0, 95, 100, txmgr.EthTxAttemptBroadcast)}
+		stuck := txmgr.FindStuckEthTxs(etxs, 100, 50, 3, maxGasPrice)
+		require.Len(t, stuck, 1)
+		assert.True(t, stuck[0].AtMaxGasPrice)
+	})
+
+	t.Run("with a zero stuck threshold only returns transactions at the max gas price", func(t *testing.T) {
+		etxs := []*txmgr.EthTx{
+			stuckTestEthTx(1, 0, 0, 10, txmgr.EthTxAttemptBroadcast),
+			stuckTestEthTx(2, 1, 0, 100, txmgr.EthTxAttemptBroadcast),
+		}
+		stuck := txmgr.FindStuckEthTxs(etxs, 100, 0, 3, maxGasPrice)
+		require.Len(t, stuck, 1)
+		assert.Equal(t, int64(2), stuck[0].EthTx.ID)
+	})
+
+	t.Run("returns transactions rejected for insufficient eth", func(t *testing.T) {
+		etxs := []*txmgr.EthTx{stuckTestEthTx(1, 0, 99, 10, txmgr.EthTxAttemptInsufficientEth)}
+		stuck := txmgr.FindStuckEthTxs(etxs, 100, 50, 3, maxGasPrice)
+		require.Len(t, stuck, 1)
+	})
+}
+
+func TestEthConfirmer_DiagnoseStuckEthTxs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("diagnoses insufficient funds", func(t *testing.T) {
+		etx := stuckTestEthTx(1, 5, 0, 10, txmgr.EthTxAttemptInsufficientEth)
+		stuck := []txmgr.StuckTx{{EthTx: *etx}}
+		txmgr.DiagnoseStuckEthTxs(stuck, []*txmgr.EthTx{etx}, 5)
+		assert.Equal(t, txmgr.StuckTxInsufficientFunds, stuck[0].Diagnosis)
+	})
+
+	t.Run("diagnoses underpriced transactions at the chain nonce", func(t *testing.T) {
+		etx := stuckTestEthTx(1, 5, 0, 10, txmgr.EthTxAttemptBroadcast)
+		stuck := []txmgr.StuckTx{{EthTx: *etx}}
+		txmgr.DiagnoseStuckEthTxs(stuck, []*txmgr.EthTx{etx}, 5)
+		assert.Equal(t, txmgr.StuckTxUnderpriced, stuck[0].Diagnosis)
+	})
+
+	t.Run("diagnoses transactions blocked by a lower nonce", func(t *testing.T) {
+		etx5 := stuckTestEthTx(1, 5, 0, 10, txmgr.EthTxAttemptBroadcast)
+		etx6 := stuckTestEthTx(2, 6, 0, 10, txmgr.EthTxAttemptBroadcast)
+		stuck := []txmgr.StuckTx{{EthTx: *etx5}, {EthTx: *etx6}}
+		txmgr.DiagnoseStuckEthTxs(stuck, []*txmgr.EthTx{etx5, etx6}, 5)
+		assert.Equal(t, txmgr.StuckTxUnderpriced, stuck[0].Diagnosis)
+		assert.Equal(t, txmgr.StuckTxBlocked, stuck[1].Diagnosis)
+	})
+
+	t.Run("diagnoses nonce gaps", func(t *testing.T) {
+		etx := stuckTestEthTx(1, 7, 0, 10, txmgr.EthTxAttemptBroadcast)
+		stuck := []txmgr.StuckTx{{EthTx: *etx}}
+		txmgr.DiagnoseStuckEthTxs(stuck, []*txmgr.EthTx{etx}, 5)
+		assert.Equal(t, txmgr.StuckTxNonceGap, stuck[0].Diagnosis)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -39,15 +40,19 @@ type Config interface {
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
+	EthTxStuckThreshold() uint64
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
 	EvmGasLimitDefault() uint64
+	EvmGasLimitTransfer() uint64
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
 	EvmUseForwarders() bool
 	EvmRPCDefaultBatchSize() uint32
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
+	OperatorFactoryAddress() string
+	EvmForwarderOwners() []common.Address
 	TriggerFallbackDBPollInterval() time.Duration
 	LogSQL() bool
 }
@@ -55,6 +60,7 @@ type Config interface {
 // KeyStore encompasses the subset of keystore used by txmgr
 type KeyStore interface {
 	GetStatesForChain(chainID *big.Int) ([]ethkey.State, error)
+	ResetNextNonce(address common.Address, chainID *big.Int, nextNonce int64, qopts ...pg.QOpt) error
 	SignTx(fromAddress common.Address, tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error)
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
 }
@@ -76,6 +82,10 @@ type TxManager interface {
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
 	SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint64) (etx EthTx, err error)
+	FindStuckTransactions(ctx context.Context) ([]StuckTx, error)
+	CancelTransaction(ctx context.Context, etxID int64) error
+	ReplaceTransaction(ctx context.Context, etxID int64, fee ReplacementFee) error
+	AbandonTransaction(ctx context.Context, etxID int64) error
 }
 
 type Txm struct {
@@ -95,6 +105,11 @@ type Txm struct {
 	chHeads        chan *evmtypes.Head
 	trigger        chan common.Address
 	resumeCallback ResumeCallback
+	notifier       notifications.Notifier
+
+	// chConfirmerRequests runs functions on the current EthConfirmer, in the
+	// run loop
+	chConfirmerRequests chan confirmerRequest
 
 	chStop   chan struct{}
 	chSubbed chan struct{}
@@ -109,6 +124,19 @@ func (b *Txm) RegisterResumeCallback(fn ResumeCallback) {
 	b.resumeCallback = fn
 }
 
+// SetNotifier sets the notifier stuck transactions are reported to. It must
+// be called before Start.
+func (b *Txm) SetNotifier(notifier notifications.Notifier) {
+	b.notifier = notifier
+}
+
+type confirmerRequest struct {
+	// stopBroadcaster stops the EthBroadcaster while fn runs
+	stopBroadcaster bool
+	fn              func(ec *EthConfirmer) error
+	chErr           chan error
+}
+
 // NewTxm creates a new Txm with the given configuration.
 func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeyStore, eventBroadcaster pg.EventBroadcaster, lggr logger.Logger, checkerFactory TransmitCheckerFactory, logPoller logpoller.LogPoller) *Txm {
 	lggr = lggr.Named("Txm")
@@ -133,8 +161,11 @@ func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeySto
 		checkerFactory:   checkerFactory,
 		chHeads:          make(chan *evmtypes.Head),
 		trigger:          make(chan common.Address),
+		notifier:         notifications.NullNotifier{},
 		chStop:           make(chan struct{}),
 		chSubbed:         make(chan struct{}),
+
+		chConfirmerRequests: make(chan confirmerRequest),
 	}
 	if cfg.EthTxResendAfterThreshold() > 0 {
 		b.ethResender = NewEthResender(lggr, db, ethClient, defaultResenderPollInterval, cfg)
@@ -147,7 +178,7 @@ func NewTxm(db *sqlx.DB, ethClient evmclient.Client, cfg Config, keyStore KeySto
 		b.logger.Info("EthTxReaper: Disabled")
 	}
 	if cfg.EvmUseForwarders() {
-		b.fwdMgr = forwarders.NewFwdMgr(db, ethClient, logPoller, lggr, cfg)
+		b.fwdMgr = forwarders.NewFwdMgr(db, ethClient, logPoller, keyStore, lggr, cfg)
 	} else {
 		b.logger.Info("EvmForwardManager: Disabled")
 	}
@@ -170,8 +201,8 @@ func (b *Txm) Start(ctx context.Context) (merr error) {
 			b.logger.Warnf("Chain %s does not have any eth keys, no transactions will be sent on this chain", b.chainID.String())
 		}
 
-		eb := NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
-		ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+		eb := b.newEthBroadcaster(keyStates)
+		ec := b.newEthConfirmer(keyStates)
 		if err := eb.Start(ctx); err != nil {
 			return errors.Wrap(err, "Txm: EthBroadcaster failed to start")
 		}
@@ -229,6 +260,18 @@ func (b *Txm) Close() (merr error) {
 	})
 }
 
+// Healthy reports an error if the forwarder manager is unhealthy, e.g. when
+// forwarders lost authorization for the keys of this node.
+func (b *Txm) Healthy() error {
+	if err := b.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	if b.fwdMgr != nil {
+		return b.fwdMgr.Healthy()
+	}
+	return nil
+}
+
 func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 	defer b.wg.Done()
 	keysChanged, unsub := b.keyStore.SubscribeToKeyChanges()
@@ -245,6 +288,22 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			eb.Trigger(address)
 		case head := <-b.chHeads:
 			ec.mb.Deliver(head)
+		case req := <-b.chConfirmerRequests:
+			if !req.stopBroadcaster {
+				req.chErr <- req.fn(ec)
+				continue
+			}
+			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
+			req.chErr <- req.fn(ec)
+			keyStates, err := b.keyStore.GetStatesForChain(&b.chainID)
+			if err != nil {
+				b.logger.Criticalw("Failed to reload key states, EthBroadcaster could not be restarted", "error", err)
+				continue
+			}
+			eb = b.newEthBroadcaster(keyStates)
+			if err := eb.Start(ctx); err != nil {
+				b.logger.Criticalw("Failed to start EthBroadcaster", "error", err)
+			}
 		case <-b.chStop:
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
 			b.logger.ErrorIfClosing(ec, "EthConfirmer")
@@ -260,8 +319,8 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 			b.logger.ErrorIfClosing(eb, "EthBroadcaster")
 			b.logger.ErrorIfClosing(ec, "EthConfirmer")
 
-			eb = NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
-			ec = NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+			eb = b.newEthBroadcaster(keyStates)
+			ec = b.newEthConfirmer(keyStates)
 
 			if err := eb.Start(ctx); err != nil {
 				b.logger.Criticalw("Failed to start EthBroadcaster", "error", err)
@@ -273,6 +332,72 @@ func (b *Txm) runLoop(eb *EthBroadcaster, ec *EthConfirmer) {
 	}
 }
 
+func (b *Txm) newEthBroadcaster(keyStates []ethkey.State) *EthBroadcaster {
+	return NewEthBroadcaster(b.db, b.ethClient, b.config, b.keyStore, b.eventBroadcaster, keyStates, b.gasEstimator, b.resumeCallback, b.logger, b.checkerFactory)
+}
+
+func (b *Txm) newEthConfirmer(keyStates []ethkey.State) *EthConfirmer {
+	ec := NewEthConfirmer(b.db, b.ethClient, b.config, b.keyStore, keyStates, b.gasEstimator, b.resumeCallback, b.logger)
+	ec.notifier = b.notifier
+	return ec
+}
+
+// withConfirmer runs fn on the current EthConfirmer, in the run loop
+func (b *Txm) withConfirmer(ctx context.Context, stopBroadcaster bool, fn func(ec *EthConfirmer) error) error {
+	if err := b.StartStopOnce.Ready(); err != nil {
+		return errors.Wrap(err, "Txm is not running")
+	}
+	req := confirmerRequest{stopBroadcaster, fn, make(chan error, 1)}
+	select {
+	case b.chConfirmerRequests <- req:
+	case <-b.chStop:
+		return errors.New("Txm is stopping")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-req.chErr:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// FindStuckTransactions returns the stuck transactions of all enabled keys,
+// with their diagnosis
+func (b *Txm) FindStuckTransactions(ctx context.Context) (stuck []StuckTx, err error) {
+	err = b.withConfirmer(ctx, false, func(ec *EthConfirmer) (err2 error) {
+		stuck, err2 = ec.FindStuckTransactions(ctx)
+		return
+	})
+	return
+}
+
+// CancelTransaction replaces an unconfirmed transaction with a zero value
+// transaction to its sender, at the same nonce
+func (b *Txm) CancelTransaction(ctx context.Context, etxID int64) error {
+	return b.withConfirmer(ctx, false, func(ec *EthConfirmer) error {
+		return ec.CancelTransaction(ctx, etxID)
+	})
+}
+
+// ReplaceTransaction sends a new attempt for an unconfirmed transaction,
+// paying the given fee
+func (b *Txm) ReplaceTransaction(ctx context.Context, etxID int64, fee ReplacementFee) error {
+	return b.withConfirmer(ctx, false, func(ec *EthConfirmer) error {
+		return ec.ReplaceTransaction(ctx, etxID, fee)
+	})
+}
+
+// AbandonTransaction gives up on an unconfirmed transaction and the later
+// transactions of its key, and rewinds the key's next nonce. The
+// EthBroadcaster is stopped meanwhile, so that it does not use a stale nonce.
+func (b *Txm) AbandonTransaction(ctx context.Context, etxID int64) error {
+	return b.withConfirmer(ctx, true, func(ec *EthConfirmer) error {
+		return ec.AbandonTransaction(ctx, etxID)
+	})
+}
+
 // OnNewLongestChain conforms to HeadTrackable
 func (b *Txm) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
 	ok := b.IfStarted(func() {
@@ -385,6 +510,9 @@ func (b *Txm) checkStateExists(q pg.Queryer, addr common.Address) error {
 	if state.EVMChainID.Cmp(utils.NewBig(&b.chainID)) != 0 {
 		return errors.Errorf("cannot send transaction on chain ID %s; eth key with address %s is pegged to chain ID %s", b.chainID.String(), addr.Hex(), state.EVMChainID.String())
 	}
+	if state.Disabled {
+		return errors.Errorf("cannot send transaction from eth key with address %s; it is disabled", addr.Hex())
+	}
 	return nil
 }
 
@@ -574,3 +702,15 @@ func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
 func (n *NullTxManager) RegisterResumeCallback(fn ResumeCallback) {}
+func (n *NullTxManager) FindStuckTransactions(context.Context) ([]StuckTx, error) {
+	return nil, errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) CancelTransaction(context.Context, int64) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) ReplaceTransaction(context.Context, int64, ReplacementFee) error {
+	return errors.New(n.ErrMsg)
+}
+func (n *NullTxManager) AbandonTransaction(context.Context, int64) error {
+	return errors.New(n.ErrMsg)
+}
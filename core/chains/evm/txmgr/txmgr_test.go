@@ -325,6 +325,22 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("cannot send transaction on chain ID 0; eth key with address %s is pegged to chain ID 1337", otherAddress.Hex()))
 	})
 
+	t.Run("returns error if eth key is disabled", func(t *testing.T) {
+		config.On("EvmMaxQueuedTransactions").Return(uint64(3)).Once()
+		_, disabledAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), *utils.NewBigI(0), 0)
+		require.NoError(t, keyStore.Eth().Disable(disabledAddress, big.NewInt(0)))
+
+		_, err := txm.CreateEthTransaction(txmgr.NewTx{
+			FromAddress:    disabledAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{1, 2, 3},
+			GasLimit:       21000,
+			Strategy:       txmgr.SendEveryStrategy{},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("cannot send transaction from eth key with address %s; it is disabled", disabledAddress.Hex()))
+	})
+
 	t.Run("simulate transmit checker", func(t *testing.T) {
 		pgtest.MustExec(t, db, `DELETE FROM eth_txes`)
 
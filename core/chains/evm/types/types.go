@@ -75,10 +75,12 @@ type ChainCfg struct {
 	EvmHeadTrackerMaxBufferSize                    null.Int
 	EvmHeadTrackerSamplingInterval                 *models.Duration
 	EvmLogBackfillBatchSize                        null.Int
+	EvmLogBroadcasterUseLogPoller                  null.Bool
 	EvmLogPollInterval                             *models.Duration
 	EvmMaxGasPriceWei                              *utils.Big
 	EvmNonceAutoSync                               null.Bool
 	EvmUseForwarders                               null.Bool
+	EvmForwarderOwners                             []common.Address
 	EvmRPCDefaultBatchSize                         null.Int
 	FlagsContractAddress                           null.String
 	GasEstimatorMode                               null.String
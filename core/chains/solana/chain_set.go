@@ -72,6 +72,7 @@ type ChainSet interface {
 
 	Add(context.Context, string, *db.ChainCfg) (DBChain, error)
 	Remove(string) error
+	Chains() []solana.Chain
 	Configure(ctx context.Context, id string, enabled bool, config *db.ChainCfg) (DBChain, error)
 	Show(id string) (DBChain, error)
 	Index(offset, limit int) ([]DBChain, int, error)
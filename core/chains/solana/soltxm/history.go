@@ -0,0 +1,143 @@
+package soltxm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// MaxHistoryLen is the number of txs kept in the history of a txm.
+const MaxHistoryLen = 1000
+
+var _ chains.TxHistory = (*Txm)(nil)
+
+// txHistory is an in memory record of the most recent txs, which is lost on
+// restart since txs are not persisted.
+type txHistory struct {
+	chainID string
+	lock    sync.RWMutex
+	sigs    []solana.Signature // oldest first
+	bySig   map[solana.Signature]*chains.Tx
+}
+
+func newTxHistory(chainID string) *txHistory {
+	return &txHistory{
+		chainID: chainID,
+		bySig:   map[solana.Signature]*chains.Tx{},
+	}
+}
+
+// add records a queued tx.
+func (h *txHistory) add(tx *solana.Transaction) {
+	if len(tx.Signatures) == 0 || len(tx.Message.AccountKeys) == 0 {
+		return
+	}
+	sig := tx.Signatures[0]
+	htx := &chains.Tx{
+		ChainType:  relay.Solana,
+		ChainID:    h.chainID,
+		ID:         sig.String(),
+		Hash:       sig.String(),
+		From:       tx.Message.AccountKeys[0].String(),
+		State:      chains.TxUnstarted,
+		ChainState: "queued",
+		CreatedAt:  time.Now(),
+	}
+	if len(tx.Message.Instructions) > 0 {
+		if program, err := tx.Message.ResolveProgramIDIndex(tx.Message.Instructions[0].ProgramIDIndex); err == nil {
+			htx.To = program.String()
+		}
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, exists := h.bySig[sig]; exists {
+		return
+	}
+	if len(h.sigs) == MaxHistoryLen {
+		delete(h.bySig, h.sigs[0])
+		h.sigs = h.sigs[1:]
+	}
+	h.sigs = append(h.sigs, sig)
+	h.bySig[sig] = htx
+}
+
+func (h *txHistory) update(sig solana.Signature, state chains.TxState, chainState, errStr string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if tx, ok := h.bySig[sig]; ok {
+		tx.State = state
+		tx.ChainState = chainState
+		tx.Error = errStr
+	}
+}
+
+// txFailStates are the chain states and errors of failed txs.
+var txFailStates = map[int][2]string{
+	TxFailRevert:    {"reverted", "transaction reverted"},
+	TxFailReject:    {"rejected", "transaction rejected"},
+	TxFailDrop:      {"dropped", "transaction not confirmed within confirm timeout"},
+	TxFailSimRevert: {"reverted", "transaction reverted in simulation"},
+	TxFailSimOther:  {"errored", "transaction failed in simulation"},
+}
+
+// pendingTxContextWithHistory updates the history as pending txs change state.
+type pendingTxContextWithHistory struct {
+	PendingTxContext
+	history *txHistory
+}
+
+func (c *pendingTxContextWithHistory) Add(sig solana.Signature, cancel context.CancelFunc) error {
+	if err := c.PendingTxContext.Add(sig, cancel); err != nil {
+		return err
+	}
+	c.history.update(sig, chains.TxPending, "broadcast", "")
+	return nil
+}
+
+func (c *pendingTxContextWithHistory) OnSuccess(sig solana.Signature) {
+	c.PendingTxContext.OnSuccess(sig)
+	c.history.update(sig, chains.TxConfirmed, "confirmed", "")
+}
+
+func (c *pendingTxContextWithHistory) OnError(sig solana.Signature, errType int) {
+	c.PendingTxContext.OnError(sig, errType)
+	fail := txFailStates[errType]
+	c.history.update(sig, chains.TxErrored, fail[0], fail[1])
+}
+
+// Txs returns the txs in the history matching filter.
+func (txm *Txm) Txs(_ context.Context, filter chains.TxFilter) ([]chains.Tx, int, error) {
+	txm.history.lock.RLock()
+	defer txm.history.lock.RUnlock()
+	var txs []chains.Tx
+	for i := len(txm.history.sigs) - 1; i >= 0; i-- {
+		if tx := *txm.history.bySig[txm.history.sigs[i]]; filter.Matches(tx) {
+			txs = append(txs, tx)
+		}
+	}
+	return chains.PaginateTxs(txs, filter.Offset, filter.Limit), len(txs), nil
+}
+
+// FindTx returns the tx in the history with the signature id.
+func (txm *Txm) FindTx(_ context.Context, chainType relay.Network, chainID, id string) (chains.Tx, error) {
+	if chainType != relay.Solana || chainID != txm.history.chainID {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	sig, err := solana.SignatureFromBase58(id)
+	if err != nil {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	txm.history.lock.RLock()
+	defer txm.history.lock.RUnlock()
+	tx, ok := txm.history.bySig[sig]
+	if !ok {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	return *tx, nil
+}
@@ -0,0 +1,105 @@
+package soltxm
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/db"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
+	keyMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+func TestTxm_History(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	cfg := config.NewConfig(db.ChainCfg{}, lggr)
+	mc := new(mocks.ReaderWriter)
+
+	key, err := solkey.New()
+	require.NoError(t, err)
+	mkey := new(keyMocks.Solana)
+	mkey.On("Get", key.ID()).Return(key, nil)
+
+	txm := NewTxm("history_test", func() (client.ReaderWriter, error) {
+		return mc, nil
+	}, cfg, mkey, lggr)
+	ctx := testutils.Context(t)
+
+	// not started, so txs stay queued
+	tx1, tx2 := getTx(t, key.PublicKey()), getTx(t, key.PublicKey())
+	require.NoError(t, txm.Enqueue("", tx1))
+	require.NoError(t, txm.Enqueue("", tx2))
+	sig1, sig2 := tx1.Signatures[0], tx2.Signatures[0]
+
+	txs, count, err := txm.Txs(ctx, chains.TxFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, txs, 2)
+	assert.Equal(t, sig2.String(), txs[0].ID)
+	assert.Equal(t, sig1.String(), txs[1].ID)
+	assert.Equal(t, relay.Solana, txs[0].ChainType)
+	assert.Equal(t, "history_test", txs[0].ChainID)
+	assert.Equal(t, key.PublicKey().String(), txs[0].From)
+	assert.Equal(t, solana.SystemProgramID.String(), txs[0].To)
+	assert.Equal(t, chains.TxUnstarted, txs[0].State)
+
+	require.NoError(t, txm.txs.Add(sig1, func() {}))
+	txm.txs.OnSuccess(sig1)
+	require.NoError(t, txm.txs.Add(sig2, func() {}))
+	txm.txs.OnError(sig2, TxFailSimRevert)
+
+	tx, err := txm.FindTx(ctx, relay.Solana, "history_test", sig1.String())
+	require.NoError(t, err)
+	assert.Equal(t, chains.TxConfirmed, tx.State)
+	assert.Equal(t, "confirmed", tx.ChainState)
+
+	txs, count, err = txm.Txs(ctx, chains.TxFilter{State: chains.TxErrored})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, txs, 1)
+	assert.Equal(t, sig2.String(), txs[0].ID)
+	assert.Equal(t, "reverted", txs[0].ChainState)
+	assert.Equal(t, "transaction reverted in simulation", txs[0].Error)
+
+	txs, count, err = txm.Txs(ctx, chains.TxFilter{ChainType: relay.Terra})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, txs)
+
+	_, err = txm.FindTx(ctx, relay.Solana, "other", sig1.String())
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+	_, err = txm.FindTx(ctx, relay.Solana, "history_test", "invalid")
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+}
+
+func TestTxHistory_MaxLen(t *testing.T) {
+	key, err := solkey.New()
+	require.NoError(t, err)
+	h := newTxHistory("history_test")
+
+	var first solana.Signature
+	for i := 0; i < MaxHistoryLen+1; i++ {
+		tx := getTx(t, key.PublicKey())
+		sig := make([]byte, 64)
+		rand.Read(sig)
+		tx.Signatures = []solana.Signature{solana.SignatureFromBytes(sig)}
+		if i == 0 {
+			first = tx.Signatures[0]
+		}
+		h.add(tx)
+	}
+	assert.Len(t, h.sigs, MaxHistoryLen)
+	assert.Len(t, h.bySig, MaxHistoryLen)
+	assert.NotContains(t, h.bySig, first)
+}
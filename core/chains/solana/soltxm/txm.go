@@ -15,6 +15,7 @@ import (
 	solanaClient "github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -43,6 +44,7 @@ type Txm struct {
 	done    sync.WaitGroup
 	cfg     config.Config
 	txs     PendingTxContext
+	history *txHistory
 	ks      keystore.Solana
 	client  *utils.LazyLoad[solanaClient.ReaderWriter]
 }
@@ -56,6 +58,7 @@ type pendingTx struct {
 // NewTxm creates a txm. Uses simulation so should only be used to send txes to trusted contracts i.e. OCR.
 func NewTxm(chainID string, tc func() (solanaClient.ReaderWriter, error), cfg config.Config, ks keystore.Solana, lggr logger.Logger) *Txm {
 	lggr = lggr.Named("Txm")
+	history := newTxHistory(chainID)
 	return &Txm{
 		starter: utils.StartStopOnce{},
 		lggr:    lggr,
@@ -63,7 +66,8 @@ func NewTxm(chainID string, tc func() (solanaClient.ReaderWriter, error), cfg co
 		chSim:   make(chan pendingTx, MaxQueueLen), // queue can support 1000 pending txs
 		chStop:  make(chan struct{}),
 		cfg:     cfg,
-		txs:     newPendingTxContextWithProm(chainID),
+		txs:     &pendingTxContextWithHistory{newPendingTxContextWithProm(chainID), history},
+		history: history,
 		ks:      ks,
 		client:  utils.NewLazyLoad(tc),
 	}
@@ -94,6 +98,7 @@ func (txm *Txm) run() {
 			sig, err := txm.sendWithRetry(ctx, msg.tx, msg.timeout)
 			if err != nil {
 				txm.lggr.Errorw("failed to send transaction", "error", err)
+				txm.history.update(msg.signature, chains.TxErrored, "rejected", err.Error())
 				txm.client.Reset() // clear client if tx fails immediately (potentially bad RPC)
 				continue           // skip remainining
 			}
@@ -385,14 +390,17 @@ func (txm *Txm) Enqueue(accountID string, tx *solanaGo.Transaction) error {
 	tx.Signatures = append(tx.Signatures, finalSig)
 
 	msg := pendingTx{
-		tx:      tx,
-		timeout: txm.cfg.TxRetryTimeout(),
+		tx:        tx,
+		timeout:   txm.cfg.TxRetryTimeout(),
+		signature: tx.Signatures[0],
 	}
 
+	txm.history.add(tx)
 	select {
 	case txm.chSend <- msg:
 	default:
 		txm.lggr.Errorw("failed to enqeue tx", "queueFull", len(txm.chSend) == MaxQueueLen, "tx", msg)
+		txm.history.update(msg.signature, chains.TxErrored, "rejected", "failed to enqueue transaction")
 		return errors.Errorf("failed to enqueue transaction for %s", accountID)
 	}
 	return nil
@@ -2,28 +2,57 @@ package starknet
 
 import (
 	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink-starknet/pkg/starknet"
 	"github.com/smartcontractkit/chainlink-starknet/pkg/starknet/db"
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/chains/starknet/monitor"
+	"github.com/smartcontractkit/chainlink/core/chains/starknet/starktxm"
 	"github.com/smartcontractkit/chainlink/core/chains/starknet/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
-var _ starknet.Chain = (*chain)(nil)
+// DefaultRequestTimeout is the default StarkNet gateway request timeout.
+const DefaultRequestTimeout = 30 * time.Second
+
+// BalancePollPeriod is how often the balance monitor polls account balances.
+const BalancePollPeriod = 30 * time.Second
+
+// Chain is a starknet.Chain which can send txs.
+type Chain interface {
+	starknet.Chain
+
+	ID() string
+	TxManager() *starktxm.Txm
+	// Client returns a client for a random node.
+	Client() (starktxm.Client, error)
+}
+
+var _ Chain = (*chain)(nil)
 
 type chain struct {
 	utils.StartStopOnce
-	id   string
-	cfg  starknet.Config
-	orm  types.ORM
-	lggr logger.Logger
+	id             string
+	cfg            starknet.Config
+	txm            *starktxm.Txm
+	balanceMonitor services.ServiceCtx
+	orm            types.ORM
+	lggr           logger.Logger
 }
 
-func NewChain(db *sqlx.DB, dbchain types.DBChain, orm types.ORM, lggr logger.Logger) (*chain, error) {
+// NewChain returns a new chain backed by the nodes of dbchain.
+func NewChain(db *sqlx.DB, ks keystore.StarkNet, dbchain types.DBChain, orm types.ORM, lggr logger.Logger) (*chain, error) {
 	cfg := starknet.NewConfig(*dbchain.Cfg, lggr)
 	lggr = lggr.With("starknetChainID", dbchain.ID)
 	var ch = chain{
@@ -32,10 +61,16 @@ func NewChain(db *sqlx.DB, dbchain types.DBChain, orm types.ORM, lggr logger.Log
 		orm:  orm,
 		lggr: lggr.Named("Chain"),
 	}
+	ch.txm = starktxm.NewTxm(ch.id, ch.Client, starktxm.DefaultConfig, ks, lggr)
+	ch.balanceMonitor = monitor.NewBalanceMonitor(ch.id, BalancePollPeriod, lggr, ks, ch.Client)
 
 	return &ch, nil
 }
 
+func (c *chain) ID() string {
+	return c.id
+}
+
 func (c *chain) Config() starknet.Config {
 	return c.cfg
 }
@@ -44,22 +79,58 @@ func (c *chain) UpdateConfig(cfg *db.ChainCfg) {
 	c.cfg.Update(*cfg)
 }
 
+func (c *chain) TxManager() *starktxm.Txm {
+	return c.txm
+}
+
+func (c *chain) Client() (starktxm.Client, error) {
+	nodes, cnt, err := c.orm.NodesForChain(c.id, 0, math.MaxInt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get nodes")
+	}
+	if cnt == 0 {
+		return nil, errors.New("no nodes available")
+	}
+	// #nosec
+	node := nodes[rand.Intn(len(nodes))]
+	c.lggr.Debugw("Created client", "name", node.Name, "url", node.URL)
+	return starktxm.NewClient(c.id, node.URL, DefaultRequestTimeout), nil
+}
+
+// Start starts starknet chain.
 func (c *chain) Start(ctx context.Context) error {
 	return c.StartOnce("Chain", func() error {
-		return nil
+		c.lggr.Debug("Starting")
+		c.lggr.Debug("Starting txm")
+		c.lggr.Debug("Starting balance monitor")
+		return multierr.Combine(
+			c.txm.Start(ctx),
+			c.balanceMonitor.Start(ctx))
 	})
 }
 
 func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() error {
-		return nil
+		c.lggr.Debug("Stopping")
+		c.lggr.Debug("Stopping txm")
+		c.lggr.Debug("Stopping balance monitor")
+		return multierr.Combine(c.txm.Close(),
+			c.balanceMonitor.Close())
 	})
 }
 
 func (c *chain) Ready() error {
-	return c.StartStopOnce.Ready()
+	return multierr.Combine(
+		c.StartStopOnce.Ready(),
+		c.txm.Ready(),
+		c.balanceMonitor.Ready(),
+	)
 }
 
 func (c *chain) Healthy() error {
-	return c.StartStopOnce.Healthy()
+	return multierr.Combine(
+		c.StartStopOnce.Healthy(),
+		c.txm.Healthy(),
+		c.balanceMonitor.Healthy(),
+	)
 }
@@ -15,13 +15,15 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/starknet/types"
 	coreconfig "github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 )
 
 type ChainSetOpts struct {
-	Config coreconfig.GeneralConfig
-	Logger logger.Logger
-	DB     *sqlx.DB
-	ORM    types.ORM
+	Config   coreconfig.GeneralConfig
+	Logger   logger.Logger
+	DB       *sqlx.DB
+	KeyStore keystore.StarkNet
+	ORM      types.ORM
 }
 
 func (o *ChainSetOpts) Validate() (err error) {
@@ -37,6 +39,9 @@ func (o *ChainSetOpts) Validate() (err error) {
 	if o.DB == nil {
 		err = multierr.Append(err, required("DB"))
 	}
+	if o.KeyStore == nil {
+		err = multierr.Append(err, required("KeyStore"))
+	}
 	if o.ORM == nil {
 		err = multierr.Append(err, required("ORM"))
 	}
@@ -51,7 +56,7 @@ func (o *ChainSetOpts) NewChain(dbchain types.DBChain) (starknet.Chain, error) {
 	if !dbchain.Enabled {
 		return nil, errors.Errorf("cannot create new chain with ID %s, the chain is disabled", dbchain.ID)
 	}
-	return NewChain(o.DB, dbchain, o.ORM, o.Logger)
+	return NewChain(o.DB, o.KeyStore, dbchain, o.ORM, o.Logger)
 }
 
 type ChainSet interface {
@@ -59,6 +64,7 @@ type ChainSet interface {
 
 	Add(context.Context, string, *db.ChainCfg) (types.DBChain, error)
 	Remove(string) error
+	Chains() []starknet.Chain
 	Configure(ctx context.Context, id string, enabled bool, config *db.ChainCfg) (types.DBChain, error)
 	Show(id string) (types.DBChain, error)
 	Index(offset, limit int) ([]types.DBChain, int, error)
@@ -0,0 +1,134 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains/starknet/starktxm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Accounts provides the accounts to be monitored. Accounts are contracts on StarkNet, so they are
+// only known once a key has sent a tx through one, which records the account in the keystore.
+type Accounts interface {
+	// Accounts returns key IDs by account address.
+	Accounts() (map[string]string, error)
+}
+
+// NewBalanceMonitor returns a balance monitoring services.ServiceCtx which reports the fee token balance of all
+// accounts to prometheus every pollPeriod.
+func NewBalanceMonitor(chainID string, pollPeriod time.Duration, lggr logger.Logger, accounts Accounts, newClient func() (starktxm.Client, error)) services.ServiceCtx {
+	return newBalanceMonitor(chainID, pollPeriod, lggr, accounts, newClient)
+}
+
+func newBalanceMonitor(chainID string, pollPeriod time.Duration, lggr logger.Logger, accounts Accounts, newClient func() (starktxm.Client, error)) *balanceMonitor {
+	b := balanceMonitor{
+		chainID:    chainID,
+		pollPeriod: pollPeriod,
+		lggr:       lggr.Named("BalanceMonitor"),
+		accounts:   accounts,
+		newClient:  newClient,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	b.updateFn = b.updateProm
+	return &b
+}
+
+type balanceMonitor struct {
+	utils.StartStopOnce
+	chainID    string
+	pollPeriod time.Duration
+	lggr       logger.Logger
+	accounts   Accounts
+	newClient  func() (starktxm.Client, error)
+	updateFn   func(account, keyID string, bal *big.Int) // overridable for testing
+
+	client starktxm.Client
+
+	stop, done chan struct{}
+}
+
+// Start starts balance monitor for starknet.
+func (b *balanceMonitor) Start(context.Context) error {
+	return b.StartOnce("StarkNetBalanceMonitor", func() error {
+		go b.monitor()
+		return nil
+	})
+}
+
+func (b *balanceMonitor) Close() error {
+	return b.StopOnce("StarkNetBalanceMonitor", func() error {
+		close(b.stop)
+		<-b.done
+		return nil
+	})
+}
+
+func (b *balanceMonitor) monitor() {
+	defer close(b.done)
+	ctx, cancel := utils.ContextFromChan(b.stop)
+	defer cancel()
+
+	tick := time.After(utils.WithJitter(b.pollPeriod))
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-tick:
+			b.updateBalances(ctx)
+			tick = time.After(utils.WithJitter(b.pollPeriod))
+		}
+	}
+}
+
+// getClient returns the cached starktxm.Client, or creates a new one if nil.
+func (b *balanceMonitor) getClient() (starktxm.Client, error) {
+	if b.client == nil {
+		var err error
+		b.client, err = b.newClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.client, nil
+}
+
+func (b *balanceMonitor) updateBalances(ctx context.Context) {
+	accounts, err := b.accounts.Accounts()
+	if err != nil {
+		b.lggr.Errorw("Failed to get accounts", "err", err)
+		return
+	}
+	if len(accounts) == 0 {
+		return
+	}
+	client, err := b.getClient()
+	if err != nil {
+		b.lggr.Errorw("Failed to get client", "err", err)
+		return
+	}
+	var gotSomeBals bool
+	for account, keyID := range accounts {
+		// Check for shutdown signal, since FeeTokenBalance blocks and may be slow.
+		select {
+		case <-b.stop:
+			return
+		default:
+		}
+		bal, err := starktxm.FeeTokenBalance(ctx, client, account)
+		if err != nil {
+			b.lggr.Errorw("Failed to get balance", "account", account, "err", err)
+			continue
+		}
+		gotSomeBals = true
+		b.updateFn(account, keyID, bal)
+	}
+	if !gotSomeBals {
+		// Try a new client next time.
+		b.client = nil
+	}
+}
@@ -0,0 +1,91 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dontpanicdao/caigo"
+	"github.com/dontpanicdao/caigo/gateway"
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/starknet/starktxm"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestBalanceMonitor(t *testing.T) {
+	const chainID = "SN_GOERLI"
+	accs := accounts{"0x1": "0xa", "0x2": "0xb", "0x3": "0xc"}
+	client := balanceClient{
+		"0x1": {"0x0", "0x0"},
+		"0x2": {"0x1", "0x0"},
+		"0x3": {"0x0", "0x1"},
+	}
+	exp := map[string]string{
+		"0x1/0xa": "0",
+		"0x2/0xb": "1",
+		"0x3/0xc": new(big.Int).Lsh(big.NewInt(1), 128).String(),
+	}
+	b := newBalanceMonitor(chainID, time.Millisecond, logger.TestLogger(t), accs, nil)
+	got := map[string]string{}
+	done := make(chan struct{})
+	b.updateFn = func(account, keyID string, bal *big.Int) {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		got[account+"/"+keyID] = bal.String()
+		if len(got) == len(exp) {
+			close(done)
+		}
+	}
+	b.client = client
+
+	require.NoError(t, b.Start(testutils.Context(t)))
+	t.Cleanup(func() {
+		assert.NoError(t, b.Close())
+	})
+	select {
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for balance monitor")
+	case <-done:
+	}
+
+	assert.Equal(t, exp, got)
+}
+
+type accounts map[string]string
+
+func (a accounts) Accounts() (map[string]string, error) {
+	return a, nil
+}
+
+// balanceClient is a starktxm.Client which only returns the fee token balances of accounts.
+type balanceClient map[string][]string
+
+func (c balanceClient) Call(_ context.Context, tx types.Transaction, _ *gateway.BlockOptions) ([]string, error) {
+	return c[tx.Calldata[0]], nil
+}
+
+func (c balanceClient) AccountNonce(context.Context, string) (*big.Int, error) {
+	panic("unimplemented")
+}
+
+func (c balanceClient) EstimateFee(context.Context, types.Transaction) (caigo.FeeEstimate, error) {
+	panic("unimplemented")
+}
+
+func (c balanceClient) InvokeWithFee(context.Context, types.Transaction, *big.Int) (*types.AddTxResponse, error) {
+	panic("unimplemented")
+}
+
+func (c balanceClient) TransactionStatus(context.Context, gateway.TransactionStatusOptions) (*types.TransactionStatus, error) {
+	panic("unimplemented")
+}
+
+var _ starktxm.Client = balanceClient{}
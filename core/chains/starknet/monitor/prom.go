@@ -0,0 +1,20 @@
+package monitor
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var promStarkNetBalance = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{Name: "starknet_balance", Help: "StarkNet account balances"},
+	[]string{"account", "key", "starknetChainID", "denomination"},
+)
+
+var weiPerEth = new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+
+func (b *balanceMonitor) updateProm(account, keyID string, bal *big.Int) {
+	balEth, _ := new(big.Float).Quo(new(big.Float).SetInt(bal), weiPerEth).Float64()
+	promStarkNetBalance.WithLabelValues(account, keyID, b.chainID, "ETH").Set(balEth)
+}
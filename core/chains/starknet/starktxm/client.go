@@ -0,0 +1,90 @@
+package starktxm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dontpanicdao/caigo"
+	"github.com/dontpanicdao/caigo/gateway"
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/pkg/errors"
+)
+
+// Client is the subset of the StarkNet gateway API used to send txs and read balances.
+type Client interface {
+	// Call calls the function named by tx.EntryPointSelector.
+	Call(ctx context.Context, tx types.Transaction, opts *gateway.BlockOptions) ([]string, error)
+	AccountNonce(ctx context.Context, address string) (*big.Int, error)
+	EstimateFee(ctx context.Context, tx types.Transaction) (caigo.FeeEstimate, error)
+	// InvokeWithFee sends tx, which must already be signed over maxFee.
+	InvokeWithFee(ctx context.Context, tx types.Transaction, maxFee *big.Int) (*types.AddTxResponse, error)
+	TransactionStatus(ctx context.Context, opts gateway.TransactionStatusOptions) (*types.TransactionStatus, error)
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	*gateway.Gateway
+	http *http.Client
+}
+
+// NewClient returns a Client for the gateway and feeder gateway of the node at url.
+func NewClient(chainID, url string, timeout time.Duration) Client {
+	httpClient := http.Client{Timeout: timeout}
+	gw := gateway.NewClient(gateway.WithChain(chainID), gateway.WithHttpClient(httpClient))
+	// NewClient only knows the public endpoints, so point it at the node instead.
+	url = strings.TrimSuffix(url, "/")
+	gw.Base = url
+	gw.Feeder = url + "/feeder_gateway"
+	gw.Gateway = url + "/gateway"
+	gw.ChainId = chainID
+	return &client{Gateway: gw, http: &httpClient}
+}
+
+// invokeTx is an INVOKE_FUNCTION add_transaction request. types.Transaction has no max_fee, so
+// caigo's Invoke can only send txs signed over a zero fee.
+type invokeTx struct {
+	Type               string   `json:"type"`
+	ContractAddress    string   `json:"contract_address"`
+	EntryPointSelector string   `json:"entry_point_selector"`
+	Calldata           []string `json:"calldata"`
+	Signature          []string `json:"signature"`
+	MaxFee             string   `json:"max_fee"`
+}
+
+func (c *client) InvokeWithFee(ctx context.Context, tx types.Transaction, maxFee *big.Int) (*types.AddTxResponse, error) {
+	body, err := json.Marshal(invokeTx{
+		Type:               gateway.INVOKE,
+		ContractAddress:    tx.ContractAddress,
+		EntryPointSelector: tx.EntryPointSelector,
+		Calldata:           tx.Calldata,
+		Signature:          tx.Signature,
+		MaxFee:             caigo.BigToHex(maxFee),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal invoke tx")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Gateway.Gateway+"/add_transaction", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 299 {
+		return nil, gateway.NewError(resp)
+	}
+	var added types.AddTxResponse
+	if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
+		return nil, errors.Wrap(err, "failed to decode add_transaction response")
+	}
+	return &added, nil
+}
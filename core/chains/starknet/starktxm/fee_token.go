@@ -0,0 +1,44 @@
+package starktxm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/dontpanicdao/caigo"
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/pkg/errors"
+)
+
+// FeeTokenAddress is the address of the ERC20 ETH contract, which fees are paid in.
+const FeeTokenAddress = "0x49d36570d4e46f48e99674bd3fcc84644ddd6b96f7c741b1562b82f9e004dc7"
+
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// FeeTokenBalance returns the fee token balance of account, in wei.
+func FeeTokenBalance(ctx context.Context, client Client, account string) (*big.Int, error) {
+	res, err := client.Call(ctx, types.Transaction{
+		ContractAddress:    FeeTokenAddress,
+		EntryPointSelector: "balanceOf",
+		Calldata:           []string{normalizeAddress(account)},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	// balances are Uint256s of low and high 128 bits.
+	if len(res) != 2 {
+		return nil, errors.Errorf("unexpected balanceOf result: %v", res)
+	}
+	balance := new(big.Int).Lsh(caigo.SNValToBN(res[1]), 128)
+	return balance.Add(balance, caigo.SNValToBN(res[0])), nil
+}
+
+// FeeTokenTransfer returns a call which transfers amount wei of the fee token to recipient.
+func FeeTokenTransfer(recipient string, amount *big.Int) types.Transaction {
+	low := new(big.Int).And(amount, maxUint128)
+	high := new(big.Int).Rsh(amount, 128)
+	return types.Transaction{
+		ContractAddress:    FeeTokenAddress,
+		EntryPointSelector: "transfer",
+		Calldata:           []string{normalizeAddress(recipient), caigo.BigToHex(low), caigo.BigToHex(high)},
+	}
+}
@@ -0,0 +1,101 @@
+package starktxm
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// MaxHistoryLen is the number of txs kept in the history of a txm.
+const MaxHistoryLen = 1000
+
+var _ chains.TxHistory = (*Txm)(nil)
+
+// txHistory is an in memory record of the most recent txs, which is lost on
+// restart since txs are not persisted.
+type txHistory struct {
+	chainID string
+	lock    sync.RWMutex
+	ids     []string // oldest first
+	byID    map[string]*chains.Tx
+}
+
+func newTxHistory(chainID string) *txHistory {
+	return &txHistory{
+		chainID: chainID,
+		byID:    map[string]*chains.Tx{},
+	}
+}
+
+// add records a queued tx.
+func (h *txHistory) add(qtx queuedTx) {
+	htx := &chains.Tx{
+		ChainType:  relay.StarkNet,
+		ChainID:    h.chainID,
+		ID:         qtx.id,
+		From:       qtx.account,
+		State:      chains.TxUnstarted,
+		ChainState: "queued",
+		CreatedAt:  time.Now(),
+	}
+	if len(qtx.calls) > 0 {
+		htx.To = normalizeAddress(qtx.calls[0].ContractAddress)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if len(h.ids) == MaxHistoryLen {
+		delete(h.byID, h.ids[0])
+		h.ids = h.ids[1:]
+	}
+	h.ids = append(h.ids, qtx.id)
+	h.byID[qtx.id] = htx
+}
+
+// update sets the state of a tx, and its hash once known.
+func (h *txHistory) update(id, hash string, state chains.TxState, chainState, errStr string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if tx, ok := h.byID[id]; ok {
+		if hash != "" {
+			tx.Hash = hash
+		}
+		tx.State = state
+		tx.ChainState = chainState
+		tx.Error = errStr
+	}
+}
+
+// Txs returns the txs in the history matching filter.
+func (t *Txm) Txs(_ context.Context, filter chains.TxFilter) ([]chains.Tx, int, error) {
+	t.history.lock.RLock()
+	defer t.history.lock.RUnlock()
+	var txs []chains.Tx
+	for i := len(t.history.ids) - 1; i >= 0; i-- {
+		if tx := *t.history.byID[t.history.ids[i]]; filter.Matches(tx) {
+			txs = append(txs, tx)
+		}
+	}
+	return chains.PaginateTxs(txs, filter.Offset, filter.Limit), len(txs), nil
+}
+
+// FindTx returns the tx in the history with the ID or hash id.
+func (t *Txm) FindTx(_ context.Context, chainType relay.Network, chainID, id string) (chains.Tx, error) {
+	if chainType != relay.StarkNet || chainID != t.history.chainID {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	t.history.lock.RLock()
+	defer t.history.lock.RUnlock()
+	if tx, ok := t.history.byID[id]; ok {
+		return *tx, nil
+	}
+	for _, tx := range t.history.byID {
+		if tx.Hash != "" && tx.Hash == id {
+			return *tx, nil
+		}
+	}
+	return chains.Tx{}, chains.ErrTxNotFound
+}
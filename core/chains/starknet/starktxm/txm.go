@@ -0,0 +1,340 @@
+package starktxm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NethermindEth/juno/pkg/crypto/pedersen"
+	"github.com/dontpanicdao/caigo"
+	"github.com/dontpanicdao/caigo/gateway"
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/starkkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	MaxQueueLen = 1000
+
+	// txVersion and txPrefix are the version and prefix of the hash which account contracts verify.
+	txVersion = 0
+	txPrefix  = "invoke"
+)
+
+// Tx statuses reported by the feeder gateway.
+const (
+	statusNotReceived  = "NOT_RECEIVED"
+	statusRejected     = "REJECTED"
+	statusAcceptedOnL2 = "ACCEPTED_ON_L2"
+	statusAcceptedOnL1 = "ACCEPTED_ON_L1"
+)
+
+var _ services.ServiceCtx = (*Txm)(nil)
+
+// curve only signs, so it does not need the constant points which caigo would download for hashing.
+var curve, _ = caigo.SC()
+
+// Config configures a Txm.
+type Config struct {
+	// ConfirmPollPeriod is how often the status of broadcast txs is polled.
+	ConfirmPollPeriod time.Duration
+	// TxTimeout is how long a broadcast tx may stay unknown to the gateway before it is considered dropped.
+	TxTimeout time.Duration
+	// FeeMultiplierPercent scales the estimated fee of a tx to its max fee.
+	FeeMultiplierPercent uint32
+}
+
+// DefaultConfig is the Config of the txms of every StarkNet chain.
+var DefaultConfig = Config{
+	ConfirmPollPeriod:    5 * time.Second,
+	TxTimeout:            2 * time.Minute,
+	FeeMultiplierPercent: 150,
+}
+
+// Keystore provides the keys which sign txs, and records which key controls each account.
+type Keystore interface {
+	Get(id string) (starkkey.Key, error)
+	AddAccount(account, keyID string) error
+	Accounts() (map[string]string, error)
+}
+
+type queuedTx struct {
+	id      string
+	account string
+	calls   []types.Transaction
+}
+
+type pendingTx struct {
+	id        string
+	hash      string
+	account   string
+	broadcast time.Time
+}
+
+// Txm sends txs through account contracts, the only way to send txs on StarkNet, and tracks them
+// until they are accepted. Txs are not persisted, so queued and pending txs are lost on restart.
+type Txm struct {
+	utils.StartStopOnce
+	chainID string
+	cfg     Config
+	lggr    logger.Logger
+	ks      Keystore
+	client  *utils.LazyLoad[Client]
+	queue   chan queuedTx
+	history *txHistory
+
+	// only accessed by run
+	nonces  map[string]*big.Int // next nonce by account address
+	pending []pendingTx
+
+	stop, done chan struct{}
+}
+
+// NewTxm returns a Txm which sends txs with clients from newClient, signed by keys from ks.
+func NewTxm(chainID string, newClient func() (Client, error), cfg Config, ks Keystore, lggr logger.Logger) *Txm {
+	return &Txm{
+		chainID: chainID,
+		cfg:     cfg,
+		lggr:    lggr.Named("Txm"),
+		ks:      ks,
+		client:  utils.NewLazyLoad(newClient),
+		queue:   make(chan queuedTx, MaxQueueLen),
+		history: newTxHistory(chainID),
+		nonces:  map[string]*big.Int{},
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start starts the Txm.
+func (t *Txm) Start(context.Context) error {
+	return t.StartOnce("StarkNetTxm", func() error {
+		go t.run()
+		return nil
+	})
+}
+
+func (t *Txm) Close() error {
+	return t.StopOnce("StarkNetTxm", func() error {
+		close(t.stop)
+		<-t.done
+		return nil
+	})
+}
+
+// Enqueue queues a tx which executes calls from account. The key of the account must be in the
+// keystore. It returns the ID of the tx in the history.
+func (t *Txm) Enqueue(ctx context.Context, account string, calls []types.Transaction) (string, error) {
+	if len(calls) == 0 {
+		return "", errors.New("no calls to execute")
+	}
+	account = normalizeAddress(account)
+	if _, err := t.accountKey(ctx, account); err != nil {
+		return "", err
+	}
+	qtx := queuedTx{id: uuid.New().String(), account: account, calls: calls}
+	t.history.add(qtx)
+	select {
+	case t.queue <- qtx:
+	default:
+		t.lggr.Errorw("Failed to enqueue tx", "queueFull", len(t.queue) == MaxQueueLen, "account", account)
+		t.history.update(qtx.id, "", chains.TxErrored, "rejected", "failed to enqueue transaction")
+		return "", errors.Errorf("failed to enqueue transaction for %s", account)
+	}
+	return qtx.id, nil
+}
+
+// accountKey returns the key of account, which is looked up by the public key the account contract reports
+// the first time, and recorded in the keystore.
+func (t *Txm) accountKey(ctx context.Context, account string) (starkkey.Key, error) {
+	accounts, err := t.ks.Accounts()
+	if err != nil {
+		return starkkey.Key{}, errors.Wrap(err, "failed to get accounts")
+	}
+	keyID, ok := accounts[account]
+	if !ok {
+		client, err := t.client.Get()
+		if err != nil {
+			return starkkey.Key{}, errors.Wrap(err, "failed to get client")
+		}
+		res, err := client.Call(ctx, types.Transaction{ContractAddress: account, EntryPointSelector: "get_public_key"}, nil)
+		if err != nil {
+			return starkkey.Key{}, errors.Wrapf(err, "failed to get public key of account %s", account)
+		}
+		if len(res) == 0 {
+			return starkkey.Key{}, errors.Errorf("no public key for account %s", account)
+		}
+		keyID = fmt.Sprintf("0x%064x", caigo.SNValToBN(res[0]))
+	}
+	key, err := t.ks.Get(keyID)
+	if err != nil {
+		return starkkey.Key{}, errors.Wrapf(err, "failed to get key of account %s", account)
+	}
+	if !ok {
+		if err := t.ks.AddAccount(account, keyID); err != nil {
+			return starkkey.Key{}, errors.Wrapf(err, "failed to record account %s", account)
+		}
+	}
+	return key, nil
+}
+
+func (t *Txm) run() {
+	defer close(t.done)
+	ctx, cancel := utils.ContextFromChan(t.stop)
+	defer cancel()
+
+	tick := time.After(utils.WithJitter(t.cfg.ConfirmPollPeriod))
+	for {
+		select {
+		case <-t.stop:
+			return
+		case qtx := <-t.queue:
+			if err := t.broadcast(ctx, qtx); err != nil {
+				t.lggr.Errorw("Failed to broadcast tx", "id", qtx.id, "account", qtx.account, "err", err)
+				t.history.update(qtx.id, "", chains.TxErrored, "rejected", err.Error())
+				// The nonce may be out of sync, or the client bad, so start afresh.
+				delete(t.nonces, qtx.account)
+				t.client.Reset()
+			}
+		case <-tick:
+			t.confirm(ctx)
+			tick = time.After(utils.WithJitter(t.cfg.ConfirmPollPeriod))
+		}
+	}
+}
+
+// broadcast signs and sends qtx, with a max fee estimated from a zero fee version of it.
+func (t *Txm) broadcast(ctx context.Context, qtx queuedTx) error {
+	client, err := t.client.Get()
+	if err != nil {
+		return errors.Wrap(err, "failed to get client")
+	}
+	key, err := t.accountKey(ctx, qtx.account)
+	if err != nil {
+		return err
+	}
+	nonce, err := t.nextNonce(ctx, client, qtx.account)
+	if err != nil {
+		return err
+	}
+
+	tx, err := sign(t.chainID, qtx.account, key, nonce, big.NewInt(0), qtx.calls)
+	if err != nil {
+		return err
+	}
+	fee, err := client.EstimateFee(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to estimate fee")
+	}
+	if fee.Amount == nil {
+		return errors.New("no fee estimated")
+	}
+	maxFee := new(big.Int).Mul(fee.Amount, big.NewInt(int64(t.cfg.FeeMultiplierPercent)))
+	maxFee.Div(maxFee, big.NewInt(100))
+	if tx, err = sign(t.chainID, qtx.account, key, nonce, maxFee, qtx.calls); err != nil {
+		return err
+	}
+
+	resp, err := client.InvokeWithFee(ctx, tx, maxFee)
+	if err != nil {
+		return errors.Wrap(err, "failed to send tx")
+	}
+	t.nonces[qtx.account] = new(big.Int).Add(nonce, big.NewInt(1))
+	t.pending = append(t.pending, pendingTx{id: qtx.id, hash: resp.TransactionHash, account: qtx.account, broadcast: time.Now()})
+	t.history.update(qtx.id, resp.TransactionHash, chains.TxPending, "broadcast", "")
+	t.lggr.Debugw("Broadcast tx", "id", qtx.id, "hash", resp.TransactionHash, "account", qtx.account, "nonce", nonce, "maxFee", maxFee)
+	return nil
+}
+
+// nextNonce returns the nonce of the next tx from account. The nonce of the account contract only
+// counts accepted txs, so it lags behind the local nonce while txs are pending.
+func (t *Txm) nextNonce(ctx context.Context, client Client, account string) (*big.Int, error) {
+	nonce, err := client.AccountNonce(ctx, account)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get nonce of account %s", account)
+	}
+	if local, ok := t.nonces[account]; ok && local.Cmp(nonce) > 0 {
+		return local, nil
+	}
+	return nonce, nil
+}
+
+// confirm polls the status of pending txs, and drops those which were accepted or failed.
+func (t *Txm) confirm(ctx context.Context) {
+	if len(t.pending) == 0 {
+		return
+	}
+	client, err := t.client.Get()
+	if err != nil {
+		t.lggr.Errorw("Failed to get client", "err", err)
+		return
+	}
+	var pending []pendingTx
+	for _, ptx := range t.pending {
+		status, err := client.TransactionStatus(ctx, gateway.TransactionStatusOptions{TransactionHash: ptx.hash})
+		if err != nil {
+			t.lggr.Errorw("Failed to get tx status", "id", ptx.id, "hash", ptx.hash, "err", err)
+			pending = append(pending, ptx)
+			continue
+		}
+		chainState := strings.ToLower(status.TxStatus)
+		switch status.TxStatus {
+		case statusAcceptedOnL2, statusAcceptedOnL1:
+			t.lggr.Debugw("Tx accepted", "id", ptx.id, "hash", ptx.hash, "status", status.TxStatus)
+			t.history.update(ptx.id, ptx.hash, chains.TxConfirmed, chainState, "")
+		case statusRejected:
+			t.lggr.Warnw("Tx rejected", "id", ptx.id, "hash", ptx.hash, "reason", status.TxFailureReason.ErrorMessage)
+			t.history.update(ptx.id, ptx.hash, chains.TxErrored, chainState, status.TxFailureReason.ErrorMessage)
+			delete(t.nonces, ptx.account)
+		case statusNotReceived:
+			if time.Since(ptx.broadcast) > t.cfg.TxTimeout {
+				t.lggr.Warnw("Tx not received within timeout", "id", ptx.id, "hash", ptx.hash, "timeout", t.cfg.TxTimeout)
+				t.history.update(ptx.id, ptx.hash, chains.TxErrored, "dropped", "transaction not received within timeout")
+				delete(t.nonces, ptx.account)
+				continue
+			}
+			pending = append(pending, ptx)
+		default:
+			t.history.update(ptx.id, ptx.hash, chains.TxPending, chainState, "")
+			pending = append(pending, ptx)
+		}
+	}
+	t.pending = pending
+}
+
+// sign returns a tx which calls __execute__ on account with calls, signed by key.
+func sign(chainID, account string, key starkkey.Key, nonce, maxFee *big.Int, calls []types.Transaction) (types.Transaction, error) {
+	calldata := caigo.FmtExecuteCalldata(nonce, calls)
+	hash := pedersen.ArrayDigest(
+		caigo.UTF8StrToBig(txPrefix),
+		big.NewInt(txVersion),
+		caigo.SNValToBN(account),
+		caigo.GetSelectorFromName(caigo.EXECUTE_SELECTOR),
+		pedersen.ArrayDigest(calldata...),
+		maxFee,
+		caigo.UTF8StrToBig(chainID),
+	)
+	r, s, err := curve.Sign(hash, key.ToPrivKey().D)
+	if err != nil {
+		return types.Transaction{}, errors.Wrap(err, "failed to sign tx")
+	}
+	return types.Transaction{
+		ContractAddress:    account,
+		EntryPointSelector: caigo.BigToHex(caigo.GetSelectorFromName(caigo.EXECUTE_SELECTOR)),
+		Calldata:           caigo.FmtExecuteCalldataStrings(nonce, calls),
+		Signature:          []string{r.String(), s.String()},
+	}, nil
+}
+
+// normalizeAddress returns address as lower case hex without leading zeros.
+func normalizeAddress(address string) string {
+	return caigo.BigToHex(caigo.SNValToBN(address))
+}
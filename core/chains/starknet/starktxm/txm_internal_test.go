@@ -0,0 +1,226 @@
+package starktxm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NethermindEth/juno/pkg/crypto/pedersen"
+	"github.com/dontpanicdao/caigo"
+	"github.com/dontpanicdao/caigo/gateway"
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/starkkey"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+type keystore struct {
+	mu       sync.Mutex
+	keys     map[string]starkkey.Key
+	accounts map[string]string
+}
+
+func newKeystore(keys ...starkkey.Key) *keystore {
+	ks := &keystore{keys: map[string]starkkey.Key{}, accounts: map[string]string{}}
+	for _, key := range keys {
+		ks.keys[key.ID()] = key
+	}
+	return ks
+}
+
+func (ks *keystore) Get(id string) (starkkey.Key, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	key, ok := ks.keys[id]
+	if !ok {
+		return starkkey.Key{}, errors.Errorf("no key %s", id)
+	}
+	return key, nil
+}
+
+func (ks *keystore) AddAccount(account, keyID string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.accounts[account] = keyID
+	return nil
+}
+
+func (ks *keystore) Accounts() (map[string]string, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	accounts := make(map[string]string, len(ks.accounts))
+	for account, keyID := range ks.accounts {
+		accounts[account] = keyID
+	}
+	return accounts, nil
+}
+
+type invocation struct {
+	tx     types.Transaction
+	maxFee *big.Int
+}
+
+// fakeClient is a gateway with a single account contract.
+type fakeClient struct {
+	account   string
+	publicKey string
+	nonce     *big.Int
+
+	mu       sync.Mutex
+	invoked  []invocation
+	statuses map[string]string
+}
+
+func (c *fakeClient) Call(_ context.Context, tx types.Transaction, _ *gateway.BlockOptions) ([]string, error) {
+	if tx.ContractAddress == c.account && tx.EntryPointSelector == "get_public_key" {
+		return []string{c.publicKey}, nil
+	}
+	return nil, errors.Errorf("no contract at %s", tx.ContractAddress)
+}
+
+func (c *fakeClient) AccountNonce(context.Context, string) (*big.Int, error) {
+	return c.nonce, nil
+}
+
+func (c *fakeClient) EstimateFee(context.Context, types.Transaction) (caigo.FeeEstimate, error) {
+	return caigo.FeeEstimate{Amount: big.NewInt(100), Unit: "wei"}, nil
+}
+
+func (c *fakeClient) InvokeWithFee(_ context.Context, tx types.Transaction, maxFee *big.Int) (*types.AddTxResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invoked = append(c.invoked, invocation{tx, maxFee})
+	hash := fmt.Sprintf("0x%d", len(c.invoked))
+	c.statuses[hash] = statusNotReceived
+	return &types.AddTxResponse{Code: "TRANSACTION_RECEIVED", TransactionHash: hash}, nil
+}
+
+func (c *fakeClient) TransactionStatus(_ context.Context, opts gateway.TransactionStatusOptions) (*types.TransactionStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	status := &types.TransactionStatus{TxStatus: c.statuses[opts.TransactionHash]}
+	if status.TxStatus == statusRejected {
+		status.TxFailureReason.ErrorMessage = "nonce too low"
+	}
+	return status, nil
+}
+
+func (c *fakeClient) setStatus(hash, status string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses[hash] = status
+}
+
+func (c *fakeClient) invocations() []invocation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]invocation(nil), c.invoked...)
+}
+
+func TestTxm(t *testing.T) {
+	const chainID = "SN_GOERLI"
+	key, err := starkkey.New()
+	require.NoError(t, err)
+	ks := newKeystore(key)
+	client := &fakeClient{
+		account:   "0x123",
+		publicKey: key.ID(),
+		nonce:     big.NewInt(5),
+		statuses:  map[string]string{},
+	}
+	cfg := Config{ConfirmPollPeriod: 10 * time.Millisecond, TxTimeout: time.Minute, FeeMultiplierPercent: 150}
+	txm := NewTxm(chainID, func() (Client, error) { return client, nil }, cfg, ks, logger.TestLogger(t))
+	ctx := testutils.Context(t)
+
+	_, err = txm.Enqueue(ctx, "0x0456", []types.Transaction{FeeTokenTransfer("0x789", big.NewInt(1))})
+	require.Error(t, err)
+	_, err = txm.Enqueue(ctx, "0x0123", nil)
+	require.Error(t, err)
+
+	id1, err := txm.Enqueue(ctx, "0x0123", []types.Transaction{FeeTokenTransfer("0x789", big.NewInt(1))})
+	require.NoError(t, err)
+	id2, err := txm.Enqueue(ctx, "0x0123", []types.Transaction{FeeTokenTransfer("0x789", big.NewInt(2))})
+	require.NoError(t, err)
+	accounts, err := ks.Accounts()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"0x123": key.ID()}, accounts)
+
+	tx, err := txm.FindTx(ctx, relay.StarkNet, chainID, id1)
+	require.NoError(t, err)
+	assert.Equal(t, chains.TxUnstarted, tx.State)
+	assert.Equal(t, "0x123", tx.From)
+	assert.Equal(t, FeeTokenAddress, tx.To)
+
+	require.NoError(t, txm.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, txm.Close()) })
+
+	require.Eventually(t, func() bool { return len(client.invocations()) == 2 }, testutils.WaitTimeout(t), 10*time.Millisecond)
+	for i, inv := range client.invocations() {
+		// nonces are tracked locally while txs are pending
+		assert.Equal(t, big.NewInt(int64(5+i)).String(), inv.tx.Calldata[len(inv.tx.Calldata)-1])
+		assert.Equal(t, big.NewInt(150), inv.maxFee)
+	}
+
+	client.setStatus("0x1", statusAcceptedOnL2)
+	client.setStatus("0x2", statusRejected)
+	require.Eventually(t, func() bool {
+		txs, _, err := txm.Txs(ctx, chains.TxFilter{State: chains.TxPending})
+		return err == nil && len(txs) == 0
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	tx, err = txm.FindTx(ctx, relay.StarkNet, chainID, id1)
+	require.NoError(t, err)
+	assert.Equal(t, chains.TxConfirmed, tx.State)
+	assert.Equal(t, "accepted_on_l2", tx.ChainState)
+	assert.Equal(t, "0x1", tx.Hash)
+
+	tx, err = txm.FindTx(ctx, relay.StarkNet, chainID, "0x2")
+	require.NoError(t, err)
+	assert.Equal(t, id2, tx.ID)
+	assert.Equal(t, chains.TxErrored, tx.State)
+	assert.Equal(t, "nonce too low", tx.Error)
+
+	txs, count, err := txm.Txs(ctx, chains.TxFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, id2, txs[0].ID)
+
+	_, err = txm.FindTx(ctx, relay.Terra, chainID, id1)
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+}
+
+func TestSign(t *testing.T) {
+	key, err := starkkey.New()
+	require.NoError(t, err)
+	nonce, maxFee := big.NewInt(3), big.NewInt(1000)
+	calls := []types.Transaction{FeeTokenTransfer("0x789", big.NewInt(1))}
+
+	tx, err := sign("SN_GOERLI", "0x123", key, nonce, maxFee, calls)
+	require.NoError(t, err)
+	assert.Equal(t, "0x123", tx.ContractAddress)
+	assert.Equal(t, caigo.FmtExecuteCalldataStrings(nonce, calls), tx.Calldata)
+	require.Len(t, tx.Signature, 2)
+
+	hash := pedersen.ArrayDigest(
+		caigo.UTF8StrToBig("invoke"),
+		big.NewInt(0),
+		big.NewInt(0x123),
+		caigo.GetSelectorFromName("__execute__"),
+		pedersen.ArrayDigest(caigo.FmtExecuteCalldata(nonce, calls)...),
+		maxFee,
+		caigo.UTF8StrToBig("SN_GOERLI"),
+	)
+	r, _ := new(big.Int).SetString(tx.Signature[0], 10)
+	s, _ := new(big.Int).SetString(tx.Signature[1], 10)
+	pub := key.PublicKey()
+	assert.True(t, curve.Verify(hash, r, s, pub.X, pub.Y))
+}
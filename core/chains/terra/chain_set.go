@@ -80,6 +80,7 @@ type ChainSet interface {
 
 	Add(context.Context, string, *db.ChainCfg) (types.DBChain, error)
 	Remove(string) error
+	Chains() []terra.Chain
 	Configure(ctx context.Context, id string, enabled bool, config *db.ChainCfg) (types.DBChain, error)
 	Show(id string) (types.DBChain, error)
 	Index(offset, limit int) ([]types.DBChain, int, error)
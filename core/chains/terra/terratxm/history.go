@@ -0,0 +1,111 @@
+package terratxm
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+var _ chains.TxHistory = (*Txm)(nil)
+
+// txStates maps chain agnostic states to msg states.
+var txStates = map[chains.TxState][]db.State{
+	chains.TxUnstarted: {db.Unstarted},
+	chains.TxPending:   {db.Started, db.Broadcasted},
+	chains.TxConfirmed: {db.Confirmed},
+	chains.TxErrored:   {db.Errored},
+}
+
+func txState(state db.State) chains.TxState {
+	for txState, states := range txStates {
+		for _, s := range states {
+			if s == state {
+				return txState
+			}
+		}
+	}
+	return chains.TxPending
+}
+
+func (txm *Txm) msgTx(msg terra.Msg) chains.Tx {
+	tx := chains.Tx{
+		ChainType:  relay.Terra,
+		ChainID:    txm.orm.chainID,
+		ID:         strconv.FormatInt(msg.ID, 10),
+		To:         msg.ContractID,
+		State:      txState(msg.State),
+		ChainState: string(msg.State),
+		CreatedAt:  msg.CreatedAt,
+	}
+	if msg.TxHash != nil {
+		tx.Hash = *msg.TxHash
+	}
+	// The sender is only encoded in the msg
+	if _, sender, err := unmarshalMsg(msg.Type, msg.Raw); err == nil {
+		tx.From = sender
+	}
+	return tx
+}
+
+// Txs returns the msgs matching filter. Msgs are listed individually, and
+// msgs which were sent in the same batch share a hash.
+func (txm *Txm) Txs(ctx context.Context, filter chains.TxFilter) ([]chains.Tx, int, error) {
+	if (filter.ChainType != "" && filter.ChainType != relay.Terra) || (filter.ChainID != "" && filter.ChainID != txm.orm.chainID) {
+		return nil, 0, nil
+	}
+	var states []db.State
+	if filter.State != "" {
+		states = txStates[filter.State]
+	}
+	if filter.From == "" {
+		msgs, count, err := txm.orm.GetMsgsPage(states, filter.Offset, filter.Limit, pg.WithParentCtx(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		txs := make([]chains.Tx, len(msgs))
+		for i, msg := range msgs {
+			txs[i] = txm.msgTx(msg)
+		}
+		return txs, count, nil
+	}
+
+	// Senders are not stored, so filtering on them requires decoding every msg.
+	msgs, _, err := txm.orm.GetMsgsPage(states, 0, 0, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+	var txs []chains.Tx
+	for _, msg := range msgs {
+		if tx := txm.msgTx(msg); filter.Matches(tx) {
+			txs = append(txs, tx)
+		}
+	}
+	return chains.PaginateTxs(txs, filter.Offset, filter.Limit), len(txs), nil
+}
+
+// FindTx returns the msg with id.
+func (txm *Txm) FindTx(ctx context.Context, chainType relay.Network, chainID, id string) (chains.Tx, error) {
+	if chainType != relay.Terra || chainID != txm.orm.chainID {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	msgID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return chains.Tx{}, chains.ErrTxNotFound
+	}
+	msgs, err := txm.orm.GetMsgs(msgID)
+	if err != nil {
+		return chains.Tx{}, err
+	}
+	for _, msg := range msgs {
+		if msg.ChainID == chainID {
+			return txm.msgTx(msg), nil
+		}
+	}
+	return chains.Tx{}, chains.ErrTxNotFound
+}
@@ -2,7 +2,9 @@ package terratxm
 
 import (
 	"database/sql"
+	"strconv"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
@@ -74,6 +76,30 @@ func (o *ORM) GetMsgs(ids ...int64) (terra.Msgs, error) {
 	return msgs, nil
 }
 
+// GetMsgsPage returns the messages with any of states, or all messages without
+// states, newest first up to limit, and their total count. A limit of zero is
+// unlimited.
+func (o *ORM) GetMsgsPage(states []db.State, offset, limit int, qopts ...pg.QOpt) (msgs terra.Msgs, count int, err error) {
+	q := o.q.WithOpts(qopts...)
+	stateSQL := ``
+	args := []interface{}{o.chainID}
+	if len(states) > 0 {
+		stateSQL = ` AND state = ANY($2)`
+		args = append(args, pq.Array(states))
+	}
+	if err = q.Get(&count, `SELECT count(*) FROM terra_msgs WHERE terra_chain_id = $1`+stateSQL, args...); err != nil {
+		return nil, 0, err
+	}
+	limitSQL := `ALL`
+	if limit > 0 {
+		limitSQL = strconv.Itoa(limit)
+	}
+	if err = q.Select(&msgs, `SELECT * FROM terra_msgs WHERE terra_chain_id = $1`+stateSQL+` ORDER BY id DESC LIMIT `+limitSQL+` OFFSET `+strconv.Itoa(offset), args...); err != nil {
+		return nil, 0, err
+	}
+	return msgs, count, nil
+}
+
 // UpdateMsgs updates msgs with the given ids.
 // Note state transitions are validated at the db level.
 func (o *ORM) UpdateMsgs(ids []int64, state db.State, txHash *string, qopts ...pg.QOpt) error {
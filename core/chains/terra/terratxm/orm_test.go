@@ -80,3 +80,38 @@ func TestORM(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(confirmed))
 }
+
+func TestORM_GetMsgsPage(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	logCfg := pgtest.NewPGCfg(true)
+	chainID := fmt.Sprintf("Chainlinktest-%d", rand.Int31n(999999))
+	_, err := terra.NewORM(db, lggr, logCfg).CreateChain(chainID, nil)
+	require.NoError(t, err)
+	o := NewORM(chainID, db, lggr, logCfg)
+
+	mid1, err := o.InsertMsg("0x123", "", []byte("hello"))
+	require.NoError(t, err)
+	mid2, err := o.InsertMsg("0xabc", "", []byte("test"))
+	require.NoError(t, err)
+	require.NoError(t, o.UpdateMsgs([]int64{mid1}, Errored, nil))
+
+	msgs, count, err := o.GetMsgsPage(nil, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, mid2, msgs[0].ID, "newest first")
+	assert.Equal(t, mid1, msgs[1].ID)
+
+	msgs, count, err = o.GetMsgsPage(nil, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, mid1, msgs[0].ID)
+
+	msgs, count, err = o.GetMsgsPage([]State{Errored, Confirmed}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, mid1, msgs[0].ID)
+}
@@ -0,0 +1,144 @@
+package chains
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// ErrTxNotFound is returned when a transaction does not match any sent by the node.
+var ErrTxNotFound = errors.New("transaction not found")
+
+// TxState is the chain agnostic state of a transaction. Each chain maps its
+// own states onto these, and keeps them in Tx.ChainState.
+type TxState string
+
+const (
+	// TxUnstarted is queued, but not yet sent.
+	TxUnstarted TxState = "unstarted"
+	// TxPending is being sent, or was sent and awaits confirmation.
+	TxPending TxState = "pending"
+	// TxConfirmed was included on chain.
+	TxConfirmed TxState = "confirmed"
+	// TxErrored failed, and will not be retried.
+	TxErrored TxState = "errored"
+)
+
+// ParseTxState parses a TxState.
+func ParseTxState(s string) (TxState, error) {
+	switch state := TxState(s); state {
+	case TxUnstarted, TxPending, TxConfirmed, TxErrored:
+		return state, nil
+	}
+	return "", errors.Errorf("invalid transaction state %q, must be one of unstarted, pending, confirmed or errored", s)
+}
+
+// Tx is a chain agnostic summary of a transaction sent by the node.
+type Tx struct {
+	ChainType relay.Network
+	ChainID   string
+	// ID identifies the transaction within its chain, e.g. the eth_tx id
+	// for EVM or the signature for Solana.
+	ID string
+	// Hash is empty until the transaction is broadcast.
+	Hash  string
+	From  string
+	To    string
+	State TxState
+	// ChainState is the state reported by the chain's tx manager.
+	ChainState string
+	Error      string
+	// JobID and PipelineRunID reference the job run which created the
+	// transaction, if known.
+	JobID         *int32
+	PipelineRunID *int64
+	CreatedAt     time.Time
+}
+
+// TxFilter filters the transactions listed by TxHistory.Txs. Zero fields
+// match any transaction.
+type TxFilter struct {
+	ChainType relay.Network
+	ChainID   string
+	From      string
+	State     TxState
+	Offset    int
+	Limit     int
+}
+
+// Matches returns true if tx matches the filter, ignoring pagination.
+func (f TxFilter) Matches(tx Tx) bool {
+	return (f.ChainType == "" || f.ChainType == tx.ChainType) &&
+		(f.ChainID == "" || f.ChainID == tx.ChainID) &&
+		(f.From == "" || f.From == tx.From) &&
+		(f.State == "" || f.State == tx.State)
+}
+
+// TxHistory is implemented by the tx managers of each chain type, to list the
+// transactions sent by the node.
+type TxHistory interface {
+	// Txs returns a page of the transactions matching filter, newest first,
+	// and the total count of matching transactions.
+	Txs(ctx context.Context, filter TxFilter) ([]Tx, int, error)
+	// FindTx returns the transaction, or ErrTxNotFound.
+	FindTx(ctx context.Context, chainType relay.Network, chainID, id string) (Tx, error)
+}
+
+type multiTxHistory []TxHistory
+
+// NewMultiTxHistory returns a TxHistory which merges histories.
+func NewMultiTxHistory(histories ...TxHistory) TxHistory {
+	return multiTxHistory(histories)
+}
+
+func (m multiTxHistory) Txs(ctx context.Context, filter TxFilter) ([]Tx, int, error) {
+	// Each history returns up to the end of the requested page, which the
+	// merged page is then cut from.
+	sub := filter
+	sub.Offset = 0
+	if filter.Limit > 0 {
+		sub.Limit = filter.Offset + filter.Limit
+	}
+	var txs []Tx
+	var count int
+	for _, h := range m {
+		htxs, hcount, err := h.Txs(ctx, sub)
+		if err != nil {
+			return nil, 0, err
+		}
+		txs = append(txs, htxs...)
+		count += hcount
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].CreatedAt.After(txs[j].CreatedAt)
+	})
+	return PaginateTxs(txs, filter.Offset, filter.Limit), count, nil
+}
+
+func (m multiTxHistory) FindTx(ctx context.Context, chainType relay.Network, chainID, id string) (Tx, error) {
+	for _, h := range m {
+		tx, err := h.FindTx(ctx, chainType, chainID, id)
+		if errors.Is(err, ErrTxNotFound) {
+			continue
+		}
+		return tx, err
+	}
+	return Tx{}, ErrTxNotFound
+}
+
+// PaginateTxs returns the page of txs from offset, up to limit. A limit of
+// zero is unlimited.
+func PaginateTxs(txs []Tx, offset, limit int) []Tx {
+	if offset >= len(txs) {
+		return nil
+	}
+	txs = txs[offset:]
+	if limit > 0 && limit < len(txs) {
+		txs = txs[:limit]
+	}
+	return txs
+}
@@ -0,0 +1,99 @@
+package chains_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// txHistory is a TxHistory of txs, newest first.
+type txHistory []chains.Tx
+
+func (h txHistory) Txs(_ context.Context, filter chains.TxFilter) ([]chains.Tx, int, error) {
+	var txs []chains.Tx
+	for _, tx := range h {
+		if filter.Matches(tx) {
+			txs = append(txs, tx)
+		}
+	}
+	return chains.PaginateTxs(txs, filter.Offset, filter.Limit), len(txs), nil
+}
+
+func (h txHistory) FindTx(_ context.Context, chainType relay.Network, chainID, id string) (chains.Tx, error) {
+	for _, tx := range h {
+		if tx.ChainType == chainType && tx.ChainID == chainID && tx.ID == id {
+			return tx, nil
+		}
+	}
+	return chains.Tx{}, chains.ErrTxNotFound
+}
+
+func TestMultiTxHistory(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	evmTxs := txHistory{
+		{ChainType: relay.EVM, ChainID: "1", ID: "2", State: chains.TxPending, CreatedAt: now.Add(-time.Second)},
+		{ChainType: relay.EVM, ChainID: "1", ID: "1", State: chains.TxConfirmed, CreatedAt: now.Add(-3 * time.Second)},
+	}
+	terraTxs := txHistory{
+		{ChainType: relay.Terra, ChainID: "bombay-12", ID: "2", State: chains.TxErrored, CreatedAt: now},
+		{ChainType: relay.Terra, ChainID: "bombay-12", ID: "1", State: chains.TxConfirmed, CreatedAt: now.Add(-2 * time.Second)},
+	}
+	history := chains.NewMultiTxHistory(evmTxs, terraTxs)
+	ctx := testutils.Context(t)
+
+	ids := func(txs []chains.Tx) (ids []string) {
+		for _, tx := range txs {
+			ids = append(ids, string(tx.ChainType)+"/"+tx.ID)
+		}
+		return
+	}
+
+	for _, tt := range []struct {
+		name   string
+		filter chains.TxFilter
+		count  int
+		ids    []string
+	}{
+		{"all", chains.TxFilter{}, 4, []string{"terra/2", "evm/2", "terra/1", "evm/1"}},
+		{"first page", chains.TxFilter{Limit: 3}, 4, []string{"terra/2", "evm/2", "terra/1"}},
+		{"second page", chains.TxFilter{Offset: 3, Limit: 3}, 4, []string{"evm/1"}},
+		{"chain type", chains.TxFilter{ChainType: relay.EVM}, 2, []string{"evm/2", "evm/1"}},
+		{"state", chains.TxFilter{State: chains.TxConfirmed}, 2, []string{"terra/1", "evm/1"}},
+		{"chain id", chains.TxFilter{ChainID: "goerli"}, 0, nil},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			txs, count, err := history.Txs(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.count, count)
+			assert.Equal(t, tt.ids, ids(txs))
+		})
+	}
+
+	tx, err := history.FindTx(ctx, relay.Terra, "bombay-12", "1")
+	require.NoError(t, err)
+	assert.Equal(t, terraTxs[1], tx)
+
+	_, err = history.FindTx(ctx, relay.Solana, "mainnet", "1")
+	assert.ErrorIs(t, err, chains.ErrTxNotFound)
+}
+
+func TestParseTxState(t *testing.T) {
+	t.Parallel()
+
+	state, err := chains.ParseTxState("pending")
+	require.NoError(t, err)
+	assert.Equal(t, chains.TxPending, state)
+
+	_, err = chains.ParseTxState("in_progress")
+	assert.Error(t, err)
+}
@@ -230,6 +230,30 @@ func NewApp(client *Client) *cli.App {
 							Name:  "level",
 							Usage: "set log level for node (debug||info||warn||error)",
 						},
+						cli.StringFlag{
+							Name:  "service",
+							Usage: "(optional) set the log level of a named logger and its descendants only, e.g. EVM.1.Txm",
+						},
+						cli.DurationFlag{
+							Name:  "expires",
+							Usage: "(optional) remove the service log level after this long, e.g. 30m",
+						},
+					},
+				},
+				{
+					Name:   "loglevels",
+					Usage:  "List the log levels set for named loggers",
+					Action: client.ListServiceLogLevels,
+				},
+				{
+					Name:   "unsetloglevel",
+					Usage:  "Unset the log level of a named logger, which reverts to the node's log level",
+					Action: client.UnsetServiceLogLevel,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "service",
+							Usage: "the named logger, e.g. EVM.1.Txm",
+						},
 					},
 				},
 				{
@@ -275,16 +299,102 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create a job",
 					Action: client.CreateJob,
 				},
+				{
+					Name:   "update",
+					Usage:  "Update the spec of a job",
+					Action: client.UpdateJob,
+				},
 				{
 					Name:   "delete",
 					Usage:  "Delete a job",
 					Action: client.DeleteJob,
 				},
+				{
+					Name:   "pause",
+					Usage:  "Stop the services of a job without deleting it",
+					Action: client.PauseJob,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Restart the services of a paused job",
+					Action: client.ResumeJob,
+				},
+				{
+					Name:   "versions",
+					Usage:  "List the recorded versions of the spec of a job",
+					Action: client.ListJobSpecVersions,
+				},
+				{
+					Name:   "diff",
+					Usage:  "Show the diff between two versions of the spec of a job",
+					Action: client.DiffJobSpecVersions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "from",
+							Usage: "version to diff from, defaults to the version before --to",
+						},
+						cli.IntFlag{
+							Name:  "to",
+							Usage: "version to diff to, defaults to the latest version",
+						},
+					},
+				},
+				{
+					Name:   "rollback",
+					Usage:  "Reapply a previous version of the spec of a job",
+					Action: client.RollbackJob,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "version",
+							Usage: "version to roll back to",
+						},
+					},
+				},
 				{
 					Name:   "run",
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "rerun",
+					Usage:  "Run a finished job run again with the same inputs",
+					Action: client.RerunPipelineRun,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "original-spec",
+							Usage: "rerun the pipeline the run executed instead of the current one",
+						},
+						cli.BoolFlag{
+							Name:  "yes, y",
+							Usage: "confirm rerunning pipelines with side effecting tasks, such as ethtx, bridge and http",
+						},
+					},
+				},
+				{
+					Name:   "runs",
+					Usage:  "List the runs of a job, or of all jobs if no job id is given",
+					Action: client.ListPipelineRuns,
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{
+							Name:  "filter",
+							Usage: "filter runs by key=value, with keys job, state, since (e.g. 1h), after and before (RFC3339), taskType, taskName and error (substring). Can be repeated",
+						},
+						cli.StringFlag{
+							Name:  "sort",
+							Usage: "asc or desc order of creation",
+							Value: "desc",
+						},
+						cli.IntFlag{
+							Name:  "limit",
+							Usage: "maximum number of runs to display",
+							Value: 25,
+						},
+						cli.StringFlag{
+							Name:  "cursor",
+							Usage: "cursor of the page to display, as printed after the previous page",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -308,6 +418,10 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Optional maximum gas price (GWei) for the creating key.",
 								},
+								cli.StringFlag{
+									Name:  "remoteAddress",
+									Usage: "Register the key for this address held by the remote signer (ETH_REMOTE_SIGNER_URL), instead of creating a key in the node's keystore.",
+								},
 							},
 						},
 						{
@@ -319,6 +433,18 @@ func NewApp(client *Client) *cli.App {
 									Name:  "maxGasPriceGWei",
 									Usage: "Maximum gas price (GWei) for the specified key.",
 								},
+								cli.BoolFlag{
+									Name:  "disable",
+									Usage: "Stop using the key for new transactions on its chain. Transactions already queued for the key are still sent.",
+								},
+								cli.BoolFlag{
+									Name:  "enable",
+									Usage: "Use a disabled key for new transactions again.",
+								},
+								cli.StringFlag{
+									Name:  "labels",
+									Usage: "Comma separated labels of the key, which job specs can select sending keys by, e.g. 'ocr,keeper'. Pass an empty string to remove all labels.",
+								},
 							},
 						},
 						{
@@ -813,6 +939,25 @@ func NewApp(client *Client) *cli.App {
 							Action: client.RollbackDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:      "restore",
+							Usage:     "Verify a backup and restore it into an empty database.",
+							ArgsUsage: "<manifest>",
+							Description: "Checks the checksums of the backup manifest and file, decrypts the backup and checks that its schema version is supported, " +
+								"then restores it with pg_restore into the target database, which must be empty. " +
+								"Encrypted backups are decrypted with the passphrase from --password, or else DATABASE_BACKUP_ENCRYPTION_KEY.",
+							Action: client.RestoreDatabase,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "password, p",
+									Usage: "text file holding the DATABASE_BACKUP_ENCRYPTION_KEY the backup was encrypted with",
+								},
+								cli.StringFlag{
+									Name:  "target",
+									Usage: "URL of the database to restore into. Defaults to DATABASE_URL",
+								},
+							},
+						},
 						{
 							Name:   "create-migration",
 							Usage:  "Create a new migration.",
@@ -856,6 +1001,38 @@ func NewApp(client *Client) *cli.App {
 			Name:  "txs",
 			Usage: "Commands for handling transactions",
 			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the transactions sent on every chain, newest first",
+					Action: client.IndexTxs,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "chain-type",
+							Usage: "only list transactions of this chain type, options: [evm, solana, terra, starknet]",
+						},
+						cli.StringFlag{
+							Name:  "chain-id",
+							Usage: "only list transactions of this chain ID",
+						},
+						cli.StringFlag{
+							Name:  "from",
+							Usage: "only list transactions sent from this address",
+						},
+						cli.StringFlag{
+							Name:  "state",
+							Usage: "only list transactions in this state, options: [unstarted, pending, confirmed, errored]",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show the transaction of <chainType> <chainID> with <ID>, as listed by txs list",
+					Action: client.ShowTx,
+				},
 				{
 					Name:  "evm",
 					Usage: "Commands for handling EVM transactions",
@@ -943,6 +1120,31 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:  "starknet",
+					Usage: "Commands for handling StarkNet transactions",
+					Subcommands: []cli.Command{
+						{
+							Name:   "create",
+							Usage:  "Send <amount> ETH (or WEI) from node StarkNet account <fromAccount> to destination <toAddress>.",
+							Action: client.StarkNetSendEth,
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "force",
+									Usage: "allows to send a higher amount than the account's balance",
+								},
+								cli.BoolFlag{
+									Name:  "wei",
+									Usage: "allows to send WEI amounts",
+								},
+								cli.StringFlag{
+									Name:  "id",
+									Usage: "chain ID",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
@@ -963,11 +1165,11 @@ func NewApp(client *Client) *cli.App {
 				nodeCommand("EVM", NewEVMNodeClient(client),
 					cli.StringFlag{
 						Name:  "ws-url",
-						Usage: "Websocket URL",
+						Usage: "Websocket URL, optional for primary nodes with an HTTP URL, which then poll for new heads and logs",
 					},
 					cli.StringFlag{
 						Name:  "http-url",
-						Usage: "HTTP URL, optional",
+						Usage: "HTTP URL, optional for primary nodes with a websocket URL",
 					},
 					cli.Int64Flag{
 						Name:  "chain-id",
@@ -1037,6 +1239,102 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
+		{
+			Name:  "secrets",
+			Usage: "Commands for managing secrets referenced by job specs and bridges as $(secrets.name).",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the names of all secrets",
+					Action: client.ListSecrets,
+				},
+				{
+					Name:   "set",
+					Usage:  "Add a secret, or replace the value of an existing one",
+					Action: client.SetSecret,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "path to a file containing the secret value",
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a secret",
+					Action: client.DeleteSecret,
+				},
+			},
+		},
+		{
+			Name:  "templates",
+			Usage: "Commands for managing pipeline templates.",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the latest version of all pipeline templates",
+					Action: client.ListPipelineTemplates,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show a pipeline template",
+					Action: client.ShowPipelineTemplate,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "version, v",
+							Usage: "template version, the latest if left empty",
+						},
+					},
+				},
+				{
+					Name:   "versions",
+					Usage:  "List all versions of a pipeline template",
+					Action: client.ListPipelineTemplateVersions,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a pipeline template, or a new version of an existing one",
+					Action: client.CreatePipelineTemplate,
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete all versions of a pipeline template which is not used by any job",
+					Action: client.DeletePipelineTemplate,
+				},
+				{
+					Name:   "plan",
+					Usage:  "Show how rolling jobs forward to a template version would change them",
+					Action: client.PlanPipelineTemplateRollForward,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "version, v",
+							Usage: "template version, the latest if left empty",
+						},
+					},
+				},
+				{
+					Name:   "rollforward",
+					Usage:  "Recreate jobs using another template version with the pipeline rendered from a template version",
+					Action: client.RollPipelineTemplateForward,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "version, v",
+							Usage: "template version, the latest if left empty",
+						},
+						cli.IntSliceFlag{
+							Name:  "job-id",
+							Usage: "only roll the given jobs forward, may be repeated",
+						},
+					},
+				},
+			},
+		},
 	}...)
 	return app
 }
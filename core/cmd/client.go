@@ -36,6 +36,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/versioning"
@@ -100,6 +102,28 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 	appLggr, closeLggr := logger.NewLogger()
 
 	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
+	if u := cfg.EthRemoteSignerURL(); u != nil {
+		remoteSigner, err2 := remotesigner.NewClient(*u, cfg.EthRemoteSignerProtocol(), cfg.EthRemoteSignerTimeout())
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to create remote signer client")
+		}
+		keyStore.Eth().SetRemoteSigner(remoteSigner)
+		appLggr.Infow("Using remote signer for remote eth keys", "url", u.Redacted(), "protocol", cfg.EthRemoteSignerProtocol())
+	}
+
+	var notifier notifications.Notifier
+	if path := cfg.NotificationsConfigPath(); path != "" {
+		notifierCfg, err2 := notifications.LoadConfig(path)
+		if err2 != nil {
+			return nil, err2
+		}
+		notifier, err2 = notifications.NewNotifier(notifierCfg, appLggr)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to create notifier")
+		}
+		logger.SetCriticalHook(notifications.CriticalLogHook(notifier))
+		appLggr.Infow("Notifications are enabled", "path", path, "sinks", len(notifierCfg.Sinks), "rules", len(notifierCfg.Rules))
+	}
 
 	// Set up the versioning ORM
 	verORM := versioning.NewORM(db, appLggr)
@@ -157,6 +181,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		ORM:              evm.NewORM(db, appLggr, cfg),
 		KeyStore:         keyStore.Eth(),
 		EventBroadcaster: eventBroadcaster,
+		Notifier:         notifier,
 	}
 	var chains chainlink.Chains
 	chains.EVM, err = evm.LoadChainSet(ccOpts)
@@ -206,10 +231,10 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 			return nil, errors.Wrap(err, "failed to setup StarkNet nodes")
 		}
 		chains.StarkNet, err = starknet.NewChainSet(starknet.ChainSetOpts{
-			Config: cfg,
-			Logger: starkLggr,
-			DB:     db,
-			//TODO KeyStore:         keyStore.StarkNet(),
+			Config:   cfg,
+			Logger:   starkLggr,
+			DB:       db,
+			KeyStore: keyStore.StarkNet(),
 			//TODO EventBroadcaster: eventBroadcaster,
 			ORM: starknet.NewORM(db, starkLggr, cfg),
 		})
@@ -233,6 +258,7 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		Version:                  static.Version,
 		RestrictedHTTPClient:     restrictedClient,
 		UnrestrictedHTTPClient:   unrestrictedClient,
+		Notifier:                 notifier,
 	})
 }
 
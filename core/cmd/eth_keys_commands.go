@@ -27,13 +27,16 @@ func (p *EthKeyPresenter) ToRow() []string {
 		p.EthBalance.String(),
 		p.LinkBalance.String(),
 		fmt.Sprintf("%v", p.IsFunding),
+		fmt.Sprintf("%v", p.Disabled),
+		strings.Join(p.Labels, ", "),
+		fmt.Sprintf("%v", p.Remote),
 		p.CreatedAt.String(),
 		p.UpdatedAt.String(),
 		p.MaxGasPriceWei.String(),
 	}
 }
 
-var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Created", "Updated", "Max Gas Price Wei"}
+var ethKeysTableHeaders = []string{"Address", "EVM Chain ID", "ETH", "LINK", "Is funding", "Disabled", "Labels", "Remote", "Created", "Updated", "Max Gas Price Wei"}
 
 // RenderTable implements TableRenderer
 func (p *EthKeyPresenter) RenderTable(rt RendererTable) error {
@@ -88,6 +91,9 @@ func (cli *Client) CreateETHKey(c *cli.Context) (err error) {
 	if c.IsSet("maxGasPriceGWei") {
 		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
 	}
+	if c.IsSet("remoteAddress") {
+		query.Set("remoteAddress", c.String("remoteAddress"))
+	}
 
 	createUrl.RawQuery = query.Encode()
 	resp, err := cli.HTTP.Post(createUrl.String(), nil)
@@ -114,10 +120,24 @@ func (cli *Client) UpdateETHKey(c *cli.Context) (err error) {
 		Path: "/v2/keys/eth/" + address,
 	}
 
+	if c.Bool("enable") && c.Bool("disable") {
+		return cli.errorOut(errors.New("Cannot both enable and disable a key"))
+	}
+
 	query := updateUrl.Query()
 	if c.IsSet("maxGasPriceGWei") {
 		query.Set("maxGasPriceGWei", c.String("maxGasPriceGWei"))
-	} else {
+	}
+	if c.Bool("enable") {
+		query.Set("disabled", "false")
+	}
+	if c.Bool("disable") {
+		query.Set("disabled", "true")
+	}
+	if c.IsSet("labels") {
+		query.Set("labels", c.String("labels"))
+	}
+	if len(query) == 0 {
 		return cli.errorOut(errors.New("Must pass at least one parameter to update"))
 	}
 
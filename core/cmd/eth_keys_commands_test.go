@@ -32,6 +32,7 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 		ethBalance     = assets.NewEth(1)
 		linkBalance    = assets.NewLinkFromJuels(2)
 		isFunding      = true
+		labels         = []string{"keeper", "ocr"}
 		createdAt      = time.Now()
 		updatedAt      = time.Now().Add(time.Second)
 		maxGasPriceWei = utils.NewBigI(12345)
@@ -47,6 +48,8 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 			EthBalance:     ethBalance,
 			LinkBalance:    linkBalance,
 			IsFunding:      isFunding,
+			Disabled:       true,
+			Labels:         labels,
 			CreatedAt:      createdAt,
 			UpdatedAt:      updatedAt,
 			MaxGasPriceWei: *maxGasPriceWei,
@@ -61,6 +64,7 @@ func TestEthKeysPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, ethBalance.String())
 	assert.Contains(t, output, linkBalance.String())
 	assert.Contains(t, output, strconv.FormatBool(isFunding))
+	assert.Contains(t, output, "keeper, ocr")
 	assert.Contains(t, output, createdAt.String())
 	assert.Contains(t, output, updatedAt.String())
 	assert.Contains(t, output, maxGasPriceWei.String())
@@ -196,6 +200,63 @@ func TestClient_UpdateETHKey(t *testing.T) {
 	require.Equal(t, assets.GWei(12345), price)
 }
 
+func TestClient_UpdateETHKey_DisabledAndLabels(t *testing.T) {
+	t.Parallel()
+
+	ethClient := newEthMock(t)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(42), nil)
+	ethClient.On("GetLINKBalance", mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+	app := startNewApplication(t,
+		withKey(),
+		withMocks(ethClient),
+		withConfigSet(func(c *configtest.TestGeneralConfig) {
+			c.Overrides.EVMEnabled = null.BoolFrom(true)
+			c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+			c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		}),
+	)
+	ethKeyStore := app.GetKeyStore().Eth()
+	client, _ := app.NewClientAndRenderer()
+
+	key, err := ethKeyStore.Create(&cltest.FixtureChainID)
+	require.NoError(t, err)
+
+	// Disable the key and label it
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("disable", false, "")
+	set.String("labels", "", "")
+	set.Set("disable", "true")
+	set.Set("labels", "ocr,keeper")
+	set.Parse([]string{key.Address.Hex()})
+	require.NoError(t, client.UpdateETHKey(cli.NewContext(nil, set, nil)))
+
+	state, err := ethKeyStore.GetState(key.ID())
+	require.NoError(t, err)
+	assert.True(t, state.Disabled)
+	assert.Equal(t, []string{"keeper", "ocr"}, []string(state.Labels))
+
+	// Enable the key again
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("enable", false, "")
+	set.Set("enable", "true")
+	set.Parse([]string{key.Address.Hex()})
+	require.NoError(t, client.UpdateETHKey(cli.NewContext(nil, set, nil)))
+
+	state, err = ethKeyStore.GetState(key.ID())
+	require.NoError(t, err)
+	assert.False(t, state.Disabled)
+	assert.Equal(t, []string{"keeper", "ocr"}, []string(state.Labels))
+
+	// Enabling and disabling at once is an error
+	set = flag.NewFlagSet("test", 0)
+	set.Bool("enable", false, "")
+	set.Bool("disable", false, "")
+	set.Set("enable", "true")
+	set.Set("disable", "true")
+	set.Parse([]string{key.Address.Hex()})
+	require.Error(t, client.UpdateETHKey(cli.NewContext(nil, set, nil)))
+}
+
 func TestClient_DeleteETHKey(t *testing.T) {
 	t.Parallel()
 
@@ -78,8 +78,8 @@ func NewEVMNodeClient(c *Client) NodeClient {
 			err = errors.New("invalid or unspecified --type, must be either primary or sendonly")
 			return
 		}
-		if t == "primary" && ws == "" {
-			err = errors.New("missing --ws-url")
+		if t == "primary" && ws == "" && httpURLStr == "" {
+			err = errors.New("missing --ws-url or --http-url")
 			return
 		}
 		var httpURL = null.NewString(httpURLStr, true)
@@ -91,6 +91,16 @@ func TestClient_CreateEVMNode(t *testing.T) {
 	err = cmd.NewEVMNodeClient(client).CreateNode(c)
 	require.NoError(t, err)
 
+	// successful HTTP only primary
+	set = flag.NewFlagSet("cli", 0)
+	set.String("name", "HTTP only", "")
+	set.String("type", "primary", "")
+	set.String("http-url", "http://TestClient_CreateEVMNode4.invalid", "")
+	set.Int64("chain-id", chain.ID.ToInt().Int64(), "")
+	c = cli.NewContext(nil, set, nil)
+	err = cmd.NewEVMNodeClient(client).CreateNode(c)
+	require.NoError(t, err)
+
 	// successful send-only
 	set = flag.NewFlagSet("cli", 0)
 	set.String("name", "Send only", "")
@@ -103,7 +113,7 @@ func TestClient_CreateEVMNode(t *testing.T) {
 
 	nodes, _, err := orm.Nodes(0, 25)
 	require.NoError(t, err)
-	require.Len(t, nodes, initialNodesCount+2)
+	require.Len(t, nodes, initialNodesCount+3)
 	n := nodes[initialNodesCount]
 	assert.Equal(t, "Example", n.Name)
 	assert.Equal(t, false, n.SendOnly)
@@ -111,6 +121,12 @@ func TestClient_CreateEVMNode(t *testing.T) {
 	assert.Equal(t, null.StringFrom("http://TestClient_CreateEVMNode2.invalid"), n.HTTPURL)
 	assert.Equal(t, chain.ID, n.EVMChainID)
 	n = nodes[initialNodesCount+1]
+	assert.Equal(t, "HTTP only", n.Name)
+	assert.Equal(t, false, n.SendOnly)
+	assert.Equal(t, null.String{}, n.WSURL)
+	assert.Equal(t, null.StringFrom("http://TestClient_CreateEVMNode4.invalid"), n.HTTPURL)
+	assert.Equal(t, chain.ID, n.EVMChainID)
+	n = nodes[initialNodesCount+2]
 	assert.Equal(t, "Send only", n.Name)
 	assert.Equal(t, true, n.SendOnly)
 	assert.Equal(t, null.String{}, n.WSURL)
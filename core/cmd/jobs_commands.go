@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -46,11 +50,20 @@ func (p JobPresenter) toRow(task string) []string {
 		p.GetID(),
 		p.Name,
 		p.Type.String(),
+		p.FriendlyStatus(),
 		task,
 		p.FriendlyCreatedAt(),
 	}
 }
 
+// FriendlyStatus returns whether the job is active or paused
+func (p JobPresenter) FriendlyStatus() string {
+	if p.PausedAt.Valid {
+		return "paused"
+	}
+	return "active"
+}
+
 // GetTasks extracts the tasks from the dependency graph
 func (p JobPresenter) GetTasks() ([]string, error) {
 	types := []string{}
@@ -127,7 +140,7 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 
 // RenderTable implements TableRenderer
 func (p *JobPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Status", "Tasks", "Created At"})
 	table.SetAutoMergeCells(true)
 	for _, r := range p.ToRows() {
 		table.Append(r)
@@ -141,7 +154,7 @@ type JobPresenters []JobPresenter
 
 // RenderTable implements TableRenderer
 func (ps JobPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Status", "Tasks", "Created At"})
 	table.SetAutoMergeCells(true)
 	for _, p := range ps {
 		for _, r := range p.ToRows() {
@@ -220,6 +233,177 @@ func (cli *Client) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// UpdateJob replaces the spec of a job
+// Valid input is the job ID and a TOML string or a path to TOML file
+func (cli *Client) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Put("/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// JobSpecVersionPresenter wraps the JSONAPI Job Spec Version Resource
+type JobSpecVersionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobSpecVersionResource
+}
+
+var jobSpecVersionHeaders = []string{"Version", "Created At"}
+
+// ToRow presents the JobSpecVersionResource as a slice of strings.
+func (p *JobSpecVersionPresenter) ToRow() []string {
+	return []string{
+		strconv.Itoa(int(p.Version)),
+		p.CreatedAt.String(),
+	}
+}
+
+// JobSpecVersionPresenters implements TableRenderer for a slice of
+// JobSpecVersionPresenter.
+type JobSpecVersionPresenters []JobSpecVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(jobSpecVersionHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// JobSpecDiffPresenter wraps the JSONAPI Job Spec Diff Resource
+type JobSpecDiffPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobSpecDiffResource
+}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecDiffPresenter) RenderTable(rt RendererTable) error {
+	_, err := fmt.Fprint(rt.Writer, p.Diff)
+	return err
+}
+
+// ListJobSpecVersions lists the recorded versions of the spec of a job
+func (cli *Client) ListJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// DiffJobSpecVersions shows the diff between two versions of the spec of a
+// job, by default between the latest version and the one before it.
+func (cli *Client) DiffJobSpecVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the job"))
+	}
+	query := url.Values{}
+	if c.IsSet("from") {
+		query.Set("from", strconv.Itoa(c.Int("from")))
+	}
+	if c.IsSet("to") {
+		query.Set("to", strconv.Itoa(c.Int("to")))
+	}
+	path := "/v2/jobs/" + c.Args().First() + "/diff"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := cli.HTTP.Get(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSpecDiffPresenter{})
+}
+
+// RollbackJob reapplies a previous version of the spec of a job
+func (cli *Client) RollbackJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the job"))
+	}
+	if !c.IsSet("version") {
+		return cli.errorOut(errors.New("must provide the version to roll back to"))
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/jobs/%s/versions/%d/rollback", c.Args().First(), c.Int("version")), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job rolled back")
+}
+
+// PauseJob stops the services of a job without deleting it
+func (cli *Client) PauseJob(c *cli.Context) (err error) {
+	return cli.setJobPaused(c, "pause", "Job paused")
+}
+
+// ResumeJob restarts the services of a paused job
+func (cli *Client) ResumeJob(c *cli.Context) (err error) {
+	return cli.setJobPaused(c, "resume", "Job resumed")
+}
+
+func (cli *Client) setJobPaused(c *cli.Context, action string, headers ...string) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the job"))
+	}
+	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/jobs/%s/%s", c.Args().First(), action), nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, headers...)
+}
+
 // DeleteJob deletes a job
 func (cli *Client) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -257,3 +441,149 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// RerunPipelineRun executes a finished pipeline run again with the same inputs
+func (cli *Client) RerunPipelineRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must provide the id of the run"))
+	}
+	request, err := json.Marshal(web.RerunPipelineRunRequest{
+		OriginalSpec:       c.Bool("original-spec"),
+		ConfirmSideEffects: c.Bool("yes"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/pipeline/runs/"+c.Args().First()+"/rerun", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	return cli.renderAPIResponse(resp, &run, "Pipeline run successfully rerun")
+}
+
+// PipelineRunPresenter wraps the JSONAPI Pipeline Run Resource
+type PipelineRunPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunResource
+}
+
+var pipelineRunHeaders = []string{"ID", "Job ID", "State", "Created At", "Finished At", "Errors"}
+
+// ToRow presents the PipelineRunResource as a slice of strings.
+func (p *PipelineRunPresenter) ToRow() []string {
+	var finishedAt string
+	if p.FinishedAt.Valid {
+		finishedAt = p.FinishedAt.Time.Format(time.RFC3339)
+	}
+	var errs []string
+	for _, err := range p.AllErrors {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+	return []string{
+		p.GetID(),
+		strconv.Itoa(int(p.PipelineSpec.JobID)),
+		string(p.State),
+		p.CreatedAt.Format(time.RFC3339),
+		finishedAt,
+		strings.Join(errs, "; "),
+	}
+}
+
+// PipelineRunPresenters implements TableRenderer for a slice of
+// PipelineRunPresenter.
+type PipelineRunPresenters []PipelineRunPresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineRunPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(pipelineRunHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// pipelineRunsFilterKeys maps the keys of --filter to the query params of
+// the pipeline runs endpoint.
+var pipelineRunsFilterKeys = map[string]string{
+	"job":      "jobID",
+	"state":    "state",
+	"after":    "createdAfter",
+	"before":   "createdBefore",
+	"taskType": "taskType",
+	"taskName": "taskName",
+	"error":    "error",
+}
+
+// ListPipelineRuns lists the runs of a job, or of all jobs, matching the
+// filters
+func (cli *Client) ListPipelineRuns(c *cli.Context) (err error) {
+	query := url.Values{}
+	for _, filter := range c.StringSlice("filter") {
+		key, value, ok := strings.Cut(filter, "=")
+		if !ok {
+			return cli.errorOut(errors.Errorf("invalid filter %q, must be key=value", filter))
+		}
+		if key == "since" {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return cli.errorOut(errors.Wrapf(err, "invalid filter %q", filter))
+			}
+			query.Set("createdAfter", time.Now().Add(-d).UTC().Format(time.RFC3339))
+			continue
+		}
+		param, ok := pipelineRunsFilterKeys[key]
+		if !ok {
+			return cli.errorOut(errors.Errorf("invalid filter %q, key must be one of job, state, since, after, before, taskType, taskName or error", filter))
+		}
+		if param == "state" && query.Has(param) {
+			value = query.Get(param) + "," + value
+		}
+		query.Set(param, value)
+	}
+	query.Set("sort", c.String("sort"))
+	query.Set("size", strconv.Itoa(c.Int("limit")))
+	if cursor := c.String("cursor"); cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	path := "/v2/pipeline/runs"
+	if c.Args().Present() {
+		path = "/v2/jobs/" + c.Args().First() + "/runs"
+	}
+	resp, err := cli.HTTP.Get(path + "?" + query.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var runs PipelineRunPresenters
+	var links jsonapi.Links
+	if err = cli.deserializeAPIResponse(resp, &runs, &links); err != nil {
+		return err
+	}
+	if err = cli.Render(&runs); err != nil {
+		return cli.errorOut(err)
+	}
+	if next, ok := links[web.KeyNextLink]; ok {
+		if u, perr := url.Parse(next.Href); perr == nil {
+			fmt.Printf("\nMore runs available, use --cursor %s to see the next page\n", u.Query().Get("cursor"))
+		}
+	}
+	return nil
+}
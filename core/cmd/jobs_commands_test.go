@@ -80,6 +80,7 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, "ds1 http")
 	assert.Contains(t, output, "ds1_parse jsonparse")
 	assert.Contains(t, output, "ds1_multiply multiply")
+	assert.Contains(t, output, "active")
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
@@ -270,15 +271,20 @@ func TestJob_ToRows(t *testing.T) {
 	}
 
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "ds1 http", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_parse jsonparse", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_multiply multiply", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1 http", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1_parse jsonparse", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "ds1_multiply multiply", now.Format(time.RFC3339)},
 	}, job.ToRows())
 
 	// Produce a single row even if there is not DAG
 	job.PipelineSpec.DotDAGSource = ""
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "active", "", now.Format(time.RFC3339)},
+	}, job.ToRows())
+
+	job.PausedAt = null.TimeFrom(now)
+	assert.Equal(t, [][]string{
+		{"1", "Test Job", "directrequest", "paused", "", now.Format(time.RFC3339)},
 	}, job.ToRows())
 }
 
@@ -405,3 +411,115 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestClient_UpdateJob_Rollback(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply job id and spec
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.Equal(t, "must pass the job id and TOML or filepath", client.UpdateJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID, "../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.UpdateJob(cli.NewContext(nil, set, nil)))
+	updated := *r.Renders[1].(*cmd.JobPresenter)
+	assert.Equal(t, output.ID, updated.ID)
+	requireJobsCount(t, app.JobORM(), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.NoError(t, client.ListJobSpecVersions(cli.NewContext(nil, set, nil)))
+	versions := *r.Renders[2].(*cmd.JobSpecVersionPresenters)
+	require.Len(t, versions, 2)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Int("version", 0, "")
+	set.Parse([]string{"--version", "1", output.ID})
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+
+	jobs, _, err := app.JobORM().FindJobs(0, 1000)
+	require.NoError(t, err)
+	specVersions, err := app.JobORM().FindSpecVersions(jobs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, specVersions, 3)
+}
+
+func TestClient_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.SetTriggerFallbackDBPollInterval(100 * time.Millisecond)
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+		c.Overrides.GlobalEvmNonceAutoSync = null.BoolFrom(false)
+		c.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+		c.Overrides.GlobalGasEstimatorMode = null.StringFrom("FixedPrice")
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	output := *r.Renders[0].(*cmd.JobPresenter)
+	assert.Equal(t, "active", output.FriendlyStatus())
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	require.Equal(t, "must provide the id of the job", client.PauseJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.NoError(t, client.PauseJob(cli.NewContext(nil, set, nil)))
+	paused := *r.Renders[1].(*cmd.JobPresenter)
+	assert.Equal(t, output.ID, paused.ID)
+	assert.Equal(t, "paused", paused.FriendlyStatus())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{output.ID})
+	require.NoError(t, client.ResumeJob(cli.NewContext(nil, set, nil)))
+	resumed := *r.Renders[2].(*cmd.JobPresenter)
+	assert.Equal(t, "active", resumed.FriendlyStatus())
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
+func TestClient_ListPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	newFlagSet := func(args ...string) *flag.FlagSet {
+		set := flag.NewFlagSet("test", 0)
+		set.Var(&cli.StringSlice{}, "filter", "")
+		set.String("sort", "desc", "")
+		set.Int("limit", 25, "")
+		set.String("cursor", "", "")
+		require.NoError(t, set.Parse(args))
+		return set
+	}
+
+	set := newFlagSet("--filter", "state=errored", "--filter", "since=1h", "--filter", "taskName=ds1", "--filter", "error=timeout")
+	require.NoError(t, client.ListPipelineRuns(cli.NewContext(nil, set, nil)))
+	runs := *r.Renders[0].(*cmd.PipelineRunPresenters)
+	assert.Empty(t, runs)
+
+	set = newFlagSet("--filter", "state")
+	assert.EqualError(t, client.ListPipelineRuns(cli.NewContext(nil, set, nil)), `invalid filter "state", must be key=value`)
+
+	set = newFlagSet("--filter", "status=errored")
+	assert.EqualError(t, client.ListPipelineRuns(cli.NewContext(nil, set, nil)), `invalid filter "status=errored", key must be one of job, state, since, after, before, taskType, taskName or error`)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
@@ -127,6 +128,24 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	// From now on, DB locks and DB connection will be released on every return.
 	// Keep watching on logger.Fatal* calls and os.Exit(), because defer will not be executed.
 
+	// In HA mode the node does not hold the DB locks yet. A standby must never
+	// migrate the database underneath the leader, so if migrations are pending it
+	// waits for leadership before building the application (cold standby).
+	leading := !cli.Config.DatabaseHAEnabled()
+	if !leading {
+		pending, err2 := migrate.Pending(ldb.DB().DB, lggr)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to check for pending migrations")
+		}
+		if pending {
+			lggr.Warn("Database migrations are pending, waiting for leadership before migrating the database")
+			if err2 = ldb.AwaitLeadership(rootCtx); err2 != nil {
+				return errors.Wrap(err2, "failed to acquire leadership")
+			}
+			leading = true
+		}
+	}
+
 	app, err := cli.AppFactory.NewApplication(cli.Config, ldb.DB())
 	if err != nil {
 		return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
@@ -157,72 +176,77 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		}
 	}
 
-	evmChainSet := app.GetChains().EVM
-	// By passing in a function we can be lazy trying to look up a default
-	// chain - if there are no existing keys, there is no need to check for
-	// a chain ID
-	DefaultEVMChainIDFunc := func() (*big.Int, error) {
-		def, err2 := evmChainSet.Default()
-		if err2 != nil {
-			return nil, errors.Wrap(err2, "cannot get default EVM chain ID; no default EVM chain available")
+	// ensureKeys migrates the keystore and creates any missing keys. This writes
+	// to the database, so in HA mode it only runs once the node is the leader.
+	ensureKeys := func() error {
+		evmChainSet := app.GetChains().EVM
+		// By passing in a function we can be lazy trying to look up a default
+		// chain - if there are no existing keys, there is no need to check for
+		// a chain ID
+		DefaultEVMChainIDFunc := func() (*big.Int, error) {
+			def, err2 := evmChainSet.Default()
+			if err2 != nil {
+				return nil, errors.Wrap(err2, "cannot get default EVM chain ID; no default EVM chain available")
+			}
+			return def.ID(), nil
 		}
-		return def.ID(), nil
-	}
-	err = keyStore.Migrate(vrfpwd, DefaultEVMChainIDFunc)
+		err = keyStore.Migrate(vrfpwd, DefaultEVMChainIDFunc)
 
-	if cli.Config.EVMEnabled() {
-		if err != nil {
-			return errors.Wrap(err, "error migrating keystore")
+		if cli.Config.EVMEnabled() {
+			if err != nil {
+				return errors.Wrap(err, "error migrating keystore")
+			}
+
+			for _, ch := range evmChainSet.Chains() {
+				err2 := app.GetKeyStore().Eth().EnsureKeys(ch.ID())
+				if err2 != nil {
+					return errors.Wrap(err2, "failed to ensure keystore keys")
+				}
+			}
 		}
 
-		for _, ch := range evmChainSet.Chains() {
-			err2 := app.GetKeyStore().Eth().EnsureKeys(ch.ID())
+		if cli.Config.FeatureOffchainReporting() {
+			err2 := app.GetKeyStore().OCR().EnsureKey()
 			if err2 != nil {
-				return errors.Wrap(err2, "failed to ensure keystore keys")
+				return errors.Wrap(err2, "failed to ensure ocr key")
 			}
 		}
-	}
-
-	if cli.Config.FeatureOffchainReporting() {
-		err2 := app.GetKeyStore().OCR().EnsureKey()
-		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure ocr key")
+		if cli.Config.FeatureOffchainReporting2() {
+			err2 := app.GetKeyStore().OCR2().EnsureKeys()
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure ocr key")
+			}
 		}
-	}
-	if cli.Config.FeatureOffchainReporting2() {
-		err2 := app.GetKeyStore().OCR2().EnsureKeys()
-		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure ocr key")
+		if cli.Config.P2PEnabled() {
+			err2 := app.GetKeyStore().P2P().EnsureKey()
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure p2p key")
+			}
 		}
-	}
-	if cli.Config.P2PEnabled() {
-		err2 := app.GetKeyStore().P2P().EnsureKey()
-		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure p2p key")
+		if cli.Config.SolanaEnabled() {
+			err2 := app.GetKeyStore().Solana().EnsureKey()
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure solana key")
+			}
 		}
-	}
-	if cli.Config.SolanaEnabled() {
-		err2 := app.GetKeyStore().Solana().EnsureKey()
-		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure solana key")
+		if cli.Config.TerraEnabled() {
+			err2 := app.GetKeyStore().Terra().EnsureKey()
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure terra key")
+			}
 		}
-	}
-	if cli.Config.TerraEnabled() {
-		err2 := app.GetKeyStore().Terra().EnsureKey()
-		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure terra key")
+		if cli.Config.StarkNetEnabled() {
+			err2 := app.GetKeyStore().StarkNet().EnsureKey()
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to ensure starknet key")
+			}
 		}
-	}
-	if cli.Config.StarkNetEnabled() {
-		err2 := app.GetKeyStore().StarkNet().EnsureKey()
+
+		err2 := app.GetKeyStore().CSA().EnsureKey()
 		if err2 != nil {
-			return errors.Wrap(err2, "failed to ensure starknet key")
+			return errors.Wrap(err2, "failed to ensure CSA key")
 		}
-	}
-
-	err2 := app.GetKeyStore().CSA().EnsureKey()
-	if err2 != nil {
-		return errors.Wrap(err2, "failed to ensure CSA key")
+		return nil
 	}
 
 	if e := checkFilePermissions(lggr, cli.Config.RootDir()); e != nil {
@@ -242,6 +266,57 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 
 	lggr.Info("API exposed for user ", user.Email)
 
+	grp, grpCtx := errgroup.WithContext(rootCtx)
+
+	serving := false
+	serve := func() {
+		serving = true
+		grp.Go(func() error {
+			errInternal := cli.Runner.Run(grpCtx, app)
+			if errors.Is(errInternal, http.ErrServerClosed) {
+				errInternal = nil
+			}
+			// In tests we have custom runners that stop the app gracefully,
+			// therefore we need to cancel rootCtx when the Runner has quit.
+			cancelRootCtx()
+			return errInternal
+		})
+	}
+
+	ha := app.GetHAStatus()
+	if !leading {
+		// Standby: warm up and serve the API, so that the role of the node is
+		// visible from the outside, then take over once the lease lapses.
+		if err = ha.SetState(pg.HAStateStandby); err != nil {
+			lggr.Errorw("Failed to record leadership transition", "err", err)
+		}
+		if err = app.WarmUp(grpCtx); err != nil {
+			return errors.Wrap(err, "error warming up app")
+		}
+		lggr.Infow(fmt.Sprintf("Chainlink is on standby, booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
+		serve()
+
+		if err = ldb.AwaitLeadership(grpCtx); err != nil {
+			cancelRootCtx()
+			if errInternal := grp.Wait(); errInternal != nil {
+				return errInternal
+			}
+			if rootCtx.Err() != nil {
+				// Shutdown was requested while on standby
+				return nil
+			}
+			return errors.Wrap(err, "failed to acquire leadership")
+		}
+		lggr.Info("Acquired database lease, taking over as leader")
+	}
+	if err = ha.SetState(pg.HAStateLeader); err != nil {
+		lggr.Errorw("Failed to record leadership transition", "err", err)
+	}
+
+	if err = ensureKeys(); err != nil {
+		return err
+	}
+
 	if err = app.Start(rootCtx); err != nil {
 		// We do not try stopping any sub-services that might be started,
 		// because the app will exit immediately upon return.
@@ -249,13 +324,18 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		return errors.Wrap(err, "error starting app")
 	}
 
-	grp, grpCtx := errgroup.WithContext(rootCtx)
-
 	grp.Go(func() error {
 		<-grpCtx.Done()
 		if errInternal := app.Stop(); errInternal != nil {
 			return errors.Wrap(errInternal, "error stopping app")
 		}
+		// The lease is released when the DB is closed, record that this node
+		// stepped down so that the transition history has no gaps
+		if ha.Enabled() {
+			if errInternal := ha.SetState(pg.HAStateStandby); errInternal != nil {
+				lggr.Errorw("Failed to record leadership transition", "err", errInternal)
+			}
+		}
 		return nil
 	})
 
@@ -263,16 +343,9 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
 
-	grp.Go(func() error {
-		errInternal := cli.Runner.Run(grpCtx, app)
-		if errors.Is(errInternal, http.ErrServerClosed) {
-			errInternal = nil
-		}
-		// In tests we have custom runners that stop the app gracefully,
-		// therefore we need to cancel rootCtx when the Runner has quit.
-		cancelRootCtx()
-		return errInternal
-	})
+	if !serving {
+		serve()
+	}
 
 	return grp.Wait()
 }
@@ -613,6 +686,35 @@ func (cli *Client) VersionDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// RestoreDatabase verifies a backup and restores it into an empty database.
+func (cli *Client) RestoreDatabase(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("You must specify the path of a backup manifest"))
+	}
+	passphrase := cli.Config.DatabaseBackupEncryptionKey()
+	if c.IsSet("password") {
+		password, err := passwordFromFile(c.String("password"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to read password from file"))
+		}
+		passphrase = password
+	}
+	target := cli.Config.DatabaseURL()
+	if c.IsSet("target") {
+		parsed, err := url.Parse(c.String("target"))
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid target database URL"))
+		}
+		target = *parsed
+	}
+
+	if err := periodicbackup.RestoreBackup(context.Background(), cli.Logger, c.Args().First(), passphrase, target); err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to restore backup"))
+	}
+	cli.Logger.Infow("Backup restored", "target", target.Redacted())
+	return nil
+}
+
 // StatusDatabase displays the database migration status
 func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	db, err := newConnection(cli.Config, cli.Logger)
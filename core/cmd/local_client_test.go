@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -68,6 +69,7 @@ func TestClient_RunNodeShowsEnv(t *testing.T) {
 	app.On("Start", mock.Anything).Return(nil)
 	app.On("Stop").Return(nil)
 	app.On("ID").Return(uuid.NewV4())
+	app.On("GetHAStatus").Return(pg.NewHAStatus(db, uuid.NewV4(), false, lggr, cfg))
 
 	var logFileSize utils.FileSize
 	err = logFileSize.UnmarshalText([]byte("100mb"))
@@ -122,9 +124,13 @@ BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE: 0
 BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE: 0
 BRIDGE_RESPONSE_URL: 
 CHAIN_TYPE: 
+DATABASE_BACKUP_ENCRYPTED: true
 DATABASE_BACKUP_FREQUENCY: 1h0m0s
 DATABASE_BACKUP_MODE: none
 DATABASE_BACKUP_ON_VERSION_UPGRADE: true
+DATABASE_BACKUP_RETENTION_COUNT: 10
+DATABASE_BACKUP_RETENTION_MAX_AGE: 0s
+DATABASE_HA_ENABLED: false
 DATABASE_LOCKING_MODE: dual
 ETH_CHAIN_ID: <nil>
 DEFAULT_HTTP_LIMIT: 32768
@@ -132,6 +138,10 @@ DEFAULT_HTTP_TIMEOUT: 15s
 CHAINLINK_DEV: false
 SHUTDOWN_GRACE_PERIOD: 5s
 EVM_RPC_ENABLED: true
+ETH_REMOTE_SIGNER_PROTOCOL: web3signer
+ETH_REMOTE_SIGNER_TIMEOUT: 10s
+ETH_REMOTE_SIGNER_URL: 
+ETH_TX_STUCK_THRESHOLD: 50
 ETH_HTTP_URL: 
 ETH_SECONDARY_URLS: []
 ETH_URL: 
@@ -166,6 +176,9 @@ LOG_SQL: false
 LOG_FILE_MAX_SIZE: 5.12gb
 LOG_FILE_MAX_AGE: 0
 LOG_FILE_MAX_BACKUPS: 1
+LOG_SAMPLING_DEBUG_FIRST: 0
+LOG_SAMPLING_DEBUG_THEREAFTER: 100
+NOTIFICATIONS_CONFIG_PATH: 
 TRIGGER_FALLBACK_DB_POLL_INTERVAL: 30s
 OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT: 
 OCR_DATABASE_TIMEOUT: 
@@ -236,6 +249,7 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 			app.On("Start", mock.Anything).Maybe().Return(nil)
 			app.On("Stop").Maybe().Return(nil)
 			app.On("ID").Maybe().Return(uuid.NewV4())
+			app.On("GetHAStatus").Maybe().Return(pg.NewHAStatus(db, uuid.NewV4(), false, logger.TestLogger(t), cfg))
 
 			ethClient := evmtest.NewEthClientMock(t)
 			ethClient.On("Dial", mock.Anything).Return(nil)
@@ -288,6 +302,7 @@ func TestClient_RunNode_CreateFundingKeyIfNotExists(t *testing.T) {
 	app.On("Start", mock.Anything).Maybe().Return(nil)
 	app.On("Stop").Maybe().Return(nil)
 	app.On("ID").Maybe().Return(uuid.NewV4())
+	app.On("GetHAStatus").Maybe().Return(pg.NewHAStatus(db, uuid.NewV4(), false, lggr, cfg))
 
 	ethClient := evmtest.NewEthClientMock(t)
 	ethClient.On("Dial", mock.Anything).Return(nil)
@@ -357,6 +372,7 @@ func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 			app.On("Start", mock.Anything).Maybe().Return(nil)
 			app.On("Stop").Maybe().Return(nil)
 			app.On("ID").Maybe().Return(uuid.NewV4())
+			app.On("GetHAStatus").Maybe().Return(pg.NewHAStatus(db, uuid.NewV4(), false, logger.TestLogger(t), cfg))
 
 			prompter := new(cmdMocks.Prompter)
 			prompter.On("IsTerminal").Return(false).Once().Maybe()
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type ServiceLogLevelPresenter struct {
+	presenters.ServiceLogLevelResource
+}
+
+// ToRow presents the ServiceLogLevelResource as a slice of strings.
+func (p *ServiceLogLevelPresenter) ToRow() []string {
+	expires := ""
+	if p.Expires != nil {
+		expires = p.Expires.Format(time.RFC3339)
+	}
+	return []string{p.ID, p.Level, expires}
+}
+
+var serviceLogLevelHeaders = []string{"Service", "Level", "Expires"}
+
+// RenderTable implements TableRenderer
+func (p *ServiceLogLevelPresenter) RenderTable(rt RendererTable) error {
+	renderList(serviceLogLevelHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+type ServiceLogLevelPresenters []ServiceLogLevelPresenter
+
+// RenderTable implements TableRenderer
+func (ps ServiceLogLevelPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(serviceLogLevelHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListServiceLogLevels lists the runtime log levels of named loggers.
+func (cli *Client) ListServiceLogLevels(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/log/services")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ServiceLogLevelPresenters{})
+}
+
+// setServiceLogLevel sets the runtime log level of the named logger service, and its descendants.
+func (cli *Client) setServiceLogLevel(service, level string, expires time.Duration) (err error) {
+	request := web.ServiceLogLevelRequest{Level: level}
+	if expires > 0 {
+		request.ExpiresIn = expires.String()
+	}
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Put("/v2/log/services/"+url.PathEscape(service), bytes.NewBuffer(requestData))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ServiceLogLevelPresenter{})
+}
+
+// UnsetServiceLogLevel removes the runtime log level of a named logger, which reverts to the global log level.
+func (cli *Client) UnsetServiceLogLevel(c *cli.Context) (err error) {
+	service := c.String("service")
+	if service == "" {
+		return cli.errorOut(errors.New("must pass the logger name [--service]"))
+	}
+
+	resp, err := cli.HTTP.Delete("/v2/log/services/" + url.PathEscape(service))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Log level of %s unset\n", service)
+	return nil
+}
@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type PipelineTemplatePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineTemplateResource
+}
+
+var pipelineTemplateHeaders = []string{"ID", "Name", "Version", "Params", "Description", "Created At"}
+
+// ToRow presents the PipelineTemplateResource as a slice of strings.
+func (p *PipelineTemplatePresenter) ToRow() []string {
+	var params []string
+	for _, param := range p.Params {
+		s := fmt.Sprintf("%s %s", param.Name, param.Type)
+		if param.Default != nil {
+			s += fmt.Sprintf(" = %q", *param.Default)
+		}
+		params = append(params, s)
+	}
+	return []string{
+		p.GetID(),
+		p.Name,
+		strconv.Itoa(int(p.Version)),
+		strings.Join(params, "\n"),
+		p.Description,
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineTemplatePresenter) RenderTable(rt RendererTable) error {
+	renderList(pipelineTemplateHeaders, [][]string{p.ToRow()}, rt.Writer)
+	_, err := fmt.Fprintf(rt.Writer, "\n%s\n", p.Source)
+	return err
+}
+
+// PipelineTemplatePresenters implements TableRenderer for a slice of
+// PipelineTemplatePresenter.
+type PipelineTemplatePresenters []PipelineTemplatePresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineTemplatePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(pipelineTemplateHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+type PipelineTemplateJobChangePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineTemplateJobChangeResource
+}
+
+var pipelineTemplateJobChangeHeaders = []string{"Job ID", "Job Name", "From Version", "To Version", "Rolled Forward", "Error"}
+
+// ToRow presents the PipelineTemplateJobChangeResource as a slice of strings.
+func (p *PipelineTemplateJobChangePresenter) ToRow() []string {
+	return []string{
+		strconv.Itoa(int(p.JobID)),
+		p.JobName,
+		strconv.Itoa(int(p.FromVersion)),
+		strconv.Itoa(int(p.ToVersion)),
+		strconv.FormatBool(p.RolledForward),
+		p.Error,
+	}
+}
+
+// PipelineTemplateJobChangePresenters implements TableRenderer for a slice of
+// PipelineTemplateJobChangePresenter.
+type PipelineTemplateJobChangePresenters []PipelineTemplateJobChangePresenter
+
+// RenderTable implements TableRenderer
+func (ps PipelineTemplateJobChangePresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(pipelineTemplateJobChangeHeaders, rows, rt.Writer)
+
+	for _, p := range ps {
+		if p.Diff == "" {
+			continue
+		}
+		if _, err := fmt.Fprintf(rt.Writer, "\n%s", p.Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pipelineTemplatePath(name string, version int, suffix string) string {
+	path := "/v2/templates/" + url.PathEscape(name) + suffix
+	if version > 0 {
+		path += "?version=" + strconv.Itoa(version)
+	}
+	return path
+}
+
+// ListPipelineTemplates lists the latest version of all pipeline templates.
+func (cli *Client) ListPipelineTemplates(c *cli.Context) (err error) {
+	return cli.getPage("/v2/templates", c.Int("page"), &PipelineTemplatePresenters{})
+}
+
+// ShowPipelineTemplate shows a version of a pipeline template, the latest
+// unless --version is given.
+func (cli *Client) ShowPipelineTemplate(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+	resp, err := cli.HTTP.Get(pipelineTemplatePath(c.Args().First(), c.Int("version"), ""))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplatePresenter{})
+}
+
+// ListPipelineTemplateVersions lists all versions of a pipeline template.
+func (cli *Client) ListPipelineTemplateVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+	resp, err := cli.HTTP.Get(pipelineTemplatePath(c.Args().First(), 0, "/versions"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplatePresenters{})
+}
+
+// CreatePipelineTemplate creates a pipeline template, or a new version of an
+// existing one.
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) CreatePipelineTemplate(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.CreatePipelineTemplateRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/templates", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplatePresenter{}, "Pipeline template created")
+}
+
+// DeletePipelineTemplate deletes all versions of a pipeline template.
+func (cli *Client) DeletePipelineTemplate(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+	resp, err := cli.HTTP.Delete(pipelineTemplatePath(c.Args().First(), 0, ""))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if _, err = cli.parseResponse(resp); err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Pipeline template %v deleted\n", c.Args().First())
+	return nil
+}
+
+// PlanPipelineTemplateRollForward shows how rolling jobs forward to a version
+// of a pipeline template would change them.
+func (cli *Client) PlanPipelineTemplateRollForward(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+	resp, err := cli.HTTP.Get(pipelineTemplatePath(c.Args().First(), c.Int("version"), "/rollforward"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplateJobChangePresenters{}, "Planned job changes")
+}
+
+// RollPipelineTemplateForward recreates jobs using another version of a
+// pipeline template with the pipeline rendered from the requested version.
+func (cli *Client) RollPipelineTemplateForward(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the template"))
+	}
+
+	var jobIDs []int32
+	for _, id := range c.IntSlice("job-id") {
+		jobIDs = append(jobIDs, int32(id))
+	}
+	request, err := json.Marshal(web.RollForwardPipelineTemplateRequest{
+		Version: int32(c.Int("version")),
+		JobIDs:  jobIDs,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post(pipelineTemplatePath(c.Args().First(), 0, "/rollforward"), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineTemplateJobChangePresenters{}, "Rolled jobs forward")
+}
@@ -433,6 +433,12 @@ func normalizePassword(password string) string {
 // SetLogLevel sets the log level on the node
 func (cli *Client) SetLogLevel(c *clipkg.Context) (err error) {
 	logLevel := c.String("level")
+	if service := c.String("service"); service != "" {
+		return cli.setServiceLogLevel(service, logLevel, c.Duration("expires"))
+	}
+	if c.IsSet("expires") {
+		return cli.errorOut(errors.New("--expires can only be used with --service"))
+	}
 	request := web.LogPatchRequest{Level: logLevel}
 	requestData, err := json.Marshal(request)
 	if err != nil {
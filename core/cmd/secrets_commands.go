@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type SecretPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.SecretResource
+}
+
+var secretHeaders = []string{"Name"}
+
+// ToRow presents the SecretResource as a slice of strings.
+func (p *SecretPresenter) ToRow() []string {
+	return []string{p.Name}
+}
+
+// RenderTable implements TableRenderer
+func (p *SecretPresenter) RenderTable(rt RendererTable) error {
+	renderList(secretHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// SecretPresenters implements TableRenderer for a slice of SecretPresenter.
+type SecretPresenters []SecretPresenter
+
+// RenderTable implements TableRenderer
+func (ps SecretPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(secretHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ListSecrets lists the names of all secrets. Secret values are never shown.
+func (cli *Client) ListSecrets(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/secrets")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenters{})
+}
+
+// SetSecret adds a secret, or replaces the value of an existing one. The
+// value is read from the file given by --file, so that it does not end up in
+// the shell history.
+func (cli *Client) SetSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret"))
+	}
+	if !c.IsSet("file") {
+		return cli.errorOut(errors.New("must pass the path of the file containing the secret value with --file"))
+	}
+
+	value, err := os.ReadFile(c.String("file"))
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to read the secret value"))
+	}
+
+	request, err := json.Marshal(web.SetSecretRequest{
+		Name:  c.Args().First(),
+		Value: strings.TrimSpace(string(value)),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/secrets", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Secret set")
+}
+
+// DeleteSecret deletes a secret.
+func (cli *Client) DeleteSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/secrets/" + url.PathEscape(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	_, err = cli.parseResponse(resp)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Secret %v deleted\n", c.Args().First())
+	return nil
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/store/models/starknet"
+)
+
+// StarkNetSendEth transfers ETH from the node's StarkNet account to a specified address.
+func (cli *Client) StarkNetSendEth(c *cli.Context) (err error) {
+	if c.NArg() < 3 {
+		return cli.errorOut(errors.New("three arguments expected: amount, fromAccount and toAddress"))
+	}
+
+	var amount assets.Eth
+	if c.IsSet("wei") {
+		wei, ok := new(big.Int).SetString(c.Args().Get(0), 10)
+		if !ok {
+			return cli.errorOut(errors.Errorf("while parsing WEI transfer amount %s", c.Args().Get(0)))
+		}
+		amount = assets.Eth(*wei)
+	} else {
+		amount, err = assets.NewEthValueS(c.Args().Get(0))
+		if err != nil {
+			return cli.errorOut(multierr.Combine(
+				errors.New("while parsing ETH transfer amount"), err))
+		}
+	}
+
+	chainID := c.String("id")
+	if chainID == "" {
+		return cli.errorOut(errors.New("missing id"))
+	}
+
+	request := starknet.SendRequest{
+		From:               c.Args().Get(1),
+		To:                 c.Args().Get(2),
+		Amount:             amount,
+		StarkNetChainID:    chainID,
+		AllowHigherAmounts: c.IsSet("force"),
+	}
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	buf := bytes.NewBuffer(requestData)
+
+	resp, err := cli.HTTP.Post("/v2/transfers/starknet", buf)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = cli.renderAPIResponse(resp, &TxPresenter{})
+	return err
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type TxPresenter struct {
+	JAID
+	presenters.TxResource
+}
+
+func (p *TxPresenter) ToRow() []string {
+	var jobID string
+	if p.JobID != nil {
+		jobID = fmt.Sprint(*p.JobID)
+	}
+	return []string{
+		p.ChainType,
+		p.ChainID,
+		p.TxID,
+		p.Hash,
+		p.From,
+		p.To,
+		p.State,
+		jobID,
+		p.CreatedAt.String(),
+	}
+}
+
+var txHeaders = []string{"Chain Type", "Chain ID", "ID", "Hash", "From", "To", "State", "Job ID", "Created"}
+
+// RenderTable implements TableRenderer
+func (p *TxPresenter) RenderTable(rt RendererTable) error {
+	renderList(txHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+type TxPresenters []TxPresenter
+
+// RenderTable implements TableRenderer
+func (ps TxPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(txHeaders, rows, rt.Writer)
+	return nil
+}
+
+// IndexTxs lists the transactions sent on every chain, newest first.
+func (cli *Client) IndexTxs(c *cli.Context) error {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"chain-type": "chainType",
+		"chain-id":   "chainID",
+		"from":       "from",
+		"state":      "state",
+	} {
+		if value := c.String(flag); value != "" {
+			q.Set(param, value)
+		}
+	}
+	uri := url.URL{Path: "/v2/txs", RawQuery: q.Encode()}
+	return cli.getPage(uri.String(), c.Int("page"), &TxPresenters{})
+}
+
+// ShowTx shows a transaction by its chain type, chain ID and ID.
+func (cli *Client) ShowTx(c *cli.Context) (err error) {
+	if c.NArg() != 3 {
+		return cli.errorOut(errors.New("must pass the chain type, chain ID and ID of the transaction"))
+	}
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/txs/%s/%s/%s", url.PathEscape(c.Args().Get(0)), url.PathEscape(c.Args().Get(1)), url.PathEscape(c.Args().Get(2))))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &TxPresenter{})
+}
@@ -0,0 +1,63 @@
+package cmd_test
+
+import (
+	"flag"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+func TestClient_IndexTxs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	confirmed := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+	cltest.MustInsertFatalErrorEthTx(t, app.TxmORM(), from)
+
+	set := flag.NewFlagSet("test txs", 0)
+	set.Int("page", 1, "doc")
+	set.String("chain-type", "evm", "doc")
+	set.String("state", "confirmed", "doc")
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.IndexTxs(c))
+
+	renderedTxs := *r.Renders[0].(*cmd.TxPresenters)
+	require.Len(t, renderedTxs, 1)
+	assert.Equal(t, strconv.FormatInt(confirmed.ID, 10), renderedTxs[0].TxID)
+	assert.Equal(t, "confirmed", renderedTxs[0].State)
+}
+
+func TestClient_ShowTx(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, app.TxmORM(), 0, 1, from)
+
+	set := flag.NewFlagSet("test show tx", 0)
+	require.NoError(t, set.Parse([]string{"evm", "0", strconv.FormatInt(tx.ID, 10)}))
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ShowTx(c))
+
+	renderedTx := *r.Renders[0].(*cmd.TxPresenter)
+	assert.Equal(t, from.Hex(), renderedTx.From)
+	assert.Equal(t, tx.EthTxAttempts[0].Hash.Hex(), renderedTx.Hash)
+
+	set = flag.NewFlagSet("test show tx", 0)
+	require.NoError(t, set.Parse([]string{"evm", "0"}))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ShowTx(c))
+}
@@ -156,3 +156,48 @@ func TestStore_boolParser(t *testing.T) {
 	_, err = parse.Bool("")
 	assert.Error(t, err)
 }
+
+func TestGeneralConfig_HTTPTaskConfig(t *testing.T) {
+	t.Setenv(envvar.Name("HTTPTaskTLSIdentities"), `[{"name": "provider", "certFile": "cert.pem", "keyFile": "key.pem", "allowedHosts": ["api.example.com"]}]`)
+	t.Setenv(envvar.Name("HTTPTaskOAuth2Providers"), `[{"name": "provider", "tokenURL": "https://auth.example.com/token", "clientID": "node", "clientSecret": "$(secrets.provider)", "tlsIdentity": "provider", "allowedHosts": ["api.example.com:8443"]}]`)
+	config := NewGeneralConfig(logger.TestLogger(t))
+	require.NoError(t, validateHTTPTaskConfig(config))
+
+	identities, err := config.HTTPTaskTLSIdentities()
+	require.NoError(t, err)
+	assert.Equal(t, []HTTPTaskTLSIdentity{{Name: "provider", CertFile: "cert.pem", KeyFile: "key.pem", AllowedHosts: []string{"api.example.com"}}}, identities)
+	assert.True(t, identities[0].AllowsURL(&url.URL{Scheme: "https", Host: "API.example.com:8443"}))
+	assert.False(t, identities[0].AllowsURL(&url.URL{Scheme: "https", Host: "api.example.com.evil.com"}))
+
+	providers, err := config.HTTPTaskOAuth2Providers()
+	require.NoError(t, err)
+	require.Len(t, providers, 1)
+	assert.Equal(t, "$(secrets.provider)", providers[0].ClientSecret)
+	assert.True(t, providers[0].AllowsURL(&url.URL{Scheme: "https", Host: "api.example.com:8443"}))
+	assert.False(t, providers[0].AllowsURL(&url.URL{Scheme: "https", Host: "api.example.com"}))
+
+	for _, test := range []struct {
+		name       string
+		identities string
+		providers  string
+		err        string
+	}{
+		{"invalid json", `{`, ``, "invalid HTTP_TASK_TLS_IDENTITIES json"},
+		{"identity without key", `[{"name": "a", "certFile": "cert.pem"}]`, ``, "identity a must have a certFile and keyFile"},
+		{"duplicate identity", `[{"name": "a", "certFile": "c", "keyFile": "k", "allowedHosts": ["h"]}, {"name": "a", "certFile": "c", "keyFile": "k", "allowedHosts": ["h"]}]`, ``, "duplicate identity a"},
+		{"identity without allowed hosts", `[{"name": "a", "certFile": "c", "keyFile": "k"}]`, ``, "identity a: must have allowedHosts"},
+		{"identity with allowed URL", `[{"name": "a", "certFile": "c", "keyFile": "k", "allowedHosts": ["https://h/"]}]`, ``, `identity a: invalid allowed host "https://h/"`},
+		{"invalid token URL", ``, `[{"name": "a", "tokenURL": "auth.example.com", "clientID": "node"}]`, "provider a has an invalid tokenURL"},
+		{"invalid auth style", ``, `[{"name": "a", "tokenURL": "https://auth.example.com", "clientID": "node", "authStyle": "cookie"}]`, "provider a authStyle must be header or params"},
+		{"provider without allowed hosts", ``, `[{"name": "a", "tokenURL": "https://auth.example.com", "clientID": "node"}]`, "provider a: must have allowedHosts"},
+		{"unknown identity", ``, `[{"name": "a", "tokenURL": "https://auth.example.com", "clientID": "node", "tlsIdentity": "b", "allowedHosts": ["h"]}]`, "provider a references unknown TLS identity b"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(envvar.Name("HTTPTaskTLSIdentities"), test.identities)
+			t.Setenv(envvar.Name("HTTPTaskOAuth2Providers"), test.providers)
+			err := validateHTTPTaskConfig(NewGeneralConfig(logger.TestLogger(t)))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
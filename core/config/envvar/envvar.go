@@ -37,6 +37,8 @@ var (
 	LogFileMaxAge                     = New("LogFileMaxAge", parse.Int64)
 	LogFileMaxBackups                 = New("LogFileMaxBackups", parse.Int64)
 	LogUnixTS                         = NewBool("LogUnixTS")
+	LogSamplingDebugFirst             = New("LogSamplingDebugFirst", parse.Int64)
+	LogSamplingDebugThereafter        = New("LogSamplingDebugThereafter", parse.Int64)
 )
 
 // EnvVar is an environment variable parsed as T.
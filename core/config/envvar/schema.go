@@ -21,7 +21,7 @@ import (
 
 // ConfigSchema records the schema of configuration at the type level
 //
-// A note on Feature Flags
+// # A note on Feature Flags
 //
 // Feature flags should be used during development of large features that might
 // span more than one release cycle. Most changes that are not considered "complete"
@@ -69,25 +69,35 @@ type ConfigSchema struct {
 	// Database Global Lock
 	AdvisoryLockCheckInterval time.Duration `env:"ADVISORY_LOCK_CHECK_INTERVAL" default:"1s"`
 	AdvisoryLockID            int64         `env:"ADVISORY_LOCK_ID" default:"1027321974924625846"`
+	DatabaseHAEnabled         bool          `env:"DATABASE_HA_ENABLED" default:"false"`
 	DatabaseLockingMode       string        `env:"DATABASE_LOCKING_MODE" default:"dual"`
 	LeaseLockDuration         time.Duration `env:"LEASE_LOCK_DURATION" default:"10s"`
 	LeaseLockRefreshInterval  time.Duration `env:"LEASE_LOCK_REFRESH_INTERVAL" default:"1s"`
 	// Database Autobackups
 	DatabaseBackupDir              string        `env:"DATABASE_BACKUP_DIR"`
+	DatabaseBackupEncrypted        bool          `env:"DATABASE_BACKUP_ENCRYPTED" default:"true"`
+	DatabaseBackupEncryptionKey    string        `env:"DATABASE_BACKUP_ENCRYPTION_KEY"`
 	DatabaseBackupFrequency        time.Duration `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode             string        `env:"DATABASE_BACKUP_MODE" default:"none"`
 	DatabaseBackupOnVersionUpgrade bool          `env:"DATABASE_BACKUP_ON_VERSION_UPGRADE" default:"true"`
+	DatabaseBackupRetentionCount   uint32        `env:"DATABASE_BACKUP_RETENTION_COUNT" default:"10"`
+	DatabaseBackupRetentionMaxAge  time.Duration `env:"DATABASE_BACKUP_RETENTION_MAX_AGE" default:"0"`
 	DatabaseBackupURL              *url.URL      `env:"DATABASE_BACKUP_URL"`
 
 	// Logging
-	JSONConsole       bool           `env:"JSON_CONSOLE" default:"false"`
-	LogFileDir        string         `env:"LOG_FILE_DIR"`
-	LogLevel          zapcore.Level  `env:"LOG_LEVEL"`
-	LogSQL            bool           `env:"LOG_SQL" default:"false"`
-	LogFileMaxSize    utils.FileSize `env:"LOG_FILE_MAX_SIZE" default:"5120mb"` // 5120mb was determined based on previously collected logs, in which a daily log would be ~2.5GB and compressed would be ~210MB
-	LogFileMaxAge     int64          `env:"LOG_FILE_MAX_AGE" default:"0"`
-	LogFileMaxBackups int64          `env:"LOG_FILE_MAX_BACKUPS" default:"1"`
-	LogUnixTS         bool           `env:"LOG_UNIX_TS" default:"false"`
+	JSONConsole                bool           `env:"JSON_CONSOLE" default:"false"`
+	LogFileDir                 string         `env:"LOG_FILE_DIR"`
+	LogLevel                   zapcore.Level  `env:"LOG_LEVEL"`
+	LogSQL                     bool           `env:"LOG_SQL" default:"false"`
+	LogFileMaxSize             utils.FileSize `env:"LOG_FILE_MAX_SIZE" default:"5120mb"` // 5120mb was determined based on previously collected logs, in which a daily log would be ~2.5GB and compressed would be ~210MB
+	LogFileMaxAge              int64          `env:"LOG_FILE_MAX_AGE" default:"0"`
+	LogFileMaxBackups          int64          `env:"LOG_FILE_MAX_BACKUPS" default:"1"`
+	LogUnixTS                  bool           `env:"LOG_UNIX_TS" default:"false"`
+	LogSamplingDebugFirst      int64          `env:"LOG_SAMPLING_DEBUG_FIRST" default:"0"`
+	LogSamplingDebugThereafter int64          `env:"LOG_SAMPLING_DEBUG_THEREAFTER" default:"100"`
+
+	// Notifications
+	NotificationsConfigPath string `env:"NOTIFICATIONS_CONFIG_PATH"`
 
 	// Web Server
 	AllowOrigins                   string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
@@ -136,7 +146,11 @@ type ConfigSchema struct {
 	EthereumSecondaryURLs string `env:"ETH_SECONDARY_URLS"`
 	EthereumURL           string `env:"ETH_URL"`
 	// Global
-	DefaultChainID *big.Int `env:"ETH_CHAIN_ID"`
+	DefaultChainID          *big.Int      `env:"ETH_CHAIN_ID"`
+	EthRemoteSignerProtocol string        `env:"ETH_REMOTE_SIGNER_PROTOCOL" default:"web3signer"`
+	EthRemoteSignerTimeout  time.Duration `env:"ETH_REMOTE_SIGNER_TIMEOUT" default:"10s"`
+	EthRemoteSignerURL      *url.URL      `env:"ETH_REMOTE_SIGNER_URL"`
+	EthTxStuckThreshold     uint64        `env:"ETH_TX_STUCK_THRESHOLD" default:"50"`
 	// Per-chain overrides
 	BalanceMonitorEnabled             bool          `env:"BALANCE_MONITOR_ENABLED"`
 	BlockBackfillDepth                uint64        `env:"BLOCK_BACKFILL_DEPTH" default:"10"`
@@ -150,6 +164,7 @@ type ConfigSchema struct {
 	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
 	EvmLogBackfillBatchSize           uint32        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmLogBroadcasterUseLogPoller     bool          `env:"ETH_LOG_BROADCASTER_USE_LOG_POLLER"`
 	EvmLogPollInterval                time.Duration `env:"ETH_LOG_POLL_INTERVAL"`
 	EvmRPCDefaultBatchSize            uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	LinkContractAddress               string        `env:"LINK_CONTRACT_ADDRESS"`
@@ -160,6 +175,7 @@ type ConfigSchema struct {
 	NodeNoNewHeadsThreshold  time.Duration `env:"NODE_NO_NEW_HEADS_THRESHOLD"`
 	NodePollFailureThreshold uint32        `env:"NODE_POLL_FAILURE_THRESHOLD"`
 	NodePollInterval         time.Duration `env:"NODE_POLL_INTERVAL"`
+	NodeHTTPPollInterval     time.Duration `env:"NODE_HTTP_POLL_INTERVAL"`
 
 	// EVM Gas Controls
 	EvmEIP1559DynamicFees bool     `env:"EVM_EIP1559_DYNAMIC_FEES"`
@@ -176,23 +192,29 @@ type ConfigSchema struct {
 	EvmMaxGasPriceWei     *big.Int `env:"ETH_MAX_GAS_PRICE_WEI"`
 	EvmMinGasPriceWei     *big.Int `env:"ETH_MIN_GAS_PRICE_WEI"`
 	// Gas Estimation
-	GasEstimatorMode                               string `env:"GAS_ESTIMATOR_MODE"`
-	BlockHistoryEstimatorBatchSize                 uint32 `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
-	BlockHistoryEstimatorBlockDelay                uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
-	BlockHistoryEstimatorBlockHistorySize          uint16 `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
-	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16 `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
-	BlockHistoryEstimatorTransactionPercentile     uint16 `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	GasEstimatorMode                               string  `env:"GAS_ESTIMATOR_MODE"`
+	BlockHistoryEstimatorBatchSize                 uint32  `env:"BLOCK_HISTORY_ESTIMATOR_BATCH_SIZE"`
+	BlockHistoryEstimatorBlockDelay                uint16  `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_DELAY"`
+	BlockHistoryEstimatorBlockHistorySize          uint16  `env:"BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
+	BlockHistoryEstimatorEIP1559FeeCapBufferBlocks uint16  `env:"BLOCK_HISTORY_ESTIMATOR_EIP1559_FEE_CAP_BUFFER_BLOCKS"`
+	BlockHistoryEstimatorTransactionPercentile     uint16  `env:"BLOCK_HISTORY_ESTIMATOR_TRANSACTION_PERCENTILE"`
+	FeeHistoryEstimatorBaseFeeMultiplier           float32 `env:"FEE_HISTORY_ESTIMATOR_BASE_FEE_MULTIPLIER"`
+	FeeHistoryEstimatorBlockCount                  uint16  `env:"FEE_HISTORY_ESTIMATOR_BLOCK_COUNT"`
+	FeeHistoryEstimatorRewardPercentile            uint16  `env:"FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE"`
 	// Txm
 	EvmGasBumpTxDepth          uint16 `env:"ETH_GAS_BUMP_TX_DEPTH"`
 	EvmMaxInFlightTransactions uint32 `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
 	EvmMaxQueuedTransactions   uint64 `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmNonceAutoSync           bool   `env:"ETH_NONCE_AUTO_SYNC"`
 	EvmUseForwarders           bool   `env:"ETH_USE_FORWARDERS"`
+	EvmForwarderOwners         string `env:"ETH_FORWARDER_OWNERS"`
 
 	// Job Pipeline and tasks
 	DefaultHTTPLimit                 int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
 	DefaultHTTPTimeout               models.Duration `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
 	FeatureExternalInitiators        bool            `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
+	HTTPTaskOAuth2Providers          string          `env:"HTTP_TASK_OAUTH2_PROVIDERS"`
+	HTTPTaskTLSIdentities            string          `env:"HTTP_TASK_TLS_IDENTITIES"`
 	JobPipelineMaxRunDuration        time.Duration   `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
 	JobPipelineReaperInterval        time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold       time.Duration   `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
@@ -213,6 +235,7 @@ type ConfigSchema struct {
 	OCR2DatabaseTimeout                    time.Duration `env:"OCR2_DATABASE_TIMEOUT" default:"10s"`                      //nodoc
 	OCR2KeyBundleID                        string        `env:"OCR2_KEY_BUNDLE_ID"`                                       //nodoc
 	OCR2MonitoringEndpoint                 string        `env:"OCR2_MONITORING_ENDPOINT"`                                 //nodoc
+	OCR2PluginsDir                         string        `env:"OCR2_PLUGINS_DIR"`                                         //nodoc
 
 	// OCR V1
 	FeatureOffchainReporting bool `env:"FEATURE_OFFCHAIN_REPORTING" default:"false"`
@@ -40,10 +40,15 @@ func TestConfigSchema(t *testing.T) {
 		"BridgeResponseURL":                              "BRIDGE_RESPONSE_URL",
 		"ChainType":                                      "CHAIN_TYPE",
 		"DatabaseBackupDir":                              "DATABASE_BACKUP_DIR",
+		"DatabaseBackupEncrypted":                        "DATABASE_BACKUP_ENCRYPTED",
+		"DatabaseBackupEncryptionKey":                    "DATABASE_BACKUP_ENCRYPTION_KEY",
 		"DatabaseBackupFrequency":                        "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                             "DATABASE_BACKUP_MODE",
 		"DatabaseBackupOnVersionUpgrade":                 "DATABASE_BACKUP_ON_VERSION_UPGRADE",
+		"DatabaseBackupRetentionCount":                   "DATABASE_BACKUP_RETENTION_COUNT",
+		"DatabaseBackupRetentionMaxAge":                  "DATABASE_BACKUP_RETENTION_MAX_AGE",
 		"DatabaseBackupURL":                              "DATABASE_BACKUP_URL",
+		"DatabaseHAEnabled":                              "DATABASE_HA_ENABLED",
 		"DatabaseListenerMaxReconnectDuration":           "DATABASE_LISTENER_MAX_RECONNECT_DURATION",
 		"DatabaseListenerMinReconnectInterval":           "DATABASE_LISTENER_MIN_RECONNECT_INTERVAL",
 		"DatabaseLockingMode":                            "DATABASE_LOCKING_MODE",
@@ -54,9 +59,13 @@ func TestConfigSchema(t *testing.T) {
 		"Dev":                                            "CHAINLINK_DEV",
 		"EVMEnabled":                                     "EVM_ENABLED",
 		"EVMRPCEnabled":                                  "EVM_RPC_ENABLED",
+		"EthRemoteSignerProtocol":                        "ETH_REMOTE_SIGNER_PROTOCOL",
+		"EthRemoteSignerTimeout":                         "ETH_REMOTE_SIGNER_TIMEOUT",
+		"EthRemoteSignerURL":                             "ETH_REMOTE_SIGNER_URL",
 		"EthTxReaperInterval":                            "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                           "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                      "ETH_TX_RESEND_AFTER_THRESHOLD",
+		"EthTxStuckThreshold":                            "ETH_TX_STUCK_THRESHOLD",
 		"EthereumHTTPURL":                                "ETH_HTTP_URL",
 		"EthereumSecondaryURL":                           "ETH_SECONDARY_URL",
 		"EthereumSecondaryURLs":                          "ETH_SECONDARY_URLS",
@@ -80,6 +89,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmHeadTrackerMaxBufferSize":                    "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":                 "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
 		"EvmLogBackfillBatchSize":                        "ETH_LOG_BACKFILL_BATCH_SIZE",
+		"EvmLogBroadcasterUseLogPoller":                  "ETH_LOG_BROADCASTER_USE_LOG_POLLER",
 		"EvmLogPollInterval":                             "ETH_LOG_POLL_INTERVAL",
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
@@ -87,6 +97,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmMinGasPriceWei":                              "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                               "ETH_NONCE_AUTO_SYNC",
 		"EvmUseForwarders":                               "ETH_USE_FORWARDERS",
+		"EvmForwarderOwners":                             "ETH_FORWARDER_OWNERS",
 		"EvmRPCDefaultBatchSize":                         "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                              "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                                 "EXPLORER_SECRET",
@@ -99,6 +110,9 @@ func TestConfigSchema(t *testing.T) {
 		"FeatureOffchainReporting":                       "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureOffchainReporting2":                      "FEATURE_OFFCHAIN_REPORTING2",
 		"FeatureUICSAKeys":                               "FEATURE_UI_CSA_KEYS",
+		"FeeHistoryEstimatorBaseFeeMultiplier":           "FEE_HISTORY_ESTIMATOR_BASE_FEE_MULTIPLIER",
+		"FeeHistoryEstimatorBlockCount":                  "FEE_HISTORY_ESTIMATOR_BLOCK_COUNT",
+		"FeeHistoryEstimatorRewardPercentile":            "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE",
 		"FlagsContractAddress":                           "FLAGS_CONTRACT_ADDRESS",
 		"GasEstimatorMode":                               "GAS_ESTIMATOR_MODE",
 		"GasUpdaterBatchSize":                            "GAS_UPDATER_BATCH_SIZE",
@@ -106,6 +120,8 @@ func TestConfigSchema(t *testing.T) {
 		"GasUpdaterBlockHistorySize":                     "GAS_UPDATER_BLOCK_HISTORY_SIZE",
 		"GasUpdaterTransactionPercentile":                "GAS_UPDATER_TRANSACTION_PERCENTILE",
 		"HTTPServerWriteTimeout":                         "HTTP_SERVER_WRITE_TIMEOUT",
+		"HTTPTaskOAuth2Providers":                        "HTTP_TASK_OAUTH2_PROVIDERS",
+		"HTTPTaskTLSIdentities":                          "HTTP_TASK_TLS_IDENTITIES",
 		"InsecureFastScrypt":                             "INSECURE_FAST_SCRYPT",
 		"JSONConsole":                                    "JSON_CONSOLE",
 		"JobPipelineMaxRunDuration":                      "JOB_PIPELINE_MAX_RUN_DURATION",
@@ -135,14 +151,18 @@ func TestConfigSchema(t *testing.T) {
 		"LogFileMaxAge":                                  "LOG_FILE_MAX_AGE",
 		"LogFileMaxBackups":                              "LOG_FILE_MAX_BACKUPS",
 		"LogUnixTS":                                      "LOG_UNIX_TS",
+		"LogSamplingDebugFirst":                          "LOG_SAMPLING_DEBUG_FIRST",
+		"LogSamplingDebugThereafter":                     "LOG_SAMPLING_DEBUG_THEREAFTER",
 		"MaximumServiceDuration":                         "MAXIMUM_SERVICE_DURATION",
 		"MigrateDatabase":                                "MIGRATE_DATABASE",
 		"MinIncomingConfirmations":                       "MIN_INCOMING_CONFIRMATIONS",
 		"MinimumContractPayment":                         "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                         "MINIMUM_SERVICE_DURATION",
+		"NodeHTTPPollInterval":                           "NODE_HTTP_POLL_INTERVAL",
 		"NodeNoNewHeadsThreshold":                        "NODE_NO_NEW_HEADS_THRESHOLD",
 		"NodePollFailureThreshold":                       "NODE_POLL_FAILURE_THRESHOLD",
 		"NodePollInterval":                               "NODE_POLL_INTERVAL",
+		"NotificationsConfigPath":                        "NOTIFICATIONS_CONFIG_PATH",
 		"ORMMaxIdleConns":                                "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                                "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                                "OPTIMISM_GAS_FEES",
@@ -188,6 +208,7 @@ func TestConfigSchema(t *testing.T) {
 		"OCR2ContractConfirmations":              "OCR2_CONTRACT_CONFIRMATIONS",
 		"OCR2KeyBundleID":                        "OCR2_KEY_BUNDLE_ID",
 		"OCR2MonitoringEndpoint":                 "OCR2_MONITORING_ENDPOINT",
+		"OCR2PluginsDir":                         "OCR2_PLUGINS_DIR",
 		"OCR2TraceLogging":                       "OCR2_TRACE_LOGGING",
 
 		// OCR v1
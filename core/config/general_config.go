@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
@@ -69,6 +70,7 @@ type GeneralOnlyConfig interface {
 	SetLogSQL(logSQL bool)
 
 	FeatureFlags
+	HTTPTaskConfig
 
 	AdvisoryLockCheckInterval() time.Duration
 	AdvisoryLockID() int64
@@ -92,12 +94,17 @@ type GeneralOnlyConfig interface {
 	BridgeResponseURL() *url.URL
 	CertFile() string
 	DatabaseBackupDir() string
+	DatabaseBackupEncrypted() bool
+	DatabaseBackupEncryptionKey() string
 	DatabaseBackupFrequency() time.Duration
 	DatabaseBackupMode() DatabaseBackupMode
 	DatabaseBackupOnVersionUpgrade() bool
+	DatabaseBackupRetentionCount() uint32
+	DatabaseBackupRetentionMaxAge() time.Duration
 	DatabaseBackupURL() *url.URL
 	DatabaseListenerMaxReconnectDuration() time.Duration
 	DatabaseListenerMinReconnectInterval() time.Duration
+	DatabaseHAEnabled() bool
 	DatabaseLockingMode() string
 	DatabaseURL() url.URL
 	DefaultChainID() *big.Int
@@ -106,6 +113,10 @@ type GeneralOnlyConfig interface {
 	DefaultLogLevel() zapcore.Level
 	Dev() bool
 	ShutdownGracePeriod() time.Duration
+	EthRemoteSignerProtocol() string
+	EthRemoteSignerTimeout() time.Duration
+	EthRemoteSignerURL() *url.URL
+	EthTxStuckThreshold() uint64
 	EthereumHTTPURL() *url.URL
 	EthereumNodes() string
 	EthereumSecondaryURLs() []url.URL
@@ -145,7 +156,10 @@ type GeneralOnlyConfig interface {
 	LogFileMaxAge() int64
 	LogFileMaxBackups() int64
 	LogUnixTimestamps() bool
+	LogSamplingDebugFirst() int64
+	LogSamplingDebugThereafter() int64
 	MigrateDatabase() bool
+	NotificationsConfigPath() string
 	ORMMaxIdleConns() int
 	ORMMaxOpenConns() int
 	Port() uint16
@@ -212,6 +226,7 @@ type GlobalConfig interface {
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
+	GlobalEvmLogBroadcasterUseLogPoller() (bool, bool)
 	GlobalEvmLogPollInterval() (time.Duration, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
@@ -219,7 +234,11 @@ type GlobalConfig interface {
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
 	GlobalEvmUseForwarders() (bool, bool)
+	GlobalEvmForwarderOwners() ([]common.Address, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalFeeHistoryEstimatorBaseFeeMultiplier() (float32, bool)
+	GlobalFeeHistoryEstimatorBlockCount() (uint16, bool)
+	GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool)
 	GlobalFlagsContractAddress() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
 	GlobalLinkContractAddress() (string, bool)
@@ -229,6 +248,7 @@ type GlobalConfig interface {
 	GlobalNodeNoNewHeadsThreshold() (time.Duration, bool)
 	GlobalNodePollFailureThreshold() (uint32, bool)
 	GlobalNodePollInterval() (time.Duration, bool)
+	GlobalNodeHTTPPollInterval() (time.Duration, bool)
 
 	OCR1Config
 	OCR2Config
@@ -397,6 +417,24 @@ EVM_ENABLED=false
 		return errors.Errorf("unrecognised value for DATABASE_LOCKING_MODE: %s (valid options are 'dual', 'lease', 'advisorylock' or 'none')", c.DatabaseLockingMode())
 	}
 
+	if err := validateHTTPTaskConfig(c); err != nil {
+		return err
+	}
+
+	switch c.EthRemoteSignerProtocol() {
+	case "web3signer", "clef":
+	default:
+		return errors.Errorf("unrecognised value for ETH_REMOTE_SIGNER_PROTOCOL: %s (valid options are 'web3signer' or 'clef')", c.EthRemoteSignerProtocol())
+	}
+
+	if c.DatabaseHAEnabled() {
+		switch c.DatabaseLockingMode() {
+		case "dual", "lease":
+		default:
+			return errors.Errorf("DATABASE_HA_ENABLED requires DATABASE_LOCKING_MODE to be 'lease' or 'dual' (got DATABASE_LOCKING_MODE=%s)", c.DatabaseLockingMode())
+		}
+	}
+
 	if c.LeaseLockRefreshInterval() > c.LeaseLockDuration()/2 {
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
@@ -595,6 +633,27 @@ func (c *generalConfig) DatabaseBackupDir() string {
 	return c.viper.GetString(envvar.Name("DatabaseBackupDir"))
 }
 
+// DatabaseBackupEncrypted controls whether backup files are encrypted. The key is derived from
+// DatabaseBackupEncryptionKey, and backups fail if it is not set.
+func (c *generalConfig) DatabaseBackupEncrypted() bool {
+	return getEnvWithFallback(c, envvar.NewBool("DatabaseBackupEncrypted"))
+}
+
+// DatabaseBackupEncryptionKey is the passphrase backups are encrypted with
+func (c *generalConfig) DatabaseBackupEncryptionKey() string {
+	return c.viper.GetString(envvar.Name("DatabaseBackupEncryptionKey"))
+}
+
+// DatabaseBackupRetentionCount is the number of backups to keep. Older backups are deleted after each backup. 0 keeps all backups.
+func (c *generalConfig) DatabaseBackupRetentionCount() uint32 {
+	return getEnvWithFallback(c, envvar.NewUint32("DatabaseBackupRetentionCount"))
+}
+
+// DatabaseBackupRetentionMaxAge is the age after which backups are deleted. 0 disables deletion by age.
+func (c *generalConfig) DatabaseBackupRetentionMaxAge() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("DatabaseBackupRetentionMaxAge"))
+}
+
 // DatabaseURL configures the URL for chainlink to connect to. This must be
 // a properly formatted URL, with a valid scheme (postgres://)
 func (c *generalConfig) DatabaseURL() url.URL {
@@ -674,6 +733,39 @@ func (c *generalConfig) FMSimulateTransactions() bool {
 	return c.viper.GetBool(envvar.Name("FMSimulateTransactions"))
 }
 
+// EthRemoteSignerProtocol is the JSON-RPC dialect spoken by the remote signer,
+// either 'web3signer' or 'clef'
+func (c *generalConfig) EthRemoteSignerProtocol() string {
+	return getEnvWithFallback(c, envvar.NewString("EthRemoteSignerProtocol"))
+}
+
+// EthRemoteSignerTimeout is the maximum time to wait for the remote signer to sign a transaction
+func (c *generalConfig) EthRemoteSignerTimeout() time.Duration {
+	return getEnvWithFallback(c, envvar.NewDuration("EthRemoteSignerTimeout"))
+}
+
+// EthRemoteSignerURL is the URL of an external signer holding the private keys
+// of remote ETH keys, or nil if there is none
+func (c *generalConfig) EthRemoteSignerURL() *url.URL {
+	s := c.viper.GetString(envvar.Name("EthRemoteSignerURL"))
+	if s == "" {
+		return nil
+	}
+	uri, err := url.Parse(s)
+	if err != nil {
+		c.lggr.Errorf("Invalid eth remote signer url %s", s)
+		return nil
+	}
+	return uri
+}
+
+// EthTxStuckThreshold is the number of blocks after which a transaction that
+// is still unconfirmed is reported as stuck. Set to 0 to only report
+// transactions which are stuck at the maximum gas price.
+func (c *generalConfig) EthTxStuckThreshold() uint64 {
+	return getEnvWithFallback(c, envvar.NewUint64("EthTxStuckThreshold"))
+}
+
 // EthereumURL represents the URL of the Ethereum node to connect Chainlink to.
 func (c *generalConfig) EthereumURL() string {
 	return c.viper.GetString(envvar.Name("EthereumURL"))
@@ -1017,6 +1109,25 @@ func (c *generalConfig) LogUnixTimestamps() bool {
 	return getEnvWithFallback(c, envvar.LogUnixTS)
 }
 
+// LogSamplingDebugFirst is the number of debug logs with the same message written each second before
+// sampling starts. If this is set to 0, debug logs are not sampled.
+func (c *generalConfig) LogSamplingDebugFirst() int64 {
+	return getEnvWithFallback(c, envvar.LogSamplingDebugFirst)
+}
+
+// LogSamplingDebugThereafter is the interval at which sampled debug logs are written, e.g. 100 writes
+// every hundredth log with the same message.
+func (c *generalConfig) LogSamplingDebugThereafter() int64 {
+	return getEnvWithFallback(c, envvar.LogSamplingDebugThereafter)
+}
+
+// NotificationsConfigPath is the path of the TOML file configuring where job
+// errors, critical logs and other events are notified. Notifications are
+// disabled if it is empty.
+func (c *generalConfig) NotificationsConfigPath() string {
+	return c.viper.GetString(envvar.Name("NotificationsConfigPath"))
+}
+
 // Port represents the port Chainlink should listen on for client requests.
 func (c *generalConfig) Port() uint16 {
 	return getEnvWithFallback(c, envvar.NewUint16("Port"))
@@ -1274,6 +1385,9 @@ func (c *generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, b
 func (c *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogBackfillBatchSize"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogBroadcasterUseLogPoller"), strconv.ParseBool)
+}
 func (c *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogPollInterval"), time.ParseDuration)
 }
@@ -1295,9 +1409,21 @@ func (c *generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 func (c *generalConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return lookupEnv(c, envvar.Name("EvmUseForwarders"), strconv.ParseBool)
 }
+func (c *generalConfig) GlobalEvmForwarderOwners() ([]common.Address, bool) {
+	return lookupEnv(c, envvar.Name("EvmForwarderOwners"), parse.Addresses)
+}
 func (c *generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmRPCDefaultBatchSize"), parse.Uint32)
 }
+func (c *generalConfig) GlobalFeeHistoryEstimatorBaseFeeMultiplier() (float32, bool) {
+	return lookupEnv(c, envvar.Name("FeeHistoryEstimatorBaseFeeMultiplier"), parse.F32)
+}
+func (c *generalConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("FeeHistoryEstimatorBlockCount"), parse.Uint16)
+}
+func (c *generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	return lookupEnv(c, envvar.Name("FeeHistoryEstimatorRewardPercentile"), parse.Uint16)
+}
 func (c *generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	return lookupEnv(c, envvar.Name("FlagsContractAddress"), parse.String)
 }
@@ -1344,12 +1470,24 @@ func (c *generalConfig) GlobalNodePollInterval() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("NodePollInterval"), time.ParseDuration)
 }
 
+func (c *generalConfig) GlobalNodeHTTPPollInterval() (time.Duration, bool) {
+	return lookupEnv(c, envvar.Name("NodeHTTPPollInterval"), time.ParseDuration)
+}
+
 // DatabaseLockingMode can be one of 'dual', 'advisorylock', 'lease' or 'none'
 // It controls which mode to use to enforce that only one Chainlink application can use the database
 func (c *generalConfig) DatabaseLockingMode() string {
 	return getEnvWithFallback(c, envvar.NewString("DatabaseLockingMode"))
 }
 
+// DatabaseHAEnabled runs the node in active/passive high availability mode.
+// Instead of blocking at startup until it can take the lease, a standby node
+// unlocks its keystore and warms up its services, then takes over as soon as
+// the lease held by the active node lapses.
+func (c *generalConfig) DatabaseHAEnabled() bool {
+	return getEnvWithFallback(c, envvar.NewBool("DatabaseHAEnabled"))
+}
+
 // LeaseLockRefreshInterval controls how often the node should attempt to
 // refresh the lease lock
 func (c *generalConfig) LeaseLockRefreshInterval() time.Duration {
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+)
+
+// HTTPTaskConfig is a subset of global config relevant to the http and
+// bridge pipeline tasks.
+type HTTPTaskConfig interface {
+	HTTPTaskTLSIdentities() ([]HTTPTaskTLSIdentity, error)
+	HTTPTaskOAuth2Providers() ([]HTTPTaskOAuth2Provider, error)
+}
+
+// HTTPTaskTLSIdentity is a client certificate which tasks present to servers
+// requiring mutual TLS.
+type HTTPTaskTLSIdentity struct {
+	Name     string `json:"name"`
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	// CAFile optionally replaces the system roots to verify servers with.
+	CAFile string `json:"caFile"`
+	// AllowedHosts are the hosts the identity may be presented to, since
+	// task URLs may be interpolated from run inputs.
+	AllowedHosts []string `json:"allowedHosts"`
+}
+
+// AllowsURL reports whether the identity may be presented to the host of u.
+func (i HTTPTaskTLSIdentity) AllowsURL(u *url.URL) bool {
+	return allowsHost(i.AllowedHosts, u)
+}
+
+// HTTPTaskOAuth2Provider fetches OAuth2 access tokens with the client
+// credentials grant.
+type HTTPTaskOAuth2Provider struct {
+	Name     string `json:"name"`
+	TokenURL string `json:"tokenURL"`
+	ClientID string `json:"clientID"`
+	// ClientSecret may reference node secrets as $(secrets.name).
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes"`
+	// EndpointParams are added to the token request, e.g. an audience.
+	EndpointParams map[string]string `json:"endpointParams"`
+	// AuthStyle is "header" (default) to send the client credentials with
+	// basic auth, or "params" to send them in the request body.
+	AuthStyle string `json:"authStyle"`
+	// TLSIdentity optionally names the identity to fetch tokens with.
+	TLSIdentity string `json:"tlsIdentity"`
+	// AllowedHosts are the hosts the access tokens may be sent to, since
+	// task URLs may be interpolated from run inputs.
+	AllowedHosts []string `json:"allowedHosts"`
+}
+
+// AllowsURL reports whether the access tokens may be sent to the host of u.
+func (p HTTPTaskOAuth2Provider) AllowsURL(u *url.URL) bool {
+	return allowsHost(p.AllowedHosts, u)
+}
+
+// allowsHost reports whether the host of u is one of allowedHosts. Hosts
+// without a port match any port of the host.
+func allowsHost(allowedHosts []string, u *url.URL) bool {
+	for _, host := range allowedHosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAllowedHosts(allowedHosts []string) error {
+	if len(allowedHosts) == 0 {
+		return errors.New("must have allowedHosts")
+	}
+	for _, host := range allowedHosts {
+		if host == "" || strings.ContainsAny(host, "/?#@") {
+			return errors.Errorf("invalid allowed host %q", host)
+		}
+	}
+	return nil
+}
+
+// HTTPTaskTLSIdentities is a JSON list of the client certificates which http
+// and bridge tasks reference by name with the tlsIdentity param.
+func (c *generalConfig) HTTPTaskTLSIdentities() ([]HTTPTaskTLSIdentity, error) {
+	return parseHTTPTaskTLSIdentities(c.viper.GetString(envvar.Name("HTTPTaskTLSIdentities")))
+}
+
+// HTTPTaskOAuth2Providers is a JSON list of the OAuth2 token providers which
+// http and bridge tasks reference by name with the oauth2Provider param.
+func (c *generalConfig) HTTPTaskOAuth2Providers() ([]HTTPTaskOAuth2Provider, error) {
+	return parseHTTPTaskOAuth2Providers(c.viper.GetString(envvar.Name("HTTPTaskOAuth2Providers")))
+}
+
+func parseHTTPTaskTLSIdentities(str string) (identities []HTTPTaskTLSIdentity, err error) {
+	if str == "" {
+		return nil, nil
+	}
+	if err = json.Unmarshal([]byte(str), &identities); err != nil {
+		return nil, errors.Wrap(err, "invalid HTTP_TASK_TLS_IDENTITIES json")
+	}
+	names := make(map[string]struct{})
+	for i, identity := range identities {
+		if identity.Name == "" {
+			return nil, errors.Errorf("HTTP_TASK_TLS_IDENTITIES: identity %d has no name", i)
+		}
+		if _, exists := names[identity.Name]; exists {
+			return nil, errors.Errorf("HTTP_TASK_TLS_IDENTITIES: duplicate identity %s", identity.Name)
+		}
+		names[identity.Name] = struct{}{}
+		if identity.CertFile == "" || identity.KeyFile == "" {
+			return nil, errors.Errorf("HTTP_TASK_TLS_IDENTITIES: identity %s must have a certFile and keyFile", identity.Name)
+		}
+		if err = validateAllowedHosts(identity.AllowedHosts); err != nil {
+			return nil, errors.Wrapf(err, "HTTP_TASK_TLS_IDENTITIES: identity %s", identity.Name)
+		}
+	}
+	return identities, nil
+}
+
+func parseHTTPTaskOAuth2Providers(str string) (providers []HTTPTaskOAuth2Provider, err error) {
+	if str == "" {
+		return nil, nil
+	}
+	if err = json.Unmarshal([]byte(str), &providers); err != nil {
+		return nil, errors.Wrap(err, "invalid HTTP_TASK_OAUTH2_PROVIDERS json")
+	}
+	names := make(map[string]struct{})
+	for i, provider := range providers {
+		if provider.Name == "" {
+			return nil, errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: provider %d has no name", i)
+		}
+		if _, exists := names[provider.Name]; exists {
+			return nil, errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: duplicate provider %s", provider.Name)
+		}
+		names[provider.Name] = struct{}{}
+		if provider.ClientID == "" {
+			return nil, errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: provider %s must have a clientID", provider.Name)
+		}
+		u, err := url.Parse(provider.TokenURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: provider %s has an invalid tokenURL", provider.Name)
+		}
+		switch provider.AuthStyle {
+		case "", "header", "params":
+		default:
+			return nil, errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: provider %s authStyle must be header or params, got %s", provider.Name, provider.AuthStyle)
+		}
+		if err = validateAllowedHosts(provider.AllowedHosts); err != nil {
+			return nil, errors.Wrapf(err, "HTTP_TASK_OAUTH2_PROVIDERS: provider %s", provider.Name)
+		}
+	}
+	return providers, nil
+}
+
+func validateHTTPTaskConfig(c HTTPTaskConfig) error {
+	identities, err := c.HTTPTaskTLSIdentities()
+	if err != nil {
+		return err
+	}
+	providers, err := c.HTTPTaskOAuth2Providers()
+	if err != nil {
+		return err
+	}
+	names := make(map[string]struct{}, len(identities))
+	for _, identity := range identities {
+		names[identity.Name] = struct{}{}
+	}
+	for _, provider := range providers {
+		if _, exists := names[provider.TLSIdentity]; provider.TLSIdentity != "" && !exists {
+			return errors.Errorf("HTTP_TASK_OAUTH2_PROVIDERS: provider %s references unknown TLS identity %s", provider.Name, provider.TLSIdentity)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 
 	assets "github.com/smartcontractkit/chainlink/core/assets"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	commontypes "github.com/smartcontractkit/libocr/commontypes"
 
 	config "github.com/smartcontractkit/chainlink/core/config"
@@ -369,6 +371,34 @@ func (_m *GeneralConfig) DatabaseBackupDir() string {
 	return r0
 }
 
+// DatabaseBackupEncrypted provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupEncrypted() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// DatabaseBackupEncryptionKey provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupEncryptionKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupFrequency provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupFrequency() time.Duration {
 	ret := _m.Called()
@@ -411,6 +441,34 @@ func (_m *GeneralConfig) DatabaseBackupOnVersionUpgrade() bool {
 	return r0
 }
 
+// DatabaseBackupRetentionCount provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupRetentionCount() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// DatabaseBackupRetentionMaxAge provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseBackupRetentionMaxAge() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // DatabaseBackupURL provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	ret := _m.Called()
@@ -427,6 +485,20 @@ func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	return r0
 }
 
+// DatabaseHAEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseHAEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // DatabaseListenerMaxReconnectDuration provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseListenerMaxReconnectDuration() time.Duration {
 	ret := _m.Called()
@@ -583,6 +655,64 @@ func (_m *GeneralConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EthRemoteSignerProtocol provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerProtocol() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerTimeout() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// EthRemoteSignerURL provides a mock function with given fields:
+func (_m *GeneralConfig) EthRemoteSignerURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// EthTxStuckThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) EthTxStuckThreshold() uint64 {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
 // EthereumHTTPURL provides a mock function with given fields:
 func (_m *GeneralConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
@@ -1100,6 +1230,29 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmForwarderOwners provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmForwarderOwners() ([]common.Address, bool) {
+	ret := _m.Called()
+
+	var r0 []common.Address
+	if rf, ok := ret.Get(0).(func() []common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.Address)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -1425,6 +1578,27 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogBroadcasterUseLogPoller provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogBroadcasterUseLogPoller() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmLogPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -1597,6 +1771,69 @@ func (_m *GeneralConfig) GlobalEvmUseForwarders() (bool, bool) {
 	return r0, r1
 }
 
+// GlobalFeeHistoryEstimatorBaseFeeMultiplier provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorBaseFeeMultiplier() (float32, bool) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalFeeHistoryEstimatorBlockCount provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorBlockCount() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalFeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalFlagsContractAddress provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
@@ -1704,6 +1941,27 @@ func (_m *GeneralConfig) GlobalMinimumContractPayment() (*assets.Link, bool) {
 	return r0, r1
 }
 
+// GlobalNodeHTTPPollInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalNodeHTTPPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalNodeNoNewHeadsThreshold provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalNodeNoNewHeadsThreshold() (time.Duration, bool) {
 	ret := _m.Called()
@@ -1886,6 +2144,52 @@ func (_m *GeneralConfig) HTTPServerWriteTimeout() time.Duration {
 	return r0
 }
 
+// HTTPTaskOAuth2Providers provides a mock function with given fields:
+func (_m *GeneralConfig) HTTPTaskOAuth2Providers() ([]config.HTTPTaskOAuth2Provider, error) {
+	ret := _m.Called()
+
+	var r0 []config.HTTPTaskOAuth2Provider
+	if rf, ok := ret.Get(0).(func() []config.HTTPTaskOAuth2Provider); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.HTTPTaskOAuth2Provider)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HTTPTaskTLSIdentities provides a mock function with given fields:
+func (_m *GeneralConfig) HTTPTaskTLSIdentities() ([]config.HTTPTaskTLSIdentity, error) {
+	ret := _m.Called()
+
+	var r0 []config.HTTPTaskTLSIdentity
+	if rf, ok := ret.Get(0).(func() []config.HTTPTaskTLSIdentity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.HTTPTaskTLSIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsecureFastScrypt provides a mock function with given fields:
 func (_m *GeneralConfig) InsecureFastScrypt() bool {
 	ret := _m.Called()
@@ -2264,6 +2568,34 @@ func (_m *GeneralConfig) LogSQL() bool {
 	return r0
 }
 
+// LogSamplingDebugFirst provides a mock function with given fields:
+func (_m *GeneralConfig) LogSamplingDebugFirst() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// LogSamplingDebugThereafter provides a mock function with given fields:
+func (_m *GeneralConfig) LogSamplingDebugThereafter() int64 {
+	ret := _m.Called()
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
 // LogUnixTimestamps provides a mock function with given fields:
 func (_m *GeneralConfig) LogUnixTimestamps() bool {
 	ret := _m.Called()
@@ -2292,6 +2624,20 @@ func (_m *GeneralConfig) MigrateDatabase() bool {
 	return r0
 }
 
+// NotificationsConfigPath provides a mock function with given fields:
+func (_m *GeneralConfig) NotificationsConfigPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2BlockchainTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) OCR2BlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -2411,6 +2757,20 @@ func (_m *GeneralConfig) OCR2MonitoringEndpoint() string {
 	return r0
 }
 
+// OCR2PluginsDir provides a mock function with given fields:
+func (_m *GeneralConfig) OCR2PluginsDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2TraceLogging provides a mock function with given fields:
 func (_m *GeneralConfig) OCR2TraceLogging() bool {
 	ret := _m.Called()
@@ -22,6 +22,7 @@ type OCR2Config interface {
 	OCR2MonitoringEndpoint() string
 	OCR2KeyBundleID() (string, error)
 	// OCR2 config, cannot override in jobs
+	OCR2PluginsDir() string
 	OCR2TraceLogging() bool
 }
 
@@ -53,6 +54,12 @@ func (c *generalConfig) OCR2MonitoringEndpoint() string {
 	return c.viper.GetString(envvar.Name("OCR2MonitoringEndpoint"))
 }
 
+// OCR2PluginsDir is the directory which the commands of external OCR2 plugins must be in. External plugins are
+// disabled if it is not set.
+func (c *generalConfig) OCR2PluginsDir() string {
+	return c.viper.GetString(envvar.Name("OCR2PluginsDir"))
+}
+
 func (c *generalConfig) OCR2KeyBundleID() (string, error) {
 	kbStr := c.viper.GetString(envvar.Name("OCR2KeyBundleID"))
 	if kbStr != "" {
@@ -7,8 +7,10 @@ import (
 	"net/url"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	homedir "github.com/mitchellh/go-homedir"
 	"go.uber.org/zap/zapcore"
 
@@ -20,6 +22,22 @@ func String(str string) (string, error) {
 	return str, nil
 }
 
+// Addresses parses a comma separated list of hex addresses.
+func Addresses(str string) ([]common.Address, error) {
+	var addrs []common.Address
+	for _, s := range strings.Split(str, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !common.IsHexAddress(s) {
+			return nil, fmt.Errorf("unable to parse '%v' into an address", s)
+		}
+		addrs = append(addrs, common.HexToAddress(s))
+	}
+	return addrs, nil
+}
+
 func Link(str string) (*assets.Link, error) {
 	i, ok := new(assets.Link).SetString(str, 10)
 	if !ok {
@@ -37,9 +37,13 @@ type EnvPrinter struct {
 	BlockHistoryEstimatorTransactionPercentile uint16          `json:"GAS_UPDATER_TRANSACTION_PERCENTILE"`
 	BridgeResponseURL                          string          `json:"BRIDGE_RESPONSE_URL,omitempty"`
 	ChainType                                  string          `json:"CHAIN_TYPE"`
+	DatabaseBackupEncrypted                    bool            `json:"DATABASE_BACKUP_ENCRYPTED"`
 	DatabaseBackupFrequency                    time.Duration   `json:"DATABASE_BACKUP_FREQUENCY"`
 	DatabaseBackupMode                         string          `json:"DATABASE_BACKUP_MODE"`
 	DatabaseBackupOnVersionUpgrade             bool            `json:"DATABASE_BACKUP_ON_VERSION_UPGRADE"`
+	DatabaseBackupRetentionCount               uint32          `json:"DATABASE_BACKUP_RETENTION_COUNT"`
+	DatabaseBackupRetentionMaxAge              time.Duration   `json:"DATABASE_BACKUP_RETENTION_MAX_AGE"`
+	DatabaseHAEnabled                          bool            `json:"DATABASE_HA_ENABLED"`
 	DatabaseLockingMode                        string          `json:"DATABASE_LOCKING_MODE"`
 	DefaultChainID                             string          `json:"ETH_CHAIN_ID"`
 	DefaultHTTPLimit                           int64           `json:"DEFAULT_HTTP_LIMIT"`
@@ -47,6 +51,10 @@ type EnvPrinter struct {
 	Dev                                        bool            `json:"CHAINLINK_DEV"`
 	ShutdownGracePeriod                        time.Duration   `json:"SHUTDOWN_GRACE_PERIOD"`
 	EVMRPCEnabled                              bool            `json:"EVM_RPC_ENABLED"`
+	EthRemoteSignerProtocol                    string          `json:"ETH_REMOTE_SIGNER_PROTOCOL"`
+	EthRemoteSignerTimeout                     time.Duration   `json:"ETH_REMOTE_SIGNER_TIMEOUT"`
+	EthRemoteSignerURL                         string          `json:"ETH_REMOTE_SIGNER_URL"`
+	EthTxStuckThreshold                        uint64          `json:"ETH_TX_STUCK_THRESHOLD"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
 	EthereumURL                                string          `json:"ETH_URL"`
@@ -81,6 +89,9 @@ type EnvPrinter struct {
 	LogFileMaxSize                             utils.FileSize  `json:"LOG_FILE_MAX_SIZE"`
 	LogFileMaxAge                              int64           `json:"LOG_FILE_MAX_AGE"`
 	LogFileMaxBackups                          int64           `json:"LOG_FILE_MAX_BACKUPS"`
+	LogSamplingDebugFirst                      int64           `json:"LOG_SAMPLING_DEBUG_FIRST"`
+	LogSamplingDebugThereafter                 int64           `json:"LOG_SAMPLING_DEBUG_THEREAFTER"`
+	NotificationsConfigPath                    string          `json:"NOTIFICATIONS_CONFIG_PATH"`
 	TriggerFallbackDBPollInterval              time.Duration   `json:"JOB_PIPELINE_DB_POLL_INTERVAL"`
 
 	// OCR1
@@ -138,6 +149,10 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 	if cfg.TelemetryIngressURL() != nil {
 		telemetryIngressURL = cfg.TelemetryIngressURL().String()
 	}
+	ethRemoteSignerURL := ""
+	if cfg.EthRemoteSignerURL() != nil {
+		ethRemoteSignerURL = cfg.EthRemoteSignerURL().Redacted()
+	}
 	bridgeResponseURL := ""
 	if cfg.BridgeResponseURL() != nil {
 		bridgeResponseURL = cfg.BridgeResponseURL().String()
@@ -151,9 +166,13 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			AllowOrigins:                   cfg.AllowOrigins(),
 			BlockBackfillDepth:             cfg.BlockBackfillDepth(),
 			BridgeResponseURL:              bridgeResponseURL,
+			DatabaseBackupEncrypted:        cfg.DatabaseBackupEncrypted(),
 			DatabaseBackupFrequency:        cfg.DatabaseBackupFrequency(),
 			DatabaseBackupMode:             string(cfg.DatabaseBackupMode()),
 			DatabaseBackupOnVersionUpgrade: cfg.DatabaseBackupOnVersionUpgrade(),
+			DatabaseBackupRetentionCount:   cfg.DatabaseBackupRetentionCount(),
+			DatabaseBackupRetentionMaxAge:  cfg.DatabaseBackupRetentionMaxAge(),
+			DatabaseHAEnabled:              cfg.DatabaseHAEnabled(),
 			DatabaseLockingMode:            cfg.DatabaseLockingMode(),
 			DefaultChainID:                 cfg.DefaultChainID().String(),
 			DefaultHTTPLimit:               cfg.DefaultHTTPLimit(),
@@ -161,6 +180,10 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			Dev:                            cfg.Dev(),
 			ShutdownGracePeriod:            cfg.ShutdownGracePeriod(),
 			EVMRPCEnabled:                  cfg.EVMRPCEnabled(),
+			EthRemoteSignerProtocol:        cfg.EthRemoteSignerProtocol(),
+			EthRemoteSignerTimeout:         cfg.EthRemoteSignerTimeout(),
+			EthRemoteSignerURL:             ethRemoteSignerURL,
+			EthTxStuckThreshold:            cfg.EthTxStuckThreshold(),
 			EthereumHTTPURL:                ethereumHTTPURL,
 			EthereumSecondaryURLs:          mapToStringA(cfg.EthereumSecondaryURLs()),
 			EthereumURL:                    cfg.EthereumURL(),
@@ -187,14 +210,17 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			KeeperTurnLookBack:                      cfg.KeeperTurnLookBack(),
 			KeeperTurnFlagEnabled:                   cfg.KeeperTurnFlagEnabled(),
 
-			LeaseLockDuration:        cfg.LeaseLockDuration(),
-			LeaseLockRefreshInterval: cfg.LeaseLockRefreshInterval(),
-			LogFileDir:               cfg.LogFileDir(),
-			LogFileMaxSize:           cfg.LogFileMaxSize(),
-			LogFileMaxAge:            cfg.LogFileMaxAge(),
-			LogFileMaxBackups:        cfg.LogFileMaxBackups(),
-			LogLevel:                 cfg.LogLevel(),
-			LogSQL:                   cfg.LogSQL(),
+			LeaseLockDuration:          cfg.LeaseLockDuration(),
+			LeaseLockRefreshInterval:   cfg.LeaseLockRefreshInterval(),
+			LogFileDir:                 cfg.LogFileDir(),
+			LogFileMaxSize:             cfg.LogFileMaxSize(),
+			LogFileMaxAge:              cfg.LogFileMaxAge(),
+			LogFileMaxBackups:          cfg.LogFileMaxBackups(),
+			LogSamplingDebugFirst:      cfg.LogSamplingDebugFirst(),
+			LogSamplingDebugThereafter: cfg.LogSamplingDebugThereafter(),
+			LogLevel:                   cfg.LogLevel(),
+			LogSQL:                     cfg.LogSQL(),
+			NotificationsConfigPath:    cfg.NotificationsConfigPath(),
 
 			// OCRV1
 			OCRContractTransmitterTransmitTimeout: ocrTransmitTimeout,
@@ -90,6 +90,7 @@ type DatabaseLock struct {
 	AdvisoryID            *int64
 	LeaseDuration         *models.Duration
 	LeaseRefreshInterval  *models.Duration
+	HAEnabled             *bool
 }
 
 type DatabaseBackup struct {
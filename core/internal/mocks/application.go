@@ -1,4 +1,4 @@
-// Code generated by mockery v2.13.0-beta.1. DO NOT EDIT.
+// Code generated by mockery v2.13.1. DO NOT EDIT.
 
 package mocks
 
@@ -8,6 +8,8 @@ import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 	chainlink "github.com/smartcontractkit/chainlink/core/services/chainlink"
 
+	chains "github.com/smartcontractkit/chainlink/core/chains"
+
 	config "github.com/smartcontractkit/chainlink/core/config"
 
 	context "context"
@@ -32,6 +34,10 @@ import (
 
 	sqlx "github.com/smartcontractkit/sqlx"
 
+	templates "github.com/smartcontractkit/chainlink/core/services/templates"
+
+	time "time"
+
 	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -207,6 +213,22 @@ func (_m *Application) GetFeedsService() feeds.Service {
 	return r0
 }
 
+// GetHAStatus provides a mock function with given fields:
+func (_m *Application) GetHAStatus() pg.HAStatus {
+	ret := _m.Called()
+
+	var r0 pg.HAStatus
+	if rf, ok := ret.Get(0).(func() pg.HAStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pg.HAStatus)
+		}
+	}
+
+	return r0
+}
+
 // GetHealthChecker provides a mock function with given fields:
 func (_m *Application) GetHealthChecker() services.Checker {
 	ret := _m.Called()
@@ -271,6 +293,22 @@ func (_m *Application) GetSqlxDB() *sqlx.DB {
 	return r0
 }
 
+// GetTemplatesService provides a mock function with given fields:
+func (_m *Application) GetTemplatesService() templates.Service {
+	ret := _m.Called()
+
+	var r0 templates.Service
+	if rf, ok := ret.Get(0).(func() templates.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(templates.Service)
+		}
+	}
+
+	return r0
+}
+
 // GetWebAuthnConfiguration provides a mock function with given fields:
 func (_m *Application) GetWebAuthnConfiguration() sessions.WebAuthnConfiguration {
 	ret := _m.Called()
@@ -333,6 +371,20 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -363,6 +415,41 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// RerunJobRun provides a mock function with given fields: ctx, runID, opts
+func (_m *Application) RerunJobRun(ctx context.Context, runID int64, opts job.RerunOpts) (int64, error) {
+	ret := _m.Called(ctx, runID, opts)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64, job.RerunOpts) int64); ok {
+		r0 = rf(ctx, runID, opts)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, job.RerunOpts) error); ok {
+		r1 = rf(ctx, runID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -419,6 +506,22 @@ func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, r
 	return r0, r1
 }
 
+// ServiceLogLevels provides a mock function with given fields:
+func (_m *Application) ServiceLogLevels() []logger.ServiceLogLevel {
+	ret := _m.Called()
+
+	var r0 []logger.ServiceLogLevel
+	if rf, ok := ret.Get(0).(func() []logger.ServiceLogLevel); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]logger.ServiceLogLevel)
+		}
+	}
+
+	return r0
+}
+
 // SessionORM provides a mock function with given fields:
 func (_m *Application) SessionORM() sessions.ORM {
 	ret := _m.Called()
@@ -449,6 +552,27 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SetServiceLogLevel provides a mock function with given fields: name, lvl, expiresIn
+func (_m *Application) SetServiceLogLevel(name string, lvl zapcore.Level, expiresIn time.Duration) (logger.ServiceLogLevel, error) {
+	ret := _m.Called(name, lvl, expiresIn)
+
+	var r0 logger.ServiceLogLevel
+	if rf, ok := ret.Get(0).(func(string, zapcore.Level, time.Duration) logger.ServiceLogLevel); ok {
+		r0 = rf(name, lvl, expiresIn)
+	} else {
+		r0 = ret.Get(0).(logger.ServiceLogLevel)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, zapcore.Level, time.Duration) error); ok {
+		r1 = rf(name, lvl, expiresIn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -477,6 +601,22 @@ func (_m *Application) Stop() error {
 	return r0
 }
 
+// TxHistory provides a mock function with given fields:
+func (_m *Application) TxHistory() chains.TxHistory {
+	ret := _m.Called()
+
+	var r0 chains.TxHistory
+	if rf, ok := ret.Get(0).(func() chains.TxHistory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chains.TxHistory)
+		}
+	}
+
+	return r0
+}
+
 // TxmORM provides a mock function with given fields:
 func (_m *Application) TxmORM() txmgr.ORM {
 	ret := _m.Called()
@@ -493,18 +633,60 @@ func (_m *Application) TxmORM() txmgr.ORM {
 	return r0
 }
 
+// UnsetServiceLogLevel provides a mock function with given fields: name
+func (_m *Application) UnsetServiceLogLevel(name string) bool {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// UpdateJob provides a mock function with given fields: ctx, _a1, spec, link
+func (_m *Application) UpdateJob(ctx context.Context, _a1 *job.Job, spec string, link *templates.JobTemplate) error {
+	ret := _m.Called(ctx, _a1, spec, link)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, string, *templates.JobTemplate) error); ok {
+		r0 = rf(ctx, _a1, spec, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
 }
 
-type NewApplicationT interface {
+// WarmUp provides a mock function with given fields: ctx
+func (_m *Application) WarmUp(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewApplication interface {
 	mock.TestingT
 	Cleanup(func())
 }
 
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewApplication(t NewApplicationT) *Application {
+func NewApplication(t mockConstructorTestingTNewApplication) *Application {
 	mock := &Application{}
 	mock.Mock.Test(t)
 
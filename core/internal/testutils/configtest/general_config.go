@@ -118,6 +118,7 @@ type GeneralConfigOverrides struct {
 
 	// OCR v2
 	OCR2DatabaseTimeout *time.Duration
+	OCR2PluginsDir      null.String
 
 	// OCR v1
 	OCRKeyBundleID            null.String
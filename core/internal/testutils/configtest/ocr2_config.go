@@ -15,3 +15,11 @@ func (c *TestGeneralConfig) OCR2DatabaseTimeout() time.Duration {
 	}
 	return c.GeneralConfig.OCR2DatabaseTimeout()
 }
+
+// OCR2PluginsDir returns the overridden value, if one exists.
+func (c *TestGeneralConfig) OCR2PluginsDir() string {
+	if c.Overrides.OCR2PluginsDir.Valid {
+		return c.Overrides.OCR2PluginsDir.String
+	}
+	return c.GeneralConfig.OCR2PluginsDir()
+}
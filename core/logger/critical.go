@@ -1,6 +1,31 @@
 package logger
 
-import "go.uber.org/zap/zapcore"
+import (
+	"fmt"
+	"sync/atomic"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// CriticalHook is called with every critical log entry, along with the name
+// and fields of the logger which logged it. It is called synchronously, so it
+// must not block, and must not log at critical level itself.
+type CriticalHook func(name string, msg string, keysAndValues []interface{})
+
+var criticalHook atomic.Value
+
+// SetCriticalHook sets the hook called with every critical log entry, e.g. to
+// notify the node operator. Passing nil removes it.
+func SetCriticalHook(hook CriticalHook) {
+	criticalHook.Store(hook)
+}
+
+func callCriticalHook(name string, msg string, keysAndValues []interface{}) {
+	hook, _ := criticalHook.Load().(CriticalHook)
+	if hook != nil {
+		hook(name, msg, keysAndValues)
+	}
+}
 
 // encodeLevel is a zapcore.EncodeLevel that encodes crit in place of dpanic for our custom Critical* level.
 func encodeLevel(l zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
@@ -14,12 +39,15 @@ func encodeLevel(l zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
 func (l *zapLogger) Critical(args ...interface{}) {
 	// DPanic is used for the appropriate numerical level (between error and panic), but we never actually panic.
 	l.sugaredHelper(1).DPanic(args...)
+	callCriticalHook(l.name, fmt.Sprint(args...), l.fields)
 }
 
 func (l *zapLogger) Criticalf(format string, values ...interface{}) {
 	l.sugaredHelper(1).DPanicf(format, values...)
+	callCriticalHook(l.name, fmt.Sprintf(format, values...), l.fields)
 }
 
 func (l *zapLogger) Criticalw(msg string, keysAndValues ...interface{}) {
 	l.sugaredHelper(1).DPanicw(msg, keysAndValues...)
+	callCriticalHook(l.name, msg, copyFields(l.fields, keysAndValues...))
 }
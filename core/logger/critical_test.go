@@ -0,0 +1,41 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSetCriticalHook(t *testing.T) {
+	type entry struct {
+		name          string
+		msg           string
+		keysAndValues []interface{}
+	}
+	var entries []entry
+	SetCriticalHook(func(name string, msg string, keysAndValues []interface{}) {
+		if name == "root.Hooked" {
+			entries = append(entries, entry{name, msg, keysAndValues})
+		}
+	})
+	t.Cleanup(func() { SetCriticalHook(nil) })
+
+	// zaptest loggers panic on critical entries, like development loggers
+	var lggr Logger = &zapLogger{SugaredLogger: zap.NewNop().Sugar()}
+	lggr = lggr.Named("root").Named("Hooked").With("foo", 1)
+	lggr.Critical("a", "b")
+	lggr.Criticalf("c %d", 2)
+	lggr.Criticalw("d", "bar", 3)
+	lggr.Errorw("not critical")
+
+	require.Len(t, entries, 3)
+	assert.Equal(t, entry{"root.Hooked", "ab", []interface{}{"foo", 1}}, entries[0])
+	assert.Equal(t, entry{"root.Hooked", "c 2", []interface{}{"foo", 1}}, entries[1])
+	assert.Equal(t, entry{"root.Hooked", "d", []interface{}{"foo", 1, "bar", 3}}, entries[2])
+
+	SetCriticalHook(nil)
+	lggr.Critical("e")
+	assert.Len(t, entries, 3)
+}
@@ -208,6 +208,18 @@ func NewLogger() (Logger, func() error) {
 		parseErrs = append(parseErrs, invalid)
 	}
 
+	var samplingFirst, samplingThereafter int64
+	samplingFirst, invalid = envvar.LogSamplingDebugFirst.Parse()
+	c.SamplingDebugFirst = int(samplingFirst)
+	if invalid != "" {
+		parseErrs = append(parseErrs, invalid)
+	}
+	samplingThereafter, invalid = envvar.LogSamplingDebugThereafter.Parse()
+	c.SamplingDebugThereafter = int(samplingThereafter)
+	if invalid != "" {
+		parseErrs = append(parseErrs, invalid)
+	}
+
 	l, close := c.New()
 	for _, msg := range parseErrs {
 		l.Error(msg)
@@ -226,6 +238,11 @@ type Config struct {
 	FileMaxSizeMB  int
 	FileMaxAgeDays int
 	FileMaxBackups int // files
+	// SamplingDebugFirst is the number of debug entries with the same message logged each second
+	// before sampling starts. Sampling is disabled if zero.
+	SamplingDebugFirst int
+	// SamplingDebugThereafter is the interval at which debug entries are logged once sampling starts.
+	SamplingDebugThereafter int
 }
 
 // New returns a new Logger with pretty printing to stdout, prometheus counters, and sentry forwarding.
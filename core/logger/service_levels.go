@@ -0,0 +1,241 @@
+package logger
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// ServiceLogLevel is a runtime log level for a named logger and all of its descendants.
+type ServiceLogLevel struct {
+	// Name is the dot separated logger name, e.g. EVM.1.Txm. Leading names may be omitted, so Txm
+	// applies to the Txm logger of every chain.
+	Name  string
+	Level zapcore.Level
+	// Expires is when the level is removed, or nil if it is kept until unset.
+	Expires *time.Time
+}
+
+// serviceLevels is a registry of ServiceLogLevels, keyed by name.
+type serviceLevels struct {
+	mu     sync.RWMutex
+	levels map[string]ServiceLogLevel
+	timers map[string]*time.Timer
+	// min is the lowest level set, or disabledLevel if there are none, so that cores can cheaply
+	// check whether any level could enable an entry.
+	min int32
+}
+
+func newServiceLevels() *serviceLevels {
+	return &serviceLevels{
+		levels: map[string]ServiceLogLevel{},
+		timers: map[string]*time.Timer{},
+		min:    int32(disabledLevel),
+	}
+}
+
+// globalServiceLevels applies to every Logger created by Config.New.
+var globalServiceLevels = newServiceLevels()
+
+// SetServiceLogLevel sets the log level of the logger name and its descendants, overriding the
+// global level. If expiresIn is positive, the level is removed after that long.
+func SetServiceLogLevel(name string, lvl zapcore.Level, expiresIn time.Duration) (ServiceLogLevel, error) {
+	return globalServiceLevels.set(name, lvl, expiresIn)
+}
+
+// UnsetServiceLogLevel removes the log level of the logger name, returning false if none was set.
+func UnsetServiceLogLevel(name string) bool {
+	return globalServiceLevels.unset(name)
+}
+
+// ServiceLogLevels returns the log levels currently set, sorted by name.
+func ServiceLogLevels() []ServiceLogLevel {
+	return globalServiceLevels.list()
+}
+
+func normalizeServiceName(name string) string {
+	return strings.Trim(strings.TrimSpace(name), ".")
+}
+
+func (s *serviceLevels) set(name string, lvl zapcore.Level, expiresIn time.Duration) (ServiceLogLevel, error) {
+	name = normalizeServiceName(name)
+	if name == "" {
+		return ServiceLogLevel{}, errors.New("service name is required")
+	}
+	if lvl < zapcore.DebugLevel || lvl > zapcore.ErrorLevel {
+		return ServiceLogLevel{}, errors.Errorf("invalid log level: %s", lvl)
+	}
+	if expiresIn < 0 {
+		return ServiceLogLevel{}, errors.Errorf("invalid expiry: %s", expiresIn)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.timers[name]; ok {
+		t.Stop()
+		delete(s.timers, name)
+	}
+	sl := ServiceLogLevel{Name: name, Level: lvl}
+	if expiresIn > 0 {
+		expires := time.Now().Add(expiresIn)
+		sl.Expires = &expires
+		var t *time.Timer
+		t = time.AfterFunc(expiresIn, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			// the level may have been replaced since this timer was started
+			if s.timers[name] == t {
+				s.remove(name)
+			}
+		})
+		s.timers[name] = t
+	}
+	s.levels[name] = sl
+	s.updateMin()
+	return sl, nil
+}
+
+func (s *serviceLevels) unset(name string) bool {
+	name = normalizeServiceName(name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.levels[name]; !ok {
+		return false
+	}
+	if t, ok := s.timers[name]; ok {
+		t.Stop()
+	}
+	s.remove(name)
+	return true
+}
+
+// remove deletes the level of name. Callers must hold mu.
+func (s *serviceLevels) remove(name string) {
+	delete(s.levels, name)
+	delete(s.timers, name)
+	s.updateMin()
+}
+
+// updateMin recomputes min. Callers must hold mu.
+func (s *serviceLevels) updateMin() {
+	min := disabledLevel
+	for _, sl := range s.levels {
+		if sl.Level < min {
+			min = sl.Level
+		}
+	}
+	atomic.StoreInt32(&s.min, int32(min))
+}
+
+func (s *serviceLevels) list() []ServiceLogLevel {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sls := make([]ServiceLogLevel, 0, len(s.levels))
+	for _, sl := range s.levels {
+		sls = append(sls, sl)
+	}
+	sort.Slice(sls, func(i, j int) bool { return sls[i].Name < sls[j].Name })
+	return sls
+}
+
+// enabled returns true if any level set enables lvl.
+func (s *serviceLevels) enabled(lvl zapcore.Level) bool {
+	return int32(lvl) >= atomic.LoadInt32(&s.min)
+}
+
+// empty returns true if no levels are set.
+func (s *serviceLevels) empty() bool {
+	return atomic.LoadInt32(&s.min) == int32(disabledLevel)
+}
+
+// level returns the level which applies to the logger name. When more than one matches, the
+// longest, and so most specific, name wins.
+func (s *serviceLevels) level(loggerName string) (zapcore.Level, bool) {
+	if s.empty() {
+		return 0, false
+	}
+	padded := "." + loggerName + "."
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var match *ServiceLogLevel
+	for name := range s.levels {
+		if (match == nil || len(name) > len(match.Name)) && strings.Contains(padded, "."+name+".") {
+			sl := s.levels[name]
+			match = &sl
+		}
+	}
+	if match == nil {
+		return 0, false
+	}
+	return match.Level, true
+}
+
+var _ zapcore.Core = &serviceLevelCore{}
+
+// serviceLevelCore filters entries by the level set for their logger name, or by the global level
+// for loggers without one. The wrapped core must be enabled at all levels.
+type serviceLevelCore struct {
+	zapcore.Core
+	global zapcore.LevelEnabler
+	levels *serviceLevels
+}
+
+func newServiceLevelCore(core zapcore.Core, global zapcore.LevelEnabler, levels *serviceLevels) zapcore.Core {
+	return &serviceLevelCore{Core: core, global: global, levels: levels}
+}
+
+func (c *serviceLevelCore) Enabled(lvl zapcore.Level) bool {
+	return c.global.Enabled(lvl) || c.levels.enabled(lvl)
+}
+
+func (c *serviceLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &serviceLevelCore{Core: c.Core.With(fields), global: c.global, levels: c.levels}
+}
+
+func (c *serviceLevelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if lvl, ok := c.levels.level(ent.LoggerName); ok {
+		if !lvl.Enabled(ent.Level) {
+			return ce
+		}
+	} else if !c.global.Enabled(ent.Level) {
+		return ce
+	}
+	return c.Core.Check(ent, ce)
+}
+
+var _ zapcore.Core = &debugSamplerCore{}
+
+// debugSamplerCore samples debug entries, which are logged at high volume, and passes all other
+// entries through unchanged.
+type debugSamplerCore struct {
+	zapcore.Core
+	sampled zapcore.Core
+}
+
+// newDebugSamplerCore returns a core which logs the first entries with a given message each tick,
+// and every thereafter-th entry after that. Sampling is disabled if first is zero.
+func newDebugSamplerCore(core zapcore.Core, tick time.Duration, first, thereafter int) zapcore.Core {
+	if first <= 0 {
+		return core
+	}
+	return &debugSamplerCore{
+		Core:    core,
+		sampled: zapcore.NewSamplerWithOptions(core, tick, first, thereafter),
+	}
+}
+
+func (c *debugSamplerCore) With(fields []zapcore.Field) zapcore.Core {
+	return &debugSamplerCore{Core: c.Core.With(fields), sampled: c.sampled.With(fields)}
+}
+
+func (c *debugSamplerCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level == zapcore.DebugLevel {
+		return c.sampled.Check(ent, ce)
+	}
+	return c.Core.Check(ent, ce)
+}
@@ -0,0 +1,131 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newServiceLevelsLogger(t *testing.T, levels *serviceLevels) (*zap.SugaredLogger, *observer.ObservedLogs) {
+	t.Helper()
+	core, logs := observer.New(zapcore.DebugLevel)
+	global := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	return zap.New(newServiceLevelCore(core, global, levels)).Sugar().Named("1.0.0@abcdef0"), logs
+}
+
+func TestServiceLevels_Set(t *testing.T) {
+	levels := newServiceLevels()
+
+	_, err := levels.set(" ", zapcore.DebugLevel, 0)
+	require.Error(t, err)
+	_, err = levels.set("EVM", zapcore.FatalLevel, 0)
+	require.Error(t, err)
+	_, err = levels.set("EVM", zapcore.DebugLevel, -time.Second)
+	require.Error(t, err)
+
+	sl, err := levels.set(".EVM.1.", zapcore.ErrorLevel, 0)
+	require.NoError(t, err)
+	assert.Equal(t, ServiceLogLevel{Name: "EVM.1", Level: zapcore.ErrorLevel}, sl)
+
+	sl, err = levels.set("EVM.1.Txm", zapcore.DebugLevel, time.Hour)
+	require.NoError(t, err)
+	require.NotNil(t, sl.Expires)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *sl.Expires, time.Minute)
+
+	sls := levels.list()
+	require.Len(t, sls, 2)
+	assert.Equal(t, "EVM.1", sls[0].Name)
+	assert.Equal(t, "EVM.1.Txm", sls[1].Name)
+	assert.True(t, levels.enabled(zapcore.DebugLevel))
+
+	assert.True(t, levels.unset("EVM.1.Txm"))
+	assert.False(t, levels.unset("EVM.1.Txm"))
+	assert.False(t, levels.enabled(zapcore.DebugLevel))
+	assert.True(t, levels.enabled(zapcore.ErrorLevel))
+
+	assert.True(t, levels.unset("EVM.1"))
+	assert.True(t, levels.empty())
+}
+
+func TestServiceLevels_Expires(t *testing.T) {
+	levels := newServiceLevels()
+
+	_, err := levels.set("OCR", zapcore.DebugLevel, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Eventually(t, levels.empty, time.Second, 5*time.Millisecond)
+
+	// replacing a level stops it expiring
+	_, err = levels.set("OCR", zapcore.DebugLevel, 10*time.Millisecond)
+	require.NoError(t, err)
+	_, err = levels.set("OCR", zapcore.WarnLevel, 0)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, levels.list(), 1)
+}
+
+func TestServiceLevelCore(t *testing.T) {
+	levels := newServiceLevels()
+	lggr, logs := newServiceLevelsLogger(t, levels)
+	txm := lggr.Named("EVM").Named("1").Named("Txm")
+	txm2 := lggr.Named("EVM").Named("2").Named("Txm")
+	ocr := lggr.Named("OCR").Named("job-42")
+
+	txm.Debug("hidden")
+	ocr.Info("shown")
+	require.Equal(t, 1, logs.Len())
+
+	_, err := levels.set("EVM.1.Txm", zapcore.DebugLevel, 0)
+	require.NoError(t, err)
+	_, err = levels.set("OCR", zapcore.ErrorLevel, 0)
+	require.NoError(t, err)
+
+	txm.Debug("txm debug")
+	txm.Named("Broadcaster").Debug("txm child debug")
+	txm2.Debug("other chain debug")
+	ocr.Warn("ocr warn")
+	ocr.Error("ocr error")
+
+	var msgs []string
+	for _, entry := range logs.TakeAll()[1:] {
+		msgs = append(msgs, entry.Message)
+	}
+	assert.Equal(t, []string{"txm debug", "txm child debug", "ocr error"}, msgs)
+
+	// leading names can be omitted, and the most specific match wins
+	_, err = levels.set("Txm", zapcore.DebugLevel, 0)
+	require.NoError(t, err)
+	_, err = levels.set("OCR.job-42", zapcore.DebugLevel, 0)
+	require.NoError(t, err)
+
+	txm2.Debug("other chain debug")
+	ocr.Debug("ocr debug")
+	lggr.Named("OCR").Named("job-43").Warn("other job warn")
+	// names only match whole segments
+	lggr.Named("OCR2").Warn("ocr2 warn")
+
+	msgs = nil
+	for _, entry := range logs.TakeAll() {
+		msgs = append(msgs, entry.Message)
+	}
+	assert.Equal(t, []string{"other chain debug", "ocr debug", "ocr2 warn"}, msgs)
+}
+
+func TestDebugSamplerCore(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	assert.Equal(t, core, newDebugSamplerCore(core, time.Minute, 0, 10))
+
+	lggr := zap.New(newDebugSamplerCore(core, time.Minute, 2, 10)).With(zap.String("foo", "bar"))
+	for i := 0; i < 25; i++ {
+		lggr.Debug("debug")
+		lggr.Info("info")
+	}
+
+	assert.Equal(t, 25, logs.FilterMessage("info").Len())
+	// the first 2, then every 10th
+	assert.Equal(t, 4, logs.FilterMessage("debug").Len())
+}
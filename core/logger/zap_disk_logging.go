@@ -40,6 +40,8 @@ type zapDiskLoggerConfig struct {
 	local          Config
 	diskStats      utils.DiskStatsProvider
 	diskPollConfig zapDiskPollConfig
+	// serviceLevels overrides the console and disk log levels of named loggers, defaulting to globalServiceLevels.
+	serviceLevels *serviceLevels
 
 	// This is for tests only
 	testDiskLogLvlChan chan zapcore.Level
@@ -64,7 +66,17 @@ func (cfg zapDiskLoggerConfig) newDiskCore(diskLogLevel zap.AtomicLevel) (zapcor
 		allLogLevels = zap.LevelEnablerFunc(diskLogLevel.Enabled)
 	)
 
-	return zapcore.NewCore(encoder, sink, allLogLevels), nil
+	// the disk core still drops everything while the disk is short of space
+	core := zapcore.NewCore(encoder, sink, allLogLevels)
+
+	return newServiceLevelCore(core, diskLogLevel, cfg.levels()), nil
+}
+
+func (cfg zapDiskLoggerConfig) levels() *serviceLevels {
+	if cfg.serviceLevels == nil {
+		return globalServiceLevels
+	}
+	return cfg.serviceLevels
 }
 
 type zapDiskLogger struct {
@@ -90,7 +102,7 @@ func (cfg zapDiskLoggerConfig) newLogger(zcfg zap.Config, cores ...zapcore.Core)
 		cores = append(cores, diskCore)
 	}
 
-	core := zapcore.NewTee(cores...)
+	core := newDebugSamplerCore(zapcore.NewTee(cores...), time.Second, cfg.local.SamplingDebugFirst, cfg.local.SamplingDebugThereafter)
 	lggr := &zapDiskLogger{
 		config:            cfg,
 		pollDiskSpaceStop: make(chan struct{}),
@@ -139,9 +151,10 @@ func (cfg zapDiskLoggerConfig) newCore(zcfg zap.Config) (zapcore.Core, zapcore.W
 		return nil, nil, errors.New("missing Level")
 	}
 
-	filteredLogLevels := zap.LevelEnablerFunc(zcfg.Level.Enabled)
+	// the console core accepts all levels, leaving filtering to the global and service levels
+	core := zapcore.NewCore(encoder, sink, zapcore.DebugLevel)
 
-	return zapcore.NewCore(encoder, sink, filteredLogLevels), errSink, nil
+	return newServiceLevelCore(core, zcfg.Level, cfg.levels()), errSink, nil
 }
 
 func (l *zapDiskLogger) pollDiskSpace() {
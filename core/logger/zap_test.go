@@ -286,3 +286,53 @@ func TestZapLogger_LogCaller(t *testing.T) {
 
 	require.Contains(t, lines[0], "logger/zap_test.go:275")
 }
+
+func TestZapLogger_ServiceLevelsOnDisk(t *testing.T) {
+	cfg := newZapConfigBase()
+	cfg.Level.SetLevel(zapcore.InfoLevel)
+
+	maxSize, invalid := envvar.LogFileMaxSize.Parse()
+	assert.Empty(t, invalid)
+
+	logsDir := t.TempDir()
+	diskMock := &utilsmocks.DiskStatsProvider{}
+	diskMock.On("AvailableSpace", logsDir).Return(maxSize*10, nil)
+	defer diskMock.AssertExpectations(t)
+
+	pollChan := make(chan time.Time)
+	levels := newServiceLevels()
+	zapCfg := zapDiskLoggerConfig{
+		local: Config{
+			Dir:            logsDir,
+			FileMaxAgeDays: 1,
+			FileMaxBackups: 1,
+			FileMaxSizeMB:  int(maxSize/utils.MB) * 2,
+		},
+		diskStats: diskMock,
+		diskPollConfig: zapDiskPollConfig{
+			stop:     func() { close(pollChan) },
+			pollChan: pollChan,
+		},
+		serviceLevels: levels,
+	}
+
+	lggr, closeLggr, err := zapCfg.newLogger(cfg)
+	require.NoError(t, err)
+	defer closeLggr()
+
+	_, err = levels.set("OCR", zapcore.ErrorLevel, 0)
+	require.NoError(t, err)
+
+	lggr.Named("OCR").Warn("ocr warn")
+	lggr.Named("OCR").Error("ocr error")
+	lggr.Named("Txm").Debug("txm debug")
+	require.NoError(t, lggr.Sync())
+
+	b, err := ioutil.ReadFile(filepath.Join(logsDir, LogsFile))
+	require.NoError(t, err)
+	logs := string(b)
+	assert.NotContains(t, logs, "ocr warn")
+	assert.Contains(t, logs, "ocr error")
+	// the disk keeps debug logs of services without a level
+	assert.Contains(t, logs, "txm debug")
+}
@@ -6,7 +6,9 @@ import (
 	"math/big"
 	"net/http"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -14,6 +16,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
 
 	pkgsolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
 	starknetrelay "github.com/smartcontractkit/chainlink-starknet/pkg/chainlink"
@@ -23,6 +26,7 @@ import (
 	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -40,6 +44,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
@@ -52,6 +57,7 @@ import (
 	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -63,6 +69,9 @@ import (
 // Application implements the common functions used in the core node.
 type Application interface {
 	Start(ctx context.Context) error
+	// WarmUp does the part of the startup work that is safe to do while the
+	// node is on standby in high availability mode.
+	WarmUp(ctx context.Context) error
 	Stop() error
 	GetLogger() logger.Logger
 	GetHealthChecker() services.Checker
@@ -71,13 +80,20 @@ type Application interface {
 	// ConfigDump returns a TOML configuration from the current environment and database configuration.
 	ConfigDump(context.Context) (string, error)
 	SetLogLevel(lvl zapcore.Level) error
+	// SetServiceLogLevel overrides the log level of the named logger and its descendants, until
+	// expiresIn has passed if it is positive.
+	SetServiceLogLevel(name string, lvl zapcore.Level, expiresIn time.Duration) (logger.ServiceLogLevel, error)
+	UnsetServiceLogLevel(name string) bool
+	ServiceLogLevels() []logger.ServiceLogLevel
 	GetKeyStore() keystore.Master
 	GetEventBroadcaster() pg.EventBroadcaster
+	GetHAStatus() pg.HAStatus
 	WakeSessionReaper()
 	GetWebAuthnConfiguration() sessions.WebAuthnConfiguration
 
 	GetExternalInitiatorManager() webhook.ExternalInitiatorManager
 	GetChains() Chains
+	TxHistory() chains.TxHistory
 
 	// V2 Jobs (TOML specified)
 	JobSpawner() job.Spawner
@@ -88,15 +104,22 @@ type Application interface {
 	SessionORM() sessions.ORM
 	TxmORM() txmgr.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
+	UpdateJob(ctx context.Context, job *job.Job, spec string, link *templates.JobTemplate) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	PauseJob(ctx context.Context, jobID int32) error
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	RerunJobRun(ctx context.Context, runID int64, opts job.RerunOpts) (int64, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// Pipeline templates
+	GetTemplatesService() templates.Service
+
 	// ReplayFromBlock replays logs from on or after the given block number. If forceBroadcast is
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
@@ -119,6 +142,7 @@ type ChainlinkApplication struct {
 	sessionORM               sessions.ORM
 	txmORM                   txmgr.ORM
 	FeedsService             feeds.Service
+	TemplatesService         templates.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   config.GeneralConfig
 	KeyStore                 keystore.Master
@@ -128,6 +152,7 @@ type ChainlinkApplication struct {
 	explorerClient           synchronization.ExplorerClient
 	subservices              []services.ServiceCtx
 	HealthChecker            services.Checker
+	HAStatus                 pg.HAStatus
 	Nurse                    *services.Nurse
 	logger                   logger.Logger
 	closeLogger              func() error
@@ -149,6 +174,8 @@ type ApplicationOpts struct {
 	Version                  string
 	RestrictedHTTPClient     *http.Client
 	UnrestrictedHTTPClient   *http.Client
+	// Notifier is optional, and must be the one passed to the chain sets
+	Notifier notifications.Notifier
 }
 
 // Chains holds a ChainSet for each type of chain.
@@ -192,6 +219,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	restrictedHTTPClient := opts.RestrictedHTTPClient
 	unrestrictedHTTPClient := opts.UnrestrictedHTTPClient
 
+	var notifier notifications.Notifier = notifications.NullNotifier{}
+	if opts.Notifier != nil {
+		// Started first and closed last, so that no events are dropped
+		notifier = opts.Notifier
+		subservices = append(subservices, notifier)
+	}
+
 	var nurse *services.Nurse
 	if cfg.AutoPprofEnabled() {
 		globalLogger.Info("Nurse service (automatic pprof profiling) is enabled")
@@ -256,8 +290,8 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
 		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), keyStore.Secrets(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM         = job.NewNotifyingORM(job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg), notifier)
 		txmORM         = txmgr.NewORM(db, globalLogger, cfg)
 	)
 
@@ -401,8 +435,9 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	// We start the log poller after the job spawner
 	// so jobs have a chance to apply their initial log filters.
-	if cfg.FeatureLogPoller() {
-		for _, c := range chains.EVM.Chains() {
+	// Chains whose log broadcaster reads from the log poller always need it.
+	for _, c := range chains.EVM.Chains() {
+		if cfg.FeatureLogPoller() || c.Config().EvmLogBroadcasterUseLogPoller() {
 			subservices = append(subservices, c.LogPoller())
 		}
 	}
@@ -434,6 +469,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		sessionORM:               sessionORM,
 		txmORM:                   txmORM,
 		FeedsService:             feedsService,
+		TemplatesService:         templates.NewService(templates.NewORM(db, globalLogger, cfg), jobORM, jobSpawner, db, globalLogger, cfg),
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
 		KeyStore:                 keyStore,
@@ -441,6 +477,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		ExternalInitiatorManager: externalInitiatorManager,
 		explorerClient:           explorerClient,
 		HealthChecker:            healthChecker,
+		HAStatus:                 pg.NewHAStatus(db, cfg.AppID(), cfg.DatabaseHAEnabled(), globalLogger, cfg),
 		Nurse:                    nurse,
 		logger:                   globalLogger,
 		closeLogger:              opts.CloseLogger,
@@ -470,6 +507,50 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// SetServiceLogLevel sets a runtime log level for the named logger subtree.
+func (app *ChainlinkApplication) SetServiceLogLevel(name string, lvl zapcore.Level, expiresIn time.Duration) (logger.ServiceLogLevel, error) {
+	sl, err := logger.SetServiceLogLevel(name, lvl, expiresIn)
+	if err != nil {
+		return sl, err
+	}
+	app.logger.Infow("Service log level set", "service", sl.Name, "level", sl.Level, "expires", sl.Expires)
+	return sl, nil
+}
+
+// UnsetServiceLogLevel removes the runtime log level of the named logger subtree, which reverts to
+// the global log level.
+func (app *ChainlinkApplication) UnsetServiceLogLevel(name string) bool {
+	ok := logger.UnsetServiceLogLevel(name)
+	if ok {
+		app.logger.Infow("Service log level unset", "service", name)
+	}
+	return ok
+}
+
+// ServiceLogLevels returns the runtime log levels of named logger subtrees.
+func (app *ChainlinkApplication) ServiceLogLevels() []logger.ServiceLogLevel {
+	return logger.ServiceLogLevels()
+}
+
+// WarmUp gives services which implement services.Warmer the chance to do
+// part of their startup work, e.g. dialing RPC nodes, while the node is on
+// standby and waiting to acquire the database lease. Services are warmed in the
+// same order they are started, and Start must still be called afterwards.
+func (app *ChainlinkApplication) WarmUp(ctx context.Context) error {
+	for _, subservice := range app.subservices {
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "aborting warm up")
+		}
+		if warmer, ok := subservice.(services.Warmer); ok {
+			app.logger.Debugw("Warming up service...", "serviceType", reflect.TypeOf(subservice))
+			if err := warmer.Warm(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -621,6 +702,22 @@ func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error
 	return app.jobSpawner.CreateJob(j, pg.WithParentCtx(ctx))
 }
 
+// UpdateJob replaces the spec of an existing job in place and records spec as
+// its next version. link is the template the spec was rendered from, if any.
+func (app *ChainlinkApplication) UpdateJob(ctx context.Context, j *job.Job, spec string, link *templates.JobTemplate) error {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(j.ID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.TemplatesService.UpdateJob(ctx, j, spec, link)
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	// Do not allow the job to be deleted if it is managed by the Feeds Manager
 	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
@@ -635,8 +732,28 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(jobID, pg.WithParentCtx(ctx))
 }
 
+// PauseJob stops the services of the job and rejects runs of it until it is
+// resumed.
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(jobID, pg.WithParentCtx(ctx))
+}
+
+// ResumeJob restarts the services of a paused job.
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(jobID, pg.WithParentCtx(ctx))
+}
+
 func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
-	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	runID, err := app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+	if errors.Is(err, webhook.ErrJobNotExists) {
+		// Paused webhook jobs are not registered with the runner, so tell
+		// them apart from jobs that do not exist.
+		jb, err2 := app.jobORM.FindJobByExternalJobID(jobUUID, pg.WithParentCtx(ctx))
+		if err2 == nil && jb.IsPaused() {
+			return 0, job.ErrJobPaused
+		}
+	}
+	return runID, err
 }
 
 // Only used for local testing, not supported by the UI.
@@ -652,6 +769,9 @@ func (app *ChainlinkApplication) RunJobV2(
 	if err != nil {
 		return 0, errors.Wrapf(err, "job ID %v", jobID)
 	}
+	if jb.IsPaused() {
+		return 0, errors.Wrapf(job.ErrJobPaused, "job ID %v", jobID)
+	}
 	var runID int64
 
 	// Some jobs are special in that they do not have a task graph.
@@ -711,10 +831,67 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// RerunJobRun executes the pipeline of a finished run again, with the same
+// jobSpec and jobRun variables, and returns the ID of the new run. The new
+// run is linked to the original one.
+func (app *ChainlinkApplication) RerunJobRun(ctx context.Context, runID int64, opts job.RerunOpts) (int64, error) {
+	run, err := app.jobORM.FindPipelineRunByID(runID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "run ID %v", runID)
+	}
+	if !run.FinishedAt.Valid {
+		return 0, errors.Wrapf(job.ErrRunNotFinished, "run ID %v", runID)
+	}
+	inputs, ok := run.Inputs.Val.(map[string]interface{})
+	if !run.Inputs.Valid || !ok {
+		return 0, errors.Errorf("run ID %v has no recorded inputs", runID)
+	}
+
+	jb, err := app.jobORM.FindJob(ctx, run.PipelineSpec.JobID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "job ID %v", run.PipelineSpec.JobID)
+	}
+	if jb.IsPaused() {
+		return 0, errors.Wrapf(job.ErrJobPaused, "job ID %v", jb.ID)
+	}
+
+	spec := *jb.PipelineSpec
+	if opts.OriginalSpec {
+		// Updating a job keeps the pipeline specs of its previous runs, so
+		// the rerun references the same spec as the run
+		spec.ID = run.PipelineSpecID
+		spec.DotDagSource = run.PipelineSpec.DotDagSource
+		spec.MaxTaskDuration = run.PipelineSpec.MaxTaskDuration
+	}
+
+	p, err := pipeline.Parse(spec.DotDagSource)
+	if err != nil {
+		return 0, err
+	}
+	if tasks := p.SideEffectingTasks(); len(tasks) > 0 && !opts.ConfirmSideEffects {
+		var dotIDs []string
+		for _, task := range tasks {
+			dotIDs = append(dotIDs, task.DotID())
+		}
+		return 0, errors.Wrapf(job.ErrRerunNotConfirmed, "tasks %s", strings.Join(dotIDs, ", "))
+	}
+
+	rerun := pipeline.NewRun(spec, pipeline.NewVarsFrom(inputs))
+	rerun.RerunOfID = null.IntFrom(runID)
+	if _, err = app.pipelineRunner.Run(ctx, &rerun, app.logger.With("rerunOfID", runID), true, nil); err != nil {
+		return 0, errors.Wrapf(err, "failed to rerun run ID %v", runID)
+	}
+	return rerun.ID, nil
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) GetTemplatesService() templates.Service {
+	return app.TemplatesService
+}
+
 // ReplayFromBlock implements the Application interface.
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	chain, err := app.Chains.EVM.Get(chainID)
@@ -730,10 +907,41 @@ func (app *ChainlinkApplication) GetChains() Chains {
 	return app.Chains
 }
 
+// TxHistory returns the transactions sent by the tx managers of every chain.
+func (app *ChainlinkApplication) TxHistory() chains.TxHistory {
+	histories := []chains.TxHistory{app.txmORM}
+	if app.Chains.Solana != nil {
+		for _, ch := range app.Chains.Solana.Chains() {
+			if history, ok := ch.TxManager().(chains.TxHistory); ok {
+				histories = append(histories, history)
+			}
+		}
+	}
+	if app.Chains.Terra != nil {
+		for _, ch := range app.Chains.Terra.Chains() {
+			if history, ok := ch.TxManager().(chains.TxHistory); ok {
+				histories = append(histories, history)
+			}
+		}
+	}
+	if app.Chains.StarkNet != nil {
+		for _, ch := range app.Chains.StarkNet.Chains() {
+			if ch, ok := ch.(starknet.Chain); ok {
+				histories = append(histories, ch.TxManager())
+			}
+		}
+	}
+	return chains.NewMultiTxHistory(histories...)
+}
+
 func (app *ChainlinkApplication) GetEventBroadcaster() pg.EventBroadcaster {
 	return app.EventBroadcaster
 }
 
+func (app *ChainlinkApplication) GetHAStatus() pg.HAStatus {
+	return app.HAStatus
+}
+
 func (app *ChainlinkApplication) GetSqlxDB() *sqlx.DB {
 	return app.sqlxDB
 }
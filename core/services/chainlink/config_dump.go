@@ -235,6 +235,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].LogBackfillBatchSize = e
 		}
 	}
+	if e := envvar.NewBool("EvmLogBroadcasterUseLogPoller").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].LogBroadcasterUseLogPoller = e
+		}
+	}
 	if e := envvar.NewDuration("EvmLogPollInterval").ParsePtr(); e != nil {
 		d := models.MustNewDuration(*e)
 		for i := range c.EVM {
@@ -300,6 +305,15 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].NodePool.PollInterval = d
 		}
 	}
+	if e := envvar.NewDuration("NodeHTTPPollInterval").ParsePtr(); e != nil {
+		d := models.MustNewDuration(*e)
+		for i := range c.EVM {
+			if c.EVM[i].NodePool == nil {
+				c.EVM[i].NodePool = &evmcfg.NodePool{}
+			}
+			c.EVM[i].NodePool.HTTPPollInterval = d
+		}
+	}
 	for i := range c.EVM {
 		if isZeroPtr(c.EVM[i].NodePool) {
 			c.EVM[i].NodePool = nil
@@ -543,11 +557,47 @@ func (c *Config) loadLegacyEVMEnv() {
 			}
 		}
 	}
+	if e := envvar.New("FeeHistoryEstimatorBaseFeeMultiplier", decimal.NewFromString).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].GasEstimator == nil {
+				c.EVM[i].GasEstimator = &evmcfg.GasEstimator{}
+			}
+			if c.EVM[i].GasEstimator.FeeHistory == nil {
+				c.EVM[i].GasEstimator.FeeHistory = &evmcfg.FeeHistoryEstimator{}
+			}
+			c.EVM[i].GasEstimator.FeeHistory.BaseFeeMultiplier = e
+		}
+	}
+	if e := envvar.NewUint16("FeeHistoryEstimatorBlockCount").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].GasEstimator == nil {
+				c.EVM[i].GasEstimator = &evmcfg.GasEstimator{}
+			}
+			if c.EVM[i].GasEstimator.FeeHistory == nil {
+				c.EVM[i].GasEstimator.FeeHistory = &evmcfg.FeeHistoryEstimator{}
+			}
+			c.EVM[i].GasEstimator.FeeHistory.BlockCount = e
+		}
+	}
+	if e := envvar.NewUint16("FeeHistoryEstimatorRewardPercentile").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].GasEstimator == nil {
+				c.EVM[i].GasEstimator = &evmcfg.GasEstimator{}
+			}
+			if c.EVM[i].GasEstimator.FeeHistory == nil {
+				c.EVM[i].GasEstimator.FeeHistory = &evmcfg.FeeHistoryEstimator{}
+			}
+			c.EVM[i].GasEstimator.FeeHistory.RewardPercentile = e
+		}
+	}
 	for i := range c.EVM {
 		if c.EVM[i].GasEstimator != nil {
 			if isZeroPtr(c.EVM[i].GasEstimator.BlockHistory) {
 				c.EVM[i].GasEstimator.BlockHistory = nil
 			}
+			if isZeroPtr(c.EVM[i].GasEstimator.FeeHistory) {
+				c.EVM[i].GasEstimator.FeeHistory = nil
+			}
 			if isZeroPtr(c.EVM[i].GasEstimator) {
 				c.EVM[i].GasEstimator = nil
 			}
@@ -573,6 +623,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].UseForwarders = e
 		}
 	}
+	if e := envvar.New("EvmForwarderOwners", parseEIP55Addresses).ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].ForwarderOwners = e
+		}
+	}
 }
 
 // loadLegacyCoreEnv loads Core values from legacy environment variables.
@@ -611,6 +666,7 @@ func (c *Config) loadLegacyCoreEnv() {
 			AdvisoryID:            envvar.AdvisoryLockID.ParsePtr(),
 			LeaseDuration:         envDuration("LeaseLockDuration"),
 			LeaseRefreshInterval:  envDuration("LeaseLockRefreshInterval"),
+			HAEnabled:             envvar.NewBool("DatabaseHAEnabled").ParsePtr(),
 		},
 		Backup: &config.DatabaseBackup{
 			Dir:              envvar.NewString("DatabaseBackupDir").ParsePtr(),
@@ -888,6 +944,18 @@ func envStringSlice(s string) *[]string {
 	}).ParsePtr()
 }
 
+func parseEIP55Addresses(s string) ([]ethkey.EIP55Address, error) {
+	addrs, err := parse.Addresses(s)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]ethkey.EIP55Address, len(addrs))
+	for i, a := range addrs {
+		v[i] = ethkey.EIP55AddressFromAddress(a)
+	}
+	return v, nil
+}
+
 func envBig(s string) *utils.Big {
 	return envvar.New(s, func(s string) (b utils.Big, err error) {
 		err = b.UnmarshalText([]byte(s))
@@ -208,6 +208,7 @@ func TestConfig_Marshal(t *testing.T) {
 			AdvisoryID:            ptr[int64](345982730592843),
 			LeaseDuration:         &minute,
 			LeaseRefreshInterval:  &second,
+			HAEnabled:             ptr(true),
 		},
 		Backup: &config.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
@@ -367,6 +368,7 @@ func TestConfig_Marshal(t *testing.T) {
 				ChainType:            ptr("Optimism"),
 				FinalityDepth:        ptr[uint32](42),
 				FlagsContractAddress: mustAddress("0xae4E781a6218A8031764928E88d457937A954fC3"),
+				ForwarderOwners:      &[]ethkey.EIP55Address{*mustAddress("0xa5B85635Be42F21f94F28034B7DA440EeFF0F418")},
 
 				GasEstimator: &evmcfg.GasEstimator{
 					Mode:               ptr("L2Suggested"),
@@ -392,6 +394,11 @@ func TestConfig_Marshal(t *testing.T) {
 						EIP1559FeeCapBufferBlocks: ptr[uint16](13),
 						TransactionPercentile:     ptr[uint16](15),
 					},
+					FeeHistory: &evmcfg.FeeHistoryEstimator{
+						BaseFeeMultiplier: mustDecimal("1.5"),
+						BlockCount:        ptr[uint16](16),
+						RewardPercentile:  ptr[uint16](40),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -403,9 +410,10 @@ func TestConfig_Marshal(t *testing.T) {
 					},
 				},
 
-				LinkContractAddress:  mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
-				LogBackfillBatchSize: ptr[uint32](17),
-				LogPollInterval:      &minute,
+				LinkContractAddress:        mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
+				LogBackfillBatchSize:       ptr[uint32](17),
+				LogBroadcasterUseLogPoller: ptr(true),
+				LogPollInterval:            &minute,
 
 				MaxInFlightTransactions:  ptr[uint32](19),
 				MaxQueuedTransactions:    ptr[uint32](99),
@@ -433,6 +441,7 @@ func TestConfig_Marshal(t *testing.T) {
 					NoNewHeadsThreshold:  &minute,
 					PollFailureThreshold: ptr[uint32](5),
 					PollInterval:         &minute,
+					HTTPPollInterval:     &second,
 				},
 				OCR: &evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -554,6 +563,7 @@ AdvisoryCheckInterval = '5m0s'
 AdvisoryID = 345982730592843
 LeaseDuration = '1m0s'
 LeaseRefreshInterval = '1s'
+HAEnabled = true
 `},
 		{"TelemetryIngress", Config{Core: config.Core{TelemetryIngress: full.TelemetryIngress}}, `
 [TelemetryIngress]
@@ -711,8 +721,10 @@ BlockBackfillSkip = true
 ChainType = 'Optimism'
 FinalityDepth = 42
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
+ForwarderOwners = ['0xa5B85635Be42F21f94F28034B7DA440EeFF0F418']
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
+LogBroadcasterUseLogPoller = true
 LogPollInterval = '1m0s'
 MaxInFlightTransactions = 19
 MaxQueuedTransactions = 99
@@ -754,6 +766,11 @@ BlockHistorySize = 12
 EIP1559FeeCapBufferBlocks = 13
 TransactionPercentile = 15
 
+[EVM.GasEstimator.FeeHistory]
+BaseFeeMultiplier = '1.5'
+BlockCount = 16
+RewardPercentile = 40
+
 [EVM.HeadTracker]
 BlockEmissionIdleWarningThreshold = '1h0m0s'
 HistoryDepth = 15
@@ -770,6 +787,7 @@ PriceMax = '79.228162514264337593543950335 gether'
 NoNewHeadsThreshold = '1m0s'
 PollFailureThreshold = 5
 PollInterval = '1m0s'
+HTTPPollInterval = '1s'
 
 [EVM.OCR]
 ContractConfirmations = 11
@@ -3,14 +3,21 @@ package directrequest
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
@@ -34,11 +41,30 @@ type (
 	Config interface {
 		MinIncomingConfirmations() uint32
 		MinimumContractPayment() *assets.Link
+		EvmEIP1559DynamicFees() bool
+		EvmMaxGasPriceWei() *big.Int
 	}
 )
 
 var _ job.Delegate = (*Delegate)(nil)
 
+// RejectionReason is why an oracle request was not run.
+type RejectionReason string
+
+const (
+	RejectionDeniedRequester     RejectionReason = "denied_requester"
+	RejectionUnallowedRequester  RejectionReason = "unallowed_requester"
+	RejectionInsufficientPayment RejectionReason = "insufficient_payment"
+	RejectionRateLimited         RejectionReason = "rate_limited"
+)
+
+var PromRejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "direct_request_rejected_requests",
+	Help: "The number of oracle requests which were not run, by reason",
+},
+	[]string{"job_id", "job_name", "reason"},
+)
+
 func NewDelegate(
 	logger logger.Logger,
 	pipelineRunner pipeline.Runner,
@@ -85,10 +111,21 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			"externalJobID", jb.ExternalJobID,
 		)
 
+	var minContractPaymentSpec *pipeline.Spec
+	if concreteSpec.MinContractPaymentSource != "" {
+		minContractPaymentSpec = &pipeline.Spec{
+			DotDagSource:    concreteSpec.MinContractPaymentSource,
+			MaxTaskDuration: jb.PipelineSpec.MaxTaskDuration,
+			JobID:           jb.ID,
+			JobName:         jb.Name.ValueOrZero(),
+		}
+	}
+
 	logListener := &listener{
 		logger:                   svcLogger.Named("DirectRequest"),
 		config:                   chain.Config(),
 		logBroadcaster:           chain.LogBroadcaster(),
+		gasEstimator:             chain.TxManager().GetGasEstimator(),
 		oracle:                   oracle,
 		pipelineRunner:           d.pipelineRunner,
 		pipelineORM:              d.pipelineORM,
@@ -97,7 +134,10 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		mbOracleCancelRequests:   utils.NewHighCapacityMailbox[log.Broadcast](),
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
-		minContractPayment:       concreteSpec.MinContractPayment,
+		deniedRequesters:         concreteSpec.DeniedRequesters,
+		requesterLimiters:        make(map[common.Address]*rate.Limiter),
+		minStaticContractPayment: concreteSpec.MinContractPayment,
+		minContractPaymentSpec:   minContractPaymentSpec,
 		chStop:                   make(chan struct{}),
 	}
 	var services []job.ServiceCtx
@@ -115,6 +155,7 @@ type listener struct {
 	logger                   logger.Logger
 	config                   Config
 	logBroadcaster           log.Broadcaster
+	gasEstimator             gas.Estimator
 	oracle                   operator_wrapper.OperatorInterface
 	pipelineRunner           pipeline.Runner
 	pipelineORM              pipeline.ORM
@@ -125,7 +166,11 @@ type listener struct {
 	mbOracleCancelRequests   *utils.Mailbox[log.Broadcast]
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
-	minContractPayment       *assets.Link
+	deniedRequesters         models.AddressCollection
+	// requesterLimiters is only accessed by processOracleRequests
+	requesterLimiters        map[common.Address]*rate.Limiter
+	minStaticContractPayment *assets.Link
+	minContractPaymentSpec   *pipeline.Spec
 	chStop                   chan struct{}
 	utils.StartStopOnce
 }
@@ -285,29 +330,34 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 		"data", fmt.Sprintf("%0x", request.Data),
 	)
 
+	if l.denyRequester(request.Requester) {
+		l.reject(request, lb, RejectionDeniedRequester)
+		return
+	}
+
 	if !l.allowRequester(request.Requester) {
-		l.logger.Infow("Rejected run for invalid requester",
-			"requester", request.Requester,
+		l.reject(request, lb, RejectionUnallowedRequester,
 			"allowedRequesters", l.requesters.ToStrings(),
 		)
-		l.markLogConsumed(lb)
 		return
 	}
 
-	var minContractPayment *assets.Link
-	if l.minContractPayment != nil {
-		minContractPayment = l.minContractPayment
-	} else {
-		minContractPayment = l.config.MinimumContractPayment()
+	if !l.allowRequest(request.Requester) {
+		l.reject(request, lb, RejectionRateLimited,
+			"requesterRateLimit", l.job.DirectRequestSpec.RequesterRateLimit.Uint32,
+			"requesterRateLimitPeriod", l.job.DirectRequestSpec.RequesterRateLimitPeriod,
+		)
+		return
 	}
+
+	// the minimum payment may run a pipeline, so it is only computed for requests which pass the other checks
+	minContractPayment := l.minContractPayment()
 	if minContractPayment != nil && request.Payment != nil {
 		requestPayment := assets.Link(*request.Payment)
 		if minContractPayment.Cmp(&requestPayment) > 0 {
-			l.logger.Warnw("Rejected run for insufficient payment",
+			l.reject(request, lb, RejectionInsufficientPayment,
 				"minContractPayment", minContractPayment.String(),
-				"requestPayment", requestPayment.String(),
 			)
-			l.markLogConsumed(lb)
 			return
 		}
 	}
@@ -354,6 +404,49 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	}
 }
 
+func (l *listener) denyRequester(requester common.Address) bool {
+	for _, addr := range l.deniedRequesters {
+		if addr == requester {
+			return true
+		}
+	}
+	return false
+}
+
+// allowRequest takes from the rate limit of requester, and returns false if it is exhausted.
+func (l *listener) allowRequest(requester common.Address) bool {
+	spec := l.job.DirectRequestSpec
+	if !spec.RequesterRateLimit.Valid {
+		return true
+	}
+	limiter, ok := l.requesterLimiters[requester]
+	if !ok {
+		limit := int(spec.RequesterRateLimit.Uint32)
+		limiter = rate.NewLimiter(rate.Every(spec.RequesterRateLimitPeriod.Duration()/time.Duration(limit)), limit)
+		l.requesterLimiters[requester] = limiter
+	}
+	return limiter.Allow()
+}
+
+// reject records a request which will not be run, and marks its log consumed. Rejections are recorded in the
+// node log, with the reason, request ID, requester and payment, and counted by PromRejectedRequests. They are
+// not persisted in the database, since requesters could otherwise fill it for the cost of the gas of their
+// requests.
+func (l *listener) reject(request *operator_wrapper.OperatorOracleRequest, lb log.Broadcast, reason RejectionReason, keysAndValues ...interface{}) {
+	var payment string
+	if request.Payment != nil {
+		payment = (*assets.Link)(request.Payment).String()
+	}
+	l.logger.Warnw("Rejected oracle request", append([]interface{}{
+		"reason", string(reason),
+		"requestId", fmt.Sprintf("%0x", request.RequestId),
+		"requester", request.Requester,
+		"payment", payment,
+	}, keysAndValues...)...)
+	PromRejectedRequests.WithLabelValues(strconv.Itoa(int(l.job.ID)), l.job.Name.ValueOrZero(), string(reason)).Inc()
+	l.markLogConsumed(lb)
+}
+
 func (l *listener) allowRequester(requester common.Address) bool {
 	if len(l.requesters) == 0 {
 		return true
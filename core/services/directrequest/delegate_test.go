@@ -2,7 +2,9 @@ package directrequest_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
@@ -10,9 +12,12 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -25,11 +30,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func TestDelegate_ServicesForSpec(t *testing.T) {
@@ -64,6 +71,7 @@ type DirectRequestUniverse struct {
 	jobORM         job.ORM
 	listener       log.Listener
 	logBroadcaster *log_mocks.Broadcaster
+	logs           *observer.ObservedLogs
 	cleanup        func()
 }
 
@@ -76,7 +84,7 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 
 	db := pgtest.NewSqlxDB(t)
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient, LogBroadcaster: broadcaster})
-	lggr := logger.TestLogger(t)
+	lggr, logs := logger.TestLoggerObserved(t, zapcore.InfoLevel)
 	orm := pipeline.NewORM(db, lggr, cfg)
 
 	keyStore := cltest.NewKeyStore(t, db, cfg)
@@ -102,6 +110,7 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 		jobORM:         jobORM,
 		listener:       nil,
 		logBroadcaster: broadcaster,
+		logs:           logs,
 		cleanup:        func() { jobORM.Close() },
 	}
 
@@ -122,6 +131,10 @@ func (uni *DirectRequestUniverse) Cleanup() {
 	uni.cleanup()
 }
 
+func rejectedRequests(jb *job.Job, reason directrequest.RejectionReason) float64 {
+	return promtestutil.ToFloat64(directrequest.PromRejectedRequests.WithLabelValues(strconv.Itoa(int(jb.ID)), jb.Name.ValueOrZero(), string(reason)))
+}
+
 func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 	t.Run("Log is an OracleRequest", func(t *testing.T) {
 		uni := NewDirectRequestUniverse(t)
@@ -538,4 +551,111 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 		uni.logBroadcaster.AssertExpectations(t)
 		uni.runner.AssertExpectations(t)
 	})
+
+	t.Run("log is requested by a denied address", func(t *testing.T) {
+		requester := testutils.NewAddress()
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+		uni := NewDirectRequestUniverseWithConfig(t, cfg, func(jb *job.Job) {
+			jb.DirectRequestSpec.DeniedRequesters = []common.Address{requester}
+		})
+		defer uni.Cleanup()
+
+		log := new(log_mocks.Broadcast)
+		defer log.AssertExpectations(t)
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		logOracleRequest := operator_wrapper.OperatorOracleRequest{
+			RequestId:        [32]byte{1},
+			CancelExpiration: big.NewInt(0),
+			Payment:          big.NewInt(100),
+			Requester:        requester,
+		}
+		log.On("RawLog").Return(types.Log{
+			Topics: []common.Hash{
+				{},
+				uni.spec.ExternalIDEncodeStringToTopic(),
+			},
+		})
+		log.On("DecodedLog").Return(&logOracleRequest)
+		markConsumedLogAwaiter := cltest.NewAwaiter()
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			markConsumedLogAwaiter.ItHappened()
+		}).Return(nil)
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(log)
+
+		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
+
+		uni.service.Close()
+		uni.logBroadcaster.AssertExpectations(t)
+		uni.runner.AssertExpectations(t)
+
+		assert.Equal(t, 1.0, rejectedRequests(uni.spec, directrequest.RejectionDeniedRequester))
+		rejections := uni.logs.FilterMessage("Rejected oracle request").All()
+		require.Len(t, rejections, 1)
+		fields := rejections[0].ContextMap()
+		assert.Equal(t, string(directrequest.RejectionDeniedRequester), fields["reason"])
+		assert.Equal(t, fmt.Sprintf("%0x", logOracleRequest.RequestId), fields["requestId"])
+		assert.Equal(t, "100", fields["payment"])
+	})
+
+	t.Run("requester exceeds the rate limit", func(t *testing.T) {
+		requester := testutils.NewAddress()
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+		cfg.Overrides.GlobalMinimumContractPayment = assets.NewLinkFromJuels(100)
+		uni := NewDirectRequestUniverseWithConfig(t, cfg, func(jb *job.Job) {
+			jb.DirectRequestSpec.RequesterRateLimit = clnull.Uint32From(1)
+			jb.DirectRequestSpec.RequesterRateLimitPeriod = models.Interval(time.Hour)
+		})
+		defer uni.Cleanup()
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		newLog := func(requestID byte) *log_mocks.Broadcast {
+			log := new(log_mocks.Broadcast)
+			log.On("RawLog").Return(types.Log{
+				Topics: []common.Hash{
+					{},
+					uni.spec.ExternalIDEncodeStringToTopic(),
+				},
+			})
+			log.On("DecodedLog").Return(&operator_wrapper.OperatorOracleRequest{
+				RequestId:        [32]byte{requestID},
+				CancelExpiration: big.NewInt(0),
+				Payment:          big.NewInt(100),
+				Requester:        requester,
+			})
+			log.On("ReceiptsRoot").Return(common.Hash{}).Maybe()
+			log.On("TransactionsRoot").Return(common.Hash{}).Maybe()
+			log.On("StateRoot").Return(common.Hash{}).Maybe()
+			return log
+		}
+		markConsumedLogAwaiter := cltest.NewAwaiter()
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			markConsumedLogAwaiter.ItHappened()
+		}).Return(nil)
+		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args.Get(4).(func(pg.Queryer) error)
+			fn(nil)
+		}).Once().Return(false, nil)
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(newLog(1))
+		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
+		markConsumedLogAwaiter = cltest.NewAwaiter()
+		uni.listener.HandleLog(newLog(2))
+		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
+
+		uni.service.Close()
+		uni.runner.AssertExpectations(t)
+
+		assert.Equal(t, 1.0, rejectedRequests(uni.spec, directrequest.RejectionRateLimited))
+		assert.Equal(t, 0.0, rejectedRequests(uni.spec, directrequest.RejectionInsufficientPayment))
+	})
 }
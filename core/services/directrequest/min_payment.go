@@ -0,0 +1,112 @@
+package directrequest
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// minContractPayment returns the minimum payment for a request. When the job sets minContractPaymentGasLimit, this
+// is the greater of the static minimum and the cost of fulfilling the request at the current gas price. If the
+// cost cannot be computed, the static minimum is used.
+func (l *listener) minContractPayment() *assets.Link {
+	static := l.minStaticContractPayment
+	if static == nil {
+		static = l.config.MinimumContractPayment()
+	}
+	if !l.job.DirectRequestSpec.MinContractPaymentGasLimit.Valid {
+		return static
+	}
+	ctx, cancel := utils.ContextFromChan(l.chStop)
+	defer cancel()
+	dynamic, err := l.minDynamicContractPayment(ctx)
+	if err != nil {
+		l.logger.Warnw("Failed to compute minimum contract payment, using the static minimum", "err", err, "minContractPayment", static)
+		return static
+	}
+	if static != nil && static.Cmp(dynamic) > 0 {
+		return static
+	}
+	return dynamic
+}
+
+// minDynamicContractPayment converts the cost of fulfilling a request at the current gas price to juels, using
+// minContractPaymentLinkEthRate. If the job has a minContractPaymentSource, its result is used instead.
+func (l *listener) minDynamicContractPayment(ctx context.Context) (*assets.Link, error) {
+	spec := l.job.DirectRequestSpec
+	gasLimit := spec.MinContractPaymentGasLimit.Uint32
+	gasPrice, err := l.estimateGasPrice(gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var juels *big.Int
+	if spec.MinContractPaymentLinkEthRate != nil {
+		// LINK and ETH both have 18 decimals, so wei / (ETH per LINK) = juels
+		costWei := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(uint64(gasLimit)))
+		juels = decimal.NewFromBigInt(costWei, 0).Div(*spec.MinContractPaymentLinkEthRate).Ceil().BigInt()
+	}
+	if l.minContractPaymentSpec == nil {
+		if juels == nil {
+			return nil, errors.New("neither minContractPaymentLinkEthRate nor minContractPaymentSource is set")
+		}
+		return (*assets.Link)(juels), nil
+	}
+
+	params := map[string]interface{}{
+		"gasPriceWei": gasPrice,
+		"gasLimit":    gasLimit,
+	}
+	if juels != nil {
+		params["linkEthRate"] = *spec.MinContractPaymentLinkEthRate
+		params["juels"] = juels
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    l.job.ID,
+			"externalJobID": l.job.ExternalJobID,
+			"name":          l.job.Name.ValueOrZero(),
+		},
+		"minContractPayment": params,
+	})
+	_, trrs, err := l.pipelineRunner.ExecuteRun(ctx, *l.minContractPaymentSpec, vars, l.logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run minContractPaymentSource")
+	}
+	result, err := trrs.FinalResult(l.logger).SingularResult()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get minContractPaymentSource result")
+	}
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "minContractPaymentSource failed")
+	}
+	asDecimal, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "minContractPaymentSource result is not a number")
+	}
+	return (*assets.Link)(asDecimal.Ceil().BigInt()), nil
+}
+
+// estimateGasPrice returns the gas price a fulfillment would currently pay. For EIP-1559 this is the fee cap,
+// which bounds the price paid.
+func (l *listener) estimateGasPrice(gasLimit uint32) (*big.Int, error) {
+	if l.gasEstimator == nil {
+		return nil, errors.New("gas estimator is not available")
+	}
+	maxGasPrice := l.config.EvmMaxGasPriceWei()
+	if l.config.EvmEIP1559DynamicFees() {
+		fee, _, err := l.gasEstimator.GetDynamicFee(uint64(gasLimit), maxGasPrice)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to estimate gas fee")
+		}
+		return fee.FeeCap, nil
+	}
+	gasPrice, _, err := l.gasEstimator.GetLegacyGas(nil, uint64(gasLimit), maxGasPrice)
+	return gasPrice, errors.Wrap(err, "failed to estimate gas price")
+}
@@ -0,0 +1,186 @@
+package directrequest
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testConfig struct {
+	minimumContractPayment *assets.Link
+	eip1559                bool
+}
+
+func (c testConfig) MinIncomingConfirmations() uint32     { return 1 }
+func (c testConfig) MinimumContractPayment() *assets.Link { return c.minimumContractPayment }
+func (c testConfig) EvmEIP1559DynamicFees() bool          { return c.eip1559 }
+func (c testConfig) EvmMaxGasPriceWei() *big.Int          { return assets.GWei(5000) }
+
+func TestListener_minContractPayment(t *testing.T) {
+	t.Parallel()
+
+	const gasLimit = 100_000
+	gasPrice := assets.GWei(100)
+	// 100 gwei * 100k gas = 0.01 ETH = 2 LINK at 0.005 ETH/LINK
+	linkEthRate := decimal.RequireFromString("0.005")
+	twoLink := assets.NewLinkFromJuels(2_000_000_000_000_000_000)
+
+	newListener := func(t *testing.T, cfg testConfig, spec job.DirectRequestSpec) (*listener, *gasmocks.Estimator) {
+		estimator := gasmocks.NewEstimator(t)
+		return &listener{
+			logger:       logger.TestLogger(t),
+			config:       cfg,
+			gasEstimator: estimator,
+			job:          job.Job{ID: 1, DirectRequestSpec: &spec},
+			chStop:       make(chan struct{}),
+		}, estimator
+	}
+
+	t.Run("static", func(t *testing.T) {
+		l, _ := newListener(t, testConfig{minimumContractPayment: assets.NewLinkFromJuels(100)}, job.DirectRequestSpec{})
+		assert.Equal(t, assets.NewLinkFromJuels(100), l.minContractPayment())
+
+		l.minStaticContractPayment = assets.NewLinkFromJuels(200)
+		assert.Equal(t, assets.NewLinkFromJuels(200), l.minContractPayment())
+	})
+
+	t.Run("legacy gas price", func(t *testing.T) {
+		l, estimator := newListener(t, testConfig{minimumContractPayment: assets.NewLinkFromJuels(100)}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit:    clnull.Uint32From(gasLimit),
+			MinContractPaymentLinkEthRate: &linkEthRate,
+		})
+		estimator.On("GetLegacyGas", []byte(nil), uint64(gasLimit), assets.GWei(5000)).Return(gasPrice, uint64(gasLimit), nil)
+		assert.Equal(t, twoLink, l.minContractPayment())
+	})
+
+	t.Run("dynamic fee", func(t *testing.T) {
+		l, estimator := newListener(t, testConfig{eip1559: true}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit:    clnull.Uint32From(gasLimit),
+			MinContractPaymentLinkEthRate: &linkEthRate,
+		})
+		estimator.On("GetDynamicFee", uint64(gasLimit), assets.GWei(5000)).Return(gas.DynamicFee{FeeCap: gasPrice, TipCap: assets.GWei(1)}, uint64(gasLimit), nil)
+		assert.Equal(t, twoLink, l.minContractPayment())
+	})
+
+	t.Run("static minimum is higher", func(t *testing.T) {
+		threeLink := assets.NewLinkFromJuels(3_000_000_000_000_000_000)
+		l, estimator := newListener(t, testConfig{minimumContractPayment: threeLink}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit:    clnull.Uint32From(gasLimit),
+			MinContractPaymentLinkEthRate: &linkEthRate,
+		})
+		estimator.On("GetLegacyGas", []byte(nil), uint64(gasLimit), assets.GWei(5000)).Return(gasPrice, uint64(gasLimit), nil)
+		assert.Equal(t, threeLink, l.minContractPayment())
+	})
+
+	t.Run("falls back to static minimum when estimation fails", func(t *testing.T) {
+		l, estimator := newListener(t, testConfig{minimumContractPayment: assets.NewLinkFromJuels(100)}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit:    clnull.Uint32From(gasLimit),
+			MinContractPaymentLinkEthRate: &linkEthRate,
+		})
+		estimator.On("GetLegacyGas", []byte(nil), uint64(gasLimit), assets.GWei(5000)).Return(nil, uint64(0), errors.New("boom"))
+		assert.Equal(t, assets.NewLinkFromJuels(100), l.minContractPayment())
+	})
+
+	t.Run("pipeline", func(t *testing.T) {
+		l, estimator := newListener(t, testConfig{}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit:    clnull.Uint32From(gasLimit),
+			MinContractPaymentLinkEthRate: &linkEthRate,
+			MinContractPaymentSource:      `multiply [type=multiply input="$(minContractPayment.juels)" times=2]`,
+		})
+		estimator.On("GetLegacyGas", []byte(nil), uint64(gasLimit), assets.GWei(5000)).Return(gasPrice, uint64(gasLimit), nil)
+		runner := pipelinemocks.NewRunner(t)
+		l.pipelineRunner = runner
+		l.minContractPaymentSpec = &pipeline.Spec{DotDagSource: l.job.DirectRequestSpec.MinContractPaymentSource}
+		runner.On("ExecuteRun", mock.Anything, *l.minContractPaymentSpec, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				vars := args.Get(2).(pipeline.Vars)
+				juels, err := vars.Get("minContractPayment.juels")
+				require.NoError(t, err)
+				assert.Equal(t, twoLink.ToInt(), juels)
+				gasPriceWei, err := vars.Get("minContractPayment.gasPriceWei")
+				require.NoError(t, err)
+				assert.Equal(t, gasPrice, gasPriceWei)
+			}).
+			Return(pipeline.Run{}, pipeline.TaskRunResults{
+				{
+					Result: pipeline.Result{Value: decimal.RequireFromString("4000000000000000000")},
+					Task:   &pipeline.MultiplyTask{},
+				},
+			}, nil)
+		assert.Equal(t, assets.NewLinkFromJuels(4_000_000_000_000_000_000), l.minContractPayment())
+	})
+
+	t.Run("pipeline error", func(t *testing.T) {
+		l, estimator := newListener(t, testConfig{minimumContractPayment: assets.NewLinkFromJuels(100)}, job.DirectRequestSpec{
+			MinContractPaymentGasLimit: clnull.Uint32From(gasLimit),
+			MinContractPaymentSource:   `fail [type=fail msg="boom"]`,
+		})
+		estimator.On("GetLegacyGas", []byte(nil), uint64(gasLimit), assets.GWei(5000)).Return(gasPrice, uint64(gasLimit), nil)
+		runner := pipelinemocks.NewRunner(t)
+		l.pipelineRunner = runner
+		l.minContractPaymentSpec = &pipeline.Spec{DotDagSource: l.job.DirectRequestSpec.MinContractPaymentSource}
+		runner.On("ExecuteRun", mock.Anything, *l.minContractPaymentSpec, mock.Anything, mock.Anything).
+			Return(pipeline.Run{}, pipeline.TaskRunResults{
+				{
+					Result: pipeline.Result{Error: errors.New("boom")},
+					Task:   &pipeline.FailTask{},
+				},
+			}, nil)
+		assert.Equal(t, assets.NewLinkFromJuels(100), l.minContractPayment())
+	})
+}
+
+func TestListener_allowRequest(t *testing.T) {
+	t.Parallel()
+
+	requester := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	other := common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C")
+
+	t.Run("no limit", func(t *testing.T) {
+		l := &listener{job: job.Job{DirectRequestSpec: &job.DirectRequestSpec{}}}
+		for i := 0; i < 100; i++ {
+			assert.True(t, l.allowRequest(requester))
+		}
+	})
+
+	t.Run("limited per requester", func(t *testing.T) {
+		l := &listener{
+			job: job.Job{DirectRequestSpec: &job.DirectRequestSpec{
+				RequesterRateLimit:       clnull.Uint32From(2),
+				RequesterRateLimitPeriod: models.Interval(time.Hour),
+			}},
+			requesterLimiters: make(map[common.Address]*rate.Limiter),
+		}
+		assert.True(t, l.allowRequest(requester))
+		assert.True(t, l.allowRequest(requester))
+		assert.False(t, l.allowRequest(requester))
+		assert.True(t, l.allowRequest(other))
+	})
+}
+
+func TestListener_denyRequester(t *testing.T) {
+	t.Parallel()
+
+	requester := common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+	l := &listener{deniedRequesters: models.AddressCollection{requester}}
+	assert.True(t, l.denyRequester(requester))
+	assert.False(t, l.denyRequester(common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C")))
+}
@@ -3,21 +3,29 @@ package directrequest
 import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type DirectRequestToml struct {
-	ContractAddress          ethkey.EIP55Address      `toml:"contractAddress"`
-	Requesters               models.AddressCollection `toml:"requesters"`
-	MinContractPayment       *assets.Link             `toml:"minContractPaymentLinkJuels"`
-	EVMChainID               *utils.Big               `toml:"evmChainID"`
-	MinIncomingConfirmations null.Uint32              `toml:"minIncomingConfirmations"`
+	ContractAddress               ethkey.EIP55Address      `toml:"contractAddress"`
+	Requesters                    models.AddressCollection `toml:"requesters"`
+	DeniedRequesters              models.AddressCollection `toml:"deniedRequesters"`
+	RequesterRateLimit            null.Uint32              `toml:"requesterRateLimit"`
+	RequesterRateLimitPeriod      models.Interval          `toml:"requesterRateLimitPeriod"`
+	MinContractPayment            *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	MinContractPaymentGasLimit    null.Uint32              `toml:"minContractPaymentGasLimit"`
+	MinContractPaymentLinkEthRate *decimal.Decimal         `toml:"minContractPaymentLinkEthRate"`
+	MinContractPaymentSource      string                   `toml:"minContractPaymentSource"`
+	EVMChainID                    *utils.Big               `toml:"evmChainID"`
+	MinIncomingConfirmations      null.Uint32              `toml:"minIncomingConfirmations"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -36,15 +44,64 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		return jb, err
 	}
 	jb.DirectRequestSpec = &job.DirectRequestSpec{
-		ContractAddress:          spec.ContractAddress,
-		Requesters:               spec.Requesters,
-		MinContractPayment:       spec.MinContractPayment,
-		EVMChainID:               spec.EVMChainID,
-		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		ContractAddress:               spec.ContractAddress,
+		Requesters:                    spec.Requesters,
+		DeniedRequesters:              spec.DeniedRequesters,
+		RequesterRateLimit:            spec.RequesterRateLimit,
+		RequesterRateLimitPeriod:      spec.RequesterRateLimitPeriod,
+		MinContractPayment:            spec.MinContractPayment,
+		MinContractPaymentGasLimit:    spec.MinContractPaymentGasLimit,
+		MinContractPaymentLinkEthRate: spec.MinContractPaymentLinkEthRate,
+		MinContractPaymentSource:      spec.MinContractPaymentSource,
+		EVMChainID:                    spec.EVMChainID,
+		MinIncomingConfirmations:      spec.MinIncomingConfirmations,
 	}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err = validateRequesterRateLimit(spec); err != nil {
+		return jb, err
+	}
+	if err = validateMinContractPayment(spec); err != nil {
+		return jb, err
+	}
 	return jb, nil
 }
+
+func validateRequesterRateLimit(spec DirectRequestToml) error {
+	if !spec.RequesterRateLimit.Valid {
+		return nil
+	}
+	if spec.RequesterRateLimit.Uint32 == 0 {
+		return errors.New("requesterRateLimit must be greater than 0")
+	}
+	if spec.RequesterRateLimitPeriod.Duration() <= 0 {
+		return errors.New("requesterRateLimitPeriod must be set when requesterRateLimit is set")
+	}
+	return nil
+}
+
+func validateMinContractPayment(spec DirectRequestToml) error {
+	if !spec.MinContractPaymentGasLimit.Valid {
+		if spec.MinContractPaymentLinkEthRate != nil || spec.MinContractPaymentSource != "" {
+			return errors.New("minContractPaymentGasLimit must be set to compute the minimum contract payment")
+		}
+		return nil
+	}
+	if spec.MinContractPaymentGasLimit.Uint32 == 0 {
+		return errors.New("minContractPaymentGasLimit must be greater than 0")
+	}
+	if spec.MinContractPaymentLinkEthRate == nil && spec.MinContractPaymentSource == "" {
+		return errors.New("either minContractPaymentLinkEthRate or minContractPaymentSource must be set with minContractPaymentGasLimit")
+	}
+	if spec.MinContractPaymentLinkEthRate != nil && !spec.MinContractPaymentLinkEthRate.IsPositive() {
+		return errors.New("minContractPaymentLinkEthRate must be greater than 0")
+	}
+	if spec.MinContractPaymentSource != "" {
+		if _, err := pipeline.Parse(spec.MinContractPaymentSource); err != nil {
+			return errors.Wrap(err, "invalid minContractPaymentSource")
+		}
+	}
+	return nil
+}
@@ -76,3 +76,65 @@ func TestValidatedDirectRequestSpec_MinIncomingConfirmations(t *testing.T) {
 		assert.Equal(t, uint32(100), s.DirectRequestSpec.MinIncomingConfirmations.Uint32)
 	})
 }
+
+func TestValidatedDirectRequestSpec_Limits(t *testing.T) {
+	t.Parallel()
+
+	const base = `
+type                = "directrequest"
+schemaVersion       = 1
+name                = "example eth request event spec"
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+externalJobID       = "A5AC14E8-7629-4726-B1F1-1AE053FC829E"
+observationSource   = """
+    ds1          [type=http method=GET url="example.com" allowunrestrictednetworkaccess="true"];
+    ds1_parse    [type=jsonparse path="USD"];
+    ds1 -> ds1_parse;
+"""
+`
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := ValidatedDirectRequestSpec(base + `
+deniedRequesters              = ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+requesterRateLimit            = 10
+requesterRateLimitPeriod      = "1m"
+minContractPaymentGasLimit    = 100000
+minContractPaymentLinkEthRate = "0.005"
+minContractPaymentSource      = """
+    multiply [type=multiply input="$(minContractPayment.juels)" times=2];
+"""
+`)
+		require.NoError(t, err)
+		spec := s.DirectRequestSpec
+		assert.Equal(t, []string{"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"}, spec.DeniedRequesters.ToStrings())
+		assert.Equal(t, uint32(10), spec.RequesterRateLimit.Uint32)
+		assert.Equal(t, time.Minute, spec.RequesterRateLimitPeriod.Duration())
+		assert.Equal(t, uint32(100000), spec.MinContractPaymentGasLimit.Uint32)
+		assert.Equal(t, "0.005", spec.MinContractPaymentLinkEthRate.String())
+		assert.Contains(t, spec.MinContractPaymentSource, "type=multiply")
+	})
+
+	for _, tc := range []struct {
+		name string
+		toml string
+		err  string
+	}{
+		{"rate limit without period", `requesterRateLimit = 10`, "requesterRateLimitPeriod must be set"},
+		{"zero rate limit", "requesterRateLimit = 0\nrequesterRateLimitPeriod = \"1m\"", "requesterRateLimit must be greater than 0"},
+		{"rate without gas limit", `minContractPaymentLinkEthRate = "0.005"`, "minContractPaymentGasLimit must be set"},
+		{"gas limit without rate or source", `minContractPaymentGasLimit = 100000`, "either minContractPaymentLinkEthRate or minContractPaymentSource"},
+		{"zero rate", "minContractPaymentGasLimit = 100000\nminContractPaymentLinkEthRate = \"0\"", "minContractPaymentLinkEthRate must be greater than 0"},
+		{"invalid source", "minContractPaymentGasLimit = 100000\nminContractPaymentSource = \"a -> \"", "invalid minContractPaymentSource"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ValidatedDirectRequestSpec(base + tc.toml)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
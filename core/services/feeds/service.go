@@ -808,6 +808,7 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	js.TOMLSpec = spec
 
 	return &js, nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,10 +19,12 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
@@ -511,6 +515,261 @@ func TestORM_CreateJob_OCR_DuplicatedContractAddress(t *testing.T) {
 	})
 }
 
+func TestORM_CreateJob_FromLabel(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	keyStore.OCR().Add(cltest.DefaultOCRKey)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	cltest.MustInsertRandomKey(t, keyStore.Eth())
+	_, labelled := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	require.NoError(t, keyStore.Eth().SetLabels(labelled, []string{"senders"}))
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	ocrSpec := func(label string) string {
+		spec := testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:   bridge.Name.String(),
+			DS2BridgeName:   bridge2.Name.String(),
+			ContractAddress: cltest.NewEIP55Address().Hex(),
+		})
+		return strings.Replace(spec.Toml(), fmt.Sprintf(`transmitterAddress = "%s"`, spec.TransmitterAddress), fmt.Sprintf(`fromLabel = "%s"`, label), 1)
+	}
+
+	t.Run("selects the OCR transmitter by label", func(t *testing.T) {
+		jb, err := ocr.ValidatedOracleSpecToml(cc, ocrSpec("senders"))
+		require.NoError(t, err)
+		require.NoError(t, jobORM.CreateJob(&jb))
+
+		found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.OCROracleSpec.TransmitterAddress)
+		assert.Equal(t, labelled, found.OCROracleSpec.TransmitterAddress.Address())
+	})
+
+	t.Run("selects the keeper from address by label", func(t *testing.T) {
+		jb, err := keeper.ValidatedKeeperSpec(fmt.Sprintf(`
+type            = "keeper"
+schemaVersion   = 1
+contractAddress = "%s"
+fromLabel       = "senders"
+`, cltest.NewEIP55Address()))
+		require.NoError(t, err)
+		require.NoError(t, jobORM.CreateJob(&jb))
+
+		found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, labelled, found.KeeperSpec.FromAddress.Address())
+	})
+
+	t.Run("fails without an enabled key with the label", func(t *testing.T) {
+		jb, err := ocr.ValidatedOracleSpecToml(cc, ocrSpec("unknown"))
+		require.NoError(t, err)
+		err = jobORM.CreateJob(&jb)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `with label "unknown"`)
+
+		require.NoError(t, keyStore.Eth().Disable(labelled, testutils.FixtureChainID))
+		jb, err = ocr.ValidatedOracleSpecToml(cc, ocrSpec("senders"))
+		require.NoError(t, err)
+		err = jobORM.CreateJob(&jb)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `with label "senders"`)
+	})
+}
+
+func TestORM_UpdateJob(t *testing.T) {
+	config := evmtest.NewChainScopedConfig(t, cltest.NewTestGeneralConfig(t))
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	keyStore.OCR().Add(cltest.DefaultOCRKey)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	spec := testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+		DS1BridgeName:      bridge.Name.String(),
+		DS2BridgeName:      bridge2.Name.String(),
+		TransmitterAddress: address.Hex(),
+		Name:               "before",
+	})
+	jb, err := ocr.ValidatedOracleSpecToml(cc, spec.Toml())
+	require.NoError(t, err)
+	jb.TOMLSpec = spec.Toml()
+	require.NoError(t, jobORM.CreateJob(&jb))
+
+	t.Run("records the spec as the first version", func(t *testing.T) {
+		versions, err := jobORM.FindSpecVersions(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, int32(1), versions[0].Version)
+		assert.Equal(t, spec.Toml(), versions[0].TOML)
+	})
+
+	t.Run("updates the job in place", func(t *testing.T) {
+		spec2 := testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge.Name.String(),
+			TransmitterAddress: address.Hex(),
+			Name:               "after",
+		})
+		updated, err := ocr.ValidatedOracleSpecToml(cc, spec2.Toml())
+		require.NoError(t, err)
+		updated.ID = jb.ID
+
+		// A run of the previous version of the job
+		run := pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			State:          pipeline.RunStatusCompleted,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{1}, Valid: true},
+			AllErrors:      pipeline.RunErrors{null.String{}},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			CreatedAt:      time.Now(),
+			FinishedAt:     null.TimeFrom(time.Now()),
+		}
+		require.NoError(t, pipelineORM.InsertFinishedRun(&run, false))
+
+		require.NoError(t, jobORM.UpdateJob(&updated))
+		cltest.AssertCount(t, db, "jobs", 1)
+		cltest.AssertCount(t, db, "ocr_oracle_specs", 1)
+		// The previous pipeline spec is kept for its runs
+		cltest.AssertCount(t, db, "pipeline_specs", 2)
+
+		found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "after", found.Name.ValueOrZero())
+		assert.Equal(t, jb.ExternalJobID, found.ExternalJobID)
+		assert.NotEqual(t, jb.PipelineSpecID, found.PipelineSpecID)
+		assert.Equal(t, updated.PipelineSpecID, found.PipelineSpecID)
+		assert.Equal(t, *jb.OCROracleSpecID, *found.OCROracleSpecID)
+		assert.Equal(t, updated.Pipeline.Source, found.PipelineSpec.DotDagSource)
+
+		var previous pipeline.Spec
+		require.NoError(t, db.Get(&previous, `SELECT * FROM pipeline_specs WHERE id = $1`, jb.PipelineSpecID))
+		assert.Equal(t, jb.Pipeline.Source, previous.DotDagSource)
+
+		// The run history of the job includes runs of the previous version
+		count, err := jobORM.CountPipelineRunsByJobID(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+		runs, _, err := jobORM.PipelineRuns(&jb.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, run.ID, runs[0].ID)
+		assert.Equal(t, jb.Pipeline.Source, runs[0].PipelineSpec.DotDagSource)
+		assert.Equal(t, jb.ID, runs[0].PipelineSpec.JobID)
+
+		jbs, err := jobORM.FindJobsByPipelineSpecIDs([]int32{jb.PipelineSpecID, found.PipelineSpecID})
+		require.NoError(t, err)
+		assert.Len(t, jbs, 2)
+		assert.Equal(t, jb.ID, jbs[jb.PipelineSpecID].ID)
+		assert.Equal(t, jb.ID, jbs[found.PipelineSpecID].ID)
+	})
+
+	t.Run("does not change the job type", func(t *testing.T) {
+		cronJob, err := cron.ValidatedCronSpec(testspecs.CronSpec)
+		require.NoError(t, err)
+		cronJob.ID = jb.ID
+		cronJob.ExternalJobID = jb.ExternalJobID
+
+		err = jobORM.UpdateJob(&cronJob)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot change job type")
+	})
+
+	t.Run("does not change the external job ID", func(t *testing.T) {
+		updated, err := ocr.ValidatedOracleSpecToml(cc, spec.Toml())
+		require.NoError(t, err)
+		updated.ID = jb.ID
+		updated.ExternalJobID = uuid.NewV4()
+
+		err = jobORM.UpdateJob(&updated)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot change externalJobID")
+	})
+
+	t.Run("returns sql.ErrNoRows for a missing job", func(t *testing.T) {
+		updated, err := ocr.ValidatedOracleSpecToml(cc, spec.Toml())
+		require.NoError(t, err)
+		updated.ID = jb.ID + 1
+
+		err = jobORM.UpdateJob(&updated)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("records spec versions", func(t *testing.T) {
+		v2, err := jobORM.InsertSpecVersion(jb.ID, "second")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), v2.Version)
+		v3, err := jobORM.InsertSpecVersion(jb.ID, "third")
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), v3.Version)
+
+		versions, err := jobORM.FindSpecVersions(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, spec.Toml(), versions[0].TOML)
+		assert.Equal(t, "second", versions[1].TOML)
+		assert.Equal(t, "third", versions[2].TOML)
+
+		v, err := jobORM.FindSpecVersion(jb.ID, 2)
+		require.NoError(t, err)
+		assert.Equal(t, "second", v.TOML)
+
+		_, err = jobORM.FindSpecVersion(jb.ID, 4)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		require.NoError(t, jobORM.DeleteJob(jb.ID))
+		cltest.AssertCount(t, db, "job_spec_versions", 0)
+		// The pipeline specs of every version are deleted with the job
+		cltest.AssertCount(t, db, "pipeline_specs", 0)
+		cltest.AssertCount(t, db, "pipeline_runs", 0)
+	})
+}
+
+func TestORM_PauseResumeJob(t *testing.T) {
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	jobORM := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := cron.ValidatedCronSpec(testspecs.CronSpec)
+	require.NoError(t, err)
+	require.NoError(t, jobORM.CreateJob(&jb))
+	assert.False(t, jb.IsPaused())
+
+	require.NoError(t, jobORM.PauseJob(jb.ID))
+	paused, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	require.True(t, paused.IsPaused())
+
+	// Pausing again keeps the original time
+	require.NoError(t, jobORM.PauseJob(jb.ID))
+	found, err := jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	assert.Equal(t, paused.PausedAt.Time, found.PausedAt.Time)
+
+	require.NoError(t, jobORM.ResumeJob(jb.ID))
+	found, err = jobORM.FindJob(testutils.Context(t), jb.ID)
+	require.NoError(t, err)
+	assert.False(t, found.IsPaused())
+
+	assert.ErrorIs(t, jobORM.PauseJob(jb.ID+1), sql.ErrNoRows)
+	assert.ErrorIs(t, jobORM.ResumeJob(jb.ID+1), sql.ErrNoRows)
+}
+
 func Test_FindJobs(t *testing.T) {
 	t.Parallel()
 
@@ -671,12 +930,13 @@ func Test_FindJobsByPipelineSpecIDs(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, jbs, 1)
 
-		assert.Equal(t, jb.ID, jbs[0].ID)
-		assert.Equal(t, jb.Name, jbs[0].Name)
+		found := jbs[jb.PipelineSpecID]
+		assert.Equal(t, jb.ID, found.ID)
+		assert.Equal(t, jb.Name, found.Name)
 
-		require.Greater(t, jbs[0].PipelineSpecID, int32(0))
-		require.Equal(t, jb.PipelineSpecID, jbs[0].PipelineSpecID)
-		require.NotNil(t, jbs[0].PipelineSpec)
+		require.Greater(t, found.PipelineSpecID, int32(0))
+		require.Equal(t, jb.PipelineSpecID, found.PipelineSpecID)
+		require.NotNil(t, found.PipelineSpec)
 	})
 
 	t.Run("without jobs", func(t *testing.T) {
@@ -744,6 +1004,133 @@ func Test_FindPipelineRuns(t *testing.T) {
 	})
 }
 
+func Test_FilterPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config)
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := ocr.ValidatedOracleSpecToml(cc,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              uuid.NewV4().String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	now := time.Now()
+	insertRun := func(state pipeline.RunStatus, createdAt time.Time, taskErrors map[string]string) pipeline.Run {
+		run := pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			State:          state,
+			AllErrors:      pipeline.RunErrors{},
+			CreatedAt:      createdAt,
+		}
+		for dotID, taskErr := range taskErrors {
+			tr := pipeline.TaskRun{
+				ID:        uuid.NewV4(),
+				Type:      pipeline.TaskTypeBridge,
+				DotID:     dotID,
+				CreatedAt: createdAt,
+			}
+			if taskErr != "" {
+				tr.Error = null.StringFrom(taskErr)
+			}
+			run.PipelineTaskRuns = append(run.PipelineTaskRuns, tr)
+		}
+		require.NoError(t, pipelineORM.CreateRun(&run))
+		return run
+	}
+
+	old := insertRun(pipeline.RunStatusErrored, now.Add(-2*time.Hour), map[string]string{"ds1": "context deadline exceeded: Timeout"})
+	timedOut := insertRun(pipeline.RunStatusErrored, now.Add(-time.Minute), map[string]string{"ds1": "context deadline exceeded: Timeout", "ds2": ""})
+	otherTask := insertRun(pipeline.RunStatusErrored, now.Add(-time.Minute), map[string]string{"ds1": "", "ds2": "request timeout"})
+	completed := insertRun(pipeline.RunStatusCompleted, now, map[string]string{"ds1": "", "ds2": ""})
+
+	runIDs := func(runs []pipeline.Run) (ids []int64) {
+		for _, run := range runs {
+			ids = append(ids, run.ID)
+		}
+		return ids
+	}
+
+	t.Run("without filters", func(t *testing.T) {
+		runs, next, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{Limit: 10})
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Equal(t, []int64{completed.ID, otherTask.ID, timedOut.ID, old.ID}, runIDs(runs))
+		assert.Equal(t, jb.ID, runs[0].PipelineSpec.JobID)
+		assert.Len(t, runs[0].PipelineTaskRuns, 2)
+	})
+
+	t.Run("by state, time range, task and error", func(t *testing.T) {
+		after := now.Add(-time.Hour)
+		runs, _, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{
+			JobID:         &jb.ID,
+			States:        []pipeline.RunStatus{pipeline.RunStatusErrored},
+			CreatedAfter:  &after,
+			TaskName:      "ds1",
+			ErrorContains: "timeout",
+			Limit:         10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{timedOut.ID}, runIDs(runs))
+	})
+
+	t.Run("by error of any task", func(t *testing.T) {
+		runs, _, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{ErrorContains: "timeout", Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{otherTask.ID, timedOut.ID, old.ID}, runIDs(runs))
+	})
+
+	t.Run("escapes wildcards", func(t *testing.T) {
+		runs, _, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{ErrorContains: "%", Limit: 10})
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+
+	t.Run("with a cursor", func(t *testing.T) {
+		runs, next, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{Ascending: true, Limit: 3})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{old.ID, timedOut.ID, otherTask.ID}, runIDs(runs))
+		require.NotNil(t, next)
+
+		runs, next, err = orm.FilterPipelineRuns(job.PipelineRunsFilter{Ascending: true, After: next, Limit: 3})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{completed.ID}, runIDs(runs))
+		assert.Nil(t, next)
+	})
+
+	t.Run("rejects a limit below 1", func(t *testing.T) {
+		for _, limit := range []int{0, -1} {
+			_, _, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{Limit: limit})
+			assert.ErrorContains(t, err, "limit must be positive")
+		}
+	})
+
+	t.Run("caps the limit", func(t *testing.T) {
+		runs, next, err := orm.FilterPipelineRuns(job.PipelineRunsFilter{Limit: math.MaxInt})
+		require.NoError(t, err)
+		assert.Nil(t, next)
+		assert.Len(t, runs, 4)
+	})
+}
+
 func Test_PipelineRunsByJobID(t *testing.T) {
 	t.Parallel()
 
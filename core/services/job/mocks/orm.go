@@ -113,6 +113,45 @@ func (_m *ORM) DismissError(ctx context.Context, errorID int64) error {
 	return r0
 }
 
+// FilterPipelineRuns provides a mock function with given fields: filter, qopts
+func (_m *ORM) FilterPipelineRuns(filter job.PipelineRunsFilter, qopts ...pg.QOpt) ([]pipeline.Run, *int64, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, filter)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter, ...pg.QOpt) []pipeline.Run); ok {
+		r0 = rf(filter, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 *int64
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter, ...pg.QOpt) *int64); ok {
+		r1 = rf(filter, qopts...)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*int64)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsFilter, ...pg.QOpt) error); ok {
+		r2 = rf(filter, qopts...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // FindJob provides a mock function with given fields: ctx, id
 func (_m *ORM) FindJob(ctx context.Context, id int32) (job.Job, error) {
 	ret := _m.Called(ctx, id)
@@ -286,15 +325,15 @@ func (_m *ORM) FindJobs(offset int, limit int) ([]job.Job, int, error) {
 }
 
 // FindJobsByPipelineSpecIDs provides a mock function with given fields: ids
-func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) ([]job.Job, error) {
+func (_m *ORM) FindJobsByPipelineSpecIDs(ids []int32) (map[int32]job.Job, error) {
 	ret := _m.Called(ids)
 
-	var r0 []job.Job
-	if rf, ok := ret.Get(0).(func([]int32) []job.Job); ok {
+	var r0 map[int32]job.Job
+	if rf, ok := ret.Get(0).(func([]int32) map[int32]job.Job); ok {
 		r0 = rf(ids)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]job.Job)
+			r0 = ret.Get(0).(map[int32]job.Job)
 		}
 	}
 
@@ -433,6 +472,64 @@ func (_m *ORM) FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]job.Spec
 	return r0, r1
 }
 
+// FindSpecVersion provides a mock function with given fields: jobID, version, qopts
+func (_m *ORM) FindSpecVersion(jobID int32, version int32, qopts ...pg.QOpt) (job.SpecVersion, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32, int32, ...pg.QOpt) job.SpecVersion); ok {
+		r0 = rf(jobID, version, qopts...)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int32, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, version, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSpecVersions provides a mock function with given fields: jobID, qopts
+func (_m *ORM) FindSpecVersions(jobID int32, qopts ...pg.QOpt) ([]job.SpecVersion, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) []job.SpecVersion); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertJob provides a mock function with given fields: _a0, qopts
 func (_m *ORM) InsertJob(_a0 *job.Job, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -454,6 +551,34 @@ func (_m *ORM) InsertJob(_a0 *job.Job, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// InsertSpecVersion provides a mock function with given fields: jobID, spec, qopts
+func (_m *ORM) InsertSpecVersion(jobID int32, spec string, qopts ...pg.QOpt) (job.SpecVersion, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, spec)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32, string, ...pg.QOpt) job.SpecVersion); ok {
+		r0 = rf(jobID, spec, qopts...)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, string, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, spec, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertWebhookSpec provides a mock function with given fields: webhookSpec, qopts
 func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -475,6 +600,27 @@ func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt)
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: id, qopts
+func (_m *ORM) PauseJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineRuns provides a mock function with given fields: jobID, offset, size
 func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(jobID, offset, size)
@@ -526,6 +672,27 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
@@ -538,6 +705,27 @@ func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
 	_m.Called(_ca...)
 }
 
+// UpdateJob provides a mock function with given fields: jb, qopts
+func (_m *ORM) UpdateJob(jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NewORMT interface {
 	mock.TestingT
 	Cleanup(func())
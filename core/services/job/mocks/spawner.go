@@ -102,6 +102,27 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -116,6 +137,27 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -144,6 +186,27 @@ func (_m *Spawner) StartService(ctx context.Context, spec job.Job) error {
 	return r0
 }
 
+// UpdateJob provides a mock function with given fields: jb, spec, qopts
+func (_m *Spawner) UpdateJob(jb *job.Job, spec string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jb, spec)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*job.Job, string, ...pg.QOpt) error); ok {
+		r0 = rf(jb, spec, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type NewSpawnerT interface {
 	mock.TestingT
 	Cleanup(func())
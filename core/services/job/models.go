@@ -11,7 +11,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -130,7 +132,16 @@ type Job struct {
 	Name                 null.String
 	MaxTaskDuration      models.Interval
 	Pipeline             pipeline.Pipeline `toml:"observationSource"`
+	PausedAt             null.Time         `toml:"-"`
 	CreatedAt            time.Time
+	// TOMLSpec is the spec the job was created from. If set, ORM.CreateJob
+	// records it as the first version of the job.
+	TOMLSpec string `toml:"-" db:"-"`
+}
+
+// IsPaused returns true if the job has been paused and should not be run.
+func (j Job) IsPaused() bool {
+	return j.PausedAt.Valid
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -185,6 +196,66 @@ func (j *SpecError) SetID(value string) error {
 	return nil
 }
 
+// SpecVersion is a TOML spec a job has run with. A new version is recorded
+// every time the job is created or updated.
+type SpecVersion struct {
+	ID        int64
+	JobID     int32
+	Version   int32
+	TOML      string `db:"toml"`
+	CreatedAt time.Time
+}
+
+// Diff returns the unified diff of the TOML specs of two versions of a job.
+func (v SpecVersion) Diff(to SpecVersion) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(v.TOML),
+		B:        difflib.SplitLines(to.TOML),
+		FromFile: fmt.Sprintf("v%d", v.Version),
+		ToFile:   fmt.Sprintf("v%d", to.Version),
+		Context:  3,
+	})
+}
+
+// PipelineRunsFilter narrows down and orders the pipeline runs returned by
+// FilterPipelineRuns. Zero valued fields do not filter.
+type PipelineRunsFilter struct {
+	// JobID only returns the runs of this job.
+	JobID *int32
+	// States only returns the runs in one of these states.
+	States []pipeline.RunStatus
+	// CreatedAfter (inclusive) and CreatedBefore (exclusive) bound the
+	// creation time of the runs.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// TaskType and TaskName only return the runs with a task run of this
+	// type and DOT ID.
+	TaskType string
+	TaskName string
+	// ErrorContains only returns the runs with an error containing this
+	// substring, ignoring case. Combined with TaskType or TaskName, the error
+	// must be that of the matching task run.
+	ErrorContains string
+	// Ascending returns the oldest runs first, instead of the latest.
+	Ascending bool
+	// After is the cursor returned with the previous page. Only the runs
+	// which come after it in the order are returned.
+	After *int64
+	// Limit is the maximum number of runs to return. It must be positive, and
+	// is capped to MaxPipelineRunsFilterLimit.
+	Limit int
+}
+
+// RerunOpts are the options of a rerun of a finished pipeline run.
+type RerunOpts struct {
+	// OriginalSpec reruns the pipeline spec the run executed, instead of the
+	// job's current pipeline.
+	OriginalSpec bool
+	// ConfirmSideEffects must be set to rerun a pipeline with side effecting
+	// tasks, like ethtx.
+	ConfirmSideEffects bool
+}
+
 type PipelineRun struct {
 	ID int64 `json:"-"`
 }
@@ -232,6 +303,10 @@ type OCROracleSpec struct {
 	ContractTransmitterTransmitTimeoutEnv     bool
 	CreatedAt                                 time.Time `toml:"-"`
 	UpdatedAt                                 time.Time `toml:"-"`
+
+	// FromLabel selects the transmitter among the keys with the label when
+	// the job is created, unless transmitterAddress is given.
+	FromLabel string `toml:"fromLabel" db:"-"`
 }
 
 // GetID is a getter function that returns the ID of the spec.
@@ -282,6 +357,9 @@ const (
 	DKG OCR2PluginType = "dkg"
 
 	OCR2VRF OCR2PluginType = "ocr2vrf"
+
+	// External refers to a plugin binary which is launched by the node and served over gRPC
+	External OCR2PluginType = "external"
 )
 
 // OCR2OracleSpec defines the job spec for OCR2 jobs.
@@ -348,15 +426,21 @@ func (w *WebhookSpec) SetID(value string) error {
 }
 
 type DirectRequestSpec struct {
-	ID                          int32                    `toml:"-"`
-	ContractAddress             ethkey.EIP55Address      `toml:"contractAddress"`
-	MinIncomingConfirmations    clnull.Uint32            `toml:"minIncomingConfirmations"`
-	MinIncomingConfirmationsEnv bool                     `toml:"minIncomingConfirmationsEnv"`
-	Requesters                  models.AddressCollection `toml:"requesters"`
-	MinContractPayment          *assets.Link             `toml:"minContractPaymentLinkJuels"`
-	EVMChainID                  *utils.Big               `toml:"evmChainID"`
-	CreatedAt                   time.Time                `toml:"-"`
-	UpdatedAt                   time.Time                `toml:"-"`
+	ID                            int32                    `toml:"-"`
+	ContractAddress               ethkey.EIP55Address      `toml:"contractAddress"`
+	MinIncomingConfirmations      clnull.Uint32            `toml:"minIncomingConfirmations"`
+	MinIncomingConfirmationsEnv   bool                     `toml:"minIncomingConfirmationsEnv"`
+	Requesters                    models.AddressCollection `toml:"requesters"`
+	DeniedRequesters              models.AddressCollection `toml:"deniedRequesters"`
+	RequesterRateLimit            clnull.Uint32            `toml:"requesterRateLimit"`
+	RequesterRateLimitPeriod      models.Interval          `toml:"requesterRateLimitPeriod"`
+	MinContractPayment            *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	MinContractPaymentGasLimit    clnull.Uint32            `toml:"minContractPaymentGasLimit"`
+	MinContractPaymentLinkEthRate *decimal.Decimal         `toml:"minContractPaymentLinkEthRate"`
+	MinContractPaymentSource      string                   `toml:"minContractPaymentSource"`
+	EVMChainID                    *utils.Big               `toml:"evmChainID"`
+	CreatedAt                     time.Time                `toml:"-"`
+	UpdatedAt                     time.Time                `toml:"-"`
 }
 
 type CronSpec struct {
@@ -405,6 +489,7 @@ type KeeperSpec struct {
 	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
 	MinIncomingConfirmations *uint32             `toml:"minIncomingConfirmations"`
 	FromAddress              ethkey.EIP55Address `toml:"fromAddress"`
+	FromLabel                string              `toml:"fromLabel" db:"-"` // Resolved to FromAddress when the job is created.
 	EVMChainID               *utils.Big          `toml:"evmChainID"`
 	CreatedAt                time.Time           `toml:"-"`
 	UpdatedAt                time.Time           `toml:"-"`
@@ -430,6 +515,7 @@ type VRFSpec struct {
 	ConfirmationsEnv         bool                  `toml:"-"`
 	EVMChainID               *utils.Big            `toml:"evmChainID"`
 	FromAddresses            []ethkey.EIP55Address `toml:"fromAddresses"`
+	FromLabel                string                `toml:"fromLabel"`  // Optional, only keys with the label send fulfillments.
 	PollPeriod               time.Duration         `toml:"pollPeriod"` // For v2 jobs
 	PollPeriodEnv            bool
 	RequestedConfsDelay      int64         `toml:"requestedConfsDelay"` // For v2 jobs. Optional, defaults to 0 if not provided.
@@ -0,0 +1,28 @@
+package job
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// notifyingORM is an ORM which also notifies every recorded job error.
+type notifyingORM struct {
+	ORM
+	notifier notifications.Notifier
+}
+
+// NewNotifyingORM returns orm, also notifying every job error it records to
+// notifier.
+func NewNotifyingORM(orm ORM, notifier notifications.Notifier) ORM {
+	return &notifyingORM{ORM: orm, notifier: notifier}
+}
+
+func (o *notifyingORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
+	o.notifier.Notify(notifications.NewJobErrorEvent(jobID, description))
+	return o.ORM.RecordError(jobID, description, qopts...)
+}
+
+func (o *notifyingORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
+	o.notifier.Notify(notifications.NewJobErrorEvent(jobID, description))
+	o.ORM.TryRecordError(jobID, description, qopts...)
+}
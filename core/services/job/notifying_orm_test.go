@@ -0,0 +1,43 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/notifications"
+	notificationsmocks "github.com/smartcontractkit/chainlink/core/services/notifications/mocks"
+)
+
+func TestNotifyingORM(t *testing.T) {
+	t.Parallel()
+
+	orm := mocks.NewORM(t)
+	notifier := notificationsmocks.NewNotifier(t)
+	notifyingORM := job.NewNotifyingORM(orm, notifier)
+
+	isJobError := func(description string) interface{} {
+		return mock.MatchedBy(func(e notifications.Event) bool {
+			return e.Kind == notifications.EventJobError && e.Summary == description && e.Labels["jobID"] == "42"
+		})
+	}
+
+	notifier.On("Notify", isJobError("boom")).Once()
+	orm.On("RecordError", int32(42), "boom").Return(errors.New("db is down")).Once()
+	err := notifyingORM.RecordError(42, "boom")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "db is down")
+
+	notifier.On("Notify", isJobError("bang")).Once()
+	orm.On("TryRecordError", int32(42), "bang").Once()
+	notifyingORM.TryRecordError(42, "bang")
+
+	orm.On("DismissError", mock.Anything, int64(1)).Return(nil).Once()
+	require.NoError(t, notifyingORM.DismissError(context.Background(), 1))
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -37,6 +39,13 @@ var (
 	ErrNoSuchKeyBundle      = errors.New("no such key bundle exists")
 	ErrNoSuchTransmitterKey = errors.New("no such transmitter key exists")
 	ErrNoSuchPublicKey      = errors.New("no such public key exists")
+	ErrJobPaused            = errors.New("job is paused")
+	// ErrRunNotFinished is returned when rerunning a run which is still
+	// running or suspended.
+	ErrRunNotFinished = errors.New("run has not finished")
+	// ErrRerunNotConfirmed is returned when rerunning a pipeline with side
+	// effecting tasks without RerunOpts.ConfirmSideEffects.
+	ErrRerunNotConfirmed = errors.New("pipeline has side effecting tasks, rerunning it must be confirmed")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -45,6 +54,10 @@ type ORM interface {
 	InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error
 	InsertJob(job *Job, qopts ...pg.QOpt) error
 	CreateJob(jb *Job, qopts ...pg.QOpt) error
+	UpdateJob(jb *Job, qopts ...pg.QOpt) error
+	InsertSpecVersion(jobID int32, spec string, qopts ...pg.QOpt) (SpecVersion, error)
+	FindSpecVersions(jobID int32, qopts ...pg.QOpt) ([]SpecVersion, error)
+	FindSpecVersion(jobID int32, version int32, qopts ...pg.QOpt) (SpecVersion, error)
 	FindJobs(offset, limit int) ([]Job, int, error)
 	FindJobTx(id int32) (Job, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
@@ -52,6 +65,8 @@ type ORM interface {
 	FindJobIDByAddress(address ethkey.EIP55Address, qopts ...pg.QOpt) (int32, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	PauseJob(id int32, qopts ...pg.QOpt) error
+	ResumeJob(id int32, qopts ...pg.QOpt) error
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
@@ -59,12 +74,13 @@ type ORM interface {
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
 	Close() error
 	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	FilterPipelineRuns(filter PipelineRunsFilter, qopts ...pg.QOpt) (runs []pipeline.Run, next *int64, err error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
 	CountPipelineRunsByJobID(jobID int32) (count int32, err error)
 
-	FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error)
+	FindJobsByPipelineSpecIDs(ids []int32) (map[int32]Job, error)
 	FindPipelineRunByID(id int64) (pipeline.Run, error)
 
 	FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]SpecError, error)
@@ -140,6 +156,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	if err := o.assertBridgesExist(p); err != nil {
 		return err
 	}
+	if err := o.resolveFromLabel(jb); err != nil {
+		return err
+	}
 
 	var jobID int32
 	err := q.Transaction(func(tx pg.Queryer) error {
@@ -151,8 +170,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		switch jb.Type {
 		case DirectRequest:
 			var specID int32
-			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :evm_chain_id, now(), now())
+			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, denied_requesters, requester_rate_limit, requester_rate_limit_period,
+				min_contract_payment, min_contract_payment_gas_limit, min_contract_payment_link_eth_rate, min_contract_payment_source, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :denied_requesters, :requester_rate_limit, :requester_rate_limit_period,
+				:min_contract_payment, :min_contract_payment_gas_limit, :min_contract_payment_link_eth_rate, :min_contract_payment_source, :evm_chain_id, now(), now())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.DirectRequestSpec); err != nil {
 				return errors.Wrap(err, "failed to create DirectRequestSpec")
@@ -171,40 +192,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.FluxMonitorSpecID = &specID
 		case OffchainReporting:
 			var specID int32
-			if jb.OCROracleSpec.EncryptedOCRKeyBundleID != nil {
-				_, err := o.keyStore.OCR().Get(jb.OCROracleSpec.EncryptedOCRKeyBundleID.String())
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCROracleSpec.EncryptedOCRKeyBundleID)
-				}
-			}
-			if jb.OCROracleSpec.TransmitterAddress != nil {
-				_, err := o.keyStore.Eth().Get(jb.OCROracleSpec.TransmitterAddress.Hex())
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCROracleSpec.TransmitterAddress)
-				}
-			}
-
-			existingSpec := new(OCROracleSpec)
-			err := tx.Get(existingSpec, `SELECT * FROM ocr_oracle_specs WHERE contract_address = $1 and (evm_chain_id = $2 or evm_chain_id IS NULL) LIMIT 1;`,
-				jb.OCROracleSpec.ContractAddress, jb.OCROracleSpec.EVMChainID,
-			)
-			if !errors.Is(err, sql.ErrNoRows) {
-				if err != nil {
-					return errors.Wrap(err, "failed to validate OffchainreportingOracleSpec on creation")
-				}
-
-				matchErr := errors.Errorf("a job with contract address %s already exists for chain ID %d", jb.OCROracleSpec.ContractAddress, jb.OCROracleSpec.EVMChainID.ToInt())
-				if existingSpec.EVMChainID == nil {
-					chain, err2 := o.chainSet.Default()
-					if err2 != nil {
-						return errors.Wrap(err2, "failed to validate OffchainreportingOracleSpec on creation")
-					}
-					if jb.OCROracleSpec.EVMChainID.Equal((*utils.Big)(chain.ID())) {
-						return matchErr
-					}
-				} else {
-					return matchErr
-				}
+			if err := o.validateOCROracleSpec(tx, jb.OCROracleSpec, nil); err != nil {
+				return err
 			}
 
 			sql := `INSERT INTO ocr_oracle_specs (contract_address, p2p_bootstrap_peers, p2pv2_bootstrappers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_address,
@@ -214,52 +203,15 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 					:observation_timeout, :blockchain_timeout, :contract_config_tracker_subscribe_interval, :contract_config_tracker_poll_interval, :contract_config_confirmations, :evm_chain_id,
 					NOW(), NOW(), :database_timeout, :observation_grace_period, :contract_transmitter_transmit_timeout)
 			RETURNING id;`
-			err = pg.PrepareQueryRowx(tx, sql, &specID, jb.OCROracleSpec)
+			err := pg.PrepareQueryRowx(tx, sql, &specID, jb.OCROracleSpec)
 			if err != nil {
 				return errors.Wrap(err, "failed to create OffchainreportingOracleSpec")
 			}
 			jb.OCROracleSpecID = &specID
 		case OffchainReporting2:
 			var specID int32
-			if jb.OCR2OracleSpec.OCRKeyBundleID.Valid {
-				_, err := o.keyStore.OCR2().Get(jb.OCR2OracleSpec.OCRKeyBundleID.String)
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCR2OracleSpec.OCRKeyBundleID)
-				}
-			}
-			if jb.OCR2OracleSpec.TransmitterID.Valid {
-				switch jb.OCR2OracleSpec.Relay {
-				case relay.EVM:
-					_, err := o.keyStore.Eth().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				case relay.Solana:
-					_, err := o.keyStore.Solana().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				case relay.Terra:
-					_, err := o.keyStore.Terra().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				}
-			}
-			switch jb.OCR2OracleSpec.PluginType {
-			case Median:
-				var cfg medianconfig.PluginConfig
-				err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &cfg)
-				if err != nil {
-					return errors.Wrap(err, "failed to parse plugin config")
-				}
-				feePipeline, err := pipeline.Parse(cfg.JuelsPerFeeCoinPipeline)
-				if err != nil {
-					return err
-				}
-				if err2 := o.assertBridgesExist(*feePipeline); err2 != nil {
-					return err2
-				}
+			if err := o.validateOCR2OracleSpec(jb.OCR2OracleSpec); err != nil {
+				return err
 			}
 
 			sql := `INSERT INTO ocr2_oracle_specs (contract_id, relay, relay_config, plugin_type, plugin_config, p2pv2_bootstrappers, ocr_key_bundle_id, transmitter_id,
@@ -296,14 +248,14 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			var specID int32
 			sql := `INSERT INTO vrf_specs (
 				coordinator_address, public_key, min_incoming_confirmations, 
-				evm_chain_id, from_addresses, poll_period, requested_confs_delay, 
+				evm_chain_id, from_addresses, from_label, poll_period, requested_confs_delay, 
 				request_timeout, chunk_size, batch_coordinator_address, batch_fulfillment_enabled, 
 				batch_fulfillment_gas_multiplier, backoff_initial_delay, backoff_max_delay,
 				max_gas_price_gwei,
 				created_at, updated_at)
 			VALUES (
 				:coordinator_address, :public_key, :min_incoming_confirmations, 
-				:evm_chain_id, :from_addresses, :poll_period, :requested_confs_delay, 
+				:evm_chain_id, :from_addresses, :from_label, :poll_period, :requested_confs_delay, 
 				:request_timeout, :chunk_size, :batch_coordinator_address, :batch_fulfillment_enabled,
 				:batch_fulfillment_gas_multiplier, :backoff_initial_delay, :backoff_max_delay,
 				:max_gas_price_gwei,
@@ -377,7 +329,15 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		jb.PipelineSpecID = pipelineSpecID
 		err = o.InsertJob(jb, pg.WithQueryer(tx))
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+
+		if jb.TOMLSpec == "" {
+			return nil
+		}
+		_, err = o.InsertSpecVersion(jb.ID, jb.TOMLSpec, pg.WithQueryer(tx))
+		return err
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -396,12 +356,381 @@ func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) erro
 
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+	return q.Transaction(func(tx pg.Queryer) error {
+		query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, ocr_oracle_spec_id, ocr2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
 				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, external_job_id, gas_limit, created_at)
 		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :ocr_oracle_spec_id, :ocr2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
 				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :external_job_id, :gas_limit, NOW())
 		RETURNING *;`
-	return q.GetNamed(query, job, job)
+		if err := pg.PrepareQueryRowx(tx, query, job, job); err != nil {
+			return err
+		}
+		return linkPipelineSpec(tx, job.ID, job.PipelineSpecID)
+	})
+}
+
+// linkPipelineSpec records that the job runs, or has run, the pipeline spec,
+// so that runs of previous versions of the job stay part of its history.
+func linkPipelineSpec(tx pg.Queryer, jobID, pipelineSpecID int32) error {
+	_, err := tx.Exec(`INSERT INTO job_pipeline_specs (job_id, pipeline_spec_id) VALUES ($1, $2)`, jobID, pipelineSpecID)
+	return errors.Wrap(err, "failed to link pipeline spec")
+}
+
+// resolveFromLabel sets the sending address of OCR and keeper jobs that
+// select it by label. Their address is their identity on chain, so it is
+// chosen once, among the enabled keys with the label, and stored in the spec.
+func (o *orm) resolveFromLabel(jb *Job) error {
+	switch jb.Type {
+	case OffchainReporting:
+		spec := jb.OCROracleSpec
+		if spec.FromLabel == "" || spec.TransmitterAddress != nil {
+			return nil
+		}
+		address, err := o.labelledAddress(spec.EVMChainID, spec.FromLabel)
+		if err != nil {
+			return err
+		}
+		spec.TransmitterAddress = &address
+	case Keeper:
+		spec := jb.KeeperSpec
+		if spec.FromLabel == "" || spec.FromAddress != "" {
+			return nil
+		}
+		address, err := o.labelledAddress(spec.EVMChainID, spec.FromLabel)
+		if err != nil {
+			return err
+		}
+		spec.FromAddress = address
+	}
+	return nil
+}
+
+func (o *orm) labelledAddress(evmChainID *utils.Big, label string) (ethkey.EIP55Address, error) {
+	var chainID *big.Int
+	if evmChainID != nil {
+		chainID = evmChainID.ToInt()
+	} else {
+		chain, err := o.chainSet.Default()
+		if err != nil {
+			return "", err
+		}
+		chainID = chain.ID()
+	}
+	address, err := o.keyStore.Eth().GetRoundRobinAddressWithLabel(chainID, label)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to select a key with label %q", label)
+	}
+	return ethkey.EIP55AddressFromAddress(address), nil
+}
+
+// validateOCROracleSpec checks that the keys referenced by the spec exist and
+// that no other job targets the same contract. excludeSpecID allows a job that
+// is being updated to keep its own contract address.
+func (o *orm) validateOCROracleSpec(tx pg.Queryer, spec *OCROracleSpec, excludeSpecID *int32) error {
+	if spec.EncryptedOCRKeyBundleID != nil {
+		_, err := o.keyStore.OCR().Get(spec.EncryptedOCRKeyBundleID.String())
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchKeyBundle, "%v", spec.EncryptedOCRKeyBundleID)
+		}
+	}
+	if spec.TransmitterAddress != nil {
+		_, err := o.keyStore.Eth().Get(spec.TransmitterAddress.Hex())
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", spec.TransmitterAddress)
+		}
+	}
+
+	var exclude int32
+	if excludeSpecID != nil {
+		exclude = *excludeSpecID
+	}
+	existingSpec := new(OCROracleSpec)
+	err := tx.Get(existingSpec, `SELECT * FROM ocr_oracle_specs WHERE contract_address = $1 and (evm_chain_id = $2 or evm_chain_id IS NULL) and id != $3 LIMIT 1;`,
+		spec.ContractAddress, spec.EVMChainID, exclude,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to validate OffchainreportingOracleSpec on creation")
+	}
+
+	matchErr := errors.Errorf("a job with contract address %s already exists for chain ID %d", spec.ContractAddress, spec.EVMChainID.ToInt())
+	if existingSpec.EVMChainID == nil {
+		chain, err2 := o.chainSet.Default()
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to validate OffchainreportingOracleSpec on creation")
+		}
+		if spec.EVMChainID.Equal((*utils.Big)(chain.ID())) {
+			return matchErr
+		}
+		return nil
+	}
+	return matchErr
+}
+
+// validateOCR2OracleSpec checks that the keys and bridges referenced by the
+// spec exist.
+func (o *orm) validateOCR2OracleSpec(spec *OCR2OracleSpec) error {
+	if spec.OCRKeyBundleID.Valid {
+		_, err := o.keyStore.OCR2().Get(spec.OCRKeyBundleID.String)
+		if err != nil {
+			return errors.Wrapf(ErrNoSuchKeyBundle, "%v", spec.OCRKeyBundleID)
+		}
+	}
+	if spec.TransmitterID.Valid {
+		switch spec.Relay {
+		case relay.EVM:
+			_, err := o.keyStore.Eth().Get(spec.TransmitterID.String)
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", spec.TransmitterID)
+			}
+		case relay.Solana:
+			_, err := o.keyStore.Solana().Get(spec.TransmitterID.String)
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", spec.TransmitterID)
+			}
+		case relay.Terra:
+			_, err := o.keyStore.Terra().Get(spec.TransmitterID.String)
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", spec.TransmitterID)
+			}
+		}
+	}
+	switch spec.PluginType {
+	case Median:
+		var cfg medianconfig.PluginConfig
+		err := json.Unmarshal(spec.PluginConfig.Bytes(), &cfg)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse plugin config")
+		}
+		feePipeline, err := pipeline.Parse(cfg.JuelsPerFeeCoinPipeline)
+		if err != nil {
+			return err
+		}
+		if err2 := o.assertBridgesExist(*feePipeline); err2 != nil {
+			return err2
+		}
+	}
+	return nil
+}
+
+// UpdateJob replaces the spec of an existing job in place. The job keeps its
+// ID, external job ID, type spec and pipeline spec rows, so that run history
+// and any state keyed on them carry over to the new spec.
+// Expects an unmarshalled job spec with jb.ID set as the jb argument i.e. output from ValidatedXX.
+// Scans all persisted records back into jb
+func (o *orm) UpdateJob(jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	if err := o.assertBridgesExist(jb.Pipeline); err != nil {
+		return err
+	}
+	if err := o.resolveFromLabel(jb); err != nil {
+		return err
+	}
+
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var existing Job
+		if err := tx.Get(&existing, `SELECT * FROM jobs WHERE id = $1 FOR UPDATE`, jb.ID); err != nil {
+			return errors.Wrap(err, "failed to load job")
+		}
+		if existing.Type != jb.Type {
+			return errors.Errorf("cannot change job type from %s to %s", existing.Type, jb.Type)
+		}
+		if jb.ExternalJobID == (uuid.UUID{}) {
+			jb.ExternalJobID = existing.ExternalJobID
+		} else if jb.ExternalJobID != existing.ExternalJobID {
+			return errors.Errorf("cannot change externalJobID from %s to %s", existing.ExternalJobID, jb.ExternalJobID)
+		}
+
+		switch jb.Type {
+		case DirectRequest:
+			jb.DirectRequestSpecID = existing.DirectRequestSpecID
+			jb.DirectRequestSpec.ID = *existing.DirectRequestSpecID
+			sql := `UPDATE direct_request_specs SET contract_address = :contract_address, min_incoming_confirmations = :min_incoming_confirmations,
+					requesters = :requesters, denied_requesters = :denied_requesters, requester_rate_limit = :requester_rate_limit, requester_rate_limit_period = :requester_rate_limit_period,
+					min_contract_payment = :min_contract_payment, min_contract_payment_gas_limit = :min_contract_payment_gas_limit,
+					min_contract_payment_link_eth_rate = :min_contract_payment_link_eth_rate, min_contract_payment_source = :min_contract_payment_source,
+					evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.DirectRequestSpec); err != nil {
+				return errors.Wrap(err, "failed to update DirectRequestSpec")
+			}
+		case FluxMonitor:
+			jb.FluxMonitorSpecID = existing.FluxMonitorSpecID
+			jb.FluxMonitorSpec.ID = *existing.FluxMonitorSpecID
+			sql := `UPDATE flux_monitor_specs SET contract_address = :contract_address, threshold = :threshold, absolute_threshold = :absolute_threshold,
+					poll_timer_period = :poll_timer_period, poll_timer_disabled = :poll_timer_disabled, idle_timer_period = :idle_timer_period,
+					idle_timer_disabled = :idle_timer_disabled, drumbeat_schedule = :drumbeat_schedule, drumbeat_random_delay = :drumbeat_random_delay,
+					drumbeat_enabled = :drumbeat_enabled, min_payment = :min_payment, evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.FluxMonitorSpec); err != nil {
+				return errors.Wrap(err, "failed to update FluxMonitorSpec")
+			}
+		case OffchainReporting:
+			jb.OCROracleSpecID = existing.OCROracleSpecID
+			jb.OCROracleSpec.ID = *existing.OCROracleSpecID
+			if err := o.validateOCROracleSpec(tx, jb.OCROracleSpec, existing.OCROracleSpecID); err != nil {
+				return err
+			}
+			sql := `UPDATE ocr_oracle_specs SET contract_address = :contract_address, p2p_bootstrap_peers = :p2p_bootstrap_peers,
+					p2pv2_bootstrappers = :p2pv2_bootstrappers, is_bootstrap_peer = :is_bootstrap_peer, encrypted_ocr_key_bundle_id = :encrypted_ocr_key_bundle_id,
+					transmitter_address = :transmitter_address, observation_timeout = :observation_timeout, blockchain_timeout = :blockchain_timeout,
+					contract_config_tracker_subscribe_interval = :contract_config_tracker_subscribe_interval,
+					contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval, contract_config_confirmations = :contract_config_confirmations,
+					evm_chain_id = :evm_chain_id, database_timeout = :database_timeout, observation_grace_period = :observation_grace_period,
+					contract_transmitter_transmit_timeout = :contract_transmitter_transmit_timeout, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.OCROracleSpec); err != nil {
+				return errors.Wrap(err, "failed to update OffchainreportingOracleSpec")
+			}
+		case OffchainReporting2:
+			jb.OCR2OracleSpecID = existing.OCR2OracleSpecID
+			jb.OCR2OracleSpec.ID = *existing.OCR2OracleSpecID
+			if err := o.validateOCR2OracleSpec(jb.OCR2OracleSpec); err != nil {
+				return err
+			}
+			sql := `UPDATE ocr2_oracle_specs SET contract_id = :contract_id, relay = :relay, relay_config = :relay_config, plugin_type = :plugin_type,
+					plugin_config = :plugin_config, p2pv2_bootstrappers = :p2pv2_bootstrappers, ocr_key_bundle_id = :ocr_key_bundle_id,
+					transmitter_id = :transmitter_id, blockchain_timeout = :blockchain_timeout,
+					contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval,
+					contract_config_confirmations = :contract_config_confirmations, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.OCR2OracleSpec); err != nil {
+				return errors.Wrap(err, "failed to update Offchainreporting2OracleSpec")
+			}
+		case Keeper:
+			jb.KeeperSpecID = existing.KeeperSpecID
+			jb.KeeperSpec.ID = *existing.KeeperSpecID
+			sql := `UPDATE keeper_specs SET contract_address = :contract_address, from_address = :from_address, evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.KeeperSpec); err != nil {
+				return errors.Wrap(err, "failed to update KeeperSpec")
+			}
+		case Cron:
+			jb.CronSpecID = existing.CronSpecID
+			jb.CronSpec.ID = *existing.CronSpecID
+			sql := `UPDATE cron_specs SET cron_schedule = :cron_schedule, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.CronSpec); err != nil {
+				return errors.Wrap(err, "failed to update CronSpec")
+			}
+		case VRF:
+			jb.VRFSpecID = existing.VRFSpecID
+			jb.VRFSpec.ID = *existing.VRFSpecID
+			sql := `UPDATE vrf_specs SET
+				coordinator_address = :coordinator_address, public_key = :public_key, min_incoming_confirmations = :min_incoming_confirmations,
+				evm_chain_id = :evm_chain_id, from_addresses = :from_addresses, from_label = :from_label, poll_period = :poll_period, requested_confs_delay = :requested_confs_delay,
+				request_timeout = :request_timeout, chunk_size = :chunk_size, batch_coordinator_address = :batch_coordinator_address,
+				batch_fulfillment_enabled = :batch_fulfillment_enabled, batch_fulfillment_gas_multiplier = :batch_fulfillment_gas_multiplier,
+				backoff_initial_delay = :backoff_initial_delay, backoff_max_delay = :backoff_max_delay,
+				max_gas_price_gwei = :max_gas_price_gwei,
+				updated_at = NOW()
+			WHERE id = :id;`
+			_, err := tx.NamedExec(sql, toVRFSpecRow(jb.VRFSpec))
+			var pqErr *pgconn.PgError
+			ok := errors.As(err, &pqErr)
+			if err != nil && ok && pqErr.Code == "23503" {
+				if pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
+					return errors.Wrapf(ErrNoSuchPublicKey, "%s", jb.VRFSpec.PublicKey.String())
+				}
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to update VRFSpec")
+			}
+		case Webhook:
+			jb.WebhookSpecID = existing.WebhookSpecID
+			jb.WebhookSpec.ID = *existing.WebhookSpecID
+			if _, err := tx.Exec(`UPDATE webhook_specs SET updated_at = NOW() WHERE id = $1`, jb.WebhookSpec.ID); err != nil {
+				return errors.Wrap(err, "failed to update WebhookSpec")
+			}
+			if _, err := tx.Exec(`DELETE FROM external_initiator_webhook_specs WHERE webhook_spec_id = $1`, jb.WebhookSpec.ID); err != nil {
+				return errors.Wrap(err, "failed to delete ExternalInitiatorWebhookSpecs")
+			}
+			if len(jb.WebhookSpec.ExternalInitiatorWebhookSpecs) > 0 {
+				for i := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+					jb.WebhookSpec.ExternalInitiatorWebhookSpecs[i].WebhookSpecID = jb.WebhookSpec.ID
+				}
+				sql := `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec)
+			VALUES (:external_initiator_id, :webhook_spec_id, :spec);`
+				query, args, err := tx.BindNamed(sql, jb.WebhookSpec.ExternalInitiatorWebhookSpecs)
+				if err != nil {
+					return errors.Wrap(err, "failed to bindquery for ExternalInitiatorWebhookSpecs")
+				}
+				if _, err = tx.Exec(query, args...); err != nil {
+					return errors.Wrap(err, "failed to create ExternalInitiatorWebhookSpecs")
+				}
+			}
+		case BlockhashStore:
+			jb.BlockhashStoreSpecID = existing.BlockhashStoreSpecID
+			jb.BlockhashStoreSpec.ID = *existing.BlockhashStoreSpecID
+			sql := `UPDATE blockhash_store_specs SET coordinator_v1_address = :coordinator_v1_address, coordinator_v2_address = :coordinator_v2_address,
+					wait_blocks = :wait_blocks, lookback_blocks = :lookback_blocks, blockhash_store_address = :blockhash_store_address,
+					poll_period = :poll_period, run_timeout = :run_timeout, evm_chain_id = :evm_chain_id, from_address = :from_address, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.BlockhashStoreSpec); err != nil {
+				return errors.Wrap(err, "failed to update BlockhashStore spec")
+			}
+		case Bootstrap:
+			jb.BootstrapSpecID = existing.BootstrapSpecID
+			jb.BootstrapSpec.ID = *existing.BootstrapSpecID
+			sql := `UPDATE bootstrap_specs SET contract_id = :contract_id, relay = :relay, relay_config = :relay_config,
+					monitoring_endpoint = :monitoring_endpoint, blockchain_timeout = :blockchain_timeout,
+					contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval,
+					contract_config_confirmations = :contract_config_confirmations, updated_at = NOW()
+			WHERE id = :id;`
+			if _, err := tx.NamedExec(sql, jb.BootstrapSpec); err != nil {
+				return errors.Wrap(err, "failed to update BootstrapSpec")
+			}
+		default:
+			o.lggr.Panicf("Unsupported jb.Type: %v", jb.Type)
+		}
+
+		// Runs of the previous version keep referencing the previous spec
+		pipelineSpecID, err := o.pipelineORM.CreateSpec(jb.Pipeline, jb.MaxTaskDuration, pg.WithQueryer(tx))
+		if err != nil {
+			return errors.Wrap(err, "failed to create pipeline spec")
+		}
+		jb.PipelineSpecID = pipelineSpecID
+		if err = linkPipelineSpec(tx, jb.ID, jb.PipelineSpecID); err != nil {
+			return err
+		}
+
+		sql := `UPDATE jobs SET name = :name, schema_version = :schema_version, max_task_duration = :max_task_duration,
+				external_job_id = :external_job_id, gas_limit = :gas_limit, pipeline_spec_id = :pipeline_spec_id
+		WHERE id = :id;`
+		_, err = tx.NamedExec(sql, jb)
+		return errors.Wrap(err, "failed to update job")
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateJobFailed")
+	}
+
+	return o.findJob(jb, "id", jb.ID, qopts...)
+}
+
+// InsertSpecVersion records spec as the next version of the job's spec.
+func (o *orm) InsertSpecVersion(jobID int32, spec string, qopts ...pg.QOpt) (v SpecVersion, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO job_spec_versions (job_id, version, toml, created_at)
+	SELECT $1, COALESCE(MAX(version), 0) + 1, $2, NOW() FROM job_spec_versions WHERE job_id = $1
+	RETURNING *;`
+	err = q.Get(&v, sql, jobID, spec)
+	return v, errors.Wrap(err, "InsertSpecVersion failed")
+}
+
+// FindSpecVersions returns every recorded spec of a job, oldest first.
+func (o *orm) FindSpecVersions(jobID int32, qopts ...pg.QOpt) (versions []SpecVersion, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&versions, `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version ASC`, jobID)
+	return versions, errors.Wrap(err, "FindSpecVersions failed")
+}
+
+// FindSpecVersion returns a single recorded spec of a job.
+func (o *orm) FindSpecVersion(jobID int32, version int32, qopts ...pg.QOpt) (v SpecVersion, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&v, `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2`, jobID, version)
+	return v, errors.Wrap(err, "FindSpecVersion failed")
 }
 
 // DeleteJob removes a job
@@ -457,7 +786,8 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		deleted_bootstrap_specs AS (
 			DELETE FROM bootstrap_specs WHERE id IN (SELECT bootstrap_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
+		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)
+			OR id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)`
 	res, cancel, err := q.ExecQIter(query, id)
 	defer cancel()
 	if err != nil {
@@ -474,6 +804,37 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	return nil
 }
 
+// PauseJob marks the job as paused. Pausing an already paused job keeps its
+// original paused_at.
+func (o *orm) PauseJob(id int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`UPDATE jobs SET paused_at = COALESCE(paused_at, NOW()) WHERE id = $1`, id)
+	defer cancel()
+	return checkJobUpdated(res, err, "PauseJob")
+}
+
+// ResumeJob clears the paused state of the job.
+func (o *orm) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`UPDATE jobs SET paused_at = NULL WHERE id = $1`, id)
+	defer cancel()
+	return checkJobUpdated(res, err, "ResumeJob")
+}
+
+func checkJobUpdated(res sql.Result, err error, op string) error {
+	if err != nil {
+		return errors.Wrapf(err, "%s failed", op)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "%s failed getting RowsAffected", op)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) RecordError(jobID int32, description string, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
@@ -831,7 +1192,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 // PipelineRunsByJobsIDs returns pipeline runs for multiple jobs, not preloading data
 func (o *orm) PipelineRunsByJobsIDs(ids []int32) (runs []pipeline.Run, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id WHERE jobs.id = ANY($1)
+		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN job_pipeline_specs ON pipeline_runs.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id WHERE job_pipeline_specs.job_id = ANY($1)
 		ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC;`
 		if err = tx.Select(&runs, stmt, ids); err != nil {
 			return errors.Wrap(err, "error loading runs")
@@ -851,7 +1212,7 @@ func (o *orm) FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []i
 		stmt := `
 SELECT pipeline_runs.id
 FROM pipeline_runs
-WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS WHERE jobs.id = $1)
+WHERE pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)
 ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC
 OFFSET $2
 LIMIT $3
@@ -918,7 +1279,7 @@ func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
 		stmt := `
 SELECT COUNT(*)
 FROM pipeline_runs
-WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS WHERE jobs.id = $1)
+WHERE pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = $1)
 `
 		if err = tx.Get(&count, stmt, jobID); err != nil {
 			return errors.Wrap(err, "error counting runs")
@@ -930,13 +1291,29 @@ WHERE pipeline_runs.pipeline_spec_id = (SELECT jobs.pipeline_spec_id FROM JOBS W
 	return count, errors.Wrap(err, "PipelineRunsByJobsIDs failed")
 }
 
-func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
-	var jbs []Job
+// FindJobsByPipelineSpecIDs returns the jobs which run, or have run, the
+// pipeline specs, keyed by pipeline spec ID. Jobs which have been updated
+// are returned for the pipeline specs of their previous versions as well.
+func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) (map[int32]Job, error) {
+	jbsBySpecID := make(map[int32]Job)
 
 	err := o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT * FROM jobs WHERE jobs.pipeline_spec_id = ANY($1) ORDER BY id ASC
+		var links []struct {
+			JobID          int32
+			PipelineSpecID int32
+		}
+		if err := tx.Select(&links, `SELECT job_id, pipeline_spec_id FROM job_pipeline_specs WHERE pipeline_spec_id = ANY($1)`, ids); err != nil {
+			return errors.Wrap(err, "error fetching jobs by pipeline spec IDs")
+		}
+		jobIDs := make([]int32, len(links))
+		for i, link := range links {
+			jobIDs[i] = link.JobID
+		}
+
+		var jbs []Job
+		stmt := `SELECT * FROM jobs WHERE jobs.id = ANY($1) ORDER BY id ASC
 `
-		if err := tx.Select(&jbs, stmt, ids); err != nil {
+		if err := tx.Select(&jbs, stmt, jobIDs); err != nil {
 			return errors.Wrap(err, "error fetching jobs by pipeline spec IDs")
 		}
 
@@ -944,17 +1321,24 @@ func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 		if err != nil {
 			return err
 		}
+		jbsByID := make(map[int32]Job, len(jbs))
 		for i := range jbs {
 			err = o.LoadEnvConfigVars(&jbs[i])
 			if err != nil {
 				return err
 			}
+			jbsByID[jbs[i].ID] = jbs[i]
+		}
+		for _, link := range links {
+			if jb, ok := jbsByID[link.JobID]; ok {
+				jbsBySpecID[link.PipelineSpecID] = jb
+			}
 		}
 
 		return nil
 	})
 
-	return jbs, errors.Wrap(err, "FindJobsByPipelineSpecIDs failed")
+	return jbsBySpecID, errors.Wrap(err, "FindJobsByPipelineSpecIDs failed")
 }
 
 // PipelineRuns returns pipeline runs for a job, with spec and taskruns loaded, latest first
@@ -964,7 +1348,7 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 		var args []interface{}
 		var filter string
 		if jobID != nil {
-			filter = "JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = $1" // TODO:  add support for more than 1 jobID?
+			filter = "JOIN job_pipeline_specs USING(pipeline_spec_id) WHERE job_pipeline_specs.job_id = $1" // TODO:  add support for more than 1 jobID?
 			args = append(args, *jobID)
 		}
 		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs %s`, filter)
@@ -988,6 +1372,109 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 	return runs, count, errors.Wrap(err, "PipelineRuns failed")
 }
 
+// MaxPipelineRunsFilterLimit is the maximum number of runs returned by
+// FilterPipelineRuns at once.
+const MaxPipelineRunsFilterLimit = 1000
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// FilterPipelineRuns returns a page of the pipeline runs matching the filter,
+// with spec and taskruns loaded. Runs are ordered by ID, which follows their
+// creation order. The returned cursor is set when there are more runs, and is
+// passed as filter.After to get the next page.
+func (o *orm) FilterPipelineRuns(filter PipelineRunsFilter, qopts ...pg.QOpt) (runs []pipeline.Run, next *int64, err error) {
+	if filter.Limit < 1 {
+		return nil, nil, errors.Errorf("FilterPipelineRuns failed: limit must be positive, got %d", filter.Limit)
+	}
+	if filter.Limit > MaxPipelineRunsFilterLimit {
+		filter.Limit = MaxPipelineRunsFilterLimit
+	}
+
+	var conds []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.JobID != nil {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.pipeline_spec_id IN (SELECT pipeline_spec_id FROM job_pipeline_specs WHERE job_id = %s)", arg(*filter.JobID)))
+	}
+	if len(filter.States) > 0 {
+		states := make([]string, len(filter.States))
+		for i, state := range filter.States {
+			states[i] = string(state)
+		}
+		conds = append(conds, fmt.Sprintf("pipeline_runs.state = ANY(%s::pipeline_runs_state[])", arg(pq.Array(states))))
+	}
+	if filter.CreatedAfter != nil {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.created_at >= %s", arg(*filter.CreatedAfter)))
+	}
+	if filter.CreatedBefore != nil {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.created_at < %s", arg(*filter.CreatedBefore)))
+	}
+
+	var errorPattern string
+	if filter.ErrorContains != "" {
+		errorPattern = arg("%" + likeEscaper.Replace(filter.ErrorContains) + "%")
+	}
+	if filter.TaskType != "" || filter.TaskName != "" {
+		taskConds := []string{"pipeline_task_runs.pipeline_run_id = pipeline_runs.id"}
+		if filter.TaskType != "" {
+			taskConds = append(taskConds, fmt.Sprintf("pipeline_task_runs.type = %s", arg(filter.TaskType)))
+		}
+		if filter.TaskName != "" {
+			taskConds = append(taskConds, fmt.Sprintf("pipeline_task_runs.dot_id = %s", arg(filter.TaskName)))
+		}
+		if errorPattern != "" {
+			taskConds = append(taskConds, fmt.Sprintf("pipeline_task_runs.error ILIKE %s", errorPattern))
+		}
+		conds = append(conds, fmt.Sprintf("EXISTS (SELECT 1 FROM pipeline_task_runs WHERE %s)", strings.Join(taskConds, " AND ")))
+	} else if errorPattern != "" {
+		conds = append(conds, fmt.Sprintf(`(pipeline_runs.all_errors::text ILIKE %[1]s OR EXISTS (
+			SELECT 1 FROM pipeline_task_runs WHERE pipeline_task_runs.pipeline_run_id = pipeline_runs.id AND pipeline_task_runs.error ILIKE %[1]s
+		))`, errorPattern))
+	}
+
+	order := "DESC"
+	if filter.Ascending {
+		order = "ASC"
+	}
+	if filter.After != nil {
+		op := "<"
+		if filter.Ascending {
+			op = ">"
+		}
+		conds = append(conds, fmt.Sprintf("pipeline_runs.id %s %s", op, arg(*filter.After)))
+	}
+
+	var where string
+	if len(conds) > 0 {
+		where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	// Fetch one more run than the limit to know whether there is a next page
+	stmt := fmt.Sprintf(`SELECT pipeline_runs.* FROM pipeline_runs %s ORDER BY pipeline_runs.id %s LIMIT %s;`, where, order, arg(filter.Limit+1))
+
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Select(&runs, stmt, args...); err != nil {
+			return errors.Wrap(err, "error loading runs")
+		}
+		if len(runs) > filter.Limit {
+			runs = runs[:filter.Limit]
+			last := runs[len(runs)-1].ID
+			next = &last
+		}
+
+		runs, err = o.loadPipelineRunsRelations(runs, tx)
+
+		return err
+	})
+
+	return runs, next, errors.Wrap(err, "FilterPipelineRuns failed")
+}
+
 func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]pipeline.Run, error) {
 	// Postload PipelineSpecs
 	// TODO: We should pull this out into a generic preload function once go has generics
@@ -1001,7 +1488,7 @@ func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]p
 	for specID := range specM {
 		specIDs = append(specIDs, specID)
 	}
-	stmt := `SELECT pipeline_specs.*, jobs.id AS job_id FROM pipeline_specs JOIN jobs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
+	stmt := `SELECT pipeline_specs.*, job_pipeline_specs.job_id FROM pipeline_specs JOIN job_pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
 	var specs []pipeline.Spec
 	if err := o.q.Select(&specs, stmt, specIDs); err != nil {
 		return nil, errors.Wrap(err, "error loading specs")
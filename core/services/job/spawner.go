@@ -26,7 +26,16 @@ type (
 	Spawner interface {
 		services.ServiceCtx
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
+		// UpdateJob replaces the spec of an existing job, records spec as its
+		// next version and restarts its services with the new spec. An empty
+		// spec is not recorded.
+		UpdateJob(jb *Job, spec string, qopts ...pg.QOpt) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		// PauseJob stops the services of a job without deleting it. The job
+		// stays paused across restarts until ResumeJob is called.
+		PauseJob(jobID int32, qopts ...pg.QOpt) error
+		// ResumeJob restarts the services of a paused job.
+		ResumeJob(jobID int32, qopts ...pg.QOpt) error
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -136,10 +145,16 @@ func (js *spawner) stopService(jobID int32) {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
-	aj := js.activeJobs[jobID]
+	js.closeServices(jobID, js.activeJobs[jobID].services)
+	js.lggr.Debugw("Stopped all services for job", "jobID", jobID)
+
+	delete(js.activeJobs, jobID)
+}
 
-	for i := len(aj.services) - 1; i >= 0; i-- {
-		service := aj.services[i]
+// closeServices stops the services of a job in reverse order of starting.
+func (js *spawner) closeServices(jobID int32, services []ServiceCtx) {
+	for i := len(services) - 1; i >= 0; i-- {
+		service := services[i]
 		err := service.Close()
 		if err != nil {
 			js.lggr.Criticalw("Error stopping job service", "jobID", jobID, "error", err, "subservice", i, "serviceType", reflect.TypeOf(service))
@@ -147,9 +162,6 @@ func (js *spawner) stopService(jobID int32) {
 			js.lggr.Debugw("Stopped job service", "jobID", jobID, "subservice", i, "serviceType", fmt.Sprintf("%T", service))
 		}
 	}
-	js.lggr.Debugw("Stopped all services for job", "jobID", jobID)
-
-	delete(js.activeJobs, jobID)
 }
 
 // StartService starts service for the given job spec.
@@ -168,13 +180,13 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	// that it was able to start without an error.
 	aj := activeJob{delegate: delegate, spec: jb}
 
-	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
-	jb.PipelineSpec.JobID = jb.ID
-	if jb.GasLimit.Valid {
-		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
+	if jb.IsPaused() {
+		js.lggr.Infow("Job is paused, not starting services", "jobID", jb.ID)
+		js.activeJobs[jb.ID] = aj
+		return nil
 	}
 
-	services, err := delegate.ServicesForSpec(jb)
+	services, err := servicesForSpec(delegate, jb)
 	if err != nil {
 		js.lggr.Errorw("Error creating services for job", "jobID", jb.ID, "error", err)
 		cctx, cancel := utils.ContextFromChan(js.chStop)
@@ -184,19 +196,35 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		return nil
 	}
 
-	js.lggr.Debugw("JobSpawner: Starting services for job", "jobID", jb.ID, "count", len(services))
+	js.activeJobs[jb.ID] = js.startServices(ctx, aj, services)
+	return nil
+}
+
+// servicesForSpec creates the services of a job without starting them.
+func servicesForSpec(delegate Delegate, jb Job) ([]ServiceCtx, error) {
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.JobID = jb.ID
+	if jb.GasLimit.Valid {
+		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return delegate.ServicesForSpec(jb)
+}
+
+// startServices starts the services of a job, and returns the active job
+// with the services that started without an error.
+func (js *spawner) startServices(ctx context.Context, aj activeJob, services []ServiceCtx) activeJob {
+	js.lggr.Debugw("JobSpawner: Starting services for job", "jobID", aj.spec.ID, "count", len(services))
 
 	for _, service := range services {
-		err = service.Start(ctx)
+		err := service.Start(ctx)
 		if err != nil {
-			js.lggr.Criticalw("Error starting service for job", "jobID", jb.ID, "error", err)
+			js.lggr.Criticalw("Error starting service for job", "jobID", aj.spec.ID, "error", err)
 			continue
 		}
 		aj.services = append(aj.services, service)
 	}
-	js.lggr.Debugw("JobSpawner: Finished starting services for job", "jobID", jb.ID, "count", len(services))
-	js.activeJobs[jb.ID] = aj
-	return nil
+	js.lggr.Debugw("JobSpawner: Finished starting services for job", "jobID", aj.spec.ID, "count", len(services))
+	return aj
 }
 
 // Should not get called before Start()
@@ -236,6 +264,89 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	return err
 }
 
+// Should not get called before Start()
+func (js *spawner) UpdateJob(jb *Job, spec string, qopts ...pg.QOpt) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		js.lggr.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+		return errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+
+	lggr := js.lggr.With("jobID", jb.ID)
+
+	var aj activeJob
+	var active bool
+	func() {
+		js.activeJobsMu.RLock()
+		defer js.activeJobsMu.RUnlock()
+		aj, active = js.activeJobs[jb.ID]
+	}()
+	if active && aj.spec.Type != jb.Type {
+		return errors.Errorf("cannot change job type from %s to %s", aj.spec.Type, jb.Type)
+	}
+
+	q := js.q.WithOpts(qopts...)
+	if q.ParentCtx != nil {
+		ctx, cancel := utils.WithCloseChan(q.ParentCtx, js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	} else {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	}
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	// The delegate callbacks read the current spec from the database (e.g.
+	// external initiators of webhook jobs), so they are called around the update.
+	if active {
+		lggr.Debugw("Callback: BeforeJobDeleted")
+		aj.delegate.BeforeJobDeleted(aj.spec)
+	}
+
+	var services []ServiceCtx
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if err := js.orm.UpdateJob(jb, pg.WithQueryer(tx), pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		if spec != "" {
+			if _, err := js.orm.InsertSpecVersion(jb.ID, spec, pg.WithQueryer(tx), pg.WithParentCtx(ctx)); err != nil {
+				return err
+			}
+		}
+		if jb.IsPaused() {
+			return nil
+		}
+		// The services of the new spec are created before anything is
+		// stopped, so that an invalid spec leaves the job running as it was.
+		var err error
+		services, err = servicesForSpec(delegate, *jb)
+		return errors.Wrap(err, "failed to create services for job")
+	})
+	if err != nil {
+		lggr.Errorw("Error updating job", "type", jb.Type, "error", err)
+		if active {
+			aj.delegate.AfterJobCreated(aj.spec)
+		}
+		return err
+	}
+
+	// The old services are stopped right before the new ones start, so that
+	// both never run against the same contract at once.
+	func() {
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		js.closeServices(jb.ID, js.activeJobs[jb.ID].services)
+		js.activeJobs[jb.ID] = js.startServices(q.ParentCtx, activeJob{delegate: delegate, spec: *jb}, services)
+	}()
+
+	delegate.AfterJobCreated(*jb)
+
+	lggr.Infow("Updated job", "type", jb.Type)
+	return nil
+}
+
 // Should not get called before Start()
 func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	if jobID == 0 {
@@ -289,6 +400,63 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return nil
 }
 
+// Should not get called before Start()
+func (js *spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	return js.setPaused(jobID, true, qopts...)
+}
+
+// Should not get called before Start()
+func (js *spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	return js.setPaused(jobID, false, qopts...)
+}
+
+// setPaused persists the paused state of the job and restarts it, which
+// starts its services only if it is no longer paused. The delegate callbacks
+// are not called, since the job still exists.
+func (js *spawner) setPaused(jobID int32, paused bool, qopts ...pg.QOpt) error {
+	lggr := js.lggr.With("jobID", jobID)
+
+	q := js.q.WithOpts(qopts...)
+	if q.ParentCtx != nil {
+		ctx, cancel := utils.WithCloseChan(q.ParentCtx, js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	} else {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	}
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	var err error
+	if paused {
+		err = js.orm.PauseJob(jobID, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx))
+	} else {
+		err = js.orm.ResumeJob(jobID, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx))
+	}
+	if err != nil {
+		lggr.Errorw("Error setting job paused state", "paused", paused, "error", err)
+		return err
+	}
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	js.stopService(jobID)
+	if err = js.StartService(q.ParentCtx, jb); err != nil {
+		return err
+	}
+
+	if paused {
+		lggr.Infow("Paused job")
+	} else {
+		lggr.Infow("Resumed job")
+	}
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -1,13 +1,16 @@
 package job_test
 
 import (
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
 
@@ -44,6 +47,19 @@ func (d delegate) ServicesForSpec(js job.Job) ([]job.ServiceCtx, error) {
 	return d.services, nil
 }
 
+// erroringDelegate fails to create services once err is set.
+type erroringDelegate struct {
+	*delegate
+	err error
+}
+
+func (d *erroringDelegate) ServicesForSpec(js job.Job) ([]job.ServiceCtx, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.delegate.ServicesForSpec(js)
+}
+
 func clearDB(t *testing.T, db *sqlx.DB) {
 	cltest.ClearDBTables(t, db, "jobs", "pipeline_runs", "pipeline_specs", "pipeline_task_runs")
 }
@@ -240,4 +256,161 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 
 		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
 	})
+	clearDB(t, db)
+
+	t.Run("restarts job services on 'UpdateJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := new(mocks.ServiceCtx)
+		serviceA2 := new(mocks.ServiceCtx)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		spawner.Start(testutils.Context(t))
+		defer spawner.Close()
+
+		eventuallyStart.AwaitOrFail(t)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		updated := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		updated.ID = jobA.ID
+		updated.ExternalJobID = jobA.ExternalJobID
+		updated.Name = null.StringFrom("updated")
+		err = spawner.UpdateJob(updated, "updated spec")
+		require.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		assert.Equal(t, "updated", spawner.ActiveJobs()[jobA.ID].Name.ValueOrZero())
+
+		versions, err := orm.FindSpecVersions(jobA.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "updated spec", versions[0].TOML)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+	})
+
+	t.Run("keeps job services running when 'UpdateJob()' fails to create the new ones", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := new(mocks.ServiceCtx)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &erroringDelegate{delegate: &delegate{jobA.Type, []job.ServiceCtx{serviceA1}, 0, nil, d}}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		spawner.Start(testutils.Context(t))
+		defer spawner.Close()
+
+		eventuallyStart.AwaitOrFail(t)
+
+		delegateA.err = errors.New("invalid spec")
+		updated := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		updated.ID = jobA.ID
+		updated.ExternalJobID = jobA.ExternalJobID
+		updated.Name = null.StringFrom("updated")
+		err = spawner.UpdateJob(updated, "updated spec")
+		require.ErrorContains(t, err, "invalid spec")
+
+		// The old services were neither stopped nor replaced.
+		mock.AssertExpectationsForObjects(t, serviceA1)
+		assert.Equal(t, jobA.Name, spawner.ActiveJobs()[jobA.ID].Name)
+
+		stored, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jobA.Name, stored.Name)
+		assert.Equal(t, jobA.PipelineSpecID, stored.PipelineSpecID)
+
+		serviceA1.On("Close").Return(nil).Once()
+	})
+
+	t.Run("stops job services on 'PauseJob()' and restarts them on 'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		eventuallyStart := cltest.NewAwaiter()
+		serviceA1 := new(mocks.ServiceCtx)
+		serviceA2 := new(mocks.ServiceCtx)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once().Run(func(mock.Arguments) { eventuallyStart.ItHappened() })
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		err := orm.CreateJob(jobA)
+		require.NoError(t, err)
+		delegateA.jobID = jobA.ID
+
+		spawner.Start(testutils.Context(t))
+
+		eventuallyStart.AwaitOrFail(t)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		assert.True(t, spawner.ActiveJobs()[jobA.ID].IsPaused())
+
+		// A paused job is not started again on restart
+		require.NoError(t, spawner.Close())
+		spawner = job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+		spawner.Start(testutils.Context(t))
+		defer spawner.Close()
+		require.Eventually(t, func() bool {
+			_, ok := spawner.ActiveJobs()[jobA.ID]
+			return ok
+		}, cltest.WaitTimeout(t), cltest.DBPollingInterval)
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		require.NoError(t, spawner.ResumeJob(jobA.ID))
+
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+		assert.False(t, spawner.ActiveJobs()[jobA.ID].IsPaused())
+
+		err = spawner.PauseJob(-1)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+	})
 }
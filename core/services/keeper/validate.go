@@ -79,6 +79,10 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.Errorf("unsupported type %s", j.Type)
 	}
 
+	if spec.FromAddress != "" && spec.FromLabel != "" {
+		return j, errors.New("fromAddress and fromLabel are mutually exclusive")
+	}
+
 	if strings.Contains(tomlString, "observationSource") ||
 		strings.Contains(tomlString, "ObservationSource") {
 		return j, errors.New("There should be no 'observationSource' parameter included in the toml")
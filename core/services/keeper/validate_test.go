@@ -71,6 +71,43 @@ func TestValidatedKeeperSpec(t *testing.T) {
 			wantErr: false,
 		},
 
+		{
+			name: "valid job spec with from label",
+			args: args{
+				tomlString: `
+						    type                        = "keeper"
+						    name                        = "example keeper spec"
+						    contractAddress             = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						    fromLabel                   = "keepers"
+						    externalJobID               =  "123e4567-e89b-12d3-a456-426655440002"
+					    `,
+			},
+			want: want{
+				id:           0,
+				contractAddr: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+				fromAddr:     "",
+				createdAt:    time.Time{},
+				updatedAt:    time.Time{},
+			},
+			wantErr: false,
+		},
+
+		{
+			name: "invalid job spec because of from address and from label",
+			args: args{
+				tomlString: `
+						type            = "keeper"
+						name            = "invalid keeper spec example"
+						contractAddress = "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba"
+						fromAddress     = "0xa8037A20989AFcBC51798de9762b351D63ff462e"
+						fromLabel       = "keepers"
+						externalJobID   = "123e4567-e89b-12d3-a456-426655440002"
+					`,
+			},
+			want:    want{},
+			wantErr: true,
+		},
+
 		{
 			name: "invalid job spec because of type",
 			args: args{
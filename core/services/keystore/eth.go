@@ -1,14 +1,17 @@
 package keystore
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"regexp"
 	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
@@ -17,6 +20,27 @@ import (
 
 //go:generate mockery --name Eth --output mocks/ --case=underscore
 
+var (
+	ErrInvalidKeyLabel = errors.New("key labels may only contain letters, digits, dashes and underscores")
+	// ErrRemoteSignerUnavailable is returned when the remote signer cannot
+	// sign for now, e.g. because it cannot be reached or the key is locked.
+	// Signing may be retried later.
+	ErrRemoteSignerUnavailable = errors.New("remote signer is unavailable")
+	// ErrRemoteSignerRejected is returned when the remote signer refuses to
+	// sign because the request breaks its policy. Retrying will not help.
+	ErrRemoteSignerRejected = errors.New("remote signer rejected the request")
+
+	keyLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// RemoteSigner signs transactions with private keys held outside of the node,
+// e.g. by Web3Signer or Clef.
+type RemoteSigner interface {
+	// Accounts returns the addresses the signer holds keys for.
+	Accounts(ctx context.Context) ([]common.Address, error)
+	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
 // Eth is the external interface for EthKeyStore
 type Eth interface {
 	Get(id string) (ethkey.KeyV2, error)
@@ -26,6 +50,9 @@ type Eth interface {
 	Delete(id string) (ethkey.KeyV2, error)
 	Import(keyJSON []byte, password string, chainID *big.Int) (ethkey.KeyV2, error)
 	Export(id string, password string) ([]byte, error)
+	// AddRemote registers a key held by the remote signer by its address.
+	AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error)
+	SetRemoteSigner(signer RemoteSigner)
 
 	EnsureKeys(chainID *big.Int) error
 	SubscribeToKeyChanges() (ch chan struct{}, unsub func())
@@ -35,6 +62,13 @@ type Eth interface {
 	SendingKeys(chainID *big.Int) (keys []ethkey.KeyV2, err error)
 	FundingKeys() (keys []ethkey.KeyV2, err error)
 	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (address common.Address, err error)
+	GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addresses ...common.Address) (address common.Address, err error)
+
+	Enable(address common.Address, chainID *big.Int) error
+	Disable(address common.Address, chainID *big.Int) error
+	SetLabels(address common.Address, labels []string) error
+
+	ResetNextNonce(address common.Address, chainID *big.Int, nextNonce int64, qopts ...pg.QOpt) error
 
 	GetState(id string) (ethkey.State, error)
 	SetState(ethkey.State) error
@@ -48,6 +82,7 @@ type eth struct {
 	*keyManager
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	remoteSigner  RemoteSigner
 }
 
 var _ Eth = &eth{}
@@ -75,7 +110,7 @@ func (ks *eth) GetAll() (keys []ethkey.KeyV2, _ error) {
 	if ks.isLocked() {
 		return nil, ErrLocked
 	}
-	for _, key := range ks.keyRing.Eth {
+	for _, key := range ks.allKeys() {
 		keys = append(keys, key)
 	}
 	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
@@ -203,6 +238,9 @@ func (ks *eth) Export(id string, password string) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if key.IsRemote() {
+		return nil, errors.Errorf("cannot export eth key %s; its private key is held by the remote signer", id)
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -216,6 +254,14 @@ func (ks *eth) Delete(id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
+	if key.IsRemote() {
+		if _, err = ks.orm.q.Exec(`DELETE FROM eth_key_states WHERE address = $1`, key.Address); err != nil {
+			return ethkey.KeyV2{}, errors.Wrap(err, "unable to remove remote eth key")
+		}
+		delete(ks.keyStates.Eth, key.ID())
+		ks.notify()
+		return key, nil
+	}
 	err = ks.safeRemoveKey(key, func(tx pg.Queryer) error {
 		_, err2 := tx.Exec(`DELETE FROM eth_key_states WHERE address = $1`, key.Address)
 		return err2
@@ -244,18 +290,82 @@ func (ks *eth) SubscribeToKeyChanges() (ch chan struct{}, unsub func()) {
 	}
 }
 
+// SignTx signs the transaction with the key, or with the remote signer if it
+// is a remote key. Remote signing errors wrap ErrRemoteSignerUnavailable or
+// ErrRemoteSignerRejected.
 func (ks *eth) SignTx(address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, remoteSigner, err := ks.getSigningKey(address)
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRemote() {
+		// The lock is not held while waiting for the remote signer
+		return remoteSigner.SignTx(context.Background(), address, tx, chainID)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+}
+
+func (ks *eth) getSigningKey(address common.Address) (ethkey.KeyV2, RemoteSigner, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
-		return nil, ErrLocked
+		return ethkey.KeyV2{}, nil, ErrLocked
 	}
 	key, err := ks.getByID(address.Hex())
 	if err != nil {
-		return nil, err
+		return ethkey.KeyV2{}, nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	if key.IsRemote() && ks.remoteSigner == nil {
+		return ethkey.KeyV2{}, nil, errors.Errorf("eth key %s is held by a remote signer, but none is configured; set ETH_REMOTE_SIGNER_URL", address.Hex())
+	}
+	return key, ks.remoteSigner, nil
+}
+
+// SetRemoteSigner sets the signer for remote keys.
+func (ks *eth) SetRemoteSigner(signer RemoteSigner) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	ks.remoteSigner = signer
+}
+
+// AddRemote registers a key, whose private key is held by the remote signer,
+// on the chain. The remote signer must hold a key for the address.
+func (ks *eth) AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	ks.lock.RLock()
+	remoteSigner := ks.remoteSigner
+	ks.lock.RUnlock()
+	if remoteSigner == nil {
+		return ethkey.KeyV2{}, errors.New("no remote signer is configured; set ETH_REMOTE_SIGNER_URL")
+	}
+	accounts, err := remoteSigner.Accounts(context.Background())
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "failed to list remote signer accounts")
+	}
+	if !containsAddress(accounts, address) {
+		return ethkey.KeyV2{}, errors.Errorf("remote signer does not hold a key for address %s", address.Hex())
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.FromAddress(ethkey.EIP55AddressFromAddress(address))
+	if _, err = ks.getByID(key.ID()); err == nil {
+		return ethkey.KeyV2{}, fmt.Errorf("key with ID %s already exists", key.ID())
+	}
+	state := ethkey.State{Address: key.Address, EVMChainID: *utils.NewBig(chainID), Remote: true}
+	sql := `INSERT INTO eth_key_states (address, next_nonce, is_funding, evm_chain_id, remote, created_at, updated_at)
+VALUES (:address, :next_nonce, :is_funding, :evm_chain_id, :remote, NOW(), NOW())
+RETURNING *;`
+	if err = ks.orm.q.GetNamed(sql, &state, state); err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "failed to insert eth_key_state")
+	}
+	ks.keyStates.Eth[key.ID()] = &state
+	ks.logger.Infow("Remote eth key added", "address", key.Address.Hex(), "evmChainID", chainID)
+	ks.notify()
+	return key, nil
 }
 
 // SendingKeys returns all sending keys for the given chain
@@ -278,7 +388,15 @@ func (ks *eth) FundingKeys() (fundingKeys []ethkey.KeyV2, err error) {
 	return ks.fundingKeys(), nil
 }
 
+// GetRoundRobinAddress returns the least recently used enabled sending key
+// for the chain, out of addresses if any are given.
 func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Address) (common.Address, error) {
+	return ks.GetRoundRobinAddressWithLabel(chainID, "", whitelist...)
+}
+
+// GetRoundRobinAddressWithLabel is like GetRoundRobinAddress, but only
+// considers keys with the label, unless label is empty.
+func (ks *eth) GetRoundRobinAddressWithLabel(chainID *big.Int, label string, whitelist ...common.Address) (common.Address, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
 	if ks.isLocked() {
@@ -286,30 +404,29 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 	}
 
 	var keys []ethkey.KeyV2
-	if len(whitelist) == 0 {
-		keys = ks.sendingKeys(chainID)
-	} else if len(whitelist) > 0 {
-		for _, k := range ks.sendingKeys(chainID) {
-			for _, addr := range whitelist {
-				if addr == k.Address.Address() {
-					keys = append(keys, k)
-				}
-			}
+	for _, k := range ks.sendingKeys(chainID) {
+		state := ks.keyStates.Eth[k.ID()]
+		if state.Disabled || (label != "" && !state.HasLabel(label)) {
+			continue
+		}
+		if len(whitelist) > 0 && !containsAddress(whitelist, k.Address.Address()) {
+			continue
 		}
+		keys = append(keys, k)
 	}
 
 	if len(keys) == 0 {
-		var err error
-		if chainID == nil && len(whitelist) == 0 {
-			err = errors.New("no sending keys available")
-		} else if chainID == nil {
-			err = errors.Errorf("no sending keys available that match whitelist: %v", whitelist)
-		} else if len(whitelist) == 0 {
-			err = errors.Errorf("no sending keys available for chain %s", chainID.String())
-		} else {
-			err = errors.Errorf("no sending keys available for chain %s that match whitelist: %v", chainID.String(), whitelist)
+		msg := "no sending keys available"
+		if chainID != nil {
+			msg += fmt.Sprintf(" for chain %s", chainID.String())
+		}
+		if label != "" {
+			msg += fmt.Sprintf(" with label %q", label)
 		}
-		return common.Address{}, err
+		if len(whitelist) > 0 {
+			msg += fmt.Sprintf(" that match whitelist: %v", whitelist)
+		}
+		return common.Address{}, errors.New(msg)
 	}
 
 	sort.SliceStable(keys, func(i, j int) bool {
@@ -321,6 +438,70 @@ func (ks *eth) GetRoundRobinAddress(chainID *big.Int, whitelist ...common.Addres
 	return leastRecentlyUsed.Address.Address(), nil
 }
 
+// Enable allows the key to be used for new transactions on the chain again.
+func (ks *eth) Enable(address common.Address, chainID *big.Int) error {
+	return ks.setDisabled(address, chainID, false)
+}
+
+// Disable stops the key from being used for new transactions on the chain.
+// Transactions already queued for the key are still sent and confirmed, so
+// that it is drained gracefully.
+func (ks *eth) Disable(address common.Address, chainID *big.Int) error {
+	return ks.setDisabled(address, chainID, true)
+}
+
+func (ks *eth) setDisabled(address common.Address, chainID *big.Int, disabled bool) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	state, err := ks.getStateOnChain(address, chainID)
+	if err != nil {
+		return err
+	}
+	sql := `UPDATE eth_key_states SET disabled = $1, updated_at = NOW() WHERE address = $2 RETURNING updated_at`
+	if err = ks.orm.q.Get(&state.UpdatedAt, sql, disabled, address); err != nil {
+		return errors.Wrap(err, "failed to update eth key state")
+	}
+	state.Disabled = disabled
+	ks.notify()
+	return nil
+}
+
+// SetLabels replaces the labels of the key.
+func (ks *eth) SetLabels(address common.Address, labels []string) error {
+	set := make(map[string]struct{})
+	sorted := pq.StringArray{}
+	for _, l := range labels {
+		if !keyLabelRegexp.MatchString(l) {
+			return errors.Wrapf(ErrInvalidKeyLabel, "%q", l)
+		}
+		if _, ok := set[l]; !ok {
+			set[l] = struct{}{}
+			sorted = append(sorted, l)
+		}
+	}
+	sort.Strings(sorted)
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	state, err := ks.getStateOnChain(address, nil)
+	if err != nil {
+		return err
+	}
+	sql := `UPDATE eth_key_states SET labels = $1, updated_at = NOW() WHERE address = $2 RETURNING updated_at`
+	if err = ks.orm.q.Get(&state.UpdatedAt, sql, sorted, address); err != nil {
+		return errors.Wrap(err, "failed to update eth key state")
+	}
+	state.Labels = sorted
+	ks.notify()
+	return nil
+}
+
 func (ks *eth) GetState(id string) (ethkey.State, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -334,6 +515,26 @@ func (ks *eth) GetState(id string) (ethkey.State, error) {
 	return *state, nil
 }
 
+// ResetNextNonce sets the nonce of the next transaction from the key on the
+// chain, e.g. so that the nonces of abandoned transactions are reused.
+func (ks *eth) ResetNextNonce(address common.Address, chainID *big.Int, nextNonce int64, qopts ...pg.QOpt) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	state, err := ks.getStateOnChain(address, chainID)
+	if err != nil {
+		return err
+	}
+	sql := `UPDATE eth_key_states SET next_nonce = $1, updated_at = NOW() WHERE address = $2 RETURNING updated_at`
+	if err = ks.orm.q.WithOpts(qopts...).Get(&state.UpdatedAt, sql, nextNonce, address); err != nil {
+		return errors.Wrap(err, "failed to reset next nonce")
+	}
+	state.NextNonce = nextNonce
+	return nil
+}
+
 // SetState is only used in tests to manually update a key's state
 func (ks *eth) SetState(state ethkey.State) error {
 	ks.lock.Lock()
@@ -345,8 +546,11 @@ func (ks *eth) SetState(state ethkey.State) error {
 	if !exists {
 		return errors.Errorf("key not found with ID %s", state.KeyID())
 	}
+	if state.Labels == nil {
+		state.Labels = pq.StringArray{}
+	}
 	ks.keyStates.Eth[state.KeyID()] = &state
-	sql := `UPDATE eth_key_states SET address = :address, next_nonce = :next_nonce, is_funding = :is_funding, evm_chain_id = :evm_chain_id, updated_at = NOW()
+	sql := `UPDATE eth_key_states SET address = :address, next_nonce = :next_nonce, is_funding = :is_funding, evm_chain_id = :evm_chain_id, disabled = :disabled, labels = :labels, updated_at = NOW()
 	WHERE address = :address;`
 	_, err := ks.orm.q.NamedExec(sql, state)
 	return errors.Wrap(err, "SetState#Exec failed")
@@ -418,16 +622,47 @@ WARNING: This will PERMANENTLY AND IRRECOVERABLY delete any legacy eth keys, so
 
 // caller must hold lock!
 func (ks *eth) getByID(id string) (ethkey.KeyV2, error) {
-	key, found := ks.keyRing.Eth[id]
-	if !found {
-		return ethkey.KeyV2{}, fmt.Errorf("unable to find eth key with id %s", id)
+	if key, found := ks.keyRing.Eth[id]; found {
+		return key, nil
 	}
-	return key, nil
+	if state, found := ks.keyStates.Eth[id]; found && state.Remote {
+		return ethkey.FromAddress(state.Address), nil
+	}
+	return ethkey.KeyV2{}, fmt.Errorf("unable to find eth key with id %s", id)
+}
+
+// caller must hold lock!
+// allKeys returns the keys in the key ring, and the remote keys, which are
+// only registered by their address.
+func (ks *eth) allKeys() map[string]ethkey.KeyV2 {
+	keys := make(map[string]ethkey.KeyV2, len(ks.keyRing.Eth))
+	for id, key := range ks.keyRing.Eth {
+		keys[id] = key
+	}
+	for id, state := range ks.keyStates.Eth {
+		if state.Remote {
+			keys[id] = ethkey.FromAddress(state.Address)
+		}
+	}
+	return keys
+}
+
+// caller must hold lock!
+// if chainID is nil, the key may be on any chain
+func (ks *eth) getStateOnChain(address common.Address, chainID *big.Int) (*ethkey.State, error) {
+	state, exists := ks.keyStates.Eth[address.Hex()]
+	if !exists {
+		return nil, errors.Errorf("no eth key exists with address %s", address.Hex())
+	}
+	if chainID != nil && state.EVMChainID.Cmp(utils.NewBig(chainID)) != 0 {
+		return nil, errors.Errorf("eth key with address %s is not on chain %s; it is pegged to chain %s", address.Hex(), chainID.String(), state.EVMChainID.String())
+	}
+	return state, nil
 }
 
 // caller must hold lock!
 func (ks *eth) fundingKeys() (fundingKeys []ethkey.KeyV2) {
-	for _, k := range ks.keyRing.Eth {
+	for _, k := range ks.allKeys() {
 		if ks.keyStates.Eth[k.ID()].IsFunding {
 			fundingKeys = append(fundingKeys, k)
 		}
@@ -439,7 +674,7 @@ func (ks *eth) fundingKeys() (fundingKeys []ethkey.KeyV2) {
 // caller must hold lock!
 // if chainID is nil, returns keys for all chains
 func (ks *eth) sendingKeys(chainID *big.Int) (sendingKeys []ethkey.KeyV2) {
-	for _, k := range ks.keyRing.Eth {
+	for _, k := range ks.allKeys() {
 		state := ks.keyStates.Eth[k.ID()]
 		if !state.IsFunding && (chainID == nil || (((*big.Int)(&state.EVMChainID)).Cmp(chainID) == 0)) {
 			sendingKeys = append(sendingKeys, k)
@@ -469,6 +704,15 @@ RETURNING *;`
 	})
 }
 
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
 // notify notifies subscribers that eth keys have changed
 func (ks *eth) notify() {
 	ks.subscribersMu.RLock()
@@ -3,6 +3,8 @@ package keystore_test
 import (
 	"fmt"
 	"math/big"
+	"net/http/httptest"
+	"net/url"
 	"sort"
 	"testing"
 	"time"
@@ -18,8 +20,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -247,6 +251,217 @@ func Test_EthKeyStore_GetRoundRobinAddress(t *testing.T) {
 	})
 }
 
+func Test_EthKeyStore_DisabledAndLabels(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethKeyStore := keyStore.Eth()
+
+	k1, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+	k2, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	t.Run("disabled keys are not returned by round robin", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.Disable(k1.Address.Address(), &cltest.FixtureChainID))
+
+		state, err := ethKeyStore.GetState(k1.ID())
+		require.NoError(t, err)
+		assert.True(t, state.Disabled)
+
+		for i := 0; i < 3; i++ {
+			address, err := ethKeyStore.GetRoundRobinAddress(&cltest.FixtureChainID)
+			require.NoError(t, err)
+			require.Equal(t, k2.Address.Address(), address)
+		}
+
+		_, err = ethKeyStore.GetRoundRobinAddress(&cltest.FixtureChainID, k1.Address.Address())
+		require.Error(t, err)
+
+		require.NoError(t, ethKeyStore.Enable(k1.Address.Address(), &cltest.FixtureChainID))
+		address, err := ethKeyStore.GetRoundRobinAddress(&cltest.FixtureChainID, k1.Address.Address())
+		require.NoError(t, err)
+		require.Equal(t, k1.Address.Address(), address)
+	})
+
+	t.Run("errors when disabling a key on another chain", func(t *testing.T) {
+		require.Error(t, ethKeyStore.Disable(k1.Address.Address(), testutils.SimulatedChainID))
+	})
+
+	t.Run("sets labels", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.SetLabels(k1.Address.Address(), []string{"ocr", "keeper", "ocr"}))
+
+		state, err := ethKeyStore.GetState(k1.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"keeper", "ocr"}, []string(state.Labels))
+		assert.True(t, state.HasLabel("ocr"))
+		assert.False(t, state.HasLabel("vrf"))
+
+		err = ethKeyStore.SetLabels(k1.Address.Address(), []string{"not valid"})
+		require.ErrorIs(t, err, keystore.ErrInvalidKeyLabel)
+	})
+
+	t.Run("rotates between keys with the given label", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			address, err := ethKeyStore.GetRoundRobinAddressWithLabel(&cltest.FixtureChainID, "ocr")
+			require.NoError(t, err)
+			require.Equal(t, k1.Address.Address(), address)
+		}
+
+		_, err := ethKeyStore.GetRoundRobinAddressWithLabel(&cltest.FixtureChainID, "vrf")
+		require.Error(t, err)
+		require.Equal(t, fmt.Sprintf("no sending keys available for chain %s with label \"vrf\"", cltest.FixtureChainID.String()), err.Error())
+	})
+
+	t.Run("clears labels", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.SetLabels(k1.Address.Address(), nil))
+
+		state, err := ethKeyStore.GetState(k1.ID())
+		require.NoError(t, err)
+		assert.Empty(t, state.Labels)
+	})
+}
+
+func Test_EthKeyStore_ResetNextNonce(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethKeyStore := keyStore.Eth()
+
+	k, address := cltest.MustInsertRandomKey(t, ethKeyStore, int64(5))
+
+	require.NoError(t, ethKeyStore.ResetNextNonce(address, &cltest.FixtureChainID, 2))
+	state, err := ethKeyStore.GetState(k.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), state.NextNonce)
+	var nextNonce int64
+	require.NoError(t, db.Get(&nextNonce, `SELECT next_nonce FROM eth_key_states WHERE address = $1`, address))
+	assert.Equal(t, int64(2), nextNonce)
+
+	require.Error(t, ethKeyStore.ResetNextNonce(address, testutils.SimulatedChainID, 1))
+	require.Error(t, ethKeyStore.ResetNextNonce(testutils.NewAddress(), &cltest.FixtureChainID, 1))
+}
+
+func Test_EthKeyStore_Remote(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	ethKeyStore := keyStore.Eth()
+	chainID := testutils.SimulatedChainID
+
+	remoteKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+	signer := remotesigner.NewReferenceSigner(remotesigner.ProtocolWeb3Signer, remoteKey)
+	server := httptest.NewServer(signer)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client, err := remotesigner.NewClient(*u, remotesigner.ProtocolWeb3Signer, time.Second)
+	require.NoError(t, err)
+
+	t.Run("errors without a remote signer", func(t *testing.T) {
+		_, err := ethKeyStore.AddRemote(remoteKey.Address.Address(), chainID)
+		require.Error(t, err)
+	})
+
+	ethKeyStore.SetRemoteSigner(client)
+
+	t.Run("errors if the remote signer does not hold the key", func(t *testing.T) {
+		_, err := ethKeyStore.AddRemote(testutils.NewAddress(), chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not hold a key")
+	})
+
+	key, err := ethKeyStore.AddRemote(remoteKey.Address.Address(), chainID)
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+	assert.Equal(t, remoteKey.Address, key.Address)
+
+	t.Run("lists the remote key as a sending key", func(t *testing.T) {
+		sendingKeys, err := ethKeyStore.SendingKeys(chainID)
+		require.NoError(t, err)
+		require.Len(t, sendingKeys, 1)
+		assert.Equal(t, remoteKey.Address, sendingKeys[0].Address)
+
+		state, err := ethKeyStore.GetState(key.ID())
+		require.NoError(t, err)
+		assert.True(t, state.Remote)
+
+		address, err := ethKeyStore.GetRoundRobinAddress(chainID)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address.Address(), address)
+	})
+
+	t.Run("errors when adding it again", func(t *testing.T) {
+		_, err := ethKeyStore.AddRemote(remoteKey.Address.Address(), chainID)
+		require.Error(t, err)
+	})
+
+	t.Run("signs with the remote signer", func(t *testing.T) {
+		to := testutils.NewAddress()
+		tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1)})
+		signed, err := ethKeyStore.SignTx(remoteKey.Address.Address(), tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, remoteKey.Address.Address(), sender)
+	})
+
+	t.Run("cannot be exported", func(t *testing.T) {
+		_, err := ethKeyStore.Export(key.ID(), cltest.Password)
+		require.Error(t, err)
+	})
+
+	t.Run("survives a restart", func(t *testing.T) {
+		keyStore2 := keystore.New(db, utils.FastScryptParams, logger.TestLogger(t), cfg)
+		require.NoError(t, keyStore2.Unlock(cltest.Password))
+		key2, err := keyStore2.Eth().Get(key.ID())
+		require.NoError(t, err)
+		assert.True(t, key2.IsRemote())
+
+		// Without a remote signer it cannot sign
+		_, err = keyStore2.Eth().SignTx(remoteKey.Address.Address(), types.NewTx(&types.LegacyTx{}), chainID)
+		require.Error(t, err)
+	})
+
+	t.Run("errors when the remote signer rejects the transaction", func(t *testing.T) {
+		signer.Deny(remoteKey.Address.Address(), true)
+		t.Cleanup(func() { signer.Deny(remoteKey.Address.Address(), false) })
+		_, err := ethKeyStore.SignTx(remoteKey.Address.Address(), types.NewTx(&types.LegacyTx{}), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerRejected)
+	})
+
+	t.Run("errors when the remote signer no longer holds the key", func(t *testing.T) {
+		signer.RemoveKey(remoteKey.Address.Address())
+		t.Cleanup(func() { signer.AddKey(remoteKey) })
+		_, err := ethKeyStore.SignTx(remoteKey.Address.Address(), types.NewTx(&types.LegacyTx{}), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+
+	t.Run("deletes the remote key", func(t *testing.T) {
+		_, err := ethKeyStore.Delete(key.ID())
+		require.NoError(t, err)
+		_, err = ethKeyStore.Get(key.ID())
+		require.Error(t, err)
+		sendingKeys, err := ethKeyStore.SendingKeys(chainID)
+		require.NoError(t, err)
+		assert.Len(t, sendingKeys, 0)
+	})
+
+	t.Run("errors when the remote signer is unavailable", func(t *testing.T) {
+		server.Close()
+		_, err := ethKeyStore.AddRemote(remoteKey.Address.Address(), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+}
+
 func Test_EthKeyStore_SignTx(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	config := configtest.NewTestGeneralConfig(t)
@@ -452,7 +667,14 @@ func Test_EthKeyStore_SubscribeToKeyChanges(t *testing.T) {
 	err = ks.Add(newKey, &cltest.FixtureChainID)
 	require.NoError(t, err)
 	assertCount(3)
+	// disabling and labelling keys changes which keys are used to send
+	require.NoError(t, ks.Disable(newKey.Address.Address(), &cltest.FixtureChainID))
+	assertCount(4)
+	require.NoError(t, ks.Enable(newKey.Address.Address(), &cltest.FixtureChainID))
+	assertCount(5)
+	require.NoError(t, ks.SetLabels(newKey.Address.Address(), []string{"ocr"}))
+	assertCount(6)
 	_, err = ks.Delete(newKey.ID())
 	require.NoError(t, err)
-	assertCount(4)
+	assertCount(7)
 }
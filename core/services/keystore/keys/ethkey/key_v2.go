@@ -58,6 +58,18 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// FromAddress returns a key for an address whose private key is held by a
+// remote signer. It can only sign through the remote signer.
+func FromAddress(address EIP55Address) KeyV2 {
+	return KeyV2{Address: address}
+}
+
+// IsRemote returns true if the key has no private key, because it is held by
+// a remote signer.
+func (key KeyV2) IsRemote() bool {
+	return key.privateKey == nil
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -3,6 +3,8 @@ package ethkey
 import (
 	"time"
 
+	"github.com/lib/pq"
+
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -12,9 +14,17 @@ type State struct {
 	NextNonce  int64
 	IsFunding  bool
 	EVMChainID utils.Big
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	lastUsed   time.Time
+	// Disabled keys are not used for new transactions, but their pending
+	// transactions are still sent and confirmed.
+	Disabled bool
+	// Labels let job specs select sending keys by purpose, e.g. "ocr" or "keeper".
+	Labels pq.StringArray
+	// Remote keys are registered by address only. Their private key is held
+	// by the remote signer, which signs all their transactions.
+	Remote    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	lastUsed  time.Time
 }
 
 func (s State) KeyID() string {
@@ -30,3 +40,13 @@ func (s State) LastUsed() time.Time {
 func (s *State) WasUsed() {
 	s.lastUsed = time.Now()
 }
+
+// HasLabel returns true if the key has the label.
+func (s State) HasLabel(label string) bool {
+	for _, l := range s.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
@@ -43,6 +43,7 @@ type Master interface {
 	OCR() OCR
 	OCR2() OCR2
 	P2P() P2P
+	Secrets() Secrets
 	Solana() Solana
 	Terra() Terra
 	StarkNet() StarkNet
@@ -59,6 +60,7 @@ type master struct {
 	ocr        *ocr
 	ocr2       ocr2
 	p2p        *p2p
+	secrets    *secrets
 	solana     *solana
 	terra      *terra
 	starknet   *starknet
@@ -86,6 +88,7 @@ func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger,
 		ocr:        newOCRKeyStore(km),
 		ocr2:       newOCR2KeyStore(km),
 		p2p:        newP2PKeyStore(km),
+		secrets:    newSecretsStore(km),
 		solana:     newSolanaKeyStore(km),
 		terra:      newTerraKeyStore(km),
 		starknet:   newStarkNetKeyStore(km),
@@ -123,6 +126,10 @@ func (ks *master) P2P() P2P {
 	return ks.p2p
 }
 
+func (ks *master) Secrets() Secrets {
+	return ks.secrets
+}
+
 func (ks *master) Solana() Solana {
 	return ks.solana
 }
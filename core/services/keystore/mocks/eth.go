@@ -12,6 +12,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -34,6 +36,27 @@ func (_m *Eth) Add(key ethkey.KeyV2, chainID *big.Int) error {
 	return r0
 }
 
+// AddRemote provides a mock function with given fields: address, chainID
+func (_m *Eth) AddRemote(address common.Address, chainID *big.Int) (ethkey.KeyV2, error) {
+	ret := _m.Called(address, chainID)
+
+	var r0 ethkey.KeyV2
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) ethkey.KeyV2); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *big.Int) error); ok {
+		r1 = rf(address, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: chainID
 func (_m *Eth) Create(chainID *big.Int) (ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -76,6 +99,34 @@ func (_m *Eth) Delete(id string) (ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// Disable provides a mock function with given fields: address, chainID
+func (_m *Eth) Disable(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Enable provides a mock function with given fields: address, chainID
+func (_m *Eth) Enable(address common.Address, chainID *big.Int) error {
+	ret := _m.Called(address, chainID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int) error); ok {
+		r0 = rf(address, chainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EnsureKeys provides a mock function with given fields: chainID
 func (_m *Eth) EnsureKeys(chainID *big.Int) error {
 	ret := _m.Called(chainID)
@@ -210,6 +261,36 @@ func (_m *Eth) GetRoundRobinAddress(chainID *big.Int, addresses ...common.Addres
 	return r0, r1
 }
 
+// GetRoundRobinAddressWithLabel provides a mock function with given fields: chainID, label, addresses
+func (_m *Eth) GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addresses ...common.Address) (common.Address, error) {
+	_va := make([]interface{}, len(addresses))
+	for _i := range addresses {
+		_va[_i] = addresses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainID, label)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func(*big.Int, string, ...common.Address) common.Address); ok {
+		r0 = rf(chainID, label, addresses...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, string, ...common.Address) error); ok {
+		r1 = rf(chainID, label, addresses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetState provides a mock function with given fields: id
 func (_m *Eth) GetState(id string) (ethkey.State, error) {
 	ret := _m.Called(id)
@@ -330,6 +411,27 @@ func (_m *Eth) Import(keyJSON []byte, password string, chainID *big.Int) (ethkey
 	return r0, r1
 }
 
+// ResetNextNonce provides a mock function with given fields: address, chainID, nextNonce, qopts
+func (_m *Eth) ResetNextNonce(address common.Address, chainID *big.Int, nextNonce int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address, chainID, nextNonce)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, int64, ...pg.QOpt) error); ok {
+		r0 = rf(address, chainID, nextNonce, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendingKeys provides a mock function with given fields: chainID
 func (_m *Eth) SendingKeys(chainID *big.Int) ([]ethkey.KeyV2, error) {
 	ret := _m.Called(chainID)
@@ -353,6 +455,25 @@ func (_m *Eth) SendingKeys(chainID *big.Int) ([]ethkey.KeyV2, error) {
 	return r0, r1
 }
 
+// SetLabels provides a mock function with given fields: address, labels
+func (_m *Eth) SetLabels(address common.Address, labels []string) error {
+	ret := _m.Called(address, labels)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, []string) error); ok {
+		r0 = rf(address, labels)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetRemoteSigner provides a mock function with given fields: signer
+func (_m *Eth) SetRemoteSigner(signer keystore.RemoteSigner) {
+	_m.Called(signer)
+}
+
 // SetState provides a mock function with given fields: _a0
 func (_m *Eth) SetState(_a0 ethkey.State) error {
 	ret := _m.Called(_a0)
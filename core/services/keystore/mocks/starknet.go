@@ -12,6 +12,29 @@ type StarkNet struct {
 	mock.Mock
 }
 
+// Accounts provides a mock function with given fields:
+func (_m *StarkNet) Accounts() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Add provides a mock function with given fields: key
 func (_m *StarkNet) Add(key starkkey.Key) error {
 	ret := _m.Called(key)
@@ -26,6 +49,20 @@ func (_m *StarkNet) Add(key starkkey.Key) error {
 	return r0
 }
 
+// AddAccount provides a mock function with given fields: account, keyID
+func (_m *StarkNet) AddAccount(account string, keyID string) error {
+	ret := _m.Called(account, keyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(account, keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Create provides a mock function with given fields:
 func (_m *StarkNet) Create() (starkkey.Key, error) {
 	ret := _m.Called()
@@ -88,6 +88,10 @@ type keyRing struct {
 	VRF        map[string]vrfkey.KeyV2
 	DKGSign    map[string]dkgsignkey.Key
 	DKGEncrypt map[string]dkgencryptkey.Key
+	Secrets    map[string]string
+	// StarkNetAccounts maps the address of each known StarkNet account
+	// contract to the ID of the StarkNet key that controls it.
+	StarkNetAccounts map[string]string
 }
 
 func newKeyRing() keyRing {
@@ -103,6 +107,9 @@ func newKeyRing() keyRing {
 		VRF:        make(map[string]vrfkey.KeyV2),
 		DKGSign:    make(map[string]dkgsignkey.Key),
 		DKGEncrypt: make(map[string]dkgencryptkey.Key),
+		Secrets:    make(map[string]string),
+
+		StarkNetAccounts: make(map[string]string),
 	}
 }
 
@@ -163,6 +170,18 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, dkgEncryptKey := range kr.DKGEncrypt {
 		rawKeys.DKGEncrypt = append(rawKeys.DKGEncrypt, dkgEncryptKey.Raw())
 	}
+	if len(kr.Secrets) > 0 {
+		rawKeys.Secrets = make(map[string]string, len(kr.Secrets))
+		for name, value := range kr.Secrets {
+			rawKeys.Secrets[name] = value
+		}
+	}
+	if len(kr.StarkNetAccounts) > 0 {
+		rawKeys.StarkNetAccounts = make(map[string]string, len(kr.StarkNetAccounts))
+		for account, keyID := range kr.StarkNetAccounts {
+			rawKeys.StarkNetAccounts[account] = keyID
+		}
+	}
 	return rawKeys
 }
 
@@ -245,6 +264,9 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 	if len(dkgEncryptIDs) > 0 {
 		lggr.Infow(fmt.Sprintf("Unlocked %d DKGEncrypt keys", len(dkgEncryptIDs)), "keys", dkgEncryptIDs)
 	}
+	if len(kr.Secrets) > 0 {
+		lggr.Infof("Unlocked %d secrets", len(kr.Secrets))
+	}
 }
 
 // rawKeyRing is an intermediate struct for encrypting / decrypting keyRing
@@ -262,6 +284,9 @@ type rawKeyRing struct {
 	VRF        []vrfkey.Raw
 	DKGSign    []dkgsignkey.Raw
 	DKGEncrypt []dkgencryptkey.Raw
+	Secrets    map[string]string `json:",omitempty"`
+
+	StarkNetAccounts map[string]string `json:",omitempty"`
 }
 
 func (rawKeys rawKeyRing) keys() (keyRing, error) {
@@ -310,6 +335,12 @@ func (rawKeys rawKeyRing) keys() (keyRing, error) {
 		dkgEncryptKey := rawDKGEncryptKey.Key()
 		keyRing.DKGEncrypt[dkgEncryptKey.ID()] = dkgEncryptKey
 	}
+	for name, value := range rawKeys.Secrets {
+		keyRing.Secrets[name] = value
+	}
+	for account, keyID := range rawKeys.StarkNetAccounts {
+		keyRing.StarkNetAccounts[account] = keyID
+	}
 	return keyRing, nil
 }
 
@@ -0,0 +1,272 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+const (
+	// ProtocolWeb3Signer is the dialect of Web3Signer's eth1 JSON-RPC API.
+	ProtocolWeb3Signer = "web3signer"
+	// ProtocolClef is the dialect of Clef's external API.
+	ProtocolClef = "clef"
+
+	maxResponseBytes = 1024 * 1024
+
+	// codeTransactionRejected is the JSON-RPC error code for a transaction
+	// the signer refuses to sign, as defined by EIP-1474.
+	codeTransactionRejected = -32003
+	// codeUserRejected is the JSON-RPC error code for a request the signer's
+	// operator refused, as defined by EIP-1193.
+	codeUserRejected = 4001
+	// clefRequestDenied is the message of the error clef returns when its
+	// rules, or its operator, deny a request.
+	clefRequestDenied = "request denied"
+)
+
+type methods struct {
+	accounts string
+	signTx   string
+}
+
+var protocolMethods = map[string]methods{
+	ProtocolWeb3Signer: {accounts: "eth_accounts", signTx: "eth_signTransaction"},
+	ProtocolClef:       {accounts: "account_list", signTx: "account_signTransaction"},
+}
+
+// TxArgs is the transaction object sent to the signer.
+type TxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+// NewTxArgs returns the transaction object for tx.
+func NewTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) TxArgs {
+	args := TxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		accessList := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	return args
+}
+
+// Tx returns the unsigned transaction described by args.
+func (args TxArgs) Tx() *types.Transaction {
+	value := (*big.Int)(args.Value)
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	if args.MaxFeePerGas != nil {
+		var accessList types.AccessList
+		if args.AccessList != nil {
+			accessList = *args.AccessList
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    (*big.Int)(args.ChainID),
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  (*big.Int)(args.MaxPriorityFeePerGas),
+			GasFeeCap:  (*big.Int)(args.MaxFeePerGas),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      value,
+			Data:       args.Data,
+			AccessList: accessList,
+		})
+	}
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    uint64(args.Nonce),
+		GasPrice: (*big.Int)(args.GasPrice),
+		Gas:      uint64(args.Gas),
+		To:       args.To,
+		Value:    value,
+		Data:     args.Data,
+	})
+}
+
+type request struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      uint64          `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rejectedByPolicy reports whether the signer refused to sign. Any other
+// error, e.g. an unknown or locked key, may go away and is retried.
+func (e *rpcError) rejectedByPolicy() bool {
+	switch e.Code {
+	case codeTransactionRejected, codeUserRejected:
+		return true
+	}
+	return strings.EqualFold(e.Message, clefRequestDenied)
+}
+
+// clefSignTxResult is the result of Clef's account_signTransaction.
+type clefSignTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+var _ keystore.RemoteSigner = &Client{}
+
+// Client signs transactions with a remote signer over HTTP JSON-RPC.
+type Client struct {
+	url     string
+	methods methods
+	http    *http.Client
+	nextID  uint64
+}
+
+// NewClient returns a client for the signer at u, which speaks protocol.
+// Requests time out after timeout.
+func NewClient(u url.URL, protocol string, timeout time.Duration) (*Client, error) {
+	m, ok := protocolMethods[protocol]
+	if !ok {
+		return nil, errors.Errorf("unsupported remote signer protocol %q", protocol)
+	}
+	return &Client{
+		url:     u.String(),
+		methods: m,
+		http:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Accounts returns the addresses the signer holds keys for.
+func (c *Client) Accounts(ctx context.Context) ([]common.Address, error) {
+	var accounts []common.Address
+	if err := c.call(ctx, &accounts, c.methods.accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// SignTx asks the signer to sign tx with the key for fromAddress, and checks
+// that it signed exactly that.
+func (c *Client) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	var result json.RawMessage
+	if err := c.call(ctx, &result, c.methods.signTx, NewTxArgs(fromAddress, tx, chainID)); err != nil {
+		return nil, err
+	}
+	raw, err := parseSignTxResult(result)
+	if err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid transaction")
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.Errorf("remote signer returned a different transaction than the one requested: got %s", signed.Hash().Hex())
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	if sender != fromAddress {
+		return nil, errors.Errorf("remote signer signed with %s instead of %s", sender.Hex(), fromAddress.Hex())
+	}
+	return signed, nil
+}
+
+// parseSignTxResult accepts both a hex string, and an object with a raw field.
+func parseSignTxResult(result json.RawMessage) ([]byte, error) {
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err == nil {
+		return raw, nil
+	}
+	var obj clefSignTxResult
+	if err := json.Unmarshal(result, &obj); err != nil || len(obj.Raw) == 0 {
+		return nil, errors.Errorf("remote signer returned an unexpected result: %s", string(result))
+	}
+	return obj.Raw, nil
+}
+
+func (c *Client) call(ctx context.Context, result interface{}, method string, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+	req := request{JSONRPC: "2.0", ID: atomic.AddUint64(&c.nextID, 1), Method: method, Params: params}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%w: %s failed: %v", keystore.ErrRemoteSignerUnavailable, method, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxResponseBytes))
+	if err != nil {
+		return fmt.Errorf("%w: %s failed to read response: %v", keystore.ErrRemoteSignerUnavailable, method, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s failed with status %d: %s", keystore.ErrRemoteSignerUnavailable, method, resp.StatusCode, string(respBody))
+	}
+
+	var rpcResp response
+	if err = json.Unmarshal(respBody, &rpcResp); err != nil {
+		return errors.Wrapf(err, "remote signer returned an invalid response to %s", method)
+	}
+	if rpcResp.Error != nil {
+		cause := keystore.ErrRemoteSignerUnavailable
+		if rpcResp.Error.rejectedByPolicy() {
+			cause = keystore.ErrRemoteSignerRejected
+		}
+		return fmt.Errorf("%w: %s failed with code %d: %s", cause, method, rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+	return errors.Wrapf(json.Unmarshal(rpcResp.Result, result), "remote signer returned an invalid result for %s", method)
+}
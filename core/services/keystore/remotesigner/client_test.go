@@ -0,0 +1,229 @@
+package remotesigner_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/remotesigner"
+)
+
+func newClient(t *testing.T, rawURL, protocol string) *remotesigner.Client {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	client, err := remotesigner.NewClient(*u, protocol, time.Second)
+	require.NoError(t, err)
+	return client
+}
+
+func newLegacyTx() *types.Transaction {
+	to := testutils.NewAddress()
+	return types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(42), Data: []byte{1, 2, 3}})
+}
+
+func newDynamicFeeTx(chainID *big.Int) *types.Transaction {
+	to := testutils.NewAddress()
+	return types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 7, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(2e9), Gas: 21000, To: &to, Value: big.NewInt(42), Data: []byte{4, 5, 6}})
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.SimulatedChainID
+	for _, protocol := range []string{remotesigner.ProtocolWeb3Signer, remotesigner.ProtocolClef} {
+		protocol := protocol
+		t.Run(protocol, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := ethkey.NewV2()
+			require.NoError(t, err)
+			signer := remotesigner.NewReferenceSigner(protocol, key)
+			server := httptest.NewServer(signer)
+			t.Cleanup(server.Close)
+			client := newClient(t, server.URL, protocol)
+
+			accounts, err := client.Accounts(context.Background())
+			require.NoError(t, err)
+			require.Len(t, accounts, 1)
+			assert.Equal(t, key.Address.Address(), accounts[0])
+
+			for name, tx := range map[string]*types.Transaction{"legacy": newLegacyTx(), "dynamic fee": newDynamicFeeTx(chainID)} {
+				signed, err := client.SignTx(context.Background(), key.Address.Address(), tx, chainID)
+				require.NoError(t, err, name)
+				sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+				require.NoError(t, err, name)
+				assert.Equal(t, key.Address.Address(), sender, name)
+				assert.Equal(t, tx.Nonce(), signed.Nonce(), name)
+				assert.Equal(t, tx.Data(), signed.Data(), name)
+			}
+
+			_, err = client.SignTx(context.Background(), testutils.NewAddress(), newLegacyTx(), chainID)
+			require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+
+			signer.Deny(key.Address.Address(), true)
+			_, err = client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+			require.ErrorIs(t, err, keystore.ErrRemoteSignerRejected)
+		})
+	}
+}
+
+func TestClient_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.SimulatedChainID
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+
+	t.Run("signer is down", func(t *testing.T) {
+		server := httptest.NewServer(remotesigner.NewReferenceSigner(remotesigner.ProtocolWeb3Signer, key))
+		client := newClient(t, server.URL, remotesigner.ProtocolWeb3Signer)
+		server.Close()
+
+		_, err := client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+		_, err = client.Accounts(context.Background())
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+
+	t.Run("signer responds with 503", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+		client := newClient(t, server.URL, remotesigner.ProtocolWeb3Signer)
+
+		_, err := client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+
+	t.Run("signer responds with a client error", func(t *testing.T) {
+		for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound} {
+			status := status
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			}))
+			t.Cleanup(server.Close)
+			client := newClient(t, server.URL, remotesigner.ProtocolWeb3Signer)
+
+			_, err := client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+			require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable, status)
+		}
+	})
+
+	t.Run("signer responds with an error other than a rejection", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"authentication needed: password or unlock"}}`))
+		}))
+		t.Cleanup(server.Close)
+		client := newClient(t, server.URL, remotesigner.ProtocolClef)
+
+		_, err := client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+
+	t.Run("signer times out", func(t *testing.T) {
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-done:
+			case <-r.Context().Done():
+			}
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(done) })
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		client, err := remotesigner.NewClient(*u, remotesigner.ProtocolWeb3Signer, 10*time.Millisecond)
+		require.NoError(t, err)
+
+		_, err = client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+		require.ErrorIs(t, err, keystore.ErrRemoteSignerUnavailable)
+	})
+}
+
+func TestClient_Rejected(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.SimulatedChainID
+	for name, rpcErr := range map[string]string{
+		"transaction rejected": `{"code":-32003,"message":"transaction rejected"}`,
+		"user rejected":        `{"code":4001,"message":"user rejected the request"}`,
+		"clef request denied":  `{"code":-32000,"message":"Request denied"}`,
+	} {
+		rpcErr := rpcErr
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":` + rpcErr + `}`))
+			}))
+			t.Cleanup(server.Close)
+			client := newClient(t, server.URL, remotesigner.ProtocolClef)
+
+			_, err := client.SignTx(context.Background(), testutils.NewAddress(), newLegacyTx(), chainID)
+			require.ErrorIs(t, err, keystore.ErrRemoteSignerRejected)
+		})
+	}
+}
+
+func TestClient_ChecksSignedTransaction(t *testing.T) {
+	t.Parallel()
+
+	chainID := testutils.SimulatedChainID
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	otherKey, err := ethkey.NewV2()
+	require.NoError(t, err)
+
+	// respond returns a signer which signs tx with key, whatever it was asked to sign
+	respond := func(tx *types.Transaction, key ethkey.KeyV2) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			signed, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), key.ToEcdsaPrivKey())
+			require.NoError(t, err)
+			raw, err := signed.MarshalBinary()
+			require.NoError(t, err)
+			result, err := json.Marshal(hexutil.Bytes(raw))
+			require.NoError(t, err)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "result": json.RawMessage(result)})
+		}
+	}
+
+	t.Run("different transaction", func(t *testing.T) {
+		server := httptest.NewServer(respond(newLegacyTx(), key))
+		t.Cleanup(server.Close)
+		client := newClient(t, server.URL, remotesigner.ProtocolWeb3Signer)
+
+		_, err := client.SignTx(context.Background(), key.Address.Address(), newLegacyTx(), chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "different transaction")
+	})
+
+	t.Run("different key", func(t *testing.T) {
+		tx := newLegacyTx()
+		server := httptest.NewServer(respond(tx, otherKey))
+		t.Cleanup(server.Close)
+		client := newClient(t, server.URL, remotesigner.ProtocolWeb3Signer)
+
+		_, err := client.SignTx(context.Background(), key.Address.Address(), tx, chainID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "instead of")
+	})
+}
+
+func TestNewClient_UnsupportedProtocol(t *testing.T) {
+	t.Parallel()
+
+	_, err := remotesigner.NewClient(url.URL{Scheme: "http", Host: "localhost"}, "foo", time.Second)
+	require.Error(t, err)
+}
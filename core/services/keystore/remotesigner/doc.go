@@ -0,0 +1,47 @@
+// Package remotesigner signs EVM transactions with keys held by an external
+// signer, instead of the node's encrypted key ring.
+//
+// # Protocol
+//
+// The node sends JSON-RPC 2.0 requests to ETH_REMOTE_SIGNER_URL with HTTP POST.
+// Two dialects are supported, selected with ETH_REMOTE_SIGNER_PROTOCOL:
+//
+//	                     web3signer            clef
+//	list accounts        eth_accounts          account_list
+//	sign a transaction   eth_signTransaction   account_signTransaction
+//
+// Listing accounts takes no params, and returns an array of hex addresses.
+//
+// Signing a transaction takes a single param, the transaction object:
+//
+//	{
+//	  "from": "0x...",                  // address of the key to sign with
+//	  "to": "0x...",
+//	  "gas": "0x5208",
+//	  "gasPrice": "0x...",              // legacy transactions only
+//	  "maxFeePerGas": "0x...",          // EIP-1559 transactions only
+//	  "maxPriorityFeePerGas": "0x...",  // EIP-1559 transactions only
+//	  "value": "0x0",
+//	  "nonce": "0x0",
+//	  "data": "0x...",
+//	  "accessList": [],                 // EIP-1559 transactions only
+//	  "chainId": "0x1"
+//	}
+//
+// and returns the signed transaction in its binary encoding, either as a hex
+// string (web3signer) or as the "raw" field of an object (clef).
+//
+// # Failures
+//
+// Only a JSON-RPC error saying that the signer refuses to sign by policy
+// wraps keystore.ErrRemoteSignerRejected, and fails the transaction: code
+// -32003 (transaction rejected, EIP-1474), code 4001 (user rejected, EIP-1193)
+// or clef's "Request denied". Any other failure wraps
+// keystore.ErrRemoteSignerUnavailable, and signing is retried: the signer
+// cannot be reached or times out, responds with an HTTP status other than
+// 200 (e.g. 401, 403, 404, 429 or 5xx), or with any other JSON-RPC error,
+// e.g. because it does not hold the key or the key is locked.
+//
+// The node also checks that the signed transaction is the one it asked to
+// sign, and that it was signed by the requested address.
+package remotesigner
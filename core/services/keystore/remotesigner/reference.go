@@ -0,0 +1,151 @@
+package remotesigner
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+const (
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeSigningFailed  = -32000
+)
+
+// ReferenceSigner is a minimal remote signer speaking either protocol, for
+// tests and local development. It holds its keys in memory.
+type ReferenceSigner struct {
+	methods methods
+	clef    bool
+
+	mu     sync.RWMutex
+	keys   map[common.Address]ethkey.KeyV2
+	denied map[common.Address]bool
+}
+
+var _ http.Handler = &ReferenceSigner{}
+
+// NewReferenceSigner returns a signer speaking protocol, which holds keys.
+// It panics if the protocol is not supported.
+func NewReferenceSigner(protocol string, keys ...ethkey.KeyV2) *ReferenceSigner {
+	m, ok := protocolMethods[protocol]
+	if !ok {
+		panic("unsupported remote signer protocol " + protocol)
+	}
+	rs := &ReferenceSigner{methods: m, clef: protocol == ProtocolClef, keys: make(map[common.Address]ethkey.KeyV2), denied: make(map[common.Address]bool)}
+	for _, k := range keys {
+		rs.AddKey(k)
+	}
+	return rs
+}
+
+// AddKey adds a key to the signer.
+func (rs *ReferenceSigner) AddKey(key ethkey.KeyV2) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.keys[key.Address.Address()] = key
+}
+
+// RemoveKey removes the key for address from the signer, so that it fails
+// requests to sign with it.
+func (rs *ReferenceSigner) RemoveKey(address common.Address) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	delete(rs.keys, address)
+}
+
+// Deny makes the signer's policy reject, or allow again, requests to sign
+// with the key for address.
+func (rs *ReferenceSigner) Deny(address common.Address, denied bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.denied[address] = denied
+}
+
+func (rs *ReferenceSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeResponse(w, response{JSONRPC: "2.0", Error: &rpcError{Code: codeInvalidRequest, Message: err.Error()}})
+		return
+	}
+	resp := response{JSONRPC: "2.0", ID: req.ID}
+	result, rpcErr := rs.handle(req.Method, req.Params)
+	if rpcErr != nil {
+		resp.Error = rpcErr
+	} else {
+		b, err := json.Marshal(result)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		resp.Result = b
+	}
+	writeResponse(w, resp)
+}
+
+func (rs *ReferenceSigner) handle(method string, params []json.RawMessage) (interface{}, *rpcError) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	switch method {
+	case rs.methods.accounts:
+		accounts := make([]common.Address, 0, len(rs.keys))
+		for address := range rs.keys {
+			accounts = append(accounts, address)
+		}
+		sort.Slice(accounts, func(i, j int) bool { return accounts[i].Hex() < accounts[j].Hex() })
+		return accounts, nil
+	case rs.methods.signTx:
+		if len(params) == 0 {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "missing transaction"}
+		}
+		var args TxArgs
+		if err := json.Unmarshal(params[0], &args); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		if args.ChainID == nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "missing chainId"}
+		}
+		if rs.denied[args.From] {
+			return nil, &rpcError{Code: codeTransactionRejected, Message: "signing with " + args.From.Hex() + " is denied"}
+		}
+		key, ok := rs.keys[args.From]
+		if !ok {
+			return nil, &rpcError{Code: codeSigningFailed, Message: "no key for account " + args.From.Hex()}
+		}
+		signed, err := types.SignTx(args.Tx(), types.LatestSignerForChainID(args.ChainID.ToInt()), key.ToEcdsaPrivKey())
+		if err != nil {
+			return nil, &rpcError{Code: codeSigningFailed, Message: err.Error()}
+		}
+		raw, err := signed.MarshalBinary()
+		if err != nil {
+			return nil, &rpcError{Code: codeSigningFailed, Message: err.Error()}
+		}
+		if rs.clef {
+			return clefSignTxResult{Raw: raw}, nil
+		}
+		return hexutil.Bytes(raw), nil
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + method}
+	}
+}
+
+func writeResponse(w http.ResponseWriter, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
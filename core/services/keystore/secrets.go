@@ -0,0 +1,132 @@
+package keystore
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name Secrets --output mocks/ --case=underscore
+
+var (
+	ErrSecretNotFound    = errors.New("secret not found")
+	ErrInvalidSecretName = errors.New("secret names may only contain letters, digits and underscores")
+
+	secretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+// Secrets stores named values, such as data provider API keys, encrypted
+// together with the keys. Job specs and bridge URLs reference them as
+// $(secrets.name), and they are only resolved when a task runs.
+type Secrets interface {
+	Get(name string) (string, error)
+	GetAll() (map[string]string, error)
+	// Names returns the sorted names of all secrets, without their values.
+	Names() ([]string, error)
+	// Set adds the secret, or replaces its value if it already exists.
+	Set(name, value string) error
+	Delete(name string) error
+}
+
+type secrets struct {
+	*keyManager
+}
+
+var _ Secrets = &secrets{}
+
+func newSecretsStore(km *keyManager) *secrets {
+	return &secrets{
+		keyManager: km,
+	}
+}
+
+// Get implements Secrets
+func (s *secrets) Get(name string) (string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.isLocked() {
+		return "", ErrLocked
+	}
+	value, found := s.keyRing.Secrets[name]
+	if !found {
+		return "", errors.Wrapf(ErrSecretNotFound, "%s", name)
+	}
+	return value, nil
+}
+
+// GetAll implements Secrets
+func (s *secrets) GetAll() (map[string]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.isLocked() {
+		return nil, ErrLocked
+	}
+	all := make(map[string]string, len(s.keyRing.Secrets))
+	for name, value := range s.keyRing.Secrets {
+		all[name] = value
+	}
+	return all, nil
+}
+
+// Names implements Secrets
+func (s *secrets) Names() ([]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.isLocked() {
+		return nil, ErrLocked
+	}
+	names := make([]string, 0, len(s.keyRing.Secrets))
+	for name := range s.keyRing.Secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Set implements Secrets
+func (s *secrets) Set(name, value string) error {
+	if !secretNameRegexp.MatchString(name) {
+		return errors.Wrapf(ErrInvalidSecretName, "%q", name)
+	}
+	if value == "" {
+		return errors.Errorf("secret %s must not be empty", name)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.isLocked() {
+		return ErrLocked
+	}
+	old, existed := s.keyRing.Secrets[name]
+	s.keyRing.Secrets[name] = value
+	if err := s.save(); err != nil {
+		// if save fails, restore the previous value
+		if existed {
+			s.keyRing.Secrets[name] = old
+		} else {
+			delete(s.keyRing.Secrets, name)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete implements Secrets
+func (s *secrets) Delete(name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.isLocked() {
+		return ErrLocked
+	}
+	old, found := s.keyRing.Secrets[name]
+	if !found {
+		return errors.Wrapf(ErrSecretNotFound, "%s", name)
+	}
+	delete(s.keyRing.Secrets, name)
+	if err := s.save(); err != nil {
+		// if save fails, add the secret back
+		s.keyRing.Secrets[name] = old
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func Test_SecretsStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ks := keyStore.Secrets()
+	reset := func() {
+		_, err := db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		names, err := ks.Names()
+		require.NoError(t, err)
+		require.Empty(t, names)
+	})
+
+	t.Run("sets, replaces and deletes secrets", func(t *testing.T) {
+		defer reset()
+		require.NoError(t, ks.Set("b_key", "foo"))
+		require.NoError(t, ks.Set("a_key", "bar"))
+		require.NoError(t, ks.Set("b_key", "baz"))
+
+		names, err := ks.Names()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a_key", "b_key"}, names)
+
+		value, err := ks.Get("b_key")
+		require.NoError(t, err)
+		assert.Equal(t, "baz", value)
+
+		all, err := ks.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a_key": "bar", "b_key": "baz"}, all)
+
+		require.NoError(t, ks.Delete("b_key"))
+		_, err = ks.Get("b_key")
+		require.ErrorIs(t, err, keystore.ErrSecretNotFound)
+		require.ErrorIs(t, ks.Delete("b_key"), keystore.ErrSecretNotFound)
+	})
+
+	t.Run("rejects invalid secrets", func(t *testing.T) {
+		defer reset()
+		require.ErrorIs(t, ks.Set("a-key", "foo"), keystore.ErrInvalidSecretName)
+		require.ErrorIs(t, ks.Set("$(secrets.a)", "foo"), keystore.ErrInvalidSecretName)
+		require.Error(t, ks.Set("a_key", ""))
+	})
+
+	t.Run("persists secrets", func(t *testing.T) {
+		defer reset()
+		require.NoError(t, ks.Set("a_key", "foo"))
+		keyStore.ResetXXXTestOnly()
+
+		_, err := ks.Get("a_key")
+		require.ErrorIs(t, err, keystore.ErrLocked)
+
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		value, err := ks.Get("a_key")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", value)
+	})
+}
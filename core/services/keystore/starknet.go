@@ -19,6 +19,12 @@ type StarkNet interface {
 	Import(keyJSON []byte, password string) (starkkey.Key, error)
 	Export(id string, password string) ([]byte, error)
 	EnsureKey() error
+	// AddAccount records that the account contract is controlled by the
+	// key, so that the account is known across restarts.
+	AddAccount(account, keyID string) error
+	// Accounts returns the IDs of the keys controlling the known account
+	// contracts, by account address.
+	Accounts() (map[string]string, error)
 }
 
 type starknet struct {
@@ -89,7 +95,19 @@ func (ks *starknet) Delete(id string) (starkkey.Key, error) {
 	if err != nil {
 		return starkkey.Key{}, err
 	}
-	err = ks.safeRemoveKey(key)
+	// the key's accounts are forgotten together with the key
+	accounts := make(map[string]string)
+	for account, keyID := range ks.keyRing.StarkNetAccounts {
+		if keyID == id {
+			accounts[account] = keyID
+			delete(ks.keyRing.StarkNetAccounts, account)
+		}
+	}
+	if err = ks.safeRemoveKey(key); err != nil {
+		for account, keyID := range accounts {
+			ks.keyRing.StarkNetAccounts[account] = keyID
+		}
+	}
 	return key, err
 }
 
@@ -142,6 +160,45 @@ func (ks *starknet) EnsureKey() error {
 	return ks.safeAddKey(key)
 }
 
+func (ks *starknet) AddAccount(account, keyID string) error {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	if _, err := ks.getByID(keyID); err != nil {
+		return err
+	}
+	old, existed := ks.keyRing.StarkNetAccounts[account]
+	if existed && old == keyID {
+		return nil
+	}
+	ks.keyRing.StarkNetAccounts[account] = keyID
+	if err := ks.save(); err != nil {
+		// if save fails, restore the previous key
+		if existed {
+			ks.keyRing.StarkNetAccounts[account] = old
+		} else {
+			delete(ks.keyRing.StarkNetAccounts, account)
+		}
+		return err
+	}
+	return nil
+}
+
+func (ks *starknet) Accounts() (map[string]string, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	accounts := make(map[string]string, len(ks.keyRing.StarkNetAccounts))
+	for account, keyID := range ks.keyRing.StarkNetAccounts {
+		accounts[account] = keyID
+	}
+	return accounts, nil
+}
+
 var (
 	ErrNoStarkNetKey = errors.New("no starknet keys exist")
 )
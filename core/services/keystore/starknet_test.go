@@ -96,4 +96,25 @@ func Test_StarkNetKeyStore_E2E(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 1, len(keys))
 	})
+
+	t.Run("records accounts / forgets them with their key", func(t *testing.T) {
+		defer reset()
+		key, err := ks.Create()
+		require.NoError(t, err)
+		require.Error(t, ks.AddAccount("0x123", "non-existant-id"))
+		require.NoError(t, ks.AddAccount("0x123", key.ID()))
+
+		// accounts are persisted with the keys
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		accounts, err := ks.Accounts()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"0x123": key.ID()}, accounts)
+
+		_, err = ks.Delete(key.ID())
+		require.NoError(t, err)
+		accounts, err = ks.Accounts()
+		require.NoError(t, err)
+		assert.Empty(t, accounts)
+	})
 }
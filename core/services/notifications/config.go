@@ -0,0 +1,193 @@
+package notifications
+
+import (
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+const (
+	SinkTypeWebhook = "webhook"
+	SinkTypeEmail   = "email"
+	SinkTypeFile    = "file"
+	SinkTypeSyslog  = "syslog"
+
+	defaultDedupWindow     = time.Hour
+	defaultRateLimitPeriod = time.Hour
+)
+
+// Config is the notifications config file, set with NOTIFICATIONS_CONFIG_PATH.
+//
+//	[[Sinks]]
+//	Name = "on-call"
+//	Type = "webhook"
+//	URL = "https://alerts.example.com/hooks/chainlink"
+//	Headers = { Authorization = "Bearer ..." }
+//
+//	[[Rules]]
+//	Name = "job errors"
+//	Kinds = ["job_error", "no_live_nodes"]
+//	Sinks = ["on-call"]
+//	DedupWindow = "30m"
+//	RateLimit = 20
+//	RateLimitPeriod = "1h"
+type Config struct {
+	Sinks []SinkConfig
+	Rules []RuleConfig
+}
+
+// SinkConfig configures a sink. Which fields apply depends on Type.
+type SinkConfig struct {
+	Name string
+	Type string
+
+	// webhook
+	URL     string
+	Headers map[string]string
+
+	// email
+	SMTPAddress string // host:port
+	Username    string
+	Password    string
+	From        string
+	To          []string
+
+	// file
+	Path string
+
+	// syslog; both empty for the local syslog daemon
+	Network string
+	Address string
+	Tag     string
+}
+
+// RuleConfig configures a rule, which routes matching events to sinks.
+type RuleConfig struct {
+	Name string
+	// Kinds of events to match. Empty matches all kinds.
+	Kinds []EventKind
+	// Labels which events must have to match, e.g. { evmChainID = "1" }.
+	Labels map[string]string
+	// Sinks are the names of the sinks to send matching events to.
+	Sinks []string
+	// DedupWindow is how long identical events are suppressed for after one
+	// was sent. Defaults to 1h. Zero disables deduplication.
+	DedupWindow *models.Duration
+	// RateLimit is the maximum number of events sent by this rule per
+	// RateLimitPeriod, which defaults to 1h. Zero means unlimited.
+	RateLimit       uint32
+	RateLimitPeriod *models.Duration
+}
+
+// LoadConfig reads and validates the config file at path.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	f, err := os.Open(path)
+	if err != nil {
+		return cfg, errors.Wrap(err, "failed to open notifications config")
+	}
+	defer f.Close()
+	if err = toml.NewDecoder(f).DisallowUnknownFields().Decode(&cfg); err != nil {
+		return cfg, errors.Wrapf(err, "failed to decode notifications config %s", path)
+	}
+	return cfg, errors.Wrapf(cfg.Validate(), "invalid notifications config %s", path)
+}
+
+// Validate returns an error if c is invalid.
+func (c Config) Validate() (err error) {
+	names := make(map[string]struct{}, len(c.Sinks))
+	for _, s := range c.Sinks {
+		if s.Name == "" {
+			err = multierr.Append(err, errors.New("sink name is required"))
+			continue
+		}
+		if _, ok := names[s.Name]; ok {
+			err = multierr.Append(err, errors.Errorf("duplicate sink name %q", s.Name))
+		}
+		names[s.Name] = struct{}{}
+		err = multierr.Append(err, errors.Wrapf(s.validate(), "sink %q", s.Name))
+	}
+	for _, r := range c.Rules {
+		if r.Name == "" {
+			err = multierr.Append(err, errors.New("rule name is required"))
+			continue
+		}
+		if len(r.Sinks) == 0 {
+			err = multierr.Append(err, errors.Errorf("rule %q: at least one sink is required", r.Name))
+		}
+		for _, name := range r.Sinks {
+			if _, ok := names[name]; !ok {
+				err = multierr.Append(err, errors.Errorf("rule %q: no such sink %q", r.Name, name))
+			}
+		}
+		for _, kind := range r.Kinds {
+			if !kind.valid() {
+				err = multierr.Append(err, errors.Errorf("rule %q: invalid event kind %q, must be one of %v", r.Name, kind, eventKinds))
+			}
+		}
+	}
+	return err
+}
+
+func (s SinkConfig) validate() error {
+	switch s.Type {
+	case SinkTypeWebhook:
+		u, err := url.Parse(s.URL)
+		if err != nil {
+			return errors.Wrap(err, "invalid URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("URL must be http or https, got %q", u.Scheme)
+		}
+	case SinkTypeEmail:
+		if s.SMTPAddress == "" || s.From == "" || len(s.To) == 0 {
+			return errors.New("SMTPAddress, From and To are required")
+		}
+	case SinkTypeFile:
+		if s.Path == "" {
+			return errors.New("Path is required")
+		}
+	case SinkTypeSyslog:
+	default:
+		return errors.Errorf("invalid type %q, must be one of %s, %s, %s or %s", s.Type, SinkTypeWebhook, SinkTypeEmail, SinkTypeFile, SinkTypeSyslog)
+	}
+	return nil
+}
+
+func (s SinkConfig) newSink() (Sink, error) {
+	switch s.Type {
+	case SinkTypeWebhook:
+		return newWebhookSink(s.Name, s.URL, s.Headers), nil
+	case SinkTypeEmail:
+		return newEmailSink(s.Name, s.SMTPAddress, s.Username, s.Password, s.From, s.To), nil
+	case SinkTypeFile:
+		return newFileSink(s.Name, s.Path), nil
+	case SinkTypeSyslog:
+		tag := s.Tag
+		if tag == "" {
+			tag = "chainlink"
+		}
+		return newSyslogSink(s.Name, s.Network, s.Address, tag)
+	}
+	return nil, errors.Errorf("invalid sink type %q", s.Type)
+}
+
+func (r RuleConfig) dedupWindow() time.Duration {
+	if r.DedupWindow == nil {
+		return defaultDedupWindow
+	}
+	return r.DedupWindow.Duration()
+}
+
+func (r RuleConfig) rateLimitPeriod() time.Duration {
+	if r.RateLimitPeriod == nil || r.RateLimitPeriod.IsInstant() {
+		return defaultRateLimitPeriod
+	}
+	return r.RateLimitPeriod.Duration()
+}
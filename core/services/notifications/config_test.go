@@ -0,0 +1,133 @@
+package notifications
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "notifications.toml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	path := writeConfig(t, `
+[[Sinks]]
+Name = "on-call"
+Type = "webhook"
+URL = "https://alerts.example.com/hooks/chainlink"
+Headers = { Authorization = "Bearer secret" }
+
+[[Sinks]]
+Name = "email"
+Type = "email"
+SMTPAddress = "smtp.example.com:587"
+Username = "node"
+Password = "secret"
+From = "node@example.com"
+To = ["ops@example.com"]
+
+[[Sinks]]
+Name = "local"
+Type = "file"
+Path = "/tmp/notifications.log"
+
+[[Rules]]
+Name = "job errors"
+Kinds = ["job_error", "no_live_nodes"]
+Labels = { evmChainID = "1" }
+Sinks = ["on-call", "email"]
+DedupWindow = "30m"
+RateLimit = 20
+RateLimitPeriod = "2h"
+
+[[Rules]]
+Name = "everything"
+Sinks = ["local"]
+`)
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Len(t, cfg.Sinks, 3)
+	assert.Equal(t, "Bearer secret", cfg.Sinks[0].Headers["Authorization"])
+	assert.Equal(t, []string{"ops@example.com"}, cfg.Sinks[1].To)
+	require.Len(t, cfg.Rules, 2)
+	r := cfg.Rules[0]
+	assert.Equal(t, []EventKind{EventJobError, EventNoLiveNodes}, r.Kinds)
+	assert.Equal(t, map[string]string{"evmChainID": "1"}, r.Labels)
+	assert.Equal(t, 30*time.Minute, r.dedupWindow())
+	assert.Equal(t, uint32(20), r.RateLimit)
+	assert.Equal(t, 2*time.Hour, r.rateLimitPeriod())
+	assert.Equal(t, defaultDedupWindow, cfg.Rules[1].dedupWindow())
+	assert.Equal(t, defaultRateLimitPeriod, cfg.Rules[1].rateLimitPeriod())
+
+	n, err := NewNotifier(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+	require.NoError(t, n.Start(testutils.Context(t)))
+	require.NoError(t, n.Close())
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		contents string
+		err      string
+	}{
+		{"unknown field", `Foo = 1`, "failed to decode"},
+		{"invalid sink type", `
+[[Sinks]]
+Name = "a"
+Type = "pager"`, `sink "a": invalid type "pager"`},
+		{"duplicate sink", `
+[[Sinks]]
+Name = "a"
+Type = "syslog"
+[[Sinks]]
+Name = "a"
+Type = "syslog"`, `duplicate sink name "a"`},
+		{"webhook scheme", `
+[[Sinks]]
+Name = "a"
+Type = "webhook"
+URL = "ftp://example.com"`, "URL must be http or https"},
+		{"email fields", `
+[[Sinks]]
+Name = "a"
+Type = "email"`, "SMTPAddress, From and To are required"},
+		{"unknown sink", `
+[[Rules]]
+Name = "r"
+Sinks = ["a"]`, `rule "r": no such sink "a"`},
+		{"no sinks", `
+[[Rules]]
+Name = "r"`, `rule "r": at least one sink is required`},
+		{"invalid kind", `
+[[Sinks]]
+Name = "a"
+Type = "file"
+Path = "/tmp/a"
+[[Rules]]
+Name = "r"
+Kinds = ["job_errors"]
+Sinks = ["a"]`, `invalid event kind "job_errors"`},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadConfig(writeConfig(t, tt.contents))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package notifications
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventKind is the kind of an event, which rules match on.
+type EventKind string
+
+const (
+	// EventJobError is a job error, as recorded in job_spec_errors and shown
+	// in the UI.
+	EventJobError EventKind = "job_error"
+	// EventCriticalLog is a log entry at critical level.
+	EventCriticalLog EventKind = "critical_log"
+	// EventNoLiveNodes is raised when none of the primary RPC nodes of an EVM
+	// chain are alive.
+	EventNoLiveNodes EventKind = "no_live_nodes"
+	// EventStuckTransaction is raised when a transaction is stuck.
+	EventStuckTransaction EventKind = "stuck_transaction"
+)
+
+var eventKinds = []EventKind{EventJobError, EventCriticalLog, EventNoLiveNodes, EventStuckTransaction}
+
+func (k EventKind) valid() bool {
+	for _, kind := range eventKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is something the node operator should know about.
+type Event struct {
+	Kind EventKind `json:"kind"`
+	// Summary is a one line description of what happened.
+	Summary string `json:"summary"`
+	// Labels identify where the event happened, e.g. the job ID. Rules match
+	// on them, and events with the same kind, summary and labels are
+	// deduplicated.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Details are additional context which does not identify the event.
+	Details map[string]interface{} `json:"details,omitempty"`
+	Time    time.Time              `json:"time"`
+	// Suppressed is the number of identical events which were deduplicated
+	// since this event was last sent.
+	Suppressed int `json:"suppressed,omitempty"`
+}
+
+// key identifies identical events, for deduplication.
+func (e Event) key() string {
+	names := make([]string, 0, len(e.Labels))
+	for name := range e.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	sb.WriteString(string(e.Kind))
+	sb.WriteString("\x00")
+	sb.WriteString(e.Summary)
+	for _, name := range names {
+		fmt.Fprintf(&sb, "\x00%s=%s", name, e.Labels[name])
+	}
+	return sb.String()
+}
+
+// Subject returns a short title for the event, e.g. for an email subject.
+func (e Event) Subject() string {
+	return fmt.Sprintf("[chainlink] %s: %s", e.Kind, e.Summary)
+}
+
+// NewJobErrorEvent returns an event for an error recorded for a job.
+func NewJobErrorEvent(jobID int32, description string) Event {
+	return Event{
+		Kind:    EventJobError,
+		Summary: description,
+		Labels:  map[string]string{"jobID": strconv.Itoa(int(jobID))},
+		Time:    time.Now(),
+	}
+}
+
+// NewCriticalLogEvent returns an event for a critical log entry, logged by the
+// named logger with keysAndValues.
+func NewCriticalLogEvent(name string, msg string, keysAndValues []interface{}) Event {
+	e := Event{
+		Kind:    EventCriticalLog,
+		Summary: msg,
+		Labels:  map[string]string{"logger": name},
+		Time:    time.Now(),
+	}
+	if len(keysAndValues) > 0 {
+		e.Details = make(map[string]interface{}, len(keysAndValues)/2)
+		for i := 0; i < len(keysAndValues); i += 2 {
+			key := fmt.Sprint(keysAndValues[i])
+			if i+1 == len(keysAndValues) {
+				e.Details[key] = nil
+				break
+			}
+			e.Details[key] = fmt.Sprint(keysAndValues[i+1])
+		}
+	}
+	return e
+}
+
+// NewNoLiveNodesEvent returns an event for an EVM chain with no live primary
+// nodes. nodeStates maps node names to their state.
+func NewNoLiveNodesEvent(chainID *big.Int, nodeStates map[string]string) Event {
+	details := make(map[string]interface{}, len(nodeStates))
+	for name, state := range nodeStates {
+		details[name] = state
+	}
+	return Event{
+		Kind:    EventNoLiveNodes,
+		Summary: fmt.Sprintf("No live RPC nodes available for chain %s", chainID),
+		Labels:  map[string]string{"evmChainID": chainID.String()},
+		Details: details,
+		Time:    time.Now(),
+	}
+}
+
+// NewStuckTransactionEvent returns an event for an EVM transaction which is
+// stuck, with the most likely reason why in diagnosis.
+func NewStuckTransactionEvent(chainID *big.Int, fromAddress string, ethTxID int64, nonce int64, diagnosis string, blocksPending int64, atMaxGasPrice bool) Event {
+	return Event{
+		Kind:    EventStuckTransaction,
+		Summary: fmt.Sprintf("Transaction %d with nonce %d is stuck: %s", ethTxID, nonce, diagnosis),
+		Labels: map[string]string{
+			"evmChainID":  chainID.String(),
+			"fromAddress": fromAddress,
+			"diagnosis":   diagnosis,
+		},
+		Details: map[string]interface{}{
+			"ethTxID":       ethTxID,
+			"nonce":         nonce,
+			"blocksPending": blocksPending,
+			"atMaxGasPrice": atMaxGasPrice,
+		},
+		Time: time.Now(),
+	}
+}
@@ -0,0 +1,182 @@
+package notifications
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	queueSize     = 100
+	sendTimeout   = 30 * time.Second
+	pruneInterval = 10 * time.Minute
+)
+
+//go:generate mockery --name Notifier --output ./mocks/ --case=underscore
+
+// Notifier routes events to sinks, by rules.
+type Notifier interface {
+	services.ServiceCtx
+	// Notify queues e to be sent to the sinks of every matching rule. It never
+	// blocks: if the queue is full, e is dropped.
+	Notify(e Event)
+}
+
+type notifier struct {
+	utils.StartStopOnce
+	lggr  logger.Logger
+	rules []*rule
+	sinks []Sink
+
+	queue  chan Event
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ Notifier = &notifier{}
+
+// NewNotifier returns a notifier which routes events by the rules in cfg.
+func NewNotifier(cfg Config, lggr logger.Logger) (Notifier, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	n := &notifier{
+		lggr:   lggr.Named("Notifier"),
+		queue:  make(chan Event, queueSize),
+		chStop: make(chan struct{}),
+	}
+	sinks := make(map[string]Sink, len(cfg.Sinks))
+	for _, sc := range cfg.Sinks {
+		s, err := sc.newSink()
+		if err != nil {
+			return nil, multierr.Combine(errors.Wrapf(err, "failed to create sink %q", sc.Name), n.closeSinks())
+		}
+		sinks[sc.Name] = s
+		n.sinks = append(n.sinks, s)
+	}
+	for _, rc := range cfg.Rules {
+		n.rules = append(n.rules, newRule(rc, sinks))
+	}
+	return n, nil
+}
+
+// CriticalLogHook returns a hook notifying critical log entries to n, for
+// logger.SetCriticalHook.
+func CriticalLogHook(n Notifier) logger.CriticalHook {
+	return func(name string, msg string, keysAndValues []interface{}) {
+		n.Notify(NewCriticalLogEvent(name, msg, keysAndValues))
+	}
+}
+
+func (n *notifier) Start(context.Context) error {
+	return n.StartOnce("Notifier", func() error {
+		n.wg.Add(1)
+		go n.run()
+		return nil
+	})
+}
+
+func (n *notifier) Close() error {
+	return n.StopOnce("Notifier", func() error {
+		close(n.chStop)
+		n.wg.Wait()
+		return n.closeSinks()
+	})
+}
+
+func (n *notifier) closeSinks() (err error) {
+	for _, s := range n.sinks {
+		err = multierr.Append(err, errors.Wrapf(s.Close(), "failed to close sink %q", s.Name()))
+	}
+	return err
+}
+
+func (n *notifier) Notify(e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	select {
+	case n.queue <- e:
+	default:
+		// Not Critical: that would be notified too
+		n.lggr.Errorw("Notification queue is full, dropping event", "kind", e.Kind, "summary", e.Summary)
+	}
+}
+
+func (n *notifier) run() {
+	defer n.wg.Done()
+
+	ctx, cancel := utils.ContextFromChan(n.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case e := <-n.queue:
+			n.route(ctx, e)
+		case <-ticker.C:
+			now := time.Now()
+			for _, r := range n.rules {
+				r.prune(now)
+			}
+		case <-n.chStop:
+			return
+		}
+	}
+}
+
+// route sends e to the sinks of every rule which matches and admits it. An
+// event matching several rules is only sent once to each sink.
+func (n *notifier) route(ctx context.Context, e Event) {
+	now := time.Now()
+	sent := make(map[Sink]struct{})
+	for _, r := range n.rules {
+		if !r.matches(e) {
+			continue
+		}
+		admitted, ok, limited := r.admit(e, now)
+		if limited {
+			n.lggr.Warnw("Notification rate limit exceeded, dropping event", "rule", r.name, "kind", e.Kind, "summary", e.Summary)
+		}
+		if !ok {
+			continue
+		}
+		for _, s := range r.sinks {
+			if _, ok := sent[s]; ok {
+				continue
+			}
+			sent[s] = struct{}{}
+			n.send(ctx, s, admitted)
+		}
+	}
+}
+
+func (n *notifier) send(ctx context.Context, s Sink, e Event) {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	if err := s.Send(ctx, e); err != nil {
+		n.lggr.Errorw("Failed to send notification", "sink", s.Name(), "kind", e.Kind, "summary", e.Summary, "err", err)
+		return
+	}
+	n.lggr.Debugw("Sent notification", "sink", s.Name(), "kind", e.Kind, "summary", e.Summary)
+}
+
+// NullNotifier drops every event.
+type NullNotifier struct{}
+
+var _ Notifier = NullNotifier{}
+
+func (NullNotifier) Start(context.Context) error { return nil }
+func (NullNotifier) Close() error                { return nil }
+func (NullNotifier) Ready() error                { return nil }
+func (NullNotifier) Healthy() error              { return nil }
+func (NullNotifier) Notify(Event)                {}
@@ -0,0 +1,208 @@
+package notifications
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testSink struct {
+	name   string
+	events chan Event
+	err    error
+}
+
+func newTestSink(name string) *testSink {
+	return &testSink{name: name, events: make(chan Event, 100)}
+}
+
+func (s *testSink) Name() string { return s.name }
+func (s *testSink) Close() error { return nil }
+func (s *testSink) Send(ctx context.Context, e Event) error {
+	s.events <- e
+	return s.err
+}
+
+func (s *testSink) awaitEvent(t *testing.T) Event {
+	select {
+	case e := <-s.events:
+		return e
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatalf("timed out waiting for an event on %s", s.name)
+	}
+	return Event{}
+}
+
+func (s *testSink) assertNoEvent(t *testing.T) {
+	select {
+	case e := <-s.events:
+		t.Fatalf("unexpected event on %s: %v", s.name, e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func newTestNotifier(t *testing.T, rules []RuleConfig, sinks ...*testSink) *notifier {
+	n := &notifier{
+		lggr:   logger.TestLogger(t),
+		queue:  make(chan Event, queueSize),
+		chStop: make(chan struct{}),
+	}
+	byName := make(map[string]Sink)
+	for _, s := range sinks {
+		byName[s.name] = s
+		n.sinks = append(n.sinks, s)
+	}
+	for _, rc := range rules {
+		n.rules = append(n.rules, newRule(rc, byName))
+	}
+	require.NoError(t, n.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, n.Close()) })
+	return n
+}
+
+func TestNotifier_Routing(t *testing.T) {
+	t.Parallel()
+
+	webhook, email := newTestSink("webhook"), newTestSink("email")
+	n := newTestNotifier(t, []RuleConfig{
+		{Name: "jobs", Kinds: []EventKind{EventJobError}, Sinks: []string{"webhook"}},
+		{Name: "chain 1", Kinds: []EventKind{EventNoLiveNodes}, Labels: map[string]string{"evmChainID": "1"}, Sinks: []string{"webhook", "email"}},
+		{Name: "everything", Sinks: []string{"email"}},
+	}, webhook, email)
+
+	n.Notify(NewJobErrorEvent(1, "boom"))
+	e := webhook.awaitEvent(t)
+	assert.Equal(t, EventJobError, e.Kind)
+	assert.Equal(t, "boom", e.Summary)
+	assert.Equal(t, "1", e.Labels["jobID"])
+	assert.Equal(t, "boom", email.awaitEvent(t).Summary)
+
+	n.Notify(NewNoLiveNodesEvent(big.NewInt(2), map[string]string{"primary": "Unreachable"}))
+	assert.Equal(t, EventNoLiveNodes, email.awaitEvent(t).Kind)
+	webhook.assertNoEvent(t)
+
+	// matched by two rules sending to email, but only sent to it once
+	n.Notify(NewNoLiveNodesEvent(big.NewInt(1), map[string]string{"primary": "Unreachable"}))
+	e = webhook.awaitEvent(t)
+	assert.Equal(t, "1", e.Labels["evmChainID"])
+	assert.Equal(t, "Unreachable", e.Details["primary"])
+	assert.Equal(t, EventNoLiveNodes, email.awaitEvent(t).Kind)
+	email.assertNoEvent(t)
+}
+
+func TestNotifier_SinkErrors(t *testing.T) {
+	t.Parallel()
+
+	failing, working := newTestSink("failing"), newTestSink("working")
+	failing.err = errors.New("connection refused")
+	n := newTestNotifier(t, []RuleConfig{{Name: "all", Sinks: []string{"failing", "working"}}}, failing, working)
+
+	n.Notify(NewJobErrorEvent(1, "boom"))
+	failing.awaitEvent(t)
+	working.awaitEvent(t)
+}
+
+func TestRule_Dedup(t *testing.T) {
+	t.Parallel()
+
+	window := models.MustMakeDuration(time.Minute)
+	r := newRule(RuleConfig{Name: "r", DedupWindow: &window}, nil)
+	now := time.Now()
+
+	e, ok, _ := r.admit(NewJobErrorEvent(1, "boom"), now)
+	require.True(t, ok)
+	assert.Zero(t, e.Suppressed)
+
+	_, ok, _ = r.admit(NewJobErrorEvent(1, "boom"), now.Add(time.Second))
+	assert.False(t, ok)
+	_, ok, _ = r.admit(NewJobErrorEvent(1, "boom"), now.Add(2*time.Second))
+	assert.False(t, ok)
+
+	// different labels or summary are not deduplicated
+	_, ok, _ = r.admit(NewJobErrorEvent(2, "boom"), now.Add(time.Second))
+	assert.True(t, ok)
+	_, ok, _ = r.admit(NewJobErrorEvent(1, "bang"), now.Add(time.Second))
+	assert.True(t, ok)
+
+	e, ok, _ = r.admit(NewJobErrorEvent(1, "boom"), now.Add(time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, 2, e.Suppressed)
+
+	r.prune(now.Add(3 * time.Minute))
+	assert.Empty(t, r.sent)
+
+	t.Run("disabled", func(t *testing.T) {
+		var zero models.Duration
+		r := newRule(RuleConfig{Name: "r", DedupWindow: &zero}, nil)
+		for i := 0; i < 3; i++ {
+			_, ok, _ := r.admit(NewJobErrorEvent(1, "boom"), now)
+			assert.True(t, ok)
+		}
+	})
+}
+
+func TestRule_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	var zero models.Duration
+	period := models.MustMakeDuration(time.Hour)
+	r := newRule(RuleConfig{Name: "r", DedupWindow: &zero, RateLimit: 2, RateLimitPeriod: &period}, nil)
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		_, ok, limited := r.admit(NewJobErrorEvent(int32(i), "boom"), now)
+		assert.True(t, ok)
+		assert.False(t, limited)
+	}
+	_, ok, limited := r.admit(NewJobErrorEvent(3, "boom"), now)
+	assert.False(t, ok)
+	assert.True(t, limited)
+
+	// one event is allowed every 30 minutes
+	_, ok, _ = r.admit(NewJobErrorEvent(3, "boom"), now.Add(30*time.Minute))
+	assert.True(t, ok)
+}
+
+func TestRule_Matches(t *testing.T) {
+	t.Parallel()
+
+	r := newRule(RuleConfig{Name: "r", Kinds: []EventKind{EventCriticalLog}, Labels: map[string]string{"logger": "Pool"}}, nil)
+	assert.True(t, r.matches(NewCriticalLogEvent("Pool", "no nodes", nil)))
+	assert.False(t, r.matches(NewCriticalLogEvent("TxManager", "no nodes", nil)))
+	assert.False(t, r.matches(NewJobErrorEvent(1, "no nodes")))
+
+	all := newRule(RuleConfig{Name: "all"}, nil)
+	assert.True(t, all.matches(NewJobErrorEvent(1, "boom")))
+	assert.True(t, all.matches(NewCriticalLogEvent("Pool", "no nodes", nil)))
+}
+
+func TestNewCriticalLogEvent(t *testing.T) {
+	t.Parallel()
+
+	e := NewCriticalLogEvent("EVM.1.Pool", "No live nodes", []interface{}{"evmChainID", "1", "count", 0, "dangling"})
+	assert.Equal(t, EventCriticalLog, e.Kind)
+	assert.Equal(t, "No live nodes", e.Summary)
+	assert.Equal(t, map[string]string{"logger": "EVM.1.Pool"}, e.Labels)
+	assert.Equal(t, map[string]interface{}{"evmChainID": "1", "count": "0", "dangling": nil}, e.Details)
+	assert.False(t, e.Time.IsZero())
+}
+
+func TestNewStuckTransactionEvent(t *testing.T) {
+	t.Parallel()
+
+	e := NewStuckTransactionEvent(big.NewInt(1), "0xabc", 42, 7, "underpriced", 60, true)
+	assert.Equal(t, EventStuckTransaction, e.Kind)
+	assert.Equal(t, "Transaction 42 with nonce 7 is stuck: underpriced", e.Summary)
+	assert.Equal(t, map[string]string{"evmChainID": "1", "fromAddress": "0xabc", "diagnosis": "underpriced"}, e.Labels)
+	assert.Equal(t, int64(60), e.Details["blocksPending"])
+	assert.Equal(t, true, e.Details["atMaxGasPrice"])
+}
@@ -0,0 +1,90 @@
+package notifications
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rule routes matching events to its sinks, suppressing repeats of identical
+// events within the dedup window, and dropping events beyond the rate limit.
+type rule struct {
+	name        string
+	kinds       map[EventKind]struct{}
+	labels      map[string]string
+	sinks       []Sink
+	dedupWindow time.Duration
+	limiter     *rate.Limiter // nil if unlimited
+
+	sent map[string]*sentEvent
+}
+
+type sentEvent struct {
+	at         time.Time
+	suppressed int
+}
+
+func newRule(cfg RuleConfig, sinks map[string]Sink) *rule {
+	r := &rule{
+		name:        cfg.Name,
+		kinds:       make(map[EventKind]struct{}, len(cfg.Kinds)),
+		labels:      cfg.Labels,
+		dedupWindow: cfg.dedupWindow(),
+		sent:        make(map[string]*sentEvent),
+	}
+	for _, kind := range cfg.Kinds {
+		r.kinds[kind] = struct{}{}
+	}
+	for _, name := range cfg.Sinks {
+		r.sinks = append(r.sinks, sinks[name])
+	}
+	if cfg.RateLimit > 0 {
+		r.limiter = rate.NewLimiter(rate.Every(cfg.rateLimitPeriod()/time.Duration(cfg.RateLimit)), int(cfg.RateLimit))
+	}
+	return r
+}
+
+func (r *rule) matches(e Event) bool {
+	if len(r.kinds) > 0 {
+		if _, ok := r.kinds[e.Kind]; !ok {
+			return false
+		}
+	}
+	for name, value := range r.labels {
+		if e.Labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// admit returns whether e should be sent at now, with the number of events
+// suppressed since the last identical one was sent. limited is true if e was
+// dropped by the rate limit.
+func (r *rule) admit(e Event, now time.Time) (admitted Event, ok bool, limited bool) {
+	key := e.key()
+	prev, seen := r.sent[key]
+	if seen && r.dedupWindow > 0 && now.Sub(prev.at) < r.dedupWindow {
+		prev.suppressed++
+		return e, false, false
+	}
+	if r.limiter != nil && !r.limiter.AllowN(now, 1) {
+		return e, false, true
+	}
+	if seen {
+		e.Suppressed = prev.suppressed
+	}
+	if r.dedupWindow > 0 {
+		r.sent[key] = &sentEvent{at: now}
+	}
+	return e, true, false
+}
+
+// prune forgets events sent longer than the dedup window ago.
+func (r *rule) prune(now time.Time) {
+	for key, s := range r.sent {
+		if now.Sub(s.at) >= r.dedupWindow {
+			delete(r.sent, key)
+		}
+	}
+}
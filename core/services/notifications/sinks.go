@@ -0,0 +1,188 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/smtp"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Sink delivers events to the node operator. Sinks are only called from the
+// notifier's run loop, so they need not be safe for concurrent use.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, e Event) error
+	Close() error
+}
+
+var (
+	_ Sink = &webhookSink{}
+	_ Sink = &emailSink{}
+	_ Sink = &fileSink{}
+)
+
+// webhookSink POSTs events as JSON to a URL.
+type webhookSink struct {
+	name    string
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func newWebhookSink(name string, url string, headers map[string]string) *webhookSink {
+	return &webhookSink{name: name, url: url, headers: headers, client: &http.Client{}}
+}
+
+func (s *webhookSink) Name() string { return s.name }
+func (s *webhookSink) Close() error { return nil }
+
+func (s *webhookSink) Send(ctx context.Context, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "webhook request failed")
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// emailSink sends events as plain text emails over SMTP.
+type emailSink struct {
+	name     string
+	address  string
+	auth     smtp.Auth
+	from     string
+	to       []string
+	sendMail sendMailFunc
+}
+
+// newEmailSink returns a sink sending emails through the SMTP server at
+// address (host:port). The server must support STARTTLS if username is set.
+func newEmailSink(name, address, username, password, from string, to []string) *emailSink {
+	s := &emailSink{name: name, address: address, from: from, to: to, sendMail: smtp.SendMail}
+	if username != "" {
+		host := address
+		if i := strings.LastIndex(address, ":"); i >= 0 {
+			host = address[:i]
+		}
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s
+}
+
+func (s *emailSink) Name() string { return s.name }
+func (s *emailSink) Close() error { return nil }
+
+func (s *emailSink) Send(ctx context.Context, e Event) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", headerValue(e.Subject()))
+	fmt.Fprintf(&msg, "Date: %s\r\n", e.Time.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(strings.ReplaceAll(formatText(e), "\r\n", "\n"), "\n", "\r\n"))
+
+	// net/smtp does not take a context, so give up waiting when it is done
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.sendMail(s.address, s.auth, s.from, s.to, msg.Bytes())
+	}()
+	select {
+	case err := <-errCh:
+		return errors.Wrap(err, "failed to send email")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to send email")
+	}
+}
+
+// headerValue strips line breaks, which would allow injecting headers.
+func headerValue(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
+
+// fileSink appends events as JSON lines to a local file.
+type fileSink struct {
+	name string
+	path string
+}
+
+func newFileSink(name, path string) *fileSink {
+	return &fileSink{name: name, path: path}
+}
+
+func (s *fileSink) Name() string { return s.name }
+func (s *fileSink) Close() error { return nil }
+
+func (s *fileSink) Send(ctx context.Context, e Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	// The file is reopened for every event, so that it can be rotated.
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open notifications file")
+	}
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed to write notifications file")
+	}
+	return errors.Wrap(f.Close(), "failed to close notifications file")
+}
+
+// formatText returns a human readable description of e.
+func formatText(e Event) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\n\n", e.Summary)
+	fmt.Fprintf(&sb, "Kind: %s\n", e.Kind)
+	fmt.Fprintf(&sb, "Time: %s\n", e.Time.Format(time.RFC3339))
+	writeSorted(&sb, e.Labels)
+	details := make(map[string]string, len(e.Details))
+	for k, v := range e.Details {
+		details[k] = fmt.Sprint(v)
+	}
+	writeSorted(&sb, details)
+	if e.Suppressed > 0 {
+		fmt.Fprintf(&sb, "\nThis event occurred %d more times since it was last sent.\n", e.Suppressed)
+	}
+	return sb.String()
+}
+
+func writeSorted(sb *strings.Builder, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(sb, "%s: %s\n", k, m[k])
+	}
+}
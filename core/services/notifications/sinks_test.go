@@ -0,0 +1,111 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestWebhookSink(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var e Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&e))
+		received <- e
+	}))
+	t.Cleanup(server.Close)
+
+	s := newWebhookSink("webhook", server.URL, map[string]string{"Authorization": "Bearer secret"})
+	require.NoError(t, s.Send(testutils.Context(t), NewJobErrorEvent(7, "boom")))
+	e := <-received
+	assert.Equal(t, EventJobError, e.Kind)
+	assert.Equal(t, "boom", e.Summary)
+	assert.Equal(t, "7", e.Labels["jobID"])
+
+	t.Run("error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		t.Cleanup(server.Close)
+
+		err := newWebhookSink("webhook", server.URL, nil).Send(testutils.Context(t), NewJobErrorEvent(7, "boom"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "502")
+	})
+}
+
+func TestEmailSink(t *testing.T) {
+	t.Parallel()
+
+	s := newEmailSink("email", "smtp.example.com:587", "user", "pass", "node@example.com", []string{"a@example.com", "b@example.com"})
+	require.NotNil(t, s.auth)
+	var sentTo []string
+	var sentMsg string
+	s.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		assert.Equal(t, "smtp.example.com:587", addr)
+		assert.Equal(t, "node@example.com", from)
+		sentTo = to
+		sentMsg = string(msg)
+		return nil
+	}
+
+	e := NewJobErrorEvent(7, "boom\r\nBcc: eve@example.com")
+	e.Suppressed = 3
+	require.NoError(t, s.Send(testutils.Context(t), e))
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, sentTo)
+	assert.Contains(t, sentMsg, "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, sentMsg, "Subject: [chainlink] job_error: boom  Bcc: eve@example.com\r\n")
+	headers := strings.SplitN(sentMsg, "\r\n\r\n", 2)[0]
+	assert.NotContains(t, headers, "\r\nBcc:")
+	assert.Contains(t, sentMsg, "jobID: 7\r\n")
+	assert.Contains(t, sentMsg, "occurred 3 more times")
+
+	t.Run("gives up when the context is done", func(t *testing.T) {
+		s := newEmailSink("email", "localhost:25", "", "", "node@example.com", []string{"a@example.com"})
+		assert.Nil(t, s.auth)
+		block := make(chan struct{})
+		t.Cleanup(func() { close(block) })
+		s.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+			<-block
+			return nil
+		}
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		require.ErrorIs(t, s.Send(ctx, NewJobErrorEvent(7, "boom")), context.Canceled)
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	s := newFileSink("file", path)
+	require.NoError(t, s.Send(testutils.Context(t), NewJobErrorEvent(1, "boom")))
+	require.NoError(t, s.Send(testutils.Context(t), NewCriticalLogEvent("Pool", "no nodes", nil)))
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+	var e Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &e))
+	assert.Equal(t, "boom", e.Summary)
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &e))
+	assert.Equal(t, EventCriticalLog, e.Kind)
+}
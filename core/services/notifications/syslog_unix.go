@@ -0,0 +1,44 @@
+//go:build !windows
+// +build !windows
+
+package notifications
+
+import (
+	"context"
+	"log/syslog"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var _ Sink = &syslogSink{}
+
+// syslogSink writes events to syslog, at critical priority for critical logs
+// and dead RPC nodes, and at error priority otherwise.
+type syslogSink struct {
+	name   string
+	writer *syslog.Writer
+}
+
+// newSyslogSink connects to the syslog daemon at address over network, or to
+// the local one if both are empty.
+func newSyslogSink(name, network, address, tag string) (Sink, error) {
+	w, err := syslog.Dial(network, address, syslog.LOG_WARNING|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to syslog")
+	}
+	return &syslogSink{name: name, writer: w}, nil
+}
+
+func (s *syslogSink) Name() string { return s.name }
+func (s *syslogSink) Close() error { return s.writer.Close() }
+
+func (s *syslogSink) Send(ctx context.Context, e Event) error {
+	msg := strings.ReplaceAll(formatText(e), "\n", " ")
+	switch e.Kind {
+	case EventCriticalLog, EventNoLiveNodes:
+		return s.writer.Crit(msg)
+	default:
+		return s.writer.Err(msg)
+	}
+}
@@ -0,0 +1,39 @@
+//go:build !windows
+// +build !windows
+
+package notifications
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	s, err := newSyslogSink("syslog", "udp", conn.LocalAddr().String(), "chainlink")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+
+	require.NoError(t, s.Send(testutils.Context(t), NewCriticalLogEvent("Pool", "no nodes", nil)))
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(testutils.WaitTimeout(t))))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	msg := string(buf[:n])
+	// LOG_DAEMON|LOG_CRIT
+	assert.Contains(t, msg, "<26>")
+	assert.Contains(t, msg, "chainlink")
+	assert.Contains(t, msg, "no nodes")
+}
@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package notifications
+
+import "github.com/pkg/errors"
+
+func newSyslogSink(name, network, address, tag string) (Sink, error) {
+	return nil, errors.New("syslog sinks are not supported on Windows")
+}
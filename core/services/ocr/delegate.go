@@ -3,7 +3,6 @@ package ocr
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/pkg/errors"
@@ -41,7 +40,7 @@ type Delegate struct {
 
 var _ job.Delegate = (*Delegate)(nil)
 
-const ConfigOverriderPollInterval = 30 * time.Second
+const ConfigOverriderPollInterval = ocrcommon.HibernationPollInterval
 
 func NewDelegate(
 	db *sqlx.DB,
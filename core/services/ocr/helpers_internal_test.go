@@ -10,7 +10,7 @@ import (
 )
 
 func (c *ConfigOverriderImpl) ExportedUpdateFlagsStatus() error {
-	return c.updateFlagsStatus()
+	return c.UpdateStatus()
 }
 
 func NewTestDB(t *testing.T, sqldb *sqlx.DB, oracleSpecID int32) *db {
@@ -64,6 +64,9 @@ func ValidatedOracleSpecToml(chainSet evm.ChainSet, tomlString string) (job.Job,
 	if !tree.Has("isBootstrapPeer") {
 		return jb, errors.New("isBootstrapPeer is not defined")
 	}
+	if spec.TransmitterAddress != nil && spec.FromLabel != "" {
+		return jb, errors.New("transmitterAddress and fromLabel are mutually exclusive")
+	}
 	for i := range spec.P2PBootstrapPeers {
 		if _, err = multiaddr.NewMultiaddr(spec.P2PBootstrapPeers[i]); err != nil {
 			return jb, errors.Wrapf(err, "p2p bootstrap peer %v is invalid", spec.P2PBootstrapPeers[i])
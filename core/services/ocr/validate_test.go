@@ -124,6 +124,25 @@ answer1      [type=median index=0];
 				assert.Contains(t, err.Error(), "unrecognised key for bootstrap peer: observationSource")
 			},
 		},
+		{
+			name: "transmitter address and from label",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer    = false
+transmitterAddress = "0xF67D0290337bca0847005C7ffD1BC75BA9AAE6e4"
+fromLabel          = "ocr"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1 -> answer1;
+answer1      [type=median index=0];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.EqualError(t, err, "transmitterAddress and fromLabel are mutually exclusive")
+			},
+		},
 		{
 			name: "empty pipeline string non-bootstrap node",
 			toml: `
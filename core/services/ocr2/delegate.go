@@ -10,11 +10,13 @@ import (
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/dkg"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/external"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/median"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/ocr2vrf"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
@@ -161,16 +163,19 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 			return nil, err2
 		}
 		ocr2Provider = medianProvider
-		pluginOracle, err = median.NewMedian(jobSpec, medianProvider, d.pipelineRunner, runResults, lggr, ocrLogger)
-	case job.DKG:
-		chainIDInterface, ok := jobSpec.OCR2OracleSpec.RelayConfig["chainID"]
-		if !ok {
-			return nil, errors.New("chainID must be provided in relay config")
+		var ethClient evmclient.Client
+		if spec.Relay == relay.EVM {
+			chain, err2 := d.getEVMChain(*spec)
+			if err2 != nil {
+				return nil, err2
+			}
+			ethClient = chain.Client()
 		}
-		chainID := int64(chainIDInterface.(float64))
-		chain, err2 := d.chainSet.Get(big.NewInt(chainID))
+		pluginOracle, err = median.NewMedian(jobSpec, medianProvider, d.pipelineRunner, runResults, lggr, ocrLogger, ethClient)
+	case job.DKG:
+		chain, err2 := d.getEVMChain(*spec)
 		if err2 != nil {
-			return nil, errors.Wrap(err2, "get chainset")
+			return nil, err2
 		}
 		dkgProvider, err2 := evmrelay.NewOCR2VRFRelayer(relayer).NewDKGProvider(
 			types.RelayArgs{
@@ -213,6 +218,26 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 		}
 		ocr2Provider = ocr2vrfProvider
 		pluginOracle, err = ocr2vrf.NewOCR2VRF(lggr.Named("OCR2VRF"))
+	case job.External:
+		externalRelayer, ok := evmrelay.NewExternalRelayer(relayer)
+		if !ok {
+			return nil, errors.Errorf("external plugins are not supported by the %s relay", spec.Relay)
+		}
+		externalProvider, err2 := externalRelayer.NewExternalProvider(
+			types.RelayArgs{
+				ExternalJobID: jobSpec.ExternalJobID,
+				JobID:         spec.ID,
+				ContractID:    spec.ContractID,
+				RelayConfig:   spec.RelayConfig.Bytes(),
+			}, types.PluginArgs{
+				TransmitterID: spec.TransmitterID.String,
+				PluginConfig:  spec.PluginConfig.Bytes(),
+			})
+		if err2 != nil {
+			return nil, err2
+		}
+		ocr2Provider = externalProvider
+		pluginOracle, err = external.NewExternal(jobSpec, d.cfg.OCR2PluginsDir(), lggr.Named("External"))
 	default:
 		return nil, errors.Errorf("plugin type %s not supported", spec.PluginType)
 	}
@@ -258,3 +283,16 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.ServiceCtx, error) {
 	oracleCtx := job.NewServiceAdapter(oracle)
 	return append([]job.ServiceCtx{runResultSaver, ocr2Provider, oracleCtx}, pluginServices...), nil
 }
+
+func (d Delegate) getEVMChain(spec job.OCR2OracleSpec) (evm.Chain, error) {
+	chainIDInterface, ok := spec.RelayConfig["chainID"]
+	if !ok {
+		return nil, errors.New("chainID must be provided in relay config")
+	}
+	chainID := int64(chainIDInterface.(float64))
+	chain, err := d.chainSet.Get(big.NewInt(chainID))
+	if err != nil {
+		return nil, errors.Wrap(err, "get chainset")
+	}
+	return chain, nil
+}
@@ -174,6 +174,20 @@ func (_m *Config) OCR2MonitoringEndpoint() string {
 	return r0
 }
 
+// OCR2PluginsDir provides a mock function with given fields:
+func (_m *Config) OCR2PluginsDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCR2TraceLogging provides a mock function with given fields:
 func (_m *Config) OCR2TraceLogging() bool {
 	ret := _m.Called()
@@ -0,0 +1,148 @@
+package external
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var _ ocr2types.ReportingPluginFactory = (*factoryClient)(nil)
+
+// factoryClient is the node's end of a plugin's ReportingPluginFactory.
+type factoryClient struct {
+	proc *process
+	lggr logger.Logger
+}
+
+func (f *factoryClient) NewReportingPlugin(cfg ocr2types.ReportingPluginConfig) (ocr2types.ReportingPlugin, ocr2types.ReportingPluginInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), startTimeout)
+	defer cancel()
+	rp := &pluginClient{proc: f.proc, cfg: cfg, lggr: f.lggr}
+	info, err := rp.connect(ctx)
+	if err != nil {
+		return nil, ocr2types.ReportingPluginInfo{}, err
+	}
+	return rp, info, nil
+}
+
+var _ ocr2types.ReportingPlugin = (*pluginClient)(nil)
+
+// pluginClient is the node's end of a ReportingPlugin served by a plugin. If the plugin is relaunched, the
+// ReportingPlugin is recreated from the same config on first use.
+type pluginClient struct {
+	proc *process
+	cfg  ocr2types.ReportingPluginConfig
+	lggr logger.Logger
+
+	mu         sync.Mutex
+	id         uint64
+	generation uint64
+	info       ocr2types.ReportingPluginInfo
+}
+
+// connect creates the ReportingPlugin on the plugin, if it has not been created since the plugin was launched.
+func (c *pluginClient) connect(ctx context.Context) (ocr2types.ReportingPluginInfo, error) {
+	_, _, info, err := c.instance(ctx)
+	return info, err
+}
+
+func (c *pluginClient) instance(ctx context.Context) (*grpc.ClientConn, uint64, ocr2types.ReportingPluginInfo, error) {
+	conn, generation, err := c.proc.client(ctx)
+	if err != nil {
+		return nil, 0, ocr2types.ReportingPluginInfo{}, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation == generation {
+		return conn, c.id, c.info, nil
+	}
+	if c.generation != 0 {
+		c.lggr.Infow("Recreating reporting plugin after plugin restart", "configDigest", c.cfg.ConfigDigest)
+	}
+	req := newReportingPluginRequest{Config: reportingPluginConfig{ReportingPluginConfig: c.cfg, ConfigDigest: c.cfg.ConfigDigest[:]}}
+	var resp newReportingPluginResponse
+	if err = invoke(ctx, conn, "NewReportingPlugin", &req, &resp); err != nil {
+		return nil, 0, ocr2types.ReportingPluginInfo{}, errors.Wrap(err, "failed to create reporting plugin")
+	}
+	if c.generation != 0 && resp.Info != c.info {
+		// libocr only reads the info once, so it must not change
+		c.lggr.Warnw("Reporting plugin info changed after plugin restart", "old", c.info, "new", resp.Info)
+	}
+	c.id, c.generation, c.info = resp.PluginID, generation, resp.Info
+	return conn, c.id, c.info, nil
+}
+
+func (c *pluginClient) Query(ctx context.Context, ts ocr2types.ReportTimestamp) (ocr2types.Query, error) {
+	conn, id, _, err := c.instance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp queryResponse
+	err = invoke(ctx, conn, "Query", &queryRequest{PluginID: id, Timestamp: newReportTimestamp(ts)}, &resp)
+	return resp.Query, err
+}
+
+func (c *pluginClient) Observation(ctx context.Context, ts ocr2types.ReportTimestamp, q ocr2types.Query) (ocr2types.Observation, error) {
+	conn, id, _, err := c.instance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp observationResponse
+	err = invoke(ctx, conn, "Observation", &observationRequest{PluginID: id, Timestamp: newReportTimestamp(ts), Query: q}, &resp)
+	return resp.Observation, err
+}
+
+func (c *pluginClient) Report(ctx context.Context, ts ocr2types.ReportTimestamp, q ocr2types.Query, aos []ocr2types.AttributedObservation) (bool, ocr2types.Report, error) {
+	conn, id, _, err := c.instance(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	req := reportRequest{PluginID: id, Timestamp: newReportTimestamp(ts), Query: q}
+	for _, ao := range aos {
+		req.Observations = append(req.Observations, attributedObservation{Observation: ao.Observation, Observer: ao.Observer})
+	}
+	var resp reportResponse
+	err = invoke(ctx, conn, "Report", &req, &resp)
+	return resp.ShouldReport, resp.Report, err
+}
+
+func (c *pluginClient) ShouldAcceptFinalizedReport(ctx context.Context, ts ocr2types.ReportTimestamp, r ocr2types.Report) (bool, error) {
+	return c.decide(ctx, "ShouldAcceptFinalizedReport", ts, r)
+}
+
+func (c *pluginClient) ShouldTransmitAcceptedReport(ctx context.Context, ts ocr2types.ReportTimestamp, r ocr2types.Report) (bool, error) {
+	return c.decide(ctx, "ShouldTransmitAcceptedReport", ts, r)
+}
+
+func (c *pluginClient) decide(ctx context.Context, method string, ts ocr2types.ReportTimestamp, r ocr2types.Report) (bool, error) {
+	conn, id, _, err := c.instance(ctx)
+	if err != nil {
+		return false, err
+	}
+	var resp reportDecisionResponse
+	err = invoke(ctx, conn, method, &reportDecisionRequest{PluginID: id, Timestamp: newReportTimestamp(ts), Report: r}, &resp)
+	return resp.Decision, err
+}
+
+// Close closes the ReportingPlugin on the plugin. It does not stop the plugin, which is owned by the job.
+func (c *pluginClient) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, generation, err := c.proc.client(ctx)
+	if err != nil {
+		// the plugin is stopped or restarting, so has no state to clean up
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return nil
+	}
+	return invoke(ctx, conn, "Close", &closeRequest{PluginID: c.id}, &closeResponse{})
+}
@@ -0,0 +1,63 @@
+// config is a separate package so that we can validate
+// the config in other packages, for example in job at job create time.
+
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PluginConfig contains the arguments needed to launch an external plugin.
+type PluginConfig struct {
+	// Command is the absolute path of the plugin binary, which must be in the plugins directory of the node.
+	Command string   `json:"command"`
+	Args    []string `json:"args,omitempty"`
+	// Env is the environment of the plugin, together with PATH and the variables set by the node. The plugin
+	// does not inherit the rest of the environment of the node. PATH and dynamic loader variables cannot be
+	// set, see IsProtectedEnv.
+	Env map[string]string `json:"env,omitempty"`
+	// Config is passed through to the plugin, which is responsible for validating it.
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// ValidatePluginConfig validates the arguments for an external plugin, whose command must be in pluginsDir.
+// External plugins are disabled if pluginsDir is empty.
+func ValidatePluginConfig(config PluginConfig, pluginsDir string) error {
+	if config.Command == "" {
+		return errors.New("no command specified")
+	}
+	if !filepath.IsAbs(config.Command) {
+		return errors.Errorf("command must be an absolute path: %s", config.Command)
+	}
+	if pluginsDir == "" {
+		return errors.New("external plugins are disabled, since OCR2_PLUGINS_DIR is not set")
+	}
+	rel, err := filepath.Rel(filepath.Clean(pluginsDir), filepath.Clean(config.Command))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return errors.Errorf("command must be in the plugins directory %s: %s", pluginsDir, config.Command)
+	}
+	for name := range config.Env {
+		if name == "" || strings.Contains(name, "=") {
+			return errors.Errorf("invalid env variable name: %q", name)
+		}
+		if IsProtectedEnv(name) {
+			return errors.Errorf("env variable %s cannot be set, as it changes which binary or libraries the plugin runs", name)
+		}
+	}
+	if len(config.Config) > 0 && !json.Valid(config.Config) {
+		return errors.New("invalid config")
+	}
+	return nil
+}
+
+// IsProtectedEnv reports whether the env variable cannot be set by a plugin config. PATH and the variables of
+// the dynamic loaders (LD_PRELOAD, LD_LIBRARY_PATH, DYLD_INSERT_LIBRARIES...) would let a job run code other than
+// the plugin binary in the plugins directory.
+func IsProtectedEnv(name string) bool {
+	name = strings.ToUpper(name)
+	return name == "PATH" || strings.HasPrefix(name, "LD_") || strings.HasPrefix(name, "DYLD_")
+}
@@ -0,0 +1,236 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/external/config"
+)
+
+// envTestPlugin makes the test binary serve testFactory instead of running tests.
+const envTestPlugin = "CL_TEST_EXTERNAL_PLUGIN"
+
+// crashEpoch makes the test plugin exit when queried.
+const crashEpoch = 13
+
+func TestMain(m *testing.M) {
+	if os.Getenv(envTestPlugin) != "" {
+		if err := Serve(newTestFactory); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type testConfig struct {
+	Name string `json:"name"`
+}
+
+func newTestFactory(lggr logger.Logger, cfg []byte) (ocr2types.ReportingPluginFactory, error) {
+	var tc testConfig
+	if err := json.Unmarshal(cfg, &tc); err != nil {
+		return nil, err
+	}
+	lggr.Infow("Test plugin started", "name", tc.Name)
+	return &testFactory{name: tc.Name, lggr: lggr}, nil
+}
+
+type testFactory struct {
+	name string
+	lggr logger.Logger
+}
+
+func (f *testFactory) NewReportingPlugin(cfg ocr2types.ReportingPluginConfig) (ocr2types.ReportingPlugin, ocr2types.ReportingPluginInfo, error) {
+	return &testPlugin{cfg: cfg, lggr: f.lggr}, ocr2types.ReportingPluginInfo{
+		Name:          f.name,
+		UniqueReports: true,
+		Limits:        ocr2types.ReportingPluginLimits{MaxQueryLength: 1, MaxObservationLength: 2, MaxReportLength: 3},
+	}, nil
+}
+
+// testPlugin reports the concatenated observations, which echo the query. The query is the offchain config.
+type testPlugin struct {
+	cfg  ocr2types.ReportingPluginConfig
+	lggr logger.Logger
+}
+
+func (p *testPlugin) Query(_ context.Context, ts ocr2types.ReportTimestamp) (ocr2types.Query, error) {
+	if ts.Epoch == crashEpoch {
+		os.Exit(2)
+	}
+	if ts.ConfigDigest != p.cfg.ConfigDigest {
+		return nil, fmt.Errorf("wrong config digest %s", ts.ConfigDigest)
+	}
+	return ocr2types.Query(p.cfg.OffchainConfig), nil
+}
+
+func (p *testPlugin) Observation(_ context.Context, ts ocr2types.ReportTimestamp, q ocr2types.Query) (ocr2types.Observation, error) {
+	p.lggr.Debugw("Observing", "epoch", ts.Epoch)
+	return append(ocr2types.Observation(q), byte(p.cfg.OracleID)), nil
+}
+
+func (p *testPlugin) Report(_ context.Context, _ ocr2types.ReportTimestamp, _ ocr2types.Query, aos []ocr2types.AttributedObservation) (bool, ocr2types.Report, error) {
+	var r ocr2types.Report
+	for _, ao := range aos {
+		r = append(r, ao.Observation...)
+		r = append(r, byte(ao.Observer))
+	}
+	return len(r) > 0, r, nil
+}
+
+func (p *testPlugin) ShouldAcceptFinalizedReport(_ context.Context, _ ocr2types.ReportTimestamp, r ocr2types.Report) (bool, error) {
+	return len(r) > 0, nil
+}
+
+func (p *testPlugin) ShouldTransmitAcceptedReport(_ context.Context, ts ocr2types.ReportTimestamp, _ ocr2types.Report) (bool, error) {
+	if ts.Round == 0 {
+		return false, fmt.Errorf("round zero")
+	}
+	return ts.Round%2 == 0, nil
+}
+
+func (p *testPlugin) Close() error { return nil }
+
+func TestExternal(t *testing.T) {
+	testBinary, err := filepath.Abs(os.Args[0])
+	require.NoError(t, err)
+	lggr, logs := logger.TestLoggerObserved(t, zapcore.DebugLevel)
+	proc := newProcess(config.PluginConfig{
+		Command: testBinary,
+		Env:     map[string]string{envTestPlugin: "true"},
+		Config:  json.RawMessage(`{"name":"echo"}`),
+	}, lggr)
+	ctx := testutils.Context(t)
+	require.NoError(t, proc.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, proc.Close()) })
+
+	factory := &factoryClient{proc: proc, lggr: lggr}
+	digest := ocr2types.ConfigDigest{1, 2, 3}
+	rp, info, err := factory.NewReportingPlugin(ocr2types.ReportingPluginConfig{
+		ConfigDigest:   digest,
+		OracleID:       4,
+		N:              4,
+		F:              1,
+		OffchainConfig: []byte{0xab},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ocr2types.ReportingPluginInfo{
+		Name:          "echo",
+		UniqueReports: true,
+		Limits:        ocr2types.ReportingPluginLimits{MaxQueryLength: 1, MaxObservationLength: 2, MaxReportLength: 3},
+	}, info)
+
+	ts := ocr2types.ReportTimestamp{ConfigDigest: digest, Epoch: 1, Round: 2}
+	q, err := rp.Query(ctx, ts)
+	require.NoError(t, err)
+	assert.Equal(t, ocr2types.Query{0xab}, q)
+
+	o, err := rp.Observation(ctx, ts, q)
+	require.NoError(t, err)
+	assert.Equal(t, ocr2types.Observation{0xab, 4}, o)
+
+	ok, r, err := rp.Report(ctx, ts, q, []ocr2types.AttributedObservation{
+		{Observation: o, Observer: commontypes.OracleID(4)},
+		{Observation: ocr2types.Observation{0xcd}, Observer: commontypes.OracleID(1)},
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, ocr2types.Report{0xab, 4, 4, 0xcd, 1}, r)
+
+	ok, err = rp.ShouldAcceptFinalizedReport(ctx, ts, r)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = rp.ShouldTransmitAcceptedReport(ctx, ts, r)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	_, err = rp.ShouldTransmitAcceptedReport(ctx, ocr2types.ReportTimestamp{ConfigDigest: digest}, r)
+	require.ErrorContains(t, err, "round zero")
+
+	t.Run("logs are piped to the node", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return logs.FilterMessage("Test plugin started").Len() == 1 && logs.FilterMessage("Observing").Len() == 1
+		}, testutils.WaitTimeout(t), 10*time.Millisecond)
+		started := logs.FilterMessage("Test plugin started").All()[0]
+		assert.Equal(t, zapcore.InfoLevel, started.Level)
+		assert.Equal(t, "echo", started.ContextMap()["name"])
+		observing := logs.FilterMessage("Observing").All()[0]
+		assert.Equal(t, zapcore.DebugLevel, observing.Level)
+		assert.Equal(t, float64(1), observing.ContextMap()["epoch"])
+	})
+
+	t.Run("restarts on crash", func(t *testing.T) {
+		_, err = rp.Query(ctx, ocr2types.ReportTimestamp{ConfigDigest: digest, Epoch: crashEpoch})
+		require.Error(t, err)
+
+		// the reporting plugin is recreated on the relaunched plugin
+		require.Eventually(t, func() bool {
+			q, err = rp.Query(ctx, ts)
+			return err == nil
+		}, testutils.WaitTimeout(t), 100*time.Millisecond)
+		assert.Equal(t, ocr2types.Query{0xab}, q)
+		assert.Equal(t, 1, logs.FilterMessage("External plugin exited, restarting").Len())
+		assert.Equal(t, 1, logs.FilterMessage("Recreating reporting plugin after plugin restart").Len())
+	})
+
+	require.NoError(t, rp.Close())
+}
+
+func TestProcess_ExitsBeforeServing(t *testing.T) {
+	lggr, logs := logger.TestLoggerObserved(t, zapcore.DebugLevel)
+	// without envTestPlugin, the test binary runs no tests and exits without serving
+	testBinary, err := filepath.Abs(os.Args[0])
+	require.NoError(t, err)
+	proc := newProcess(config.PluginConfig{
+		Command: testBinary,
+		Args:    []string{"-test.run=^$"},
+	}, lggr)
+	require.NoError(t, proc.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, proc.Close()) })
+
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("External plugin exited, restarting").Len() > 0
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = proc.client(ctx)
+	require.Error(t, err)
+}
+
+func TestServe_RequiresNode(t *testing.T) {
+	t.Setenv(EnvSocket, "")
+	require.ErrorContains(t, Serve(newTestFactory), EnvSocket)
+}
+
+func TestProcess_Env(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	t.Setenv("CL_TEST_NODE_SECRET", "secret")
+	proc := newProcess(config.PluginConfig{
+		Command: "/usr/local/bin/my-plugin",
+		Env:     map[string]string{"FOO": "bar", EnvSocket: "/tmp/other.sock", "PATH": "/opt/bin", "LD_PRELOAD": "/tmp/evil.so"},
+		Config:  json.RawMessage(`{"name":"echo"}`),
+	}, logger.TestLogger(t))
+
+	// the node's environment is not inherited, and the plugin config cannot change the socket, PATH or loader
+	assert.Equal(t, []string{
+		EnvSocket + "=/tmp/plugin.sock",
+		EnvPluginConfig + `={"name":"echo"}`,
+		"PATH=/usr/bin",
+		"FOO=bar",
+	}, proc.env("/tmp/plugin.sock"))
+}
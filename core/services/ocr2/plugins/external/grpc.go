@@ -0,0 +1,305 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+// serviceName is the gRPC service served by plugins, which bridges ocr2types.ReportingPluginFactory and the
+// ocr2types.ReportingPlugins it creates.
+const serviceName = "chainlink.ocr2.ReportingPlugin"
+
+var _ encoding.Codec = jsonCodec{}
+
+// jsonCodec encodes messages as JSON, so that plugins need no generated protobuf code. It is forced on both ends
+// of the connection.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                               { return "json" }
+
+// reportTimestamp is an ocr2types.ReportTimestamp. ConfigDigest only implements encoding.TextMarshaler, so it
+// is sent as bytes instead.
+type reportTimestamp struct {
+	ConfigDigest []byte
+	Epoch        uint32
+	Round        uint8
+}
+
+func newReportTimestamp(ts ocr2types.ReportTimestamp) reportTimestamp {
+	return reportTimestamp{ConfigDigest: ts.ConfigDigest[:], Epoch: ts.Epoch, Round: ts.Round}
+}
+
+func (ts reportTimestamp) toOCR() (rt ocr2types.ReportTimestamp, err error) {
+	rt.ConfigDigest, err = ocr2types.BytesToConfigDigest(ts.ConfigDigest)
+	rt.Epoch, rt.Round = ts.Epoch, ts.Round
+	return
+}
+
+// reportingPluginConfig is an ocr2types.ReportingPluginConfig, with its ConfigDigest sent as bytes.
+type reportingPluginConfig struct {
+	ocr2types.ReportingPluginConfig
+	// ConfigDigest shadows the embedded field.
+	ConfigDigest []byte
+}
+
+type newReportingPluginRequest struct {
+	Config reportingPluginConfig
+}
+
+type newReportingPluginResponse struct {
+	PluginID uint64
+	Info     ocr2types.ReportingPluginInfo
+}
+
+type queryRequest struct {
+	PluginID  uint64
+	Timestamp reportTimestamp
+}
+
+type queryResponse struct {
+	Query ocr2types.Query
+}
+
+type observationRequest struct {
+	PluginID  uint64
+	Timestamp reportTimestamp
+	Query     ocr2types.Query
+}
+
+type observationResponse struct {
+	Observation ocr2types.Observation
+}
+
+type attributedObservation struct {
+	Observation ocr2types.Observation
+	Observer    commontypes.OracleID
+}
+
+type reportRequest struct {
+	PluginID     uint64
+	Timestamp    reportTimestamp
+	Query        ocr2types.Query
+	Observations []attributedObservation
+}
+
+type reportResponse struct {
+	ShouldReport bool
+	Report       ocr2types.Report
+}
+
+// reportDecisionRequest is sent to both ShouldAcceptFinalizedReport and ShouldTransmitAcceptedReport.
+type reportDecisionRequest struct {
+	PluginID  uint64
+	Timestamp reportTimestamp
+	Report    ocr2types.Report
+}
+
+type reportDecisionResponse struct {
+	Decision bool
+}
+
+type closeRequest struct {
+	PluginID uint64
+}
+
+type closeResponse struct{}
+
+// unaryHandler adapts a typed server method to a grpc.MethodHandler.
+func unaryHandler[Req, Resp any](method string, call func(s *server, ctx context.Context, req *Req) (*Resp, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := new(Req)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			s := srv.(*server)
+			if interceptor == nil {
+				return call(s, ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/" + serviceName + "/" + method}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(s, ctx, req.(*Req))
+			})
+		},
+	}
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		unaryHandler("NewReportingPlugin", (*server).newReportingPlugin),
+		unaryHandler("Query", (*server).query),
+		unaryHandler("Observation", (*server).observation),
+		unaryHandler("Report", (*server).report),
+		unaryHandler("ShouldAcceptFinalizedReport", (*server).shouldAcceptFinalizedReport),
+		unaryHandler("ShouldTransmitAcceptedReport", (*server).shouldTransmitAcceptedReport),
+		unaryHandler("Close", (*server).close),
+	},
+}
+
+// server serves a plugin's ReportingPluginFactory, and the ReportingPlugins it creates by ID.
+type server struct {
+	factory ocr2types.ReportingPluginFactory
+
+	mu      sync.RWMutex
+	nextID  uint64
+	plugins map[uint64]ocr2types.ReportingPlugin
+}
+
+func newServer(factory ocr2types.ReportingPluginFactory) *server {
+	return &server{factory: factory, plugins: map[uint64]ocr2types.ReportingPlugin{}}
+}
+
+func (s *server) plugin(id uint64) (ocr2types.ReportingPlugin, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rp, ok := s.plugins[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no reporting plugin with id %d", id)
+	}
+	return rp, nil
+}
+
+func (s *server) newReportingPlugin(_ context.Context, req *newReportingPluginRequest) (*newReportingPluginResponse, error) {
+	cfg := req.Config.ReportingPluginConfig
+	var err error
+	cfg.ConfigDigest, err = ocr2types.BytesToConfigDigest(req.Config.ConfigDigest)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rp, info, err := s.factory.NewReportingPlugin(cfg)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	s.plugins[s.nextID] = rp
+	return &newReportingPluginResponse{PluginID: s.nextID, Info: info}, nil
+}
+
+func (s *server) query(ctx context.Context, req *queryRequest) (*queryResponse, error) {
+	rp, err := s.plugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := req.Timestamp.toOCR()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	q, err := rp.Query(ctx, ts)
+	if err != nil {
+		return nil, err
+	}
+	return &queryResponse{Query: q}, nil
+}
+
+func (s *server) observation(ctx context.Context, req *observationRequest) (*observationResponse, error) {
+	rp, err := s.plugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := req.Timestamp.toOCR()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	o, err := rp.Observation(ctx, ts, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	return &observationResponse{Observation: o}, nil
+}
+
+func (s *server) report(ctx context.Context, req *reportRequest) (*reportResponse, error) {
+	rp, err := s.plugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := req.Timestamp.toOCR()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	aos := make([]ocr2types.AttributedObservation, len(req.Observations))
+	for i, ao := range req.Observations {
+		aos[i] = ocr2types.AttributedObservation{Observation: ao.Observation, Observer: ao.Observer}
+	}
+	ok, r, err := rp.Report(ctx, ts, req.Query, aos)
+	if err != nil {
+		return nil, err
+	}
+	return &reportResponse{ShouldReport: ok, Report: r}, nil
+}
+
+func (s *server) shouldAcceptFinalizedReport(ctx context.Context, req *reportDecisionRequest) (*reportDecisionResponse, error) {
+	rp, err := s.plugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := req.Timestamp.toOCR()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ok, err := rp.ShouldAcceptFinalizedReport(ctx, ts, req.Report)
+	if err != nil {
+		return nil, err
+	}
+	return &reportDecisionResponse{Decision: ok}, nil
+}
+
+func (s *server) shouldTransmitAcceptedReport(ctx context.Context, req *reportDecisionRequest) (*reportDecisionResponse, error) {
+	rp, err := s.plugin(req.PluginID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := req.Timestamp.toOCR()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ok, err := rp.ShouldTransmitAcceptedReport(ctx, ts, req.Report)
+	if err != nil {
+		return nil, err
+	}
+	return &reportDecisionResponse{Decision: ok}, nil
+}
+
+func (s *server) close(_ context.Context, req *closeRequest) (*closeResponse, error) {
+	s.mu.Lock()
+	rp, ok := s.plugins[req.PluginID]
+	delete(s.plugins, req.PluginID)
+	s.mu.Unlock()
+	if !ok {
+		return &closeResponse{}, nil
+	}
+	return &closeResponse{}, rp.Close()
+}
+
+// closeAll closes all ReportingPlugins, when the plugin is stopped.
+func (s *server) closeAll() (err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, rp := range s.plugins {
+		if cerr := rp.Close(); cerr != nil {
+			err = errors.Wrapf(cerr, "failed to close reporting plugin %d", id)
+		}
+		delete(s.plugins, id)
+	}
+	return
+}
+
+// invoke calls method on the plugin served over conn.
+func invoke(ctx context.Context, conn *grpc.ClientConn, method string, req, resp interface{}) error {
+	return conn.Invoke(ctx, "/"+serviceName+"/"+method, req, resp, grpc.ForceCodec(jsonCodec{}))
+}
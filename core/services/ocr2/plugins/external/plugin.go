@@ -0,0 +1,46 @@
+package external
+
+import (
+	"encoding/json"
+
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/external/config"
+)
+
+// External runs a reporting plugin from a binary named by the job spec, which the node launches and talks to
+// over gRPC.
+type External struct {
+	proc *process
+	lggr logger.Logger
+}
+
+var _ plugins.OraclePlugin = &External{}
+
+// NewExternal parses the plugin config of jb and returns a new External, which launches the plugin when its
+// services are started. The plugin command must be in pluginsDir.
+func NewExternal(jb job.Job, pluginsDir string, lggr logger.Logger) (*External, error) {
+	var pluginConfig config.PluginConfig
+	err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
+	if err != nil {
+		return &External{}, err
+	}
+	err = config.ValidatePluginConfig(pluginConfig, pluginsDir)
+	if err != nil {
+		return &External{}, err
+	}
+	return &External{proc: newProcess(pluginConfig, lggr), lggr: lggr}, nil
+}
+
+// GetPluginFactory returns a ReportingPluginFactory which creates ReportingPlugins on the plugin.
+func (e *External) GetPluginFactory() (ocr2types.ReportingPluginFactory, error) {
+	return &factoryClient{proc: e.proc, lggr: e.lggr}, nil
+}
+
+// GetServices returns the supervisor of the plugin process.
+func (e *External) GetServices() ([]job.ServiceCtx, error) {
+	return []job.ServiceCtx{e.proc}, nil
+}
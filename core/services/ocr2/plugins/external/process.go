@@ -0,0 +1,312 @@
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/external/config"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// startTimeout is how long a plugin has to start serving after it is launched.
+	startTimeout = 30 * time.Second
+	// stopTimeout is how long a plugin has to exit after it is interrupted, before it is killed.
+	stopTimeout = 5 * time.Second
+)
+
+var _ job.ServiceCtx = (*process)(nil)
+
+// process launches a plugin binary and supervises it, relaunching it with a backoff whenever it exits.
+type process struct {
+	utils.StartStopOnce
+	cfg  config.PluginConfig
+	lggr logger.Logger
+	dir  string
+
+	mu         sync.RWMutex
+	conn       *grpc.ClientConn
+	generation uint64
+	ready      chan struct{} // closed once conn is serving, and replaced when the plugin exits
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newProcess(cfg config.PluginConfig, lggr logger.Logger) *process {
+	return &process{
+		cfg:    cfg,
+		lggr:   lggr,
+		ready:  make(chan struct{}),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (p *process) Start(context.Context) error {
+	return p.StartOnce("ExternalPlugin", func() (err error) {
+		// unix socket paths are limited to ~100 bytes, so keep the dir short
+		p.dir, err = os.MkdirTemp("", "ocr2plugin")
+		if err != nil {
+			return errors.Wrap(err, "failed to create plugin socket dir")
+		}
+		p.wg.Add(1)
+		go p.run()
+		return nil
+	})
+}
+
+func (p *process) Close() error {
+	return p.StopOnce("ExternalPlugin", func() error {
+		close(p.chStop)
+		p.wg.Wait()
+		return os.RemoveAll(p.dir)
+	})
+}
+
+// client waits until the plugin is serving, and returns its connection. generation is incremented each time the
+// plugin is relaunched, since plugin state does not survive a restart.
+func (p *process) client(ctx context.Context) (conn *grpc.ClientConn, generation uint64, err error) {
+	p.mu.RLock()
+	ready := p.ready
+	p.mu.RUnlock()
+	select {
+	case <-ready:
+	case <-ctx.Done():
+		return nil, 0, errors.Wrap(ctx.Err(), "plugin is not serving")
+	case <-p.chStop:
+		return nil, 0, errors.New("plugin is stopped")
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.conn == nil {
+		// exited since ready was closed
+		return nil, 0, errors.New("plugin exited")
+	}
+	return p.conn, p.generation, nil
+}
+
+func (p *process) run() {
+	defer p.wg.Done()
+	backoff := utils.NewRedialBackoff()
+	for {
+		started := time.Now()
+		err := p.launch()
+		select {
+		case <-p.chStop:
+			return
+		default:
+		}
+		if time.Since(started) > backoff.Max {
+			// the plugin ran for a while, so this is a fresh crash rather than a crash loop
+			backoff.Reset()
+		}
+		wait := backoff.Duration()
+		p.lggr.Errorw("External plugin exited, restarting", "err", err, "wait", wait)
+		select {
+		case <-p.chStop:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// launch runs the plugin until it exits, or until the process is stopped.
+func (p *process) launch() error {
+	socket := filepath.Join(p.dir, "plugin.sock")
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove stale socket")
+	}
+
+	cmd := exec.Command(p.cfg.Command, p.cfg.Args...) //nolint:gosec
+	cmd.Env = p.env(socket)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.Wrap(err, "failed to launch plugin")
+	}
+	p.lggr.Infow("Launched external plugin", "command", p.cfg.Command, "pid", cmd.Process.Pid)
+
+	var pipes sync.WaitGroup
+	pipes.Add(2)
+	go func() { defer pipes.Done(); p.pipeLogs(stdout, false) }()
+	go func() { defer pipes.Done(); p.pipeLogs(stderr, true) }()
+	exited := make(chan error, 1)
+	go func() {
+		// Wait must not be called until the pipes have been read
+		pipes.Wait()
+		exited <- cmd.Wait()
+	}()
+
+	ctx, cancel := utils.ContextFromChan(p.chStop)
+	defer cancel()
+	dialCtx, dialCancel := context.WithTimeout(ctx, startTimeout)
+	defer dialCancel()
+	dialed := make(chan struct{})
+	var conn *grpc.ClientConn
+	var dialErr error
+	go func() {
+		defer close(dialed)
+		conn, dialErr = grpc.DialContext(dialCtx, "unix://"+socket,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+		)
+	}()
+	select {
+	case <-dialed:
+	case err := <-exited:
+		dialCancel()
+		<-dialed
+		if conn != nil {
+			p.lggr.ErrorIfClosing(conn, "plugin connection")
+		}
+		return errors.Wrap(err, "plugin exited before serving")
+	}
+	if dialErr != nil {
+		p.kill(cmd, exited)
+		return errors.Wrap(dialErr, "failed to connect to plugin")
+	}
+
+	p.mu.Lock()
+	p.conn = conn
+	p.generation++
+	close(p.ready)
+	p.mu.Unlock()
+
+	select {
+	case <-p.chStop:
+		p.reset()
+		p.kill(cmd, exited)
+		return nil
+	case err = <-exited:
+		p.reset()
+		if err == nil {
+			err = errors.New("plugin exited")
+		}
+		return err
+	}
+}
+
+// reset closes the connection to an exited plugin, so that clients wait for its replacement.
+func (p *process) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn != nil {
+		p.lggr.ErrorIfClosing(p.conn, "plugin connection")
+		p.conn = nil
+	}
+	p.ready = make(chan struct{})
+}
+
+// kill interrupts the plugin, and kills it if it has not exited within stopTimeout.
+func (p *process) kill(cmd *exec.Cmd, exited <-chan error) {
+	if err := cmd.Process.Signal(os.Interrupt); err != nil {
+		p.lggr.Warnw("Failed to interrupt plugin", "err", err)
+	}
+	select {
+	case <-exited:
+	case <-time.After(stopTimeout):
+		p.lggr.Warn("Plugin did not exit in time, killing it")
+		if err := cmd.Process.Kill(); err != nil {
+			p.lggr.Errorw("Failed to kill plugin", "err", err)
+		}
+		<-exited
+	}
+}
+
+// env returns the environment of the plugin. The environment of the node holds its secrets, so only PATH is passed
+// on, together with the env of the plugin config.
+func (p *process) env(socket string) []string {
+	env := []string{EnvSocket + "=" + socket, EnvPluginConfig + "=" + string(p.cfg.Config)}
+	if path, ok := os.LookupEnv("PATH"); ok {
+		env = append(env, "PATH="+path)
+	}
+	keys := make([]string, 0, len(p.cfg.Env))
+	for k := range p.cfg.Env {
+		if k == EnvSocket || k == EnvPluginConfig || config.IsProtectedEnv(k) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		env = append(env, k+"="+p.cfg.Env[k])
+	}
+	return env
+}
+
+// pipeLogs writes each line output by the plugin to the node log. JSON lines, as written by a plugin's
+// logger.Logger, keep their level and fields. Other lines are logged at info, or warn for stderr.
+func (p *process) pipeLogs(r io.Reader, stderr bool) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		var entry map[string]interface{}
+		if err := json.Unmarshal(line, &entry); err != nil || entry["msg"] == nil {
+			if stderr {
+				p.lggr.Warn(string(line))
+			} else {
+				p.lggr.Info(string(line))
+			}
+			continue
+		}
+		p.logEntry(entry)
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, os.ErrClosed) {
+		p.lggr.Warnw("Failed to read plugin output", "err", err)
+	}
+}
+
+func (p *process) logEntry(entry map[string]interface{}) {
+	msg, _ := entry["msg"].(string)
+	lvl, _ := entry["level"].(string)
+	lggr := p.lggr
+	if name, ok := entry["logger"].(string); ok && name != "" {
+		lggr = lggr.Named(name)
+	}
+	for _, k := range []string{"msg", "level", "logger", "ts", "caller"} {
+		delete(entry, k)
+	}
+	keys := make([]string, 0, len(entry))
+	for k := range entry {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kvs := make([]interface{}, 0, 2*len(entry))
+	for _, k := range keys {
+		kvs = append(kvs, k, entry[k])
+	}
+	switch lvl {
+	case "debug":
+		lggr.Debugw(msg, kvs...)
+	case "info":
+		lggr.Infow(msg, kvs...)
+	case "warn":
+		lggr.Warnw(msg, kvs...)
+	case "error":
+		lggr.Errorw(msg, kvs...)
+	default:
+		// crit, panic and fatal must not take down the node
+		lggr.Criticalw(msg, kvs...)
+	}
+}
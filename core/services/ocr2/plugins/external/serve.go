@@ -0,0 +1,65 @@
+package external
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// EnvSocket is the path of the unix socket which the node expects a plugin to serve on.
+	EnvSocket = "CL_OCR2_PLUGIN_SOCKET"
+	// EnvPluginConfig is the config from the pluginConfig.config of the job spec, as JSON.
+	EnvPluginConfig = "CL_OCR2_PLUGIN_CONFIG"
+)
+
+// NewFactoryFunc returns the ReportingPluginFactory of a plugin, given the config from its job spec.
+type NewFactoryFunc func(lggr logger.Logger, config []byte) (ocr2types.ReportingPluginFactory, error)
+
+// Serve serves the ReportingPluginFactory returned by newFactory to the node which launched the plugin, until
+// the plugin is interrupted. It should be called from the main function of the plugin binary.
+//
+// The logger passed to newFactory writes to stderr, which is piped into the node log.
+func Serve(newFactory NewFactoryFunc) error {
+	socket := os.Getenv(EnvSocket)
+	if socket == "" {
+		return errors.Errorf("%s is not set: plugins must be launched by a node", EnvSocket)
+	}
+
+	lcfg := logger.Config{LogLevel: zapcore.DebugLevel, JsonConsole: true}
+	lggr, closeLggr := lcfg.New()
+	defer func() { _ = closeLggr() }()
+
+	factory, err := newFactory(lggr, []byte(os.Getenv(EnvPluginConfig)))
+	if err != nil {
+		return errors.Wrap(err, "failed to create reporting plugin factory")
+	}
+
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+	srv := newServer(factory)
+	s := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}))
+	s.RegisterService(&serviceDesc, srv)
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		s.GracefulStop()
+	}()
+
+	if err = s.Serve(lis); err != nil {
+		return errors.Wrap(err, "failed to serve")
+	}
+	return srv.closeAll()
+}
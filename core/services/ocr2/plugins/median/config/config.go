@@ -4,6 +4,7 @@
 package config
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -12,6 +13,9 @@ import (
 // The PluginConfig struct contains the custom arguments needed for the Median plugin.
 type PluginConfig struct {
 	JuelsPerFeeCoinPipeline string `json:"juelsPerFeeCoinSource"`
+	// FlagsContractAddress is the optional address of the Flags contract used
+	// to hibernate the feed. EVM only.
+	FlagsContractAddress string `json:"flagsContractAddress,omitempty"`
 }
 
 // ValidatePluginConfig validates the arguments for the Median plugin.
@@ -19,6 +23,9 @@ func ValidatePluginConfig(config PluginConfig) error {
 	if _, err := pipeline.Parse(config.JuelsPerFeeCoinPipeline); err != nil {
 		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
 	}
+	if config.FlagsContractAddress != "" && !common.IsHexAddress(config.FlagsContractAddress) {
+		return errors.Errorf("invalid flagsContractAddress: %s", config.FlagsContractAddress)
+	}
 
 	return nil
 }
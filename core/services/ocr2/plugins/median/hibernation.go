@@ -0,0 +1,63 @@
+package median
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// Hibernation reports whether a feed is flagged for hibernation, and the
+// heartbeat to use while it is.
+type Hibernation interface {
+	IsHibernating() bool
+	HibernationDeltaC() time.Duration
+}
+
+// hibernatingFactory wraps a ReportingPluginFactory so that its plugins
+// stop reporting on deviation while the feed hibernates. OCR2 has no config
+// override, so the equivalent of the OCR1 override (infinite alpha, long
+// deltaC) is applied when deciding whether to report.
+type hibernatingFactory struct {
+	ocr2types.ReportingPluginFactory
+	hibernation Hibernation
+	contract    median.MedianContract
+	lggr        logger.Logger
+}
+
+var _ ocr2types.ReportingPluginFactory = &hibernatingFactory{}
+
+func (f *hibernatingFactory) NewReportingPlugin(config ocr2types.ReportingPluginConfig) (ocr2types.ReportingPlugin, ocr2types.ReportingPluginInfo, error) {
+	plugin, info, err := f.ReportingPluginFactory.NewReportingPlugin(config)
+	if err != nil {
+		return nil, info, err
+	}
+	return &hibernatingPlugin{plugin, f.hibernation, f.contract, f.lggr}, info, nil
+}
+
+type hibernatingPlugin struct {
+	ocr2types.ReportingPlugin
+	hibernation Hibernation
+	contract    median.MedianContract
+	lggr        logger.Logger
+}
+
+// Report only lets the wrapped plugin report while hibernating if the last
+// transmission is older than the hibernation heartbeat.
+func (p *hibernatingPlugin) Report(ctx context.Context, ts ocr2types.ReportTimestamp, q ocr2types.Query, aos []ocr2types.AttributedObservation) (bool, ocr2types.Report, error) {
+	if p.hibernation.IsHibernating() {
+		_, _, _, _, latestTimestamp, err := p.contract.LatestTransmissionDetails(ctx)
+		if err != nil {
+			return false, nil, err
+		}
+		if deltaC := p.hibernation.HibernationDeltaC(); time.Since(latestTimestamp) < deltaC {
+			p.lggr.Debugw("Hibernating, skipping report until heartbeat",
+				"latestTransmission", latestTimestamp, "deltaC", deltaC)
+			return false, nil, nil
+		}
+	}
+	return p.ReportingPlugin.Report(ctx, ts, q, aos)
+}
@@ -0,0 +1,74 @@
+package median
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type fakeHibernation struct {
+	hibernating bool
+	deltaC      time.Duration
+}
+
+func (h fakeHibernation) IsHibernating() bool              { return h.hibernating }
+func (h fakeHibernation) HibernationDeltaC() time.Duration { return h.deltaC }
+
+type fakeMedianContract struct {
+	median.MedianContract
+	latestTimestamp time.Time
+}
+
+func (c fakeMedianContract) LatestTransmissionDetails(context.Context) (ocr2types.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
+	return ocr2types.ConfigDigest{}, 0, 0, big.NewInt(0), c.latestTimestamp, nil
+}
+
+type fakeReportingPlugin struct {
+	ocr2types.ReportingPlugin
+}
+
+func (fakeReportingPlugin) Report(context.Context, ocr2types.ReportTimestamp, ocr2types.Query, []ocr2types.AttributedObservation) (bool, ocr2types.Report, error) {
+	return true, ocr2types.Report("report"), nil
+}
+
+func TestHibernatingPlugin_Report(t *testing.T) {
+	t.Parallel()
+
+	deltaC := 23 * time.Hour
+	tests := []struct {
+		name            string
+		hibernating     bool
+		latestTimestamp time.Time
+		shouldReport    bool
+	}{
+		{"not hibernating", false, time.Now(), true},
+		{"hibernating before heartbeat", true, time.Now().Add(-time.Hour), false},
+		{"hibernating after heartbeat", true, time.Now().Add(-24 * time.Hour), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := &hibernatingPlugin{
+				fakeReportingPlugin{},
+				fakeHibernation{tt.hibernating, deltaC},
+				fakeMedianContract{latestTimestamp: tt.latestTimestamp},
+				logger.TestLogger(t),
+			}
+			shouldReport, report, err := p.Report(testutils.Context(t), ocr2types.ReportTimestamp{}, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.shouldReport, shouldReport)
+			if tt.shouldReport {
+				assert.Equal(t, ocr2types.Report("report"), report)
+			}
+		})
+	}
+}
@@ -8,14 +8,19 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
 
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/median/config"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // The Median struct holds parameters needed to run a Median plugin.
@@ -28,12 +33,15 @@ type Median struct {
 	ocrLogger      commontypes.Logger
 
 	pluginConfig config.PluginConfig
+	hibernator   *ocrcommon.Hibernator
 }
 
 var _ plugins.OraclePlugin = &Median{}
 
-// NewMedian parses the arguments and returns a new Median struct.
-func NewMedian(jb job.Job, ocr2Provider types.MedianProvider, pipelineRunner pipeline.Runner, runResults chan pipeline.Run, lggr logger.Logger, ocrLogger commontypes.Logger) (*Median, error) {
+// NewMedian parses the arguments and returns a new Median struct. The
+// ethClient is used to read the flags contract, and may be nil for relays
+// other than EVM.
+func NewMedian(jb job.Job, ocr2Provider types.MedianProvider, pipelineRunner pipeline.Runner, runResults chan pipeline.Run, lggr logger.Logger, ocrLogger commontypes.Logger, ethClient evmclient.Client) (*Median, error) {
 	var pluginConfig config.PluginConfig
 	err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &pluginConfig)
 	if err != nil {
@@ -44,6 +52,14 @@ func NewMedian(jb job.Job, ocr2Provider types.MedianProvider, pipelineRunner pip
 		return &Median{}, err
 	}
 
+	var hibernator *ocrcommon.Hibernator
+	if pluginConfig.FlagsContractAddress != "" {
+		hibernator, err = newHibernator(jb, pluginConfig.FlagsContractAddress, ethClient, lggr)
+		if err != nil {
+			return &Median{}, err
+		}
+	}
+
 	return &Median{
 		jb:             jb,
 		ocr2Provider:   ocr2Provider,
@@ -52,17 +68,35 @@ func NewMedian(jb job.Job, ocr2Provider types.MedianProvider, pipelineRunner pip
 		lggr:           lggr,
 		ocrLogger:      ocrLogger,
 		pluginConfig:   pluginConfig,
+		hibernator:     hibernator,
 	}, nil
 }
 
-// GetPluginFactory return a median.NumericalMedianFactory.
+func newHibernator(jb job.Job, flagsContractAddress string, ethClient evmclient.Client, lggr logger.Logger) (*ocrcommon.Hibernator, error) {
+	if ethClient == nil {
+		return nil, errors.Errorf("flagsContractAddress is not supported by the %s relay", jb.OCR2OracleSpec.Relay)
+	}
+	contractAddress, err := ethkey.NewEIP55Address(jb.OCR2OracleSpec.ContractID)
+	if err != nil {
+		return nil, errors.Wrap(err, "flagsContractAddress requires contractID to be an EVM address")
+	}
+	flags, err := ocrcommon.NewFlags(flagsContractAddress, ethClient)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create Flags contract instance, check flagsContractAddress: %s", flagsContractAddress)
+	}
+	ticker := utils.NewPausableTicker(ocrcommon.HibernationPollInterval)
+	return ocrcommon.NewHibernator(lggr.Named("Hibernation"), contractAddress, flags, &ticker)
+}
+
+// GetPluginFactory return a median.NumericalMedianFactory, which stops reporting on deviation while the feed is
+// flagged for hibernation.
 func (m *Median) GetPluginFactory() (ocr2types.ReportingPluginFactory, error) {
 	juelsPerFeeCoinPipelineSpec := pipeline.Spec{
 		ID:           m.jb.ID,
 		DotDagSource: m.pluginConfig.JuelsPerFeeCoinPipeline,
 		CreatedAt:    time.Now(),
 	}
-	return median.NumericalMedianFactory{
+	factory := median.NumericalMedianFactory{
 		ContractTransmitter: m.ocr2Provider.MedianContract(),
 		DataSource: ocrcommon.NewDataSourceV2(m.pipelineRunner,
 			m.jb,
@@ -73,11 +107,18 @@ func (m *Median) GetPluginFactory() (ocr2types.ReportingPluginFactory, error) {
 		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(m.pipelineRunner, m.jb, juelsPerFeeCoinPipelineSpec, m.lggr),
 		ReportCodec:               m.ocr2Provider.ReportCodec(),
 		Logger:                    m.ocrLogger,
-	}, nil
+	}
+	if m.hibernator == nil {
+		return factory, nil
+	}
+	return &hibernatingFactory{factory, m.hibernator, factory.ContractTransmitter, m.lggr}, nil
 }
 
-// GetServices return an empty Service slice because Median does not need any services besides the generic OCR2 ones
-// supplied in the OCR2 delegate. This method exists to satisfy the plugins.OraclePlugin interface.
+// GetServices returns the hibernation service if a flags contract is configured. Median does not need any other
+// services besides the generic OCR2 ones supplied in the OCR2 delegate.
 func (m *Median) GetServices() ([]job.ServiceCtx, error) {
+	if m.hibernator != nil {
+		return []job.ServiceCtx{m.hibernator}, nil
+	}
 	return []job.ServiceCtx{}, nil
 }
@@ -12,6 +12,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/dkg/config"
+	externalconfig "github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/external/config"
+	medianconfig "github.com/smartcontractkit/chainlink/core/services/ocr2/plugins/median/config"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
 )
@@ -53,7 +55,7 @@ func ValidatedOracleSpecToml(config Config, tomlString string) (job.Job, error)
 		}
 	}
 
-	if err := validateSpec(tree, jb); err != nil {
+	if err := validateSpec(tree, jb, config); err != nil {
 		return jb, err
 	}
 	if err := validateTimingParameters(config, spec); err != nil {
@@ -84,7 +86,7 @@ func validateTimingParameters(config Config, spec job.OCR2OracleSpec) error {
 	return libocr2.SanityCheckLocalConfig(lc)
 }
 
-func validateSpec(tree *toml.Tree, spec job.Job) error {
+func validateSpec(tree *toml.Tree, spec job.Job, config Config) error {
 	expected, notExpected := ocrcommon.CloneSet(params), ocrcommon.CloneSet(notExpectedParams)
 	if err := ocrcommon.ValidateExplicitlySetKeys(tree, expected, notExpected, "ocr2"); err != nil {
 		return err
@@ -95,11 +97,16 @@ func validateSpec(tree *toml.Tree, spec job.Job) error {
 		if spec.Pipeline.Source == "" {
 			return errors.New("no pipeline specified")
 		}
+		if err := validateMedianSpec(*spec.OCR2OracleSpec); err != nil {
+			return err
+		}
 	case job.DKG:
 		err := validateDKGSpec(spec.OCR2OracleSpec.PluginConfig)
 		return err
 	case job.OCR2VRF:
 		return nil
+	case job.External:
+		return validateExternalSpec(*spec.OCR2OracleSpec, config.OCR2PluginsDir())
 	case "":
 		return errors.New("no plugin specified")
 	default:
@@ -109,6 +116,23 @@ func validateSpec(tree *toml.Tree, spec job.Job) error {
 	return nil
 }
 
+func validateMedianSpec(spec job.OCR2OracleSpec) error {
+	if spec.PluginConfig == nil {
+		return nil
+	}
+	var pluginConfig medianconfig.PluginConfig
+	if err := json.Unmarshal(spec.PluginConfig.Bytes(), &pluginConfig); err != nil {
+		return errors.Wrap(err, "error while unmarshaling plugin config")
+	}
+	if pluginConfig.FlagsContractAddress == "" {
+		return nil
+	}
+	if spec.Relay != relay.EVM {
+		return errors.Errorf("flagsContractAddress is not supported by the %s relay", spec.Relay)
+	}
+	return medianconfig.ValidatePluginConfig(pluginConfig)
+}
+
 func validateDKGSpec(jsonConfig job.JSONConfig) error {
 	if jsonConfig == nil {
 		return errors.New("pluginConfig is empty")
@@ -134,6 +158,20 @@ func validateDKGSpec(jsonConfig job.JSONConfig) error {
 	return nil
 }
 
+func validateExternalSpec(spec job.OCR2OracleSpec, pluginsDir string) error {
+	if spec.PluginConfig == nil {
+		return errors.New("pluginConfig is empty")
+	}
+	if spec.Relay != relay.EVM {
+		return errors.Errorf("external plugins are not supported by the %s relay", spec.Relay)
+	}
+	var pluginConfig externalconfig.PluginConfig
+	if err := json.Unmarshal(spec.PluginConfig.Bytes(), &pluginConfig); err != nil {
+		return errors.Wrap(err, "error while unmarshaling plugin config")
+	}
+	return externalconfig.ValidatePluginConfig(pluginConfig, pluginsDir)
+}
+
 func validateHexString(val string, expectedLengthInBytes uint) error {
 	decoded, err := hex.DecodeString(val)
 	if err != nil {
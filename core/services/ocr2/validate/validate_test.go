@@ -176,6 +176,50 @@ chainID = 1337
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "flags contract on non EVM relay",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "solana"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+ds1 [type=memo value=1];
+"""
+[relayConfig]
+chainID = "devnet"
+[pluginConfig]
+juelsPerFeeCoinSource = "ds1 [type=memo value=1];"
+flagsContractAddress  = "0x3489d2f0b2b1a8cc7aa8b16e2d2ae0b4e8ba3a8d"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "flagsContractAddress is not supported by the solana relay")
+			},
+		},
+		{
+			name: "invalid flags contract address",
+			toml: `
+type               = "offchainreporting2"
+pluginType         = "median"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource  = """
+ds1 [type=memo value=1];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+juelsPerFeeCoinSource = "ds1 [type=memo value=1];"
+flagsContractAddress  = "not an address"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid flagsContractAddress")
+			},
+		},
 		{
 			name: "invalid peer address",
 			toml: `
@@ -579,6 +623,282 @@ KeyID               = "6f3b82406688b8ddb944c6f2e6d808f014c8fa8d568d639c25019568c
 				require.Contains(t, err.Error(), "validation error for keyID")
 			},
 		},
+		{
+			name: "external plugin",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+args = ["--network", "testnet"]
+config = { feed = "ETH/USD" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.External, os.OCR2OracleSpec.PluginType)
+			},
+		},
+		{
+			name: "external plugin command is not absolute",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "my-plugin"
+args = ["--network", "testnet"]
+config = { feed = "ETH/USD" }
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must be an absolute path")
+			},
+		},
+		{
+			name: "external plugins are disabled",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+args = ["--network", "testnet"]
+config = { feed = "ETH/USD" }
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "OCR2_PLUGINS_DIR is not set")
+			},
+		},
+		{
+			name: "external plugin command is outside the plugins directory",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/../../../tmp/my-plugin"
+args = ["--network", "testnet"]
+config = { feed = "ETH/USD" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "must be in the plugins directory")
+			},
+		},
+		{
+			name: "external plugin env sets LD_PRELOAD",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+env = { FEED = "ETH/USD", LD_PRELOAD = "/tmp/evil.so" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "env variable LD_PRELOAD cannot be set")
+			},
+		},
+		{
+			name: "external plugin env sets LD_LIBRARY_PATH",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+env = { LD_LIBRARY_PATH = "/tmp" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "env variable LD_LIBRARY_PATH cannot be set")
+			},
+		},
+		{
+			name: "external plugin env sets DYLD_INSERT_LIBRARIES",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+env = { DYLD_INSERT_LIBRARIES = "/tmp/evil.dylib" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "env variable DYLD_INSERT_LIBRARIES cannot be set")
+			},
+		},
+		{
+			name: "external plugin env sets PATH",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+env = { PATH = "/tmp" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "env variable PATH cannot be set")
+			},
+		},
+		{
+			name: "external plugin env",
+			toml: `
+type = "offchainreporting2"
+schemaVersion = 1
+name = "external"
+externalJobID = "6d46d85f-d38c-4f4a-9f00-ac29a25b6330"
+contractID = "0x3e54dCc49F16411A3aaa4cDbC41A25bCa9763Cee"
+ocrKeyBundleID = "08d14c6eed757414d72055d28de6caf06535806c6a14e450f3a2f1c854420e17"
+p2pv2Bootstrappers = [
+	"12D3KooWSbPRwXY4gxFRJT7LWCnjgGbR4S839nfCRCDgQUiNenxa@127.0.0.1:8000"
+]
+relay = "evm"
+pluginType = "external"
+transmitterID = "0x74103Cf8b436465870b26aa9Fa2F62AD62b22E35"
+
+[relayConfig]
+chainID = 4
+
+[pluginConfig]
+command = "/usr/local/bin/my-plugin"
+env = { FEED = "ETH/USD", LOG_LEVEL = "debug" }
+`,
+			setGlobals: func(t *testing.T, c *configtest.TestGeneralConfig) {
+				c.Overrides.OCR2PluginsDir = null.StringFrom("/usr/local/bin")
+			},
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 
 	for _, tc := range tt {
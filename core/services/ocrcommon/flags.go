@@ -0,0 +1,69 @@
+package ocrcommon
+
+import (
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ContractFlags wraps the a contract
+type ContractFlags struct {
+	flags_wrapper.FlagsInterface
+}
+
+// NewFlags constructs a new Flags from a flags contract address
+func NewFlags(addrHex string, ethClient evmclient.Client) (*ContractFlags, error) {
+	flags := &ContractFlags{}
+
+	if addrHex == "" {
+		return flags, nil
+	}
+
+	contractAddr := common.HexToAddress(addrHex)
+	contract, err := flags_wrapper.NewFlags(contractAddr, ethClient)
+	if err != nil {
+		return flags, errors.Wrap(err, "Failed to create flags wrapper")
+	}
+
+	// This is necessary due to the unfortunate fact that assigning `nil` to an
+	// interface variable causes `x == nil` checks to always return false. If we
+	// do this here, in the constructor, we can avoid using reflection when we
+	// check `p.flags == nil` later in the code.
+	if contract != nil && !reflect.ValueOf(contract).IsNil() {
+		flags.FlagsInterface = contract
+	}
+
+	return flags, nil
+}
+
+// Contract returns the flags contract
+func (f *ContractFlags) Contract() flags_wrapper.FlagsInterface {
+	return f.FlagsInterface
+}
+
+// ContractExists returns whether a flag contract exists
+func (f *ContractFlags) ContractExists() bool {
+	return f.FlagsInterface != nil
+}
+
+// IsLowered determines whether the flag is lowered for a given contract.
+// If a contract does not exist, it is considered to be lowered
+func (f *ContractFlags) IsLowered(contractAddr common.Address) (bool, error) {
+	if !f.ContractExists() {
+		return true, nil
+	}
+
+	flags, err := f.GetFlags(nil,
+		[]common.Address{utils.ZeroAddress, contractAddr},
+	)
+	if err != nil {
+		return true, errors.Wrap(err, "Failed to call GetFlags in the contract")
+	}
+
+	return !flags[0] || !flags[1], nil
+}
@@ -1,4 +1,4 @@
-package ocr_test
+package ocrcommon_test
 
 import (
 	"testing"
@@ -6,7 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
-	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -36,7 +36,7 @@ func TestFlags_IsLowered(t *testing.T) {
 				address       = testutils.NewAddress()
 			)
 
-			flags := fluxmonitorv2.ContractFlags{FlagsInterface: flagsContract}
+			flags := ocrcommon.ContractFlags{FlagsInterface: flagsContract}
 
 			flagsContract.On("GetFlags", mock.Anything, mock.Anything).
 				Run(func(args mock.Arguments) {
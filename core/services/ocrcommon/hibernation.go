@@ -0,0 +1,153 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// HibernationPollInterval is how often the Flags contract is polled
+const HibernationPollInterval = 30 * time.Second
+
+// InitialHibernationStatus - hibernation state set until the first successful update from the chain
+const InitialHibernationStatus = false
+
+// Hibernator polls the Flags contract and tracks whether an OCR contract
+// should hibernate, i.e. only report on a long heartbeat. A contract
+// hibernates while both the global flag and its own flag are raised.
+type Hibernator struct {
+	utils.StartStopOnce
+	logger          logger.Logger
+	flags           *ContractFlags
+	contractAddress ethkey.EIP55Address
+
+	pollTicker               utils.TickerBase
+	lastStateChangeTimestamp time.Time
+	isHibernating            bool
+	// DeltaCFromAddress is the heartbeat used while hibernating. It is spread
+	// over an hour after 23h based on the contract address, so that
+	// hibernating feeds do not all report at once.
+	DeltaCFromAddress time.Duration
+
+	// Start/Stop lifecycle
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	chDone    chan struct{}
+
+	mu sync.RWMutex
+}
+
+// NewHibernator returns a Hibernator for the given contract, or an error if
+// the flags contract does not exist.
+func NewHibernator(
+	lggr logger.Logger,
+	contractAddress ethkey.EIP55Address,
+	flags *ContractFlags,
+	pollTicker utils.TickerBase,
+) (*Hibernator, error) {
+	if !flags.ContractExists() {
+		return nil, errors.Errorf("Flags contract instance is missing, the contract does not exist: %s. "+
+			"Please create the contract or remove the FLAGS_CONTRACT_ADDRESS configuration variable", contractAddress.Address())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Hibernator{
+		logger:                   lggr,
+		flags:                    flags,
+		contractAddress:          contractAddress,
+		pollTicker:               pollTicker,
+		lastStateChangeTimestamp: time.Now(),
+		isHibernating:            InitialHibernationStatus,
+		DeltaCFromAddress:        DeltaCFromAddress(contractAddress),
+		ctx:                      ctx,
+		ctxCancel:                cancel,
+		chDone:                   make(chan struct{}),
+	}, nil
+}
+
+// DeltaCFromAddress returns the heartbeat of a hibernating contract.
+func DeltaCFromAddress(contractAddress ethkey.EIP55Address) time.Duration {
+	addressBig := contractAddress.Big()
+	addressSeconds := addressBig.Mod(addressBig, big.NewInt(3600)).Uint64()
+	return 23*time.Hour + time.Duration(addressSeconds)*time.Second
+}
+
+// Start starts Hibernator.
+func (h *Hibernator) Start(context.Context) error {
+	return h.StartOnce("Hibernator", func() (err error) {
+		if err := h.UpdateStatus(); err != nil {
+			h.logger.Errorw("Error updating hibernation status at job start. Will default to not hibernating, until next successful update.", "err", err)
+		}
+
+		go h.eventLoop()
+		return nil
+	})
+}
+
+// Close stops polling the flags contract.
+func (h *Hibernator) Close() error {
+	return h.StopOnce("Hibernator", func() error {
+		h.ctxCancel()
+		<-h.chDone
+		return nil
+	})
+}
+
+func (h *Hibernator) eventLoop() {
+	defer close(h.chDone)
+	h.pollTicker.Resume()
+	defer h.pollTicker.Destroy()
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-h.pollTicker.Ticks():
+			if err := h.UpdateStatus(); err != nil {
+				h.logger.Errorw("Error updating hibernation status", "err", err)
+			}
+		}
+	}
+}
+
+// UpdateStatus reads the flags of the contract and updates the hibernation
+// state.
+func (h *Hibernator) UpdateStatus() error {
+	isFlagLowered, err := h.flags.IsLowered(h.contractAddress.Address())
+	if err != nil {
+		return errors.Wrap(err, "Failed to check if flag is lowered")
+	}
+	shouldHibernate := !isFlagLowered
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.isHibernating != shouldHibernate {
+		h.logger.Infow(
+			fmt.Sprintf("Setting hibernation state to '%v'", shouldHibernate),
+			"elapsedSinceLastChange", time.Since(h.lastStateChangeTimestamp),
+		)
+		h.lastStateChangeTimestamp = time.Now()
+		h.isHibernating = shouldHibernate
+	}
+	return nil
+}
+
+// IsHibernating returns whether the contract is flagged for hibernation.
+func (h *Hibernator) IsHibernating() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.isHibernating
+}
+
+// HibernationDeltaC returns the heartbeat used while hibernating.
+func (h *Hibernator) HibernationDeltaC() time.Duration {
+	return h.DeltaCFromAddress
+}
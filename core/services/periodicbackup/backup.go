@@ -2,7 +2,10 @@ package periodicbackup
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
@@ -16,13 +19,20 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 var (
-	filePattern        = "cl_backup_%s.dump"
+	filePrefix         = "cl_backup_"
+	filePattern        = filePrefix + "%s_%s.dump"
+	encryptedExtension = ".enc"
+	timestampFormat    = "20060102T150405Z"
 	minBackupFrequency = time.Minute
 
+	// ErrNoEncryptionKey is returned by backups when encryption is enabled but no key is set.
+	ErrNoEncryptionKey = errors.New("DATABASE_BACKUP_ENCRYPTION_KEY must be set to take encrypted backups, set DATABASE_BACKUP_ENCRYPTED=false to take unencrypted backups instead")
+
 	excludedDataFromTables = []string{
 		"pipeline_runs",
 		"pipeline_task_runs",
@@ -32,6 +42,7 @@ var (
 type backupResult struct {
 	size            int64
 	path            string
+	manifest        Manifest
 	maskedArguments []string
 	pgDumpArguments []string
 }
@@ -48,6 +59,11 @@ type (
 		mode            config.DatabaseBackupMode
 		frequency       time.Duration
 		outputParentDir string
+		encrypted       bool
+		encryptionKey   string
+		scryptParams    utils.ScryptParams
+		retentionCount  uint32
+		retentionMaxAge time.Duration
 		done            chan bool
 		utils.StartStopOnce
 	}
@@ -57,12 +73,17 @@ type (
 		DatabaseBackupFrequency() time.Duration
 		DatabaseBackupURL() *url.URL
 		DatabaseBackupDir() string
+		DatabaseBackupEncrypted() bool
+		DatabaseBackupEncryptionKey() string
+		DatabaseBackupRetentionCount() uint32
+		DatabaseBackupRetentionMaxAge() time.Duration
 		DatabaseURL() url.URL
+		InsecureFastScrypt() bool
 		RootDir() string
 	}
 )
 
-// NewDatabaseBackup instantiates a *databaseBackup
+// NewDatabaseBackup instantiates a *databaseBackup.
 func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error) {
 	lggr = lggr.Named("DatabaseBackup")
 	dbUrl := config.DatabaseURL()
@@ -81,13 +102,17 @@ func NewDatabaseBackup(config Config, lggr logger.Logger) (DatabaseBackup, error
 	}
 
 	return &databaseBackup{
-		lggr,
-		dbUrl,
-		config.DatabaseBackupMode(),
-		config.DatabaseBackupFrequency(),
-		outputParentDir,
-		make(chan bool),
-		utils.StartStopOnce{},
+		logger:          lggr,
+		databaseURL:     dbUrl,
+		mode:            config.DatabaseBackupMode(),
+		frequency:       config.DatabaseBackupFrequency(),
+		outputParentDir: outputParentDir,
+		encrypted:       config.DatabaseBackupEncrypted(),
+		encryptionKey:   config.DatabaseBackupEncryptionKey(),
+		scryptParams:    utils.GetScryptParams(config),
+		retentionCount:  config.DatabaseBackupRetentionCount(),
+		retentionMaxAge: config.DatabaseBackupRetentionMaxAge(),
+		done:            make(chan bool),
 	}, nil
 }
 
@@ -141,12 +166,32 @@ func (backup *databaseBackup) RunBackup(version string) error {
 		backup.logger.Errorw("Backup failed", "duration", duration, "err", err)
 		return err
 	}
-	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path)
+	backup.logger.Infow("Backup completed successfully.", "duration", duration, "fileSize", result.size, "filePath", result.path,
+		"schemaVersion", result.manifest.SchemaVersion, "encrypted", result.manifest.Encryption != nil)
+	if err = backup.pruneBackups(time.Now()); err != nil {
+		backup.logger.Errorw("Failed to delete old backups", "err", err)
+	}
 	return nil
 }
 
+// passphrase returns the passphrase to encrypt backups with, or "" if they are not to be encrypted.
+// Backups are never written unencrypted when encryption is enabled, so it fails if no key is set.
+func (backup *databaseBackup) passphrase() (string, error) {
+	if !backup.encrypted {
+		return "", nil
+	}
+	if backup.encryptionKey == "" {
+		return "", ErrNoEncryptionKey
+	}
+	return backup.encryptionKey, nil
+}
+
 func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
-	err := os.MkdirAll(backup.outputParentDir, os.ModePerm)
+	passphrase, err := backup.passphrase()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(backup.outputParentDir, os.ModePerm)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to create directories on the path: %s", backup.outputParentDir)
 	}
@@ -201,26 +246,116 @@ func (backup *databaseBackup) runBackup(version string) (*backupResult, error) {
 		return partialResult, errors.Wrap(err, "pg_dump failed")
 	}
 
-	if version == "" {
-		version = "unknown"
-	}
-	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version))
-	_ = os.Remove(finalFilePath)
-	err = os.Rename(tmpFile.Name(), finalFilePath)
+	// restores are checked against the schema version, so a backup without one is useless
+	schemaVersion, err := backup.schemaVersion()
 	if err != nil {
-		_ = os.Remove(tmpFile.Name())
-		return nil, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+		os.Remove(tmpFile.Name())
+		return nil, errors.Wrap(err, "failed to get the schema version of the backed up database")
 	}
 
-	file, err := os.Stat(finalFilePath)
+	finalFilePath, manifest, err := backup.writeBackup(tmpFile.Name(), version, schemaVersion, passphrase, time.Now())
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to access the final backup file")
+		return nil, err
 	}
 
 	return &backupResult{
-		size:            file.Size(),
+		size:            manifest.Size,
 		path:            finalFilePath,
+		manifest:        manifest,
 		maskedArguments: maskedArgs,
 		pgDumpArguments: args,
 	}, nil
 }
+
+// schemaVersion returns the latest migration applied to the backed up database.
+func (backup *databaseBackup) schemaVersion() (version int64, err error) {
+	db, err := sql.Open(string(dialects.Postgres), backup.databaseURL.String())
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = db.QueryRowContext(ctx, `SELECT version_id FROM goose_migrations WHERE is_applied ORDER BY id DESC LIMIT 1`).Scan(&version)
+	return version, err
+}
+
+// writeBackup moves the pg_dump output at dumpPath to the final backup file,
+// encrypting it if passphrase is set, and writes its manifest.
+func (backup *databaseBackup) writeBackup(dumpPath, version string, schemaVersion int64, passphrase string, createdAt time.Time) (string, Manifest, error) {
+	defer os.Remove(dumpPath)
+	if version == "" {
+		version = "unknown"
+	}
+	createdAt = createdAt.UTC()
+	finalFilePath := filepath.Join(backup.outputParentDir, fmt.Sprintf(filePattern, version, createdAt.Format(timestampFormat)))
+	manifest := Manifest{
+		AppVersion:    version,
+		SchemaVersion: schemaVersion,
+		Mode:          backup.mode,
+		CreatedAt:     createdAt,
+	}
+
+	dump, err := os.Open(dumpPath)
+	if err != nil {
+		return "", manifest, errors.Wrap(err, "Failed to open the temp file")
+	}
+	defer dump.Close()
+	if passphrase != "" {
+		manifest.Encryption, err = newEncryption(backup.scryptParams)
+		if err != nil {
+			return "", manifest, err
+		}
+		finalFilePath += encryptedExtension
+	}
+
+	tmpFile, err := ioutil.TempFile(backup.outputParentDir, "cl_backup_tmp_")
+	if err != nil {
+		return "", manifest, errors.Wrap(err, "Failed to create a tmp file")
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	dumpHash, fileHash := sha256.New(), sha256.New()
+	counter := &countingWriter{}
+	fileWriter := io.MultiWriter(tmpFile, fileHash, counter)
+	if manifest.Encryption == nil {
+		_, err = io.Copy(io.MultiWriter(fileWriter, dumpHash), dump)
+	} else {
+		var ew *encryptWriter
+		ew, err = newEncryptWriter(fileWriter, *manifest.Encryption, passphrase)
+		if err != nil {
+			return "", manifest, err
+		}
+		if _, err = io.Copy(io.MultiWriter(ew, dumpHash), dump); err == nil {
+			err = ew.Close()
+		}
+	}
+	if err != nil {
+		return "", manifest, errors.Wrap(err, "Failed to write the backup file")
+	}
+	if err = tmpFile.Close(); err != nil {
+		return "", manifest, errors.Wrap(err, "Failed to write the backup file")
+	}
+
+	manifest.File = filepath.Base(finalFilePath)
+	manifest.Size = counter.n
+	manifest.SHA256 = hexSum(fileHash)
+	manifest.DumpSHA256 = hexSum(dumpHash)
+
+	if err = os.Rename(tmpFile.Name(), finalFilePath); err != nil {
+		return "", manifest, errors.Wrap(err, "Failed to rename the temp file to the final backup file")
+	}
+	if err = writeManifest(manifestPath(finalFilePath), manifest); err != nil {
+		_ = os.Remove(finalFilePath)
+		return "", manifest, err
+	}
+	return finalFilePath, manifest, nil
+}
+
+type countingWriter struct{ n int64 }
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -1,9 +1,12 @@
 package periodicbackup
 
 import (
+	"crypto/rand"
+	"math"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -117,10 +120,171 @@ func TestPeriodicBackup_AlternativeOutputDir(t *testing.T) {
 	require.NoError(t, err, "error not nil when checking for output file")
 
 	assert.Greater(t, file.Size(), int64(0))
-	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9.dump")
+	assert.Contains(t, result.path, "/alternative/cl_backup_0.9.9_")
+	assert.True(t, strings.HasSuffix(result.path, ".dump"))
 
 }
 
+func newFileBackup(t *testing.T, dir string, encryptionKey string) *databaseBackup {
+	cfg := newTestConfig(time.Minute, nil, url.URL{}, dir, dir, config.DatabaseBackupModeFull)
+	cfg.encryptionKey = encryptionKey
+	b, err := NewDatabaseBackup(cfg, logger.TestLogger(t))
+	require.NoError(t, err)
+	return b.(*databaseBackup)
+}
+
+func writeDump(t *testing.T, dir string, size int) (string, []byte) {
+	dump := make([]byte, size)
+	_, err := rand.Read(dump)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "pg_dump_output")
+	require.NoError(t, os.WriteFile(path, dump, 0600))
+	return path, dump
+}
+
+func TestPeriodicBackup_WriteBackupAndRestore(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC)
+
+	t.Run("unencrypted", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "")
+		dumpPath, _ := writeDump(t, dir, 1000)
+
+		path, m, err := backup.writeBackup(dumpPath, "1.8.0", 138, "", createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.dump"), path)
+		assert.NoFileExists(t, dumpPath)
+		assert.Nil(t, m.Encryption)
+		assert.Equal(t, m.SHA256, m.DumpSHA256)
+
+		manifestPath := filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.json")
+		read, err := ReadManifest(manifestPath)
+		require.NoError(t, err)
+		assert.Equal(t, int64(138), read.SchemaVersion)
+		assert.Equal(t, int64(1000), read.Size)
+		assert.Equal(t, config.DatabaseBackupModeFull, read.Mode)
+
+		restorePath, cleanup, err := prepareRestore(manifestPath, "")
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, path, restorePath)
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "backup-key")
+		dumpPath, dump := writeDump(t, dir, 3*encryptionChunkSize+17)
+
+		passphrase, err := backup.passphrase()
+		require.NoError(t, err)
+		path, m, err := backup.writeBackup(dumpPath, "1.8.0", 138, passphrase, createdAt)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.dump.enc"), path)
+		require.NotNil(t, m.Encryption)
+		assert.NotEqual(t, m.SHA256, m.DumpSHA256)
+
+		manifestPath := filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.json")
+
+		_, _, err = prepareRestore(manifestPath, "wrong-key")
+		assert.ErrorIs(t, err, ErrDecryption)
+
+		restorePath, cleanup, err := prepareRestore(manifestPath, "backup-key")
+		require.NoError(t, err)
+		restored, err := os.ReadFile(restorePath)
+		require.NoError(t, err)
+		assert.Equal(t, dump, restored)
+		cleanup()
+		assert.NoFileExists(t, restorePath)
+	})
+
+	t.Run("corrupted backup", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "")
+		dumpPath, _ := writeDump(t, dir, 1000)
+		path, _, err := backup.writeBackup(dumpPath, "1.8.0", 138, "", createdAt)
+		require.NoError(t, err)
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		b[0] ^= 0xff
+		require.NoError(t, os.WriteFile(path, b, 0600))
+
+		_, _, err = prepareRestore(filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.json"), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is corrupted")
+	})
+
+	t.Run("modified manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "")
+		dumpPath, _ := writeDump(t, dir, 1000)
+		_, _, err := backup.writeBackup(dumpPath, "1.8.0", 138, "", createdAt)
+		require.NoError(t, err)
+
+		manifestPath := filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.json")
+		b, err := os.ReadFile(manifestPath)
+		require.NoError(t, err)
+		modified := strings.Replace(string(b), `"schemaVersion": 138`, `"schemaVersion": 1`, 1)
+		require.NoError(t, os.WriteFile(manifestPath, []byte(modified), 0600))
+
+		_, err = ReadManifest(manifestPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is corrupted")
+	})
+
+	t.Run("newer schema version", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "")
+		dumpPath, _ := writeDump(t, dir, 1000)
+		_, _, err := backup.writeBackup(dumpPath, "99.0.0", math.MaxInt32, "", createdAt)
+		require.NoError(t, err)
+
+		_, _, err = prepareRestore(filepath.Join(dir, "cl_backup_99.0.0_20220901T123000Z.json"), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "restore it with node version 99.0.0 or newer")
+	})
+
+	t.Run("no schema version", func(t *testing.T) {
+		dir := t.TempDir()
+		backup := newFileBackup(t, dir, "")
+		dumpPath, _ := writeDump(t, dir, 1000)
+		_, _, err := backup.writeBackup(dumpPath, "1.8.0", 0, "", createdAt)
+		require.NoError(t, err)
+
+		_, _, err = prepareRestore(filepath.Join(dir, "cl_backup_1.8.0_20220901T123000Z.json"), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "backup has no schema version")
+	})
+}
+
+func TestPeriodicBackup_Passphrase(t *testing.T) {
+	t.Parallel()
+
+	backup := newFileBackup(t, t.TempDir(), "")
+	backup.encrypted = true
+
+	// No key is set, so the backup must not be taken
+	_, err := backup.passphrase()
+	assert.ErrorIs(t, err, ErrNoEncryptionKey)
+	_, err = backup.runBackup("0.9.9")
+	assert.ErrorIs(t, err, ErrNoEncryptionKey)
+	entries, err := os.ReadDir(backup.outputParentDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	backup.encryptionKey = "backup-key"
+	passphrase, err := backup.passphrase()
+	require.NoError(t, err)
+	assert.Equal(t, "backup-key", passphrase)
+
+	backup.encrypted = false
+	passphrase, err = backup.passphrase()
+	require.NoError(t, err)
+	assert.Empty(t, passphrase)
+}
+
 type testConfig struct {
 	databaseBackupFrequency time.Duration
 	databaseBackupMode      config.DatabaseBackupMode
@@ -128,6 +292,9 @@ type testConfig struct {
 	databaseBackupDir       string
 	databaseURL             url.URL
 	rootDir                 string
+	encryptionKey           string
+	retentionCount          uint32
+	retentionMaxAge         time.Duration
 }
 
 func (config testConfig) DatabaseBackupFrequency() time.Duration {
@@ -142,6 +309,21 @@ func (config testConfig) DatabaseBackupURL() *url.URL {
 func (config testConfig) DatabaseBackupDir() string {
 	return config.databaseBackupDir
 }
+func (config testConfig) DatabaseBackupEncrypted() bool {
+	return config.encryptionKey != ""
+}
+func (config testConfig) DatabaseBackupEncryptionKey() string {
+	return config.encryptionKey
+}
+func (config testConfig) DatabaseBackupRetentionCount() uint32 {
+	return config.retentionCount
+}
+func (config testConfig) DatabaseBackupRetentionMaxAge() time.Duration {
+	return config.retentionMaxAge
+}
+func (config testConfig) InsecureFastScrypt() bool {
+	return true
+}
 func (config testConfig) DatabaseURL() url.URL {
 	return config.databaseURL
 }
@@ -0,0 +1,171 @@
+package periodicbackup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// EncryptionCipher is a stream of AES-256-GCM sealed chunks. Each chunk's nonce
+	// holds its index and whether it is the last chunk, so that chunks cannot be
+	// reordered, dropped or truncated without failing authentication.
+	EncryptionCipher = "aes-256-gcm-chunked"
+	// EncryptionKDF derives the AES key from the passphrase.
+	EncryptionKDF = "scrypt"
+
+	encryptionChunkSize = 64 * 1024
+	encryptionSaltSize  = 32
+	encryptionKeySize   = 32
+	scryptR             = 8
+)
+
+// ErrDecryption is returned when a backup cannot be authenticated, because it
+// was modified or the passphrase is wrong.
+var ErrDecryption = errors.New("failed to decrypt backup: the file is corrupted or the passphrase is wrong")
+
+// Encryption describes how a backup file was encrypted. Together with the
+// passphrase it is all that is needed to decrypt the file.
+type Encryption struct {
+	Cipher    string `json:"cipher"`
+	KDF       string `json:"kdf"`
+	ScryptN   int    `json:"scryptN"`
+	ScryptP   int    `json:"scryptP"`
+	Salt      string `json:"salt"`
+	ChunkSize int    `json:"chunkSize"`
+}
+
+func newEncryption(scryptParams utils.ScryptParams) (*Encryption, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "failed to generate salt")
+	}
+	return &Encryption{
+		Cipher:    EncryptionCipher,
+		KDF:       EncryptionKDF,
+		ScryptN:   scryptParams.N,
+		ScryptP:   scryptParams.P,
+		Salt:      hex.EncodeToString(salt),
+		ChunkSize: encryptionChunkSize,
+	}, nil
+}
+
+func (e Encryption) aead(passphrase string) (cipher.AEAD, error) {
+	if e.Cipher != EncryptionCipher || e.KDF != EncryptionKDF {
+		return nil, errors.Errorf("unsupported backup encryption %s with %s", e.Cipher, e.KDF)
+	}
+	if e.ChunkSize <= 0 {
+		return nil, errors.Errorf("invalid backup encryption chunk size %d", e.ChunkSize)
+	}
+	if passphrase == "" {
+		return nil, errors.New("a passphrase is required to decrypt the backup")
+	}
+	salt, err := hex.DecodeString(e.Salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid backup encryption salt")
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, e.ScryptN, scryptR, e.ScryptP, encryptionKeySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive backup encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(aead cipher.AEAD, index uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptWriter seals everything written to it in chunks of ChunkSize. Every
+// chunk but the last is full, and the last is written by Close.
+type encryptWriter struct {
+	w         io.Writer
+	aead      cipher.AEAD
+	chunkSize int
+	buf       []byte
+	index     uint64
+}
+
+func newEncryptWriter(w io.Writer, e Encryption, passphrase string) (*encryptWriter, error) {
+	aead, err := e.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptWriter{w: w, aead: aead, chunkSize: e.ChunkSize, buf: make([]byte, 0, e.ChunkSize)}, nil
+}
+
+func (ew *encryptWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		c := copy(ew.buf[len(ew.buf):ew.chunkSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+c]
+		p = p[c:]
+		n += c
+		if len(ew.buf) == ew.chunkSize {
+			if err = ew.seal(false); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close writes the last chunk, which may be empty. It does not close the underlying writer.
+func (ew *encryptWriter) Close() error {
+	return ew.seal(true)
+}
+
+func (ew *encryptWriter) seal(last bool) error {
+	sealed := ew.aead.Seal(nil, chunkNonce(ew.aead, ew.index, last), ew.buf, nil)
+	ew.index++
+	ew.buf = ew.buf[:0]
+	_, err := ew.w.Write(sealed)
+	return err
+}
+
+// decrypt writes the plaintext of src to dst. A chunk is only written once it
+// has been authenticated, but dst may receive a prefix of the plaintext before
+// an error is returned.
+func decrypt(dst io.Writer, src io.Reader, e Encryption, passphrase string) error {
+	aead, err := e.aead(passphrase)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, e.ChunkSize+aead.Overhead())
+	for index := uint64(0); ; index++ {
+		n, err := io.ReadFull(src, buf)
+		last := false
+		switch {
+		case err == nil:
+		case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF):
+			last = true
+		default:
+			return errors.Wrap(err, "failed to read backup")
+		}
+		plaintext, err := aead.Open(buf[:0], chunkNonce(aead, index, last), buf[:n], nil)
+		if err != nil {
+			return ErrDecryption
+		}
+		if _, err = dst.Write(plaintext); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package periodicbackup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestEncryption_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	e, err := newEncryption(utils.FastScryptParams)
+	require.NoError(t, err)
+	e.ChunkSize = 16
+
+	for _, size := range []int{0, 1, 15, 16, 17, 32, 100} {
+		plaintext := make([]byte, size)
+		_, err = rand.Read(plaintext)
+		require.NoError(t, err)
+
+		var sealed bytes.Buffer
+		ew, err := newEncryptWriter(&sealed, *e, "passphrase")
+		require.NoError(t, err)
+		_, err = ew.Write(plaintext)
+		require.NoError(t, err)
+		require.NoError(t, ew.Close())
+
+		var opened bytes.Buffer
+		require.NoError(t, decrypt(&opened, bytes.NewReader(sealed.Bytes()), *e, "passphrase"), "size %d", size)
+		assert.True(t, bytes.Equal(plaintext, opened.Bytes()), "size %d", size)
+
+		// Dropping whole chunks or truncating the last one must not go unnoticed
+		for _, n := range []int{sealed.Len() - 1, sealed.Len() - (16 + 16), 0} {
+			if n < 0 {
+				continue
+			}
+			assert.ErrorIs(t, decrypt(&bytes.Buffer{}, bytes.NewReader(sealed.Bytes()[:n]), *e, "passphrase"), ErrDecryption, "size %d truncated to %d", size, n)
+		}
+
+		assert.ErrorIs(t, decrypt(&bytes.Buffer{}, bytes.NewReader(sealed.Bytes()), *e, "wrong"), ErrDecryption)
+	}
+}
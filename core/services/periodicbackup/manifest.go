@@ -0,0 +1,120 @@
+package periodicbackup
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+)
+
+const manifestExtension = ".json"
+
+// Manifest describes a backup file. It is written next to the backup, and is
+// used to verify the backup before it is restored.
+type Manifest struct {
+	// File is the name of the backup file, in the same directory as the manifest.
+	File          string                    `json:"file"`
+	AppVersion    string                    `json:"appVersion"`
+	SchemaVersion int64                     `json:"schemaVersion"`
+	Mode          config.DatabaseBackupMode `json:"mode"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	Size          int64                     `json:"size"`
+	// SHA256 is the checksum of the backup file.
+	SHA256 string `json:"sha256"`
+	// DumpSHA256 is the checksum of the pg_dump output. It differs from SHA256 when the backup is encrypted.
+	DumpSHA256 string      `json:"dumpSHA256"`
+	Encryption *Encryption `json:"encryption,omitempty"`
+	// Checksum is the checksum of the manifest itself, with Checksum unset.
+	Checksum string `json:"checksum"`
+}
+
+func (m Manifest) checksum() (string, error) {
+	m.Checksum = ""
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func manifestPath(backupPath string) string {
+	dir, name := filepath.Split(backupPath)
+	name = strings.TrimSuffix(name, encryptedExtension)
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	return filepath.Join(dir, name+manifestExtension)
+}
+
+func writeManifest(path string, m Manifest) error {
+	checksum, err := m.checksum()
+	if err != nil {
+		return err
+	}
+	m.Checksum = checksum
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(os.WriteFile(path, b, 0600), "failed to write backup manifest")
+}
+
+// ReadManifest reads the manifest at path and checks that it has not been modified.
+func ReadManifest(path string) (m Manifest, err error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return m, errors.Wrap(err, "failed to read backup manifest")
+	}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "failed to parse backup manifest")
+	}
+	checksum, err := m.checksum()
+	if err != nil {
+		return m, err
+	}
+	if checksum != m.Checksum {
+		return m, errors.Errorf("backup manifest %s is corrupted: checksum is %s but expected %s", path, checksum, m.Checksum)
+	}
+	if m.File == "" || filepath.Base(m.File) != m.File {
+		return m, errors.Errorf("backup manifest %s has an invalid file name %q", path, m.File)
+	}
+	return m, nil
+}
+
+// BackupPath returns the path of the backup file described by the manifest at manifestPath.
+func (m Manifest) BackupPath(manifestPath string) string {
+	return filepath.Join(filepath.Dir(manifestPath), m.File)
+}
+
+// Verify checks the size and checksum of the backup file at path.
+func (m Manifest) Verify(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup")
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return errors.Wrap(err, "failed to read backup")
+	}
+	if size != m.Size {
+		return errors.Errorf("backup %s is %d bytes but expected %d", path, size, m.Size)
+	}
+	if sum := hexSum(h); sum != m.SHA256 {
+		return errors.Errorf("backup %s is corrupted: checksum is %s but expected %s", path, sum, m.SHA256)
+	}
+	return nil
+}
+
+func hexSum(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,127 @@
+package periodicbackup
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/dialects"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+)
+
+// RestoreBackup restores the backup described by the manifest at manifestPath
+// into the empty database at targetURL. Before anything is written to the
+// target, it checks the manifest and backup checksums, decrypts the backup with
+// passphrase if it is encrypted, and checks that the backup's schema version is
+// supported by this version of the node.
+func RestoreBackup(ctx context.Context, lggr logger.Logger, manifestPath, passphrase string, targetURL url.URL) error {
+	lggr = lggr.Named("DatabaseRestore")
+	dumpPath, cleanup, err := prepareRestore(manifestPath, passphrase)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err = checkTargetEmpty(ctx, targetURL); err != nil {
+		return err
+	}
+
+	args := []string{
+		"--exit-on-error",
+		"-d", targetURL.String(),
+		dumpPath,
+	}
+	lggr.Infow("Running pg_restore", "target", targetURL.Redacted(), "manifest", manifestPath)
+	cmd := exec.CommandContext(ctx, "pg_restore", args...)
+	if _, err = cmd.Output(); err != nil {
+		var ee *exec.ExitError
+		if errors.As(err, &ee) {
+			return errors.Wrapf(err, "pg_restore failed with output: %s", string(ee.Stderr))
+		}
+		return errors.Wrap(err, "pg_restore failed")
+	}
+	return nil
+}
+
+// prepareRestore verifies the backup described by the manifest at manifestPath,
+// and returns the path of the pg_dump output to restore. cleanup removes any
+// temporary files.
+func prepareRestore(manifestPath, passphrase string) (dumpPath string, cleanup func(), err error) {
+	cleanup = func() {}
+	m, err := ReadManifest(manifestPath)
+	if err != nil {
+		return "", cleanup, err
+	}
+	latest, err := migrate.Latest()
+	if err != nil {
+		return "", cleanup, errors.Wrap(err, "failed to get the latest migration")
+	}
+	if m.SchemaVersion <= 0 {
+		return "", cleanup, errors.New("backup has no schema version")
+	}
+	if m.SchemaVersion > latest {
+		return "", cleanup, errors.Errorf("backup has schema version %d, but this node only supports up to %d: restore it with node version %s or newer", m.SchemaVersion, latest, m.AppVersion)
+	}
+	backupPath := m.BackupPath(manifestPath)
+	if err = m.Verify(backupPath); err != nil {
+		return "", cleanup, err
+	}
+	if m.Encryption == nil {
+		return backupPath, cleanup, nil
+	}
+
+	backupFile, err := os.Open(backupPath)
+	if err != nil {
+		return "", cleanup, errors.Wrap(err, "failed to open backup")
+	}
+	defer backupFile.Close()
+	tmpFile, err := ioutil.TempFile(filepath.Dir(backupPath), "cl_restore_tmp_")
+	if err != nil {
+		return "", cleanup, errors.Wrap(err, "failed to create a tmp file")
+	}
+	defer tmpFile.Close()
+	cleanup = func() { _ = os.Remove(tmpFile.Name()) }
+
+	h := sha256.New()
+	if err = decrypt(io.MultiWriter(tmpFile, h), backupFile, *m.Encryption, passphrase); err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+	if sum := hexSum(h); sum != m.DumpSHA256 {
+		cleanup()
+		return "", func() {}, errors.Errorf("decrypted backup is corrupted: checksum is %s but expected %s", sum, m.DumpSHA256)
+	}
+	if err = tmpFile.Close(); err != nil {
+		cleanup()
+		return "", func() {}, errors.Wrap(err, "failed to write decrypted backup")
+	}
+	return tmpFile.Name(), cleanup, nil
+}
+
+// checkTargetEmpty returns an error if the database at targetURL has any tables,
+// so that a restore never overwrites or mixes with existing data.
+func checkTargetEmpty(ctx context.Context, targetURL url.URL) error {
+	db, err := sql.Open(string(dialects.Postgres), targetURL.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to open target database")
+	}
+	defer db.Close()
+	var tables int
+	err = db.QueryRowContext(ctx, `SELECT count(*) FROM pg_catalog.pg_tables WHERE schemaname NOT IN ('pg_catalog', 'information_schema')`).Scan(&tables)
+	if err != nil {
+		return errors.Wrap(err, "failed to check target database")
+	}
+	if tables > 0 {
+		return errors.Errorf("target database %s is not empty: it has %d tables", targetURL.Redacted(), tables)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package periodicbackup
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type manifestFile struct {
+	path     string
+	manifest Manifest
+}
+
+// listBackups returns the backups in dir which have a valid manifest, newest first.
+// Backups without one, such as those taken by older versions, are left alone.
+func (backup *databaseBackup) listBackups() ([]manifestFile, error) {
+	paths, err := filepath.Glob(filepath.Join(backup.outputParentDir, filePrefix+"*"+manifestExtension))
+	if err != nil {
+		return nil, err
+	}
+	var backups []manifestFile
+	for _, path := range paths {
+		m, err := ReadManifest(path)
+		if err != nil {
+			backup.logger.Warnw("Skipping backup with an invalid manifest", "path", path, "err", err)
+			continue
+		}
+		backups = append(backups, manifestFile{path, m})
+	}
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].manifest.CreatedAt.After(backups[j].manifest.CreatedAt)
+	})
+	return backups, nil
+}
+
+// pruneBackups deletes the backups beyond the newest retentionCount, and those
+// older than retentionMaxAge. The newest backup is always kept.
+func (backup *databaseBackup) pruneBackups(now time.Time) error {
+	if backup.retentionCount == 0 && backup.retentionMaxAge == 0 {
+		return nil
+	}
+	backups, err := backup.listBackups()
+	if err != nil {
+		return errors.Wrap(err, "failed to list backups")
+	}
+	for i, b := range backups {
+		if i == 0 {
+			continue
+		}
+		tooMany := backup.retentionCount > 0 && i >= int(backup.retentionCount)
+		tooOld := backup.retentionMaxAge > 0 && now.Sub(b.manifest.CreatedAt) > backup.retentionMaxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		backupPath := b.manifest.BackupPath(b.path)
+		if err = os.Remove(backupPath); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to delete backup %s", backupPath)
+		}
+		if err = os.Remove(b.path); err != nil {
+			return errors.Wrapf(err, "failed to delete backup manifest %s", b.path)
+		}
+		backup.logger.Infow("Deleted old backup", "path", backupPath, "createdAt", b.manifest.CreatedAt)
+	}
+	return nil
+}
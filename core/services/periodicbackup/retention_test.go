@@ -0,0 +1,83 @@
+package periodicbackup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicBackup_PruneBackups(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 9, 10, 0, 0, 0, 0, time.UTC)
+	// one backup a day, the newest taken now
+	writeBackups := func(t *testing.T, backup *databaseBackup, n int) []string {
+		var paths []string
+		for i := 0; i < n; i++ {
+			dumpPath, _ := writeDump(t, backup.outputParentDir, 100)
+			path, _, err := backup.writeBackup(dumpPath, "1.8.0", 138, "", now.Add(-time.Duration(n-1-i)*24*time.Hour))
+			require.NoError(t, err)
+			paths = append(paths, path)
+		}
+		return paths
+	}
+	remaining := func(t *testing.T, paths []string) (kept []string) {
+		for _, path := range paths {
+			_, err := os.Stat(path)
+			if err == nil {
+				assert.FileExists(t, manifestPath(path))
+				kept = append(kept, path)
+			} else {
+				assert.NoFileExists(t, manifestPath(path))
+			}
+		}
+		return
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		backup := newFileBackup(t, t.TempDir(), "")
+		paths := writeBackups(t, backup, 5)
+		require.NoError(t, backup.pruneBackups(now))
+		assert.Equal(t, paths, remaining(t, paths))
+	})
+
+	t.Run("count", func(t *testing.T) {
+		backup := newFileBackup(t, t.TempDir(), "")
+		backup.retentionCount = 2
+		paths := writeBackups(t, backup, 5)
+		require.NoError(t, backup.pruneBackups(now))
+		assert.Equal(t, paths[3:], remaining(t, paths))
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		backup := newFileBackup(t, t.TempDir(), "")
+		backup.retentionMaxAge = 36 * time.Hour
+		paths := writeBackups(t, backup, 5)
+		require.NoError(t, backup.pruneBackups(now))
+		assert.Equal(t, paths[3:], remaining(t, paths))
+	})
+
+	t.Run("keeps the newest backup", func(t *testing.T) {
+		backup := newFileBackup(t, t.TempDir(), "")
+		backup.retentionMaxAge = time.Hour
+		paths := writeBackups(t, backup, 3)
+		require.NoError(t, backup.pruneBackups(now.Add(24*time.Hour)))
+		assert.Equal(t, paths[2:], remaining(t, paths))
+	})
+
+	t.Run("ignores backups without a manifest", func(t *testing.T) {
+		dir := t.TempDir()
+		legacy := filepath.Join(dir, "cl_backup_1.7.0.dump")
+		require.NoError(t, os.WriteFile(legacy, []byte("legacy"), 0600))
+		backup := newFileBackup(t, dir, "")
+		backup.retentionCount = 1
+		paths := writeBackups(t, backup, 2)
+		require.NoError(t, backup.pruneBackups(now))
+		assert.Equal(t, paths[1:], remaining(t, paths))
+		assert.FileExists(t, legacy)
+	})
+}
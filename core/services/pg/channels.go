@@ -5,3 +5,20 @@ const (
 	ChannelInsertOnEthTx    = "insert_on_eth_txes"
 	ChannelInsertOnTerraMsg = "insert_on_terra_msg"
 )
+
+// Postgres channels used by GraphQL subscriptions
+const (
+	// ChannelPipelineRunCreated is notified with the ID of every new pipeline run
+	ChannelPipelineRunCreated = "pipeline_run_created"
+	// ChannelPipelineRunFinished is notified with the ID of a pipeline run when it finishes
+	ChannelPipelineRunFinished = "pipeline_run_finished"
+	// ChannelEthTxStateChanged is notified with the ID of an eth_tx when it is
+	// inserted or its state changes
+	ChannelEthTxStateChanged = "eth_tx_state_changed"
+	// ChannelJobSpecErrorRecorded is notified with the ID of a job spec error
+	// whenever it occurs
+	ChannelJobSpecErrorRecorded = "job_spec_error_recorded"
+	// ChannelEVMNodeStateChanged is notified by the node itself, with a JSON
+	// payload, when an EVM RPC node changes state
+	ChannelEVMNodeStateChanged = "evm_node_state_changed"
+)
@@ -0,0 +1,142 @@
+package pg
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// HAState is the role a node plays when running in active/passive high
+// availability mode (see LeaseLock).
+type HAState string
+
+const (
+	// HAStateStandby means the node is warmed up and waiting for the lease
+	// held by another node to lapse.
+	HAStateStandby HAState = "standby"
+	// HAStateLeader means the node holds the database lease and runs jobs.
+	HAStateLeader HAState = "leader"
+)
+
+// LeaderTransition is a recorded change of HA role of a node.
+type LeaderTransition struct {
+	ID        int64
+	ClientID  uuid.UUID
+	State     HAState
+	CreatedAt time.Time
+}
+
+//go:generate mockery --name HAStatus --output ./mocks/ --case=underscore
+
+// HAStatus exposes the current high availability role of this node, and the
+// history of leadership transitions of all nodes sharing the database.
+type HAStatus interface {
+	// Enabled returns true if the node runs in active/passive HA mode.
+	Enabled() bool
+	// State returns the current role of this node. Nodes that are not
+	// running in HA mode are always leaders.
+	State() HAState
+	// Since returns the time of the last role change of this node.
+	Since() time.Time
+	// SetState changes the role of this node and records the transition.
+	SetState(state HAState) error
+	// Transitions returns the most recent leadership transitions, newest first.
+	Transitions(limit int) ([]LeaderTransition, error)
+	// Ready returns an error while the node is on standby, so that load
+	// balancers only route traffic to the leader.
+	Ready() error
+	Healthy() error
+}
+
+var _ HAStatus = &haStatus{}
+
+type haStatus struct {
+	q        Q
+	clientID uuid.UUID
+	enabled  bool
+	lggr     logger.Logger
+
+	mu    sync.RWMutex
+	state HAState
+	since time.Time
+	// recorded is false until the initial role has been recorded
+	recorded bool
+}
+
+// NewHAStatus creates a HAStatus for the node identified by clientID. Nodes
+// running in HA mode start on standby, all other nodes start as leaders. The
+// initial role is recorded by the first call to SetState.
+func NewHAStatus(db *sqlx.DB, clientID uuid.UUID, enabled bool, lggr logger.Logger, cfg LogConfig) HAStatus {
+	lggr = lggr.Named("HAStatus")
+	state := HAStateLeader
+	if enabled {
+		state = HAStateStandby
+	}
+	return &haStatus{
+		q:        NewQ(db, lggr, cfg),
+		clientID: clientID,
+		enabled:  enabled,
+		lggr:     lggr,
+		state:    state,
+		since:    time.Now(),
+	}
+}
+
+func (h *haStatus) Enabled() bool {
+	return h.enabled
+}
+
+func (h *haStatus) State() HAState {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.state
+}
+
+func (h *haStatus) Since() time.Time {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.since
+}
+
+func (h *haStatus) SetState(state HAState) error {
+	h.mu.Lock()
+	if h.recorded && h.state == state {
+		h.mu.Unlock()
+		return nil
+	}
+	prev := h.state
+	if h.state != state {
+		h.state = state
+		h.since = time.Now()
+	}
+	h.recorded = true
+	h.mu.Unlock()
+	if !h.enabled {
+		return nil
+	}
+
+	h.lggr.Infow("HA role changed", "from", prev, "to", state, "clientID", h.clientID)
+	err := h.q.ExecQ(`INSERT INTO leader_transitions (client_id, state, created_at) VALUES ($1, $2, NOW())`, h.clientID, state)
+	return errors.Wrap(err, "failed to record leadership transition")
+}
+
+func (h *haStatus) Transitions(limit int) (transitions []LeaderTransition, err error) {
+	err = h.q.Select(&transitions, `SELECT id, client_id, state, created_at FROM leader_transitions ORDER BY id DESC LIMIT $1`, limit)
+	return transitions, errors.Wrap(err, "failed to load leadership transitions")
+}
+
+func (h *haStatus) Ready() error {
+	if h.State() != HAStateLeader {
+		return errors.New("node is on standby")
+	}
+	return nil
+}
+
+func (h *haStatus) Healthy() error {
+	return nil
+}
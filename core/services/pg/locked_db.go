@@ -14,6 +14,10 @@ import (
 // LockedDB bounds DB connection and DB locks.
 type LockedDB interface {
 	Open(ctx context.Context) error
+	// AwaitLeadership blocks until this node holds the DB locks, or ctx is
+	// cancelled. It returns immediately unless high availability is enabled,
+	// since Open has already taken the locks in that case.
+	AwaitLeadership(ctx context.Context) error
 	Close() error
 	DB() *sqlx.DB
 }
@@ -64,28 +68,63 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 		}
 	}
 
+	// In HA mode the locks are taken later by AwaitLeadership, so that a
+	// standby node can warm up while another node holds the lease.
+	if l.cfg.DatabaseHAEnabled() {
+		l.lggr.Debug("High availability is enabled, deferring DB locks until leadership is acquired")
+		return nil
+	}
+
 	// Step 2: acquire DB locks
+	if err = l.takeLocks(ctx); err != nil {
+		defer revert()
+		return err
+	}
+
+	return
+}
+
+// AwaitLeadership takes the DB locks which were deferred by Open in HA mode.
+// This is a blocking function which returns once the lease held by another
+// node has lapsed.
+// NOT THREAD SAFE
+func (l *lockedDb) AwaitLeadership(ctx context.Context) error {
+	if l.db == nil {
+		l.lggr.Panic("calling AwaitLeadership() before Open()")
+	}
+	if !l.cfg.DatabaseHAEnabled() || l.leaseLock != nil {
+		return nil
+	}
+	l.lggr.Info("Waiting for database lease to become available...")
+	return l.takeLocks(ctx)
+}
+
+func (l *lockedDb) takeLocks(ctx context.Context) (err error) {
 	lockingMode := l.cfg.DatabaseLockingMode()
 	l.lggr.Debugf("Using database locking mode: %s", lockingMode)
 
 	// Take the lease before any other DB operations
 	switch lockingMode {
 	case "lease", "dual":
-		l.leaseLock = NewLeaseLock(l.db, l.cfg.AppID(), l.lggr, l.cfg.LeaseLockRefreshInterval(), l.cfg.LeaseLockDuration())
-		if err = l.leaseLock.TakeAndHold(ctx); err != nil {
-			defer revert()
+		leaseLock := NewLeaseLock(l.db, l.cfg.AppID(), l.lggr, l.cfg.LeaseLockRefreshInterval(), l.cfg.LeaseLockDuration())
+		if err = leaseLock.TakeAndHold(ctx); err != nil {
 			return errors.Wrap(err, "failed to take initial lease on database")
 		}
+		l.leaseLock = leaseLock
 	}
 
 	// Try to acquire an advisory lock to prevent multiple nodes starting at the same time
 	switch lockingMode {
 	case "advisorylock", "dual":
-		l.advisoryLock = NewAdvisoryLock(l.db, l.cfg.AdvisoryLockID(), l.lggr, l.cfg.AdvisoryLockCheckInterval())
-		if err = l.advisoryLock.TakeAndHold(ctx); err != nil {
-			defer revert()
+		advisoryLock := NewAdvisoryLock(l.db, l.cfg.AdvisoryLockID(), l.lggr, l.cfg.AdvisoryLockCheckInterval())
+		if err = advisoryLock.TakeAndHold(ctx); err != nil {
+			if l.leaseLock != nil {
+				l.leaseLock.Release()
+				l.leaseLock = nil
+			}
 			return errors.Wrap(err, "error acquiring lock")
 		}
+		l.advisoryLock = advisoryLock
 	}
 
 	return
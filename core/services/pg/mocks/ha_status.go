@@ -0,0 +1,137 @@
+// Code generated by mockery v2.13.0-beta.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HAStatus is an autogenerated mock type for the HAStatus type
+type HAStatus struct {
+	mock.Mock
+}
+
+// Enabled provides a mock function with given fields:
+func (_m *HAStatus) Enabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Healthy provides a mock function with given fields:
+func (_m *HAStatus) Healthy() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *HAStatus) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetState provides a mock function with given fields: state
+func (_m *HAStatus) SetState(state pg.HAState) error {
+	ret := _m.Called(state)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pg.HAState) error); ok {
+		r0 = rf(state)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Since provides a mock function with given fields:
+func (_m *HAStatus) Since() time.Time {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
+// State provides a mock function with given fields:
+func (_m *HAStatus) State() pg.HAState {
+	ret := _m.Called()
+
+	var r0 pg.HAState
+	if rf, ok := ret.Get(0).(func() pg.HAState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(pg.HAState)
+	}
+
+	return r0
+}
+
+// Transitions provides a mock function with given fields: limit
+func (_m *HAStatus) Transitions(limit int) ([]pg.LeaderTransition, error) {
+	ret := _m.Called(limit)
+
+	var r0 []pg.LeaderTransition
+	if rf, ok := ret.Get(0).(func(int) []pg.LeaderTransition); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pg.LeaderTransition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type NewHAStatusT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHAStatus creates a new instance of HAStatus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHAStatus(t NewHAStatusT) *HAStatus {
+	mock := &HAStatus{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -19,6 +19,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	cnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -49,6 +50,8 @@ type (
 		DatabaseURL() url.URL
 		DefaultHTTPLimit() int64
 		DefaultHTTPTimeout() models.Duration
+		HTTPTaskTLSIdentities() ([]config.HTTPTaskTLSIdentity, error)
+		HTTPTaskOAuth2Providers() ([]config.HTTPTaskOAuth2Provider, error)
 		TriggerFallbackDBPollInterval() time.Duration
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
@@ -284,6 +287,7 @@ const (
 	TaskTypeMultiply         TaskType = "multiply"
 	TaskTypeDivide           TaskType = "divide"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJQ               TaskType = "jq"
 	TaskTypeCBORParse        TaskType = "cborparse"
 	TaskTypeAny              TaskType = "any"
 	TaskTypeVRF              TaskType = "vrf"
@@ -345,6 +349,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJQ:
+		task = &JQTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
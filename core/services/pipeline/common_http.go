@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/pkg/errors"
@@ -14,37 +16,147 @@ import (
 	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
 )
 
+// Encodings of the request bodies of the http and bridge tasks
+const (
+	RequestEncodingJSON = "json"
+	RequestEncodingForm = "form"
+	RequestEncodingRaw  = "raw"
+)
+
+// httpRequestBody is an encoded request body. The content type can be
+// overridden with the request headers.
+type httpRequestBody struct {
+	data        []byte
+	contentType string
+}
+
+// encodeRequestBody encodes the requestData of a task. JSON and form encoded
+// bodies are made from a map, raw bodies are sent as they are.
+func encodeRequestBody(encoding StringParam, requestData interface{}) (body httpRequestBody, err error) {
+	switch encoding {
+	case RequestEncodingJSON:
+		body.contentType = "application/json"
+		if data, ok := requestData.(MapParam); ok && data == nil {
+			return body, nil
+		}
+		body.data, err = json.Marshal(requestData)
+		return body, errors.Wrap(err, "failed to encode request body as JSON")
+	case RequestEncodingForm:
+		body.contentType = "application/x-www-form-urlencoded"
+		data, ok := requestData.(MapParam)
+		if !ok {
+			return body, errors.Errorf("form encoded request data must be a map, got %T", requestData)
+		}
+		values, err := formValues(data)
+		if err != nil {
+			return body, errors.Wrap(err, "failed to encode request body as form")
+		}
+		body.data = []byte(values.Encode())
+		return body, nil
+	case RequestEncodingRaw:
+		body.contentType = "text/plain; charset=utf-8"
+		data, ok := requestData.(StringParam)
+		if !ok {
+			return body, errors.Errorf("raw request data must be a string, got %T", requestData)
+		}
+		if data != "" {
+			body.data = []byte(data)
+		}
+		return body, nil
+	default:
+		return body, errors.Errorf("unknown request encoding %s, must be json, form or raw", encoding)
+	}
+}
+
+// formValues converts request data to form values. Arrays are repeated
+// keys, maps are encoded as JSON, and other values are formatted as they are.
+func formValues(data MapParam) (url.Values, error) {
+	values := url.Values{}
+	for key, value := range data {
+		if elems, ok := value.([]interface{}); ok {
+			for _, elem := range elems {
+				str, err := formValue(elem)
+				if err != nil {
+					return nil, errors.Wrapf(err, "key %s", key)
+				}
+				values.Add(key, str)
+			}
+			continue
+		}
+		str, err := formValue(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %s", key)
+		}
+		values.Set(key, str)
+	}
+	return values, nil
+}
+
+func formValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(v)
+		return string(b), err
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// resolveRequestData resolves the requestData param of a task, which is a
+// string for raw bodies and a map otherwise.
+func resolveRequestData(encoding StringParam, requestData string, vars Vars) (interface{}, error) {
+	if encoding == RequestEncodingRaw {
+		var data StringParam
+		err := ResolveParam(&data, From(VarExpr(requestData, vars), requestData))
+		return data, err
+	}
+	var data MapParam
+	err := ResolveParam(&data, From(VarExpr(requestData, vars), JSONWithVarExprs(requestData, vars, false), nil))
+	return data, err
+}
+
+// makeHTTPRequest sends the request. With an OAuth2 token provider, the
+// request is authorized with its access token, which is dropped once
+// rejected.
 func makeHTTPRequest(
 	ctx context.Context,
 	lggr logger.Logger,
 	method StringParam,
 	url URLParam,
 	reqHeaders []string,
-	requestData MapParam,
+	body httpRequestBody,
 	client *http.Client,
+	tokens *oauth2TokenProvider,
 	httpLimit int64,
 ) ([]byte, int, http.Header, time.Duration, error) {
 
 	var bodyReader io.Reader
-	if requestData != nil {
-		bodyBytes, err := json.Marshal(requestData)
-		if err != nil {
-			return nil, 0, nil, 0, errors.Wrap(err, "failed to encode request body as JSON")
-		}
-		bodyReader = bytes.NewReader(bodyBytes)
+	if body.data != nil {
+		bodyReader = bytes.NewReader(body.data)
 	}
 
 	request, err := http.NewRequestWithContext(ctx, string(method), url.String(), bodyReader)
 	if err != nil {
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
-	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Content-Type", body.contentType)
 	if len(reqHeaders)%2 != 0 {
 		panic("headers must have an even number of elements")
 	}
 	for i := 0; i+1 < len(reqHeaders); i += 2 {
 		request.Header.Set(reqHeaders[i], reqHeaders[i+1])
 	}
+	var token string
+	if tokens != nil {
+		if token, err = tokens.Token(ctx); err != nil {
+			return nil, 0, nil, 0, err
+		}
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
 
 	httpRequest := clhttp.HTTPRequest{
 		Client:  client,
@@ -63,6 +175,9 @@ func makeHTTPRequest(
 	}
 	elapsed := time.Since(start) // TODO: return elapsed from utils/http
 
+	if statusCode == http.StatusUnauthorized && tokens != nil {
+		tokens.Invalidate(token)
+	}
 	if statusCode >= 400 {
 		maybeErr := bestEffortExtractError(responseBytes)
 		return nil, statusCode, respHeaders, 0, errors.Errorf("got error from %s: (status code %v) %s", url.String(), statusCode, maybeErr)
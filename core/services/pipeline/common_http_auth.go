@@ -0,0 +1,290 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const (
+	// oauth2ExpiryDelta is how long before their expiry access tokens are
+	// refreshed, so that they do not expire in flight.
+	oauth2ExpiryDelta = 10 * time.Second
+	// oauth2TokenResponseLimit is the size limit of token responses
+	oauth2TokenResponseLimit = 1 << 16
+)
+
+// httpClientAuth holds the node's TLS client identities and OAuth2 token
+// providers, which the http and bridge tasks reference by name.
+type httpClientAuth struct {
+	identities map[string]config.HTTPTaskTLSIdentity
+	providers  map[string]*oauth2TokenProvider
+
+	mu      sync.Mutex
+	clients map[httpClientKey]*http.Client
+}
+
+type httpClientKey struct {
+	base     *http.Client
+	identity string
+}
+
+func newHTTPClientAuth(cfg Config, secrets SecretStore, tokenClient *http.Client, lggr logger.Logger) *httpClientAuth {
+	a := &httpClientAuth{
+		identities: make(map[string]config.HTTPTaskTLSIdentity),
+		providers:  make(map[string]*oauth2TokenProvider),
+		clients:    make(map[httpClientKey]*http.Client),
+	}
+	identities, err := cfg.HTTPTaskTLSIdentities()
+	if err != nil {
+		lggr.Errorw("Failed to load TLS identities, tasks referencing them will fail", "err", err)
+	}
+	for _, identity := range identities {
+		a.identities[identity.Name] = identity
+	}
+	providers, err := cfg.HTTPTaskOAuth2Providers()
+	if err != nil {
+		lggr.Errorw("Failed to load OAuth2 providers, tasks referencing them will fail", "err", err)
+	}
+	for _, provider := range providers {
+		a.providers[provider.Name] = &oauth2TokenProvider{
+			cfg:         provider,
+			auth:        a,
+			secrets:     secrets,
+			tokenClient: tokenClient,
+		}
+	}
+	return a
+}
+
+// client returns a client which presents the TLS identity, and otherwise
+// behaves like base. Without an identity, base is returned.
+func (a *httpClientAuth) client(base *http.Client, identity string) (*http.Client, error) {
+	if identity == "" {
+		return base, nil
+	}
+	if a == nil {
+		return nil, errors.Errorf("unknown TLS identity %s", identity)
+	}
+	tlsIdentity, ok := a.identities[identity]
+	if !ok {
+		return nil, errors.Errorf("unknown TLS identity %s", identity)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key := httpClientKey{base, identity}
+	if client, ok := a.clients[key]; ok {
+		return client, nil
+	}
+
+	baseTransport, ok := base.Transport.(*http.Transport)
+	if !ok {
+		return nil, errors.Errorf("TLS identity %s cannot be used with a %T transport", identity, base.Transport)
+	}
+	tlsConfig, err := loadTLSIdentity(tlsIdentity)
+	if err != nil {
+		return nil, err
+	}
+	tr := baseTransport.Clone()
+	tr.TLSClientConfig = tlsConfig
+	client := &http.Client{Transport: tr, CheckRedirect: checkIdentityRedirect(tlsIdentity, base.CheckRedirect), Jar: base.Jar, Timeout: base.Timeout}
+	a.clients[key] = client
+	return client, nil
+}
+
+// checkIdentityRedirect refuses redirects to hosts the identity is not allowed
+// for, and otherwise applies checkRedirect, or the default policy of
+// http.Client without one.
+func checkIdentityRedirect(identity config.HTTPTaskTLSIdentity, checkRedirect func(*http.Request, []*http.Request) error) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if !identity.AllowsURL(req.URL) {
+			return errors.Errorf("TLS identity %s is not allowed for host %s", identity.Name, req.URL.Host)
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+}
+
+func loadTLSIdentity(identity config.HTTPTaskTLSIdentity) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(identity.CertFile, identity.KeyFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load TLS identity %s", identity.Name)
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if identity.CAFile != "" {
+		pem, err := os.ReadFile(identity.CAFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load CA of TLS identity %s", identity.Name)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("CA of TLS identity %s has no PEM certificates", identity.Name)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// checkURL returns an error unless the TLS identity and the OAuth2 provider
+// are allowed for the host of u. Task URLs may be interpolated from run
+// inputs, so the node's credentials must only be sent to the hosts they were
+// configured for. Unknown names are left to client and tokenProvider.
+func (a *httpClientAuth) checkURL(identity, provider string, u URLParam) error {
+	if a == nil {
+		return nil
+	}
+	requestURL := url.URL(u)
+	if tlsIdentity, ok := a.identities[identity]; ok && !tlsIdentity.AllowsURL(&requestURL) {
+		return errors.Errorf("TLS identity %s is not allowed for host %s", identity, requestURL.Host)
+	}
+	if tokens, ok := a.providers[provider]; ok && !tokens.cfg.AllowsURL(&requestURL) {
+		return errors.Errorf("OAuth2 provider %s is not allowed for host %s", provider, requestURL.Host)
+	}
+	return nil
+}
+
+// tokenProvider returns the OAuth2 token provider, or nil without a name.
+func (a *httpClientAuth) tokenProvider(name string) (*oauth2TokenProvider, error) {
+	if name == "" {
+		return nil, nil
+	}
+	if a == nil {
+		return nil, errors.Errorf("unknown OAuth2 provider %s", name)
+	}
+	provider, ok := a.providers[name]
+	if !ok {
+		return nil, errors.Errorf("unknown OAuth2 provider %s", name)
+	}
+	return provider, nil
+}
+
+// oauth2TokenProvider fetches access tokens with the client credentials
+// grant, and caches them until shortly before they expire.
+type oauth2TokenProvider struct {
+	cfg         config.HTTPTaskOAuth2Provider
+	auth        *httpClientAuth
+	secrets     SecretStore
+	tokenClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns a cached access token, or fetches a new one.
+func (p *oauth2TokenProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && (p.expiry.IsZero() || time.Now().Before(p.expiry)) {
+		return p.token, nil
+	}
+
+	token, expiresIn, err := p.fetchToken(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to fetch access token from OAuth2 provider %s", p.cfg.Name)
+	}
+	p.token = token
+	p.expiry = time.Time{}
+	if expiresIn > 0 {
+		p.expiry = time.Now().Add(expiresIn - oauth2ExpiryDelta)
+	}
+	return p.token, nil
+}
+
+// Invalidate drops token from the cache, e.g. once it was rejected, so that
+// the next request fetches a new one.
+func (p *oauth2TokenProvider) Invalidate(token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token == token {
+		p.token = ""
+	}
+}
+
+func (p *oauth2TokenProvider) fetchToken(ctx context.Context) (string, time.Duration, error) {
+	clientSecret := p.cfg.ClientSecret
+	if secretRefRegexp.MatchString(clientSecret) {
+		var err error
+		if clientSecret, err = interpolateStoreSecrets(clientSecret, p.secrets); err != nil {
+			return "", 0, errors.Wrap(err, "clientSecret")
+		}
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(p.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	}
+	for key, value := range p.cfg.EndpointParams {
+		form.Set(key, value)
+	}
+	if p.cfg.AuthStyle == "params" {
+		form.Set("client_id", p.cfg.ClientID)
+		form.Set("client_secret", clientSecret)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.cfg.AuthStyle != "params" {
+		request.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(clientSecret))
+	}
+
+	client, err := p.auth.client(p.tokenClient, p.cfg.TLSIdentity)
+	if err != nil {
+		return "", 0, err
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return "", 0, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(response.Body, oauth2TokenResponseLimit))
+	if err != nil {
+		return "", 0, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", 0, errors.Errorf("status code %d: %s", response.StatusCode, bestEffortExtractError(body))
+	}
+
+	var token oauth2TokenResponse
+	if err = json.Unmarshal(body, &token); err != nil {
+		return "", 0, errors.Wrap(err, "invalid token response")
+	}
+	if token.AccessToken == "" {
+		return "", 0, errors.New("token response has no access_token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return "", 0, errors.Errorf("unsupported token_type %s", token.TokenType)
+	}
+	return token.AccessToken, time.Duration(token.ExpiresIn) * time.Second, nil
+}
@@ -147,6 +147,7 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
 		{pipeline.TaskTypeJSONParse, &pipeline.JSONParseTask{}},
+		{pipeline.TaskTypeJQ, &pipeline.JQTask{}},
 		{pipeline.TaskTypeCBORParse, &pipeline.CBORParseTask{}},
 		{pipeline.TaskTypeAny, &pipeline.AnyTask{}},
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
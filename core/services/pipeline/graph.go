@@ -199,6 +199,21 @@ func (p *Pipeline) RequiresPreInsert() bool {
 	return false
 }
 
+// SideEffectingTasks returns the tasks with effects outside of the node, like
+// sending transactions or calling external adapters, which are repeated when
+// the pipeline runs again.
+func (p *Pipeline) SideEffectingTasks() []Task {
+	var tasks []Task
+	for _, task := range p.Tasks {
+		switch task.Type() {
+		case TaskTypeETHTx, TaskTypeBridge, TaskTypeHTTP:
+			tasks = append(tasks, task)
+		default:
+		}
+	}
+	return tasks
+}
+
 func (p *Pipeline) ByDotID(id string) Task {
 	for _, task := range p.Tasks {
 		if task.DotID() == id {
@@ -241,3 +241,32 @@ func TestGraph_ImplicitDependencies(t *testing.T) {
 	require.True(t, g.HasEdgeFromTo(nodes["b"], nodes["c"]))
 	require.True(t, g.HasEdgeFromTo(nodes["c"], nodes["d"]))
 }
+
+func TestGraph_SideEffectingTasks(t *testing.T) {
+	p, err := pipeline.Parse(`
+		ds [type=memo value="1"];
+		parse [type=jsonparse path="a"];
+		ds -> parse;
+	`)
+	require.NoError(t, err)
+	require.Empty(t, p.SideEffectingTasks())
+
+	p, err = pipeline.Parse(`
+		encode [type=ethabiencode abi="foo(uint256 a)" data=<{"a": 1}>];
+		submit [type=ethtx to="0x0000000000000000000000000000000000000001" data="$(encode)"];
+		encode -> submit;
+	`)
+	require.NoError(t, err)
+	tasks := p.SideEffectingTasks()
+	require.Len(t, tasks, 1)
+	require.Equal(t, "submit", tasks[0].DotID())
+
+	// Bridges and HTTP requests may have effects on external systems
+	p, err = pipeline.Parse(pipeline.DotStr)
+	require.NoError(t, err)
+	var dotIDs []string
+	for _, task := range p.SideEffectingTasks() {
+		dotIDs = append(dotIDs, task.DotID())
+	}
+	require.ElementsMatch(t, []string{"ds1", "ds2", "answer2"}, dotIDs)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/smartcontractkit/sqlx"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 const (
@@ -43,6 +44,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func (t *HTTPTask) HelperSetAuth(config Config, secrets SecretStore, tokenClient *http.Client) {
+	t.auth = newHTTPClientAuth(config, secrets, tokenClient, logger.NullLogger)
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.chainSet = cc
 	t.config = config
@@ -52,3 +57,7 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore)
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (vars *Vars) HelperSetSecrets(store SecretStore) {
+	vars.secrets = newRunSecrets(store)
+}
@@ -3,9 +3,11 @@
 package mocks
 
 import (
-	models "github.com/smartcontractkit/chainlink/core/store/models"
+	config "github.com/smartcontractkit/chainlink/core/config"
 	mock "github.com/stretchr/testify/mock"
 
+	models "github.com/smartcontractkit/chainlink/core/store/models"
+
 	time "time"
 
 	url "net/url"
@@ -74,6 +76,52 @@ func (_m *Config) DefaultHTTPTimeout() models.Duration {
 	return r0
 }
 
+// HTTPTaskOAuth2Providers provides a mock function with given fields:
+func (_m *Config) HTTPTaskOAuth2Providers() ([]config.HTTPTaskOAuth2Provider, error) {
+	ret := _m.Called()
+
+	var r0 []config.HTTPTaskOAuth2Provider
+	if rf, ok := ret.Get(0).(func() []config.HTTPTaskOAuth2Provider); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.HTTPTaskOAuth2Provider)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HTTPTaskTLSIdentities provides a mock function with given fields:
+func (_m *Config) HTTPTaskTLSIdentities() ([]config.HTTPTaskTLSIdentity, error) {
+	ret := _m.Called()
+
+	var r0 []config.HTTPTaskTLSIdentity
+	if rf, ok := ret.Get(0).(func() []config.HTTPTaskTLSIdentity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]config.HTTPTaskTLSIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
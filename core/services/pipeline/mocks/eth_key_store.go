@@ -44,6 +44,36 @@ func (_m *ETHKeyStore) GetRoundRobinAddress(chainID *big.Int, addrs ...common.Ad
 	return r0, r1
 }
 
+// GetRoundRobinAddressWithLabel provides a mock function with given fields: chainID, label, addrs
+func (_m *ETHKeyStore) GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addrs ...common.Address) (common.Address, error) {
+	_va := make([]interface{}, len(addrs))
+	for _i := range addrs {
+		_va[_i] = addrs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, chainID, label)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func(*big.Int, string, ...common.Address) common.Address); ok {
+		r0 = rf(chainID, label, addrs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*big.Int, string, ...common.Address) error); ok {
+		r1 = rf(chainID, label, addrs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewETHKeyStoreT interface {
 	mock.TestingT
 	Cleanup(func())
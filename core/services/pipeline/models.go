@@ -50,6 +50,8 @@ type Run struct {
 	FinishedAt       null.Time        `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun        `json:"taskRuns"`
 	State            RunStatus        `json:"state"`
+	// RerunOfID is the ID of the run this run re-executed, if any
+	RerunOfID null.Int `json:"rerunOfID"`
 
 	Pending bool
 	// FailSilently is used to signal that a task with the failEarly flag has failed, and we want to not put this in the db
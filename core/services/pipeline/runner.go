@@ -55,10 +55,12 @@ type runner struct {
 	chainSet               evm.ChainSet
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
+	secrets                SecretStore
 	runReaperWorker        utils.SleeperTask
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpAuth               *httpClientAuth
 
 	// test helper
 	runFinished func(*Run)
@@ -98,13 +100,14 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, secrets SecretStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
 	r := &runner{
 		orm:                    orm,
 		config:                 config,
 		chainSet:               chainSet,
 		ethKeyStore:            ethks,
 		vrfKeyStore:            vrfks,
+		secrets:                secrets,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
@@ -112,6 +115,8 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
 	}
+	// Token endpoints are configured by the node operator, like bridges
+	r.httpAuth = newHTTPClientAuth(config, secrets, unrestrictedHTTPClient, r.lggr)
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
 	)
@@ -234,6 +239,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).auth = r.httpAuth
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.GetQ()
@@ -241,6 +247,7 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).auth = r.httpAuth
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -272,6 +279,10 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
+	if r.secrets != nil {
+		vars.secrets = newRunSecrets(r.secrets)
+	}
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -324,14 +335,19 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
-		output := result.Result.OutputDB()
+		// Secrets are redacted from the persisted results only
+		taskResult := result.Result
+		if vars.secrets != nil {
+			taskResult = vars.secrets.redactResult(taskResult)
+		}
+		output := taskResult.OutputDB()
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
 			PipelineRunID: run.ID,
 			Type:          result.Task.Type(),
 			Index:         result.Task.OutputIndex(),
 			Output:        output,
-			Error:         result.Result.ErrorDB(),
+			Error:         taskResult.ErrorDB(),
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
@@ -408,12 +424,16 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	logged := result
+	if taskRun.vars.secrets != nil {
+		logged = taskRun.vars.secrets.redactResult(result)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
-		"resultValue", result.Value,
-		"resultError", result.Error,
-		"resultType", fmt.Sprintf("%T", result.Value),
+		"resultValue", logged.Value,
+		"resultError", logged.Error,
+		"resultType", fmt.Sprintf("%T", logged.Value),
 	}
-	switch v := result.Value.(type) {
+	switch v := logged.Value.(type) {
 	case []byte:
 		loggerFields = append(loggerFields, "resultString", fmt.Sprintf("%q", v))
 		loggerFields = append(loggerFields, "resultHex", fmt.Sprintf("%x", v))
@@ -0,0 +1,170 @@
+package pipeline
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// SecretStore holds the node-local secrets which pipelines reference as
+// $(secrets.name).
+type SecretStore interface {
+	Get(name string) (string, error)
+}
+
+const (
+	secretsVarsKey = "secrets"
+	// RedactedSecret replaces secret values in task results and logs
+	RedactedSecret = "[REDACTED]"
+)
+
+var secretRefRegexp = regexp.MustCompile(`\$\(\s*secrets\.([a-zA-Z0-9_]+)\s*\)`)
+
+// runSecrets resolves secrets for a single run. Secrets are never added to the
+// run's vars, so they are not persisted with its inputs. Only the secrets the
+// run references are loaded from the store.
+type runSecrets struct {
+	store SecretStore
+
+	mu sync.RWMutex
+	// values are the secrets resolved so far, they are redacted from
+	// persisted task results and logs
+	values []string
+}
+
+func newRunSecrets(store SecretStore) *runSecrets {
+	return &runSecrets{store: store}
+}
+
+func (s *runSecrets) get(name string) (interface{}, error) {
+	value, err := s.store.Get(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "secret %s", name)
+	}
+	if value != "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, v := range s.values {
+			if v == value {
+				return value, nil
+			}
+		}
+		s.values = append(s.values, value)
+	}
+	return value, nil
+}
+
+func (s *runSecrets) resolved() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.values
+}
+
+func (s *runSecrets) redactString(str string) string {
+	for _, value := range s.resolved() {
+		str = strings.ReplaceAll(str, value, RedactedSecret)
+	}
+	return str
+}
+
+func (s *runSecrets) redactBytes(b []byte) []byte {
+	for _, value := range s.resolved() {
+		if bytes.Contains(b, []byte(value)) {
+			b = bytes.ReplaceAll(b, []byte(value), []byte(RedactedSecret))
+		}
+	}
+	return b
+}
+
+// redact returns val with every secret value replaced. Maps and slices are
+// copied rather than modified in place.
+func (s *runSecrets) redact(val interface{}) interface{} {
+	switch v := val.(type) {
+	case string:
+		return s.redactString(v)
+	case []byte:
+		return s.redactBytes(v)
+	case []string:
+		redacted := make([]string, len(v))
+		for i, str := range v {
+			redacted[i] = s.redactString(str)
+		}
+		return redacted
+	case StringSliceParam:
+		return s.redact([]string(v))
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, elem := range v {
+			redacted[i] = s.redact(elem)
+		}
+		return redacted
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			redacted[key] = s.redact(elem)
+		}
+		return redacted
+	case MapParam:
+		return s.redact(map[string]interface{}(v))
+	default:
+		return val
+	}
+}
+
+// redactResult returns the result with every secret value replaced. Results
+// are only redacted once they are persisted, so that tasks still receive the
+// actual values of their inputs.
+func (s *runSecrets) redactResult(result Result) Result {
+	if len(s.resolved()) == 0 {
+		return result
+	}
+	result.Value = s.redact(result.Value)
+	if result.Error != nil {
+		if msg := s.redactString(result.Error.Error()); msg != result.Error.Error() {
+			result.Error = errors.New(msg)
+		}
+	}
+	return result
+}
+
+// interpolateSecrets replaces every $(secrets.name) reference in str. It is
+// used for values which are not task params, like bridge URLs.
+func (vars Vars) interpolateSecrets(str string) (string, error) {
+	var err error
+	interpolated := secretRefRegexp.ReplaceAllStringFunc(str, func(ref string) string {
+		name := secretRefRegexp.FindStringSubmatch(ref)[1]
+		if vars.secrets == nil {
+			err = errors.Errorf("secret %s cannot be resolved: no secret store", name)
+			return ref
+		}
+		value, err2 := vars.secrets.get(name)
+		if err2 != nil {
+			err = err2
+			return ref
+		}
+		return value.(string)
+	})
+	return interpolated, err
+}
+
+// interpolateStoreSecrets replaces every $(secrets.name) reference in str
+// with secrets from store. It is used outside of runs, like for the node's
+// OAuth2 client secrets.
+func interpolateStoreSecrets(str string, store SecretStore) (string, error) {
+	if store == nil {
+		return Vars{}.interpolateSecrets(str)
+	}
+	return Vars{secrets: newRunSecrets(store)}.interpolateSecrets(str)
+}
+
+// Redact replaces the values of any secrets in val. It should be applied to
+// values which might contain resolved secrets before they are logged.
+func (vars Vars) Redact(val interface{}) interface{} {
+	if vars.secrets == nil {
+		return val
+	}
+	return vars.secrets.redact(val)
+}
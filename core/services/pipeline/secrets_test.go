@@ -0,0 +1,133 @@
+package pipeline_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+var errSecretNotFound = errors.New("secret not found")
+
+type mapSecretStore map[string]string
+
+func (s mapSecretStore) Get(name string) (string, error) {
+	value, found := s[name]
+	if !found {
+		return "", errSecretNotFound
+	}
+	return value, nil
+}
+
+func TestVars_Secrets(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": "bar"})
+	vars.HelperSetSecrets(mapSecretStore{"api_key": "s3cr3t", "unused": "hunter2"})
+
+	t.Run("resolves secrets by name", func(t *testing.T) {
+		got, err := vars.Get("secrets.api_key")
+		require.NoError(t, err)
+		require.Equal(t, "s3cr3t", got)
+
+		got, err = vars.Copy().Get("secrets.api_key")
+		require.NoError(t, err)
+		require.Equal(t, "s3cr3t", got)
+	})
+
+	t.Run("errors for unknown secrets", func(t *testing.T) {
+		_, err := vars.Get("secrets.unknown")
+		require.ErrorIs(t, err, errSecretNotFound)
+	})
+
+	t.Run("errors for nested keypaths", func(t *testing.T) {
+		_, err := vars.Get("secrets")
+		require.ErrorIs(t, err, pipeline.ErrKeypathNotFound)
+	})
+
+	t.Run("redacts secret values", func(t *testing.T) {
+		assert.Equal(t, "key=[REDACTED]", vars.Redact("key=s3cr3t"))
+		assert.Equal(t, []byte(`{"key":"[REDACTED]"}`), vars.Redact([]byte(`{"key":"s3cr3t"}`)))
+		assert.Equal(t,
+			map[string]interface{}{"a": []interface{}{"[REDACTED]", 1}},
+			vars.Redact(map[string]interface{}{"a": []interface{}{"s3cr3t", 1}}),
+		)
+		assert.Equal(t, 42, vars.Redact(42))
+	})
+
+	t.Run("only redacts resolved secrets", func(t *testing.T) {
+		assert.Equal(t, "hunter2", vars.Redact("hunter2"))
+	})
+
+	t.Run("does not resolve secrets without a secret store", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(nil)
+		_, err := vars.Get("secrets.api_key")
+		require.ErrorIs(t, err, pipeline.ErrKeypathNotFound)
+		assert.Equal(t, "s3cr3t", vars.Redact("s3cr3t"))
+	})
+}
+
+func Test_PipelineRunner_Secrets(t *testing.T) {
+	t.Parallel()
+
+	const apiKey = "s3cr3t"
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Header.Get("X-Api-Key") != apiKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// echo the request back, as a misbehaving data provider might
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	}))
+	defer s.Close()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	lggr := logger.TestLogger(t)
+	r := pipeline.NewRunner(new(mocks.ORM), cfg, nil, nil, nil, mapSecretStore{"api_key": apiKey}, lggr, c, c)
+
+	t.Run("resolves secrets and redacts them from persisted results", func(t *testing.T) {
+		run, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+ds [type=http method=POST url="` + s.URL + `" headers=<["X-Api-Key", $(secrets.api_key)]> requestData=<{"key": $(secrets.api_key)}>]
+`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		// Tasks and callers get the actual value
+		result, err := trrs.FinalResult(lggr).SingularResult()
+		require.NoError(t, err)
+		assert.Equal(t, `{"key":"s3cr3t"}`, result.Value)
+
+		require.Len(t, run.PipelineTaskRuns, 1)
+		assert.Equal(t, `{"key":"[REDACTED]"}`, run.PipelineTaskRuns[0].Output.Val)
+		assert.Equal(t, []interface{}{`{"key":"[REDACTED]"}`}, run.Outputs.Val)
+	})
+
+	t.Run("fails tasks which reference unknown secrets", func(t *testing.T) {
+		_, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+			DotDagSource: `
+ds [type=http method=POST url="` + s.URL + `" headers=<["X-Api-Key", $(secrets.unknown)]>]
+`,
+		}, pipeline.NewVarsFrom(nil), lggr)
+		require.NoError(t, err)
+
+		result, err := trrs.FinalResult(lggr).SingularResult()
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Error, errSecretNotFound)
+	})
+}
@@ -26,10 +26,14 @@ type BridgeTask struct {
 	RequestData       string `json:"requestData"`
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
+	RequestEncoding   string `json:"requestEncoding"`
+	TLSIdentity       string `json:"tlsIdentity"`
+	OAuth2Provider    string `json:"oauth2Provider"`
 
 	queryer    pg.Queryer
 	config     Config
 	httpClient *http.Client
+	auth       *httpClientAuth
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -50,17 +54,27 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		name              StringParam
 		requestData       MapParam
 		includeInputAtKey StringParam
+		requestEncoding   StringParam
+		tlsIdentity       StringParam
+		oauth2Provider    StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
+		errors.Wrap(ResolveParam(&requestEncoding, From(NonemptyString(t.RequestEncoding), RequestEncodingJSON)), "requestEncoding"),
+		errors.Wrap(ResolveParam(&tlsIdentity, From(t.TLSIdentity)), "tlsIdentity"),
+		errors.Wrap(ResolveParam(&oauth2Provider, From(t.OAuth2Provider)), "oauth2Provider"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	// Bridge requests always include the run's meta, so must be made from a map
+	if requestEncoding == RequestEncodingRaw {
+		return Result{Error: errors.New("requestEncoding: bridge requests must be json or form encoded")}, runInfo
+	}
 
-	url, err := t.getBridgeURLFromName(name)
+	url, err := t.getBridgeURLFromName(name, vars)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
@@ -94,19 +108,30 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		requestData["responseURL"] = responseURL.String()
 	}
 
-	requestDataJSON, err := json.Marshal(requestData)
+	body, err := encodeRequestBody(requestEncoding, requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 	lggr.Debugw("Bridge task: sending request",
-		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"requestData", vars.Redact(string(body.data)),
+		"url", vars.Redact(url.String()),
 	)
 
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), []string{}, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	if err = t.auth.checkURL(string(tlsIdentity), string(oauth2Provider), URLParam(url)); err != nil {
+		return Result{Error: err}, runInfo
+	}
+	client, err := t.auth.client(t.httpClient, string(tlsIdentity))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	tokens, err := t.auth.tokenProvider(string(oauth2Provider))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), []string{}, body, client, tokens, t.config.DefaultHTTPLimit())
 	if err != nil {
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
@@ -135,20 +160,35 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
 
 	lggr.Debugw("Bridge task: fetched answer",
-		"answer", result.Value,
-		"url", url.String(),
+		"answer", vars.Redact(result.Value),
+		"url", vars.Redact(url.String()),
 		"dotID", t.DotID(),
 	)
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+// getBridgeURLFromName looks up the URL of the bridge and resolves any
+// $(secrets.name) references in it.
+func (t BridgeTask) getBridgeURLFromName(name StringParam, vars Vars) (URLParam, error) {
 	var bt bridges.BridgeType
 	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
 		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	rawURL := bt.URL.String()
+	if !secretRefRegexp.MatchString(rawURL) {
+		return URLParam(bt.URL), nil
+	}
+	resolved, err := vars.interpolateSecrets(rawURL)
+	if err != nil {
+		return URLParam{}, errors.Wrapf(err, "bridge '%s' URL", name)
+	}
+	u, err := url.Parse(resolved)
+	if err != nil {
+		// The error would contain the resolved secret
+		return URLParam{}, errors.Errorf("bridge '%s' URL is invalid once its secrets are resolved", name)
+	}
+	return URLParam(*u), nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -26,6 +26,7 @@ import (
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
 	From             string `json:"from"`
+	FromLabel        string `json:"fromLabel"`
 	To               string `json:"to"`
 	Data             string `json:"data"`
 	GasLimit         string `json:"gasLimit"`
@@ -47,6 +48,7 @@ type ETHTxTask struct {
 
 type ETHKeyStore interface {
 	GetRoundRobinAddress(chainID *big.Int, addrs ...common.Address) (common.Address, error)
+	GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addrs ...common.Address) (common.Address, error)
 }
 
 var _ Task = (*ETHTxTask)(nil)
@@ -80,6 +82,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 
 	var (
 		fromAddrs             AddressSliceParam
+		fromLabel             StringParam
 		toAddr                AddressParam
 		data                  BytesParam
 		gasLimit              Uint64Param
@@ -90,6 +93,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
+		errors.Wrap(ResolveParam(&fromLabel, From(VarExpr(t.FromLabel, vars), NonemptyString(t.FromLabel), "")), "fromLabel"),
 		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
 		errors.Wrap(ResolveParam(&gasLimit, From(VarExpr(t.GasLimit, vars), NonemptyString(t.GasLimit), maximumGasLimit)), "gasLimit"),
@@ -120,7 +124,12 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: err}, runInfo
 	}
 
-	fromAddr, err := t.keyStore.GetRoundRobinAddress(chain.ID(), fromAddrs...)
+	var fromAddr common.Address
+	if fromLabel != "" {
+		fromAddr, err = t.keyStore.GetRoundRobinAddressWithLabel(chain.ID(), string(fromLabel), fromAddrs...)
+	} else {
+		fromAddr, err = t.keyStore.GetRoundRobinAddress(chain.ID(), fromAddrs...)
+	}
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
 		lggr.Error(err)
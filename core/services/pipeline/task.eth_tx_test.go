@@ -577,3 +577,62 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_FromLabel(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	other := common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	newTask := func(t *testing.T, from, fromLabel string) (pipeline.ETHTxTask, *keystoremocks.Eth, *txmmocks.TxManager) {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             from,
+			FromLabel:        fromLabel,
+			To:               to.Hex(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: "0",
+		}
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewTestGeneralConfig(t)
+		cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+		task.HelperSetDependencies(cc, keyStore)
+		return task, keyStore, txManager
+	}
+
+	t.Run("selects a key with the label", func(t *testing.T) {
+		task, keyStore, txManager := newTask(t, "", "ocr")
+		keyStore.On("GetRoundRobinAddressWithLabel", testutils.FixtureChainID, "ocr").Return(from, nil)
+		txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+			return tx.FromAddress == from
+		})).Return(txmgr.EthTx{}, nil)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("selects a key with the label out of from", func(t *testing.T) {
+		task, keyStore, txManager := newTask(t, `["`+from.Hex()+`", "`+other.Hex()+`"]`, "$(label)")
+		keyStore.On("GetRoundRobinAddressWithLabel", testutils.FixtureChainID, "ocr", from, other).Return(other, nil)
+		txManager.On("CreateEthTransaction", mock.MatchedBy(func(tx txmgr.NewTx) bool {
+			return tx.FromAddress == other
+		})).Return(txmgr.EthTx{}, nil)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"label": "ocr"}), nil)
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("no key with the label", func(t *testing.T) {
+		task, keyStore, _ := newTask(t, "", "keeper")
+		keyStore.On("GetRoundRobinAddressWithLabel", testutils.FixtureChainID, "keeper").Return(common.Address{}, errors.New(`no sending keys available for chain 0 with label "keeper"`))
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), `with label "keeper"`)
+		assert.True(t, runInfo.IsRetryable)
+	})
+}
@@ -2,7 +2,6 @@ package pipeline
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 
 	"go.uber.org/multierr"
@@ -23,12 +22,16 @@ type HTTPTask struct {
 	Method                         string
 	URL                            string
 	RequestData                    string `json:"requestData"`
+	RequestEncoding                string `json:"requestEncoding"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	TLSIdentity                    string `json:"tlsIdentity"`
+	OAuth2Provider                 string `json:"oauth2Provider"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	auth                   *httpClientAuth
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -61,38 +64,48 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	var (
 		method                         StringParam
 		url                            URLParam
-		requestData                    MapParam
+		requestEncoding                StringParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		tlsIdentity                    StringParam
+		oauth2Provider                 StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
 		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
-		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
+		errors.Wrap(ResolveParam(&requestEncoding, From(NonemptyString(t.RequestEncoding), RequestEncodingJSON)), "requestEncoding"),
 		// Any hardcoded strings used for URL uses the unrestricted HTTP adapter
 		// Interpolated variable URLs use restricted HTTP adapter by default
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
-		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&reqHeaders, From(JSONWithVarExprs(t.Headers, vars, false), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&tlsIdentity, From(t.TLSIdentity)), "tlsIdentity"),
+		errors.Wrap(ResolveParam(&oauth2Provider, From(t.OAuth2Provider)), "oauth2Provider"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	requestData, err := resolveRequestData(requestEncoding, t.RequestData, vars)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "requestData")}, runInfo
+	}
 
 	if len(reqHeaders)%2 != 0 {
 		return Result{Error: errors.Errorf("headers must have an even number of elements")}, runInfo
 	}
 
-	requestDataJSON, err := json.Marshal(requestData)
+	body, err := encodeRequestBody(requestEncoding, requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 	lggr.Debugw("HTTP task: sending request",
-		"requestData", string(requestDataJSON),
-		"url", url.String(),
+		"requestData", vars.Redact(string(body.data)),
+		"url", vars.Redact(url.String()),
 		"method", method,
-		"reqHeaders", reqHeaders,
+		"reqHeaders", vars.Redact([]string(reqHeaders)),
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
+		"tlsIdentity", tlsIdentity,
+		"oauth2Provider", oauth2Provider,
 	)
 
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
@@ -104,7 +117,18 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	if err = t.auth.checkURL(string(tlsIdentity), string(oauth2Provider), url); err != nil {
+		return Result{Error: err}, runInfo
+	}
+	client, err = t.auth.client(client, string(tlsIdentity))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	tokens, err := t.auth.tokenProvider(string(oauth2Provider))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, body, client, tokens, t.config.DefaultHTTPLimit())
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
@@ -113,9 +137,9 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	}
 
 	lggr.Debugw("HTTP task got response",
-		"response", string(responseBytes),
+		"response", vars.Redact(string(responseBytes)),
 		"respHeaders", respHeaders,
-		"url", url.String(),
+		"url", vars.Redact(url.String()),
 		"dotID", t.DotID(),
 	)
 
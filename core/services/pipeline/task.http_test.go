@@ -2,12 +2,26 @@ package pipeline_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sort"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -16,11 +30,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	clconfig "github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	clhttptest "github.com/smartcontractkit/chainlink/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	clhttp "github.com/smartcontractkit/chainlink/core/utils/http"
 )
@@ -402,3 +419,285 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_RequestEncoding(t *testing.T) {
+	t.Parallel()
+
+	var contentType, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = string(b)
+		_, err = w.Write([]byte(`{}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	config := cltest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"xml": "<price><base>ETH</base></price>"})
+
+	t.Run("form", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			Method:          "POST",
+			URL:             server.URL,
+			RequestData:     `{"base": "ETH", "quotes": ["USD", "EUR"], "amount": 1.5}`,
+			RequestEncoding: "form",
+		}
+		task.HelperSetDependencies(config, c, c)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "application/x-www-form-urlencoded", contentType)
+		assert.Equal(t, "amount=1.5&base=ETH&quotes=USD&quotes=EUR", body)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			Method:          "POST",
+			URL:             server.URL,
+			RequestData:     `$(xml)`,
+			RequestEncoding: "raw",
+			Headers:         `["Content-Type", "application/xml"]`,
+		}
+		task.HelperSetDependencies(config, c, c)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, "application/xml", contentType)
+		assert.Equal(t, "<price><base>ETH</base></price>", body)
+	})
+
+	t.Run("unknown encoding", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			Method:          "POST",
+			URL:             server.URL,
+			RequestData:     `{"base": "ETH"}`,
+			RequestEncoding: "yaml",
+		}
+		task.HelperSetDependencies(config, c, c)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "unknown request encoding yaml")
+	})
+}
+
+func TestHTTPTask_OAuth2Provider(t *testing.T) {
+	t.Parallel()
+
+	var tokenRequests int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, "node", id)
+		assert.Equal(t, "s3cr3t", secret)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "prices", r.PostForm.Get("scope"))
+
+		n := atomic.AddInt32(&tokenRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, err := fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`, n)
+		require.NoError(t, err)
+	}))
+	defer tokenServer.Close()
+
+	var rejectToken atomic.Value
+	rejectToken.Store("")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if auth == "" || auth == "Bearer "+rejectToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte(auth))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	config := new(pipelinemocks.Config)
+	config.On("DefaultHTTPLimit").Return(int64(32768))
+	config.On("DefaultHTTPTimeout").Return(models.MustMakeDuration(15 * time.Second))
+	config.On("HTTPTaskTLSIdentities").Return(nil, nil)
+	config.On("HTTPTaskOAuth2Providers").Return([]clconfig.HTTPTaskOAuth2Provider{{
+		Name:         "prices",
+		TokenURL:     tokenServer.URL,
+		ClientID:     "node",
+		ClientSecret: "$(secrets.client_secret)",
+		Scopes:       []string{"prices"},
+		AllowedHosts: []string{serverURL.Host},
+	}}, nil)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	task := pipeline.HTTPTask{
+		BaseTask:       pipeline.NewBaseTask(0, "http", nil, nil, 0),
+		Method:         "GET",
+		URL:            server.URL,
+		OAuth2Provider: "prices",
+	}
+	task.HelperSetDependencies(config, c, c)
+	task.HelperSetAuth(config, mapSecretStore{"client_secret": "s3cr3t"}, c)
+
+	run := func() pipeline.Result {
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	// The token is cached
+	for i := 0; i < 2; i++ {
+		result := run()
+		require.NoError(t, result.Error)
+		assert.Equal(t, "Bearer token-1", result.Value)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+
+	// A rejected token is refreshed by the next request
+	rejectToken.Store("token-1")
+	require.Error(t, run().Error)
+	result := run()
+	require.NoError(t, result.Error)
+	assert.Equal(t, "Bearer token-2", result.Value)
+
+	t.Run("interpolated URL of another host", func(t *testing.T) {
+		var authorized int32
+		otherServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" {
+				atomic.AddInt32(&authorized, 1)
+			}
+		}))
+		defer otherServer.Close()
+
+		task := pipeline.HTTPTask{
+			BaseTask:       pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:         "GET",
+			URL:            "$(request.url)",
+			OAuth2Provider: "prices",
+		}
+		task.HelperSetDependencies(config, c, c)
+		task.HelperSetAuth(config, mapSecretStore{"client_secret": "s3cr3t"}, c)
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"request": map[string]interface{}{"url": otherServer.URL}})
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "OAuth2 provider prices is not allowed for host")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&authorized))
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		task := pipeline.HTTPTask{
+			Method:         "GET",
+			URL:            server.URL,
+			OAuth2Provider: "unknown",
+		}
+		task.HelperSetDependencies(config, c, c)
+		task.HelperSetAuth(config, nil, c)
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.Error(t, result.Error)
+		assert.Equal(t, "unknown OAuth2 provider unknown", result.Error.Error())
+	})
+}
+
+func TestHTTPTask_TLSIdentity(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	clientCert := newTestClientCertificate(t, dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+		require.NoError(t, err)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: x509.NewCertPool()}
+	server.TLS.ClientCAs.AddCert(clientCert)
+	server.StartTLS()
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	var presented int32
+	otherServer := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			atomic.AddInt32(&presented, 1)
+		}
+	}))
+	otherServer.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	otherServer.StartTLS()
+	defer otherServer.Close()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	config := new(pipelinemocks.Config)
+	config.On("DefaultHTTPLimit").Return(int64(32768))
+	config.On("DefaultHTTPTimeout").Return(models.MustMakeDuration(15 * time.Second))
+	config.On("HTTPTaskTLSIdentities").Return([]clconfig.HTTPTaskTLSIdentity{{
+		Name:         "provider",
+		CertFile:     filepath.Join(dir, "cert.pem"),
+		KeyFile:      filepath.Join(dir, "key.pem"),
+		CAFile:       caFile,
+		AllowedHosts: []string{serverURL.Host},
+	}}, nil)
+	config.On("HTTPTaskOAuth2Providers").Return(nil, nil)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+
+	task := pipeline.HTTPTask{
+		BaseTask:    pipeline.NewBaseTask(0, "http", nil, nil, 0),
+		Method:      "GET",
+		URL:         server.URL,
+		TLSIdentity: "provider",
+	}
+	task.HelperSetDependencies(config, c, c)
+	task.HelperSetAuth(config, nil, c)
+
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	assert.Equal(t, "chainlink-node", result.Value)
+
+	// Without the identity, the handshake fails
+	task.TLSIdentity = ""
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+
+	// The identity is not presented to a host it is not allowed for
+	task.TLSIdentity = "provider"
+	task.URL = "$(request.url)"
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"request": map[string]interface{}{"url": otherServer.URL}})
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	require.Error(t, result.Error)
+	assert.Contains(t, result.Error.Error(), "TLS identity provider is not allowed for host")
+	assert.Equal(t, int32(0), atomic.LoadInt32(&presented))
+}
+
+// newTestClientCertificate writes a self signed client certificate and its
+// key to cert.pem and key.pem in dir.
+func newTestClientCertificate(t *testing.T, dir string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cert.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
@@ -0,0 +1,212 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// JQTask evaluates a jq expression over its input. Entries of vars are bound
+// to variables of the same name, e.g. vars=<{"min": 2}> is available as $min.
+//
+// The evaluator is sandboxed: the environment, input/output and clock
+// builtins are unavailable, so the result only depends on data and vars.
+// The expression must produce exactly one value.
+//
+// Return types:
+//     int
+//     float64
+//     *big.Int
+//     string
+//     bool
+//     map[string]interface{}
+//     []interface{}
+//     nil
+//
+type JQTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Data       string `json:"data"`
+	Vars       string `json:"vars"`
+}
+
+var _ Task = (*JQTask)(nil)
+
+// jqDefaultTimeout bounds the evaluation of an expression when no timeout
+// is set on the task itself.
+const jqDefaultTimeout = time.Second
+
+var (
+	jqVariableName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// jqSandbox shadows the builtins which would make an expression depend on
+	// the node it runs on, or stop the evaluation in unexpected ways.
+	jqSandbox = mustParseJQ(`
+		def now: error("now is not available");
+		def localtime: error("localtime is not available");
+		def strflocaltime($f): error("strflocaltime is not available");
+		def input_filename: error("input_filename is not available");
+		def halt: error("halt is not available");
+		def halt_error: error("halt_error is not available");
+		def halt_error($code): error("halt_error is not available");
+		.
+	`).FuncDefs
+
+	// jqCache holds compiled expressions keyed by expression and variable names.
+	jqCache sync.Map
+)
+
+func mustParseJQ(expr string) *gojq.Query {
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+func (t *JQTask) Type() TaskType {
+	return TaskTypeJQ
+}
+
+func (t *JQTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression StringParam
+		data       JSONParam
+		jqVars     MapParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+		errors.Wrap(ResolveParam(&jqVars, From(VarExpr(t.Vars, vars), JSONWithVarExprs(t.Vars, vars, false), nil)), "vars"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	names := make([]string, 0, len(jqVars))
+	for name := range jqVars {
+		if !jqVariableName.MatchString(name) {
+			return Result{Error: errors.Wrapf(ErrBadInput, "vars: invalid variable name %q", name)}, runInfo
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]interface{}, len(names))
+	for i, name := range names {
+		var value JSONParam
+		if err = value.UnmarshalPipelineParam(jqVars[name]); err != nil {
+			return Result{Error: errors.Wrapf(err, "vars: %s", name)}, runInfo
+		}
+		values[i] = value.Val
+	}
+
+	code, err := compileJQ(string(expression), names)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	if _, isSet := t.TaskTimeout(); !isSet {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, jqDefaultTimeout)
+		defer cancel()
+	}
+
+	value, err := evaluateJQ(ctx, code, data.Val, values)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+func compileJQ(expression string, names []string) (*gojq.Code, error) {
+	key := expression
+	for _, name := range names {
+		key += "\x00" + name
+	}
+	if code, ok := jqCache.Load(key); ok {
+		return code.(*gojq.Code), nil
+	}
+
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "expression: %v", err)
+	}
+	query.FuncDefs = append(append([]*gojq.FuncDef{}, jqSandbox...), query.FuncDefs...)
+
+	variables := make([]string, len(names))
+	for i, name := range names {
+		variables[i] = "$" + name
+	}
+	code, err := gojq.Compile(query,
+		gojq.WithVariables(variables),
+		gojq.WithEnvironLoader(func() []string { return nil }),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "expression: %v", err)
+	}
+
+	jqCache.Store(key, code)
+	return code, nil
+}
+
+func evaluateJQ(ctx context.Context, code *gojq.Code, data interface{}, values []interface{}) (interface{}, error) {
+	iter := code.RunWithContext(ctx, data, values...)
+
+	value, ok := iter.Next()
+	if !ok {
+		return nil, errors.New("expression produced no value")
+	}
+	if err, isErr := value.(error); isErr {
+		return nil, errors.Wrap(err, "expression")
+	}
+	if next, more := iter.Next(); more {
+		if err, isErr := next.(error); isErr {
+			return nil, errors.Wrap(err, "expression")
+		}
+		return nil, errors.New("expression produced more than one value, wrap it in [...] to collect all of them")
+	}
+
+	if err := checkJQValue(value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// checkJQValue makes sure the result can be serialized, as jq allows NaN and
+// infinite numbers which JSON does not.
+func checkJQValue(v interface{}) error {
+	switch v := v.(type) {
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.Errorf("expression produced a non-finite number: %v", v)
+		}
+	case []interface{}:
+		for _, x := range v {
+			if err := checkJQValue(x); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for _, x := range v {
+			if err := checkJQValue(x); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestJQTask(t *testing.T) {
+	t.Parallel()
+
+	prices := `{"data": [{"exchange": "a", "price": 3.5}, {"exchange": "b", "price": 4}, {"exchange": "c", "price": 1}]}`
+
+	tests := []struct {
+		name              string
+		expression        string
+		data              string
+		jqVars            string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorContains string
+	}{
+		{
+			"max by field",
+			".data | max_by(.price) | .exchange",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"b",
+			"",
+		},
+		{
+			"filter with vars",
+			"[.data[] | select(.price > $min) | .exchange]",
+			"",
+			`{"min": $(foo.min)}`,
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"min": 2}}),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"a", "b"},
+			"",
+		},
+		{
+			"map over object",
+			"map_values(. * 2)",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"a": 1, "b": 2.5}`}},
+			map[string]interface{}{"a": 2, "b": float64(5)},
+			"",
+		},
+		{
+			"data from vars",
+			".price",
+			"$(foo)",
+			"",
+			pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"price": 42}}),
+			nil,
+			42,
+			"",
+		},
+		{
+			"large integers keep precision",
+			".value + 1",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"value": 123456789012345678901234567890}`}},
+			big.NewInt(0).Add(mustBigInt(t, "123456789012345678901234567890"), big.NewInt(1)),
+			"",
+		},
+		{
+			"no value",
+			"empty",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"produced no value",
+		},
+		{
+			"more than one value",
+			".data[]",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"more than one value",
+		},
+		{
+			"invalid expression",
+			".data[",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"expression",
+		},
+		{
+			"invalid JSON input",
+			".",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data": `}},
+			nil,
+			"invalid JSON",
+		},
+		{
+			"invalid variable name",
+			".",
+			"",
+			`{"not-valid": 1}`,
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"invalid variable name",
+		},
+		{
+			"non-finite result",
+			"infinite",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"non-finite",
+		},
+		{
+			"clock is not available",
+			"now",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"now is not available",
+		},
+		{
+			"environment is not available",
+			"env | length",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			0,
+			"",
+		},
+		{
+			"input is not available",
+			"input",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			"input",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.JQTask{
+				BaseTask:   pipeline.NewBaseTask(0, "jq", nil, nil, 0),
+				Expression: test.expression,
+				Data:       test.data,
+				Vars:       test.jqVars,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), test.wantErrorContains)
+				assert.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				assert.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
+
+func TestJQTask_Timeout(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.JQTask{
+		BaseTask:   pipeline.NewBaseTask(0, "jq", nil, nil, 0),
+		Expression: "def f: f; f",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: `{}`}})
+	require.Error(t, result.Error)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func mustBigInt(t *testing.T, s string) *big.Int {
+	n, ok := big.NewInt(0).SetString(s, 10)
+	require.True(t, ok)
+	return n
+}
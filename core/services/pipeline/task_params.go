@@ -1,6 +1,7 @@
 package pipeline
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"math"
@@ -680,6 +681,45 @@ func (s *AddressSliceParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// JSONParam holds an arbitrary JSON value. Strings and bytes are decoded as
+// JSON documents, any other value is converted to its JSON representation.
+// Numbers are kept as json.Number, so that large integers do not lose
+// precision.
+type JSONParam struct {
+	Val interface{}
+}
+
+func (j *JSONParam) UnmarshalPipelineParam(val interface{}) error {
+	var bs []byte
+	switch v := val.(type) {
+	case nil:
+		*j = JSONParam{}
+		return nil
+	case string:
+		bs = []byte(v)
+	case []byte:
+		bs = v
+	default:
+		var err error
+		bs, err = json.Marshal(replaceBytesWithHex(v))
+		if err != nil {
+			return errors.Wrapf(ErrBadInput, "cannot convert %T to JSON: %v", val, err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return errors.Wrapf(ErrBadInput, "invalid JSON: %v", err)
+	}
+	if decoder.More() {
+		return errors.Wrap(ErrBadInput, "invalid JSON: unexpected data after top-level value")
+	}
+	*j = JSONParam{Val: decoded}
+	return nil
+}
+
 type JSONPathParam []string
 
 // NewJSONPathParam returns a new JSONPathParam using the given separator, or the default if empty.
@@ -18,6 +18,8 @@ var (
 
 type Vars struct {
 	vars map[string]interface{}
+	// secrets resolves $(secrets.name), if a secret store is available
+	secrets *runSecrets
 }
 
 // NewVarsFrom creates new Vars from the given map.
@@ -42,6 +44,13 @@ func (vars Vars) Get(keypathStr string) (interface{}, error) {
 		return nil, ErrVarsRoot
 	}
 
+	if keypath.Part0 == secretsVarsKey && vars.secrets != nil {
+		if keypath.NumParts != 2 {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "secrets must be referenced by name / keypath %v", keypathStr)
+		}
+		return vars.secrets.get(keypath.Part1)
+	}
+
 	var val interface{}
 	var exists bool
 
@@ -98,5 +107,7 @@ func (vars Vars) Copy() Vars {
 	for k, v := range vars.vars {
 		newVars[k] = v
 	}
-	return NewVarsFrom(newVars)
+	copied := NewVarsFrom(newVars)
+	copied.secrets = vars.secrets
+	return copied
 }
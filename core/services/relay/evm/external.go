@@ -0,0 +1,60 @@
+package evm
+
+import (
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ExternalProvider provides the components common to all OCR2 plugins, for plugins which run outside the node.
+type ExternalProvider interface {
+	relaytypes.Plugin
+}
+
+// ExternalRelayer contains the relayer and instantiating function for external plugin providers.
+type ExternalRelayer interface {
+	relaytypes.Relayer
+	NewExternalProvider(rargs relaytypes.RelayArgs, pargs relaytypes.PluginArgs) (ExternalProvider, error)
+}
+
+// Relayer with added external provider function.
+type externalRelayer struct {
+	*Relayer
+}
+
+var _ ExternalRelayer = (*externalRelayer)(nil)
+
+// NewExternalRelayer returns an ExternalRelayer, or false if relayer is not an EVM relayer.
+func NewExternalRelayer(relayer interface{}) (ExternalRelayer, bool) {
+	r, ok := relayer.(*Relayer)
+	if !ok {
+		return nil, false
+	}
+	return &externalRelayer{r}, true
+}
+
+type externalProvider struct {
+	*configWatcher
+	contractTransmitter *ContractTransmitter
+}
+
+var _ ExternalProvider = (*externalProvider)(nil)
+
+func (r *externalRelayer) NewExternalProvider(rargs relaytypes.RelayArgs, pargs relaytypes.PluginArgs) (ExternalProvider, error) {
+	configWatcher, err := newConfigProvider(r.lggr, r.chainSet, rargs)
+	if err != nil {
+		return nil, err
+	}
+	contractTransmitter, err := newContractTransmitter(r.lggr, rargs, pargs.TransmitterID, configWatcher)
+	if err != nil {
+		return nil, err
+	}
+	return &externalProvider{
+		configWatcher:       configWatcher,
+		contractTransmitter: contractTransmitter,
+	}, nil
+}
+
+func (c *externalProvider) ContractTransmitter() types.ContractTransmitter {
+	return c.contractTransmitter
+}
@@ -86,4 +86,13 @@ type (
 
 		Checkable
 	}
+
+	// Warmer is implemented by services which can do part of their startup
+	// work ahead of Start, e.g. dialing remote connections while the node is on
+	// standby in high availability mode. Warm must not have side effects that
+	// are visible to other nodes sharing the database, and Start must still
+	// succeed after Warm has been called.
+	Warmer interface {
+		Warm(context.Context) error
+	}
 )
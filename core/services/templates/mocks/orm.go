@@ -0,0 +1,280 @@
+// Code generated by mockery v2.13.0-beta.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+	templates "github.com/smartcontractkit/chainlink/core/services/templates"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ORM is an autogenerated mock type for the ORM type
+type ORM struct {
+	mock.Mock
+}
+
+// CountJobTemplates provides a mock function with given fields: name, qopts
+func (_m *ORM) CountJobTemplates(name string, qopts ...pg.QOpt) (int, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) int); ok {
+		r0 = rf(name, qopts...)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTemplate provides a mock function with given fields: t, qopts
+func (_m *ORM) CreateTemplate(t *templates.Template, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, t)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*templates.Template, ...pg.QOpt) error); ok {
+		r0 = rf(t, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTemplate provides a mock function with given fields: name, qopts
+func (_m *ORM) DeleteTemplate(name string, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) error); ok {
+		r0 = rf(name, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindJobTemplates provides a mock function with given fields: name, qopts
+func (_m *ORM) FindJobTemplates(name string, qopts ...pg.QOpt) ([]templates.JobTemplate, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []templates.JobTemplate
+	if rf, ok := ret.Get(0).(func(string, ...pg.QOpt) []templates.JobTemplate); ok {
+		r0 = rf(name, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.JobTemplate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplate provides a mock function with given fields: name, version, qopts
+func (_m *ORM) FindTemplate(name string, version int32, qopts ...pg.QOpt) (templates.Template, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 templates.Template
+	if rf, ok := ret.Get(0).(func(string, int32, ...pg.QOpt) templates.Template); ok {
+		r0 = rf(name, version, qopts...)
+	} else {
+		r0 = ret.Get(0).(templates.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int32, ...pg.QOpt) error); ok {
+		r1 = rf(name, version, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplateByID provides a mock function with given fields: id, qopts
+func (_m *ORM) FindTemplateByID(id int64, qopts ...pg.QOpt) (templates.Template, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 templates.Template
+	if rf, ok := ret.Get(0).(func(int64, ...pg.QOpt) templates.Template); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Get(0).(templates.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, ...pg.QOpt) error); ok {
+		r1 = rf(id, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplateVersions provides a mock function with given fields: name
+func (_m *ORM) FindTemplateVersions(name string) ([]templates.Template, error) {
+	ret := _m.Called(name)
+
+	var r0 []templates.Template
+	if rf, ok := ret.Get(0).(func(string) []templates.Template); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTemplates provides a mock function with given fields: offset, limit
+func (_m *ORM) FindTemplates(offset int, limit int) ([]templates.Template, int, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []templates.Template
+	if rf, ok := ret.Get(0).(func(int, int) []templates.Template); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.Template)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// LinkJob provides a mock function with given fields: link, qopts
+func (_m *ORM) LinkJob(link *templates.JobTemplate, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, link)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*templates.JobTemplate, ...pg.QOpt) error); ok {
+		r0 = rf(link, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnlinkJob provides a mock function with given fields: jobID, qopts
+func (_m *ORM) UnlinkJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type NewORMT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewORM(t NewORMT) *ORM {
+	mock := &ORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
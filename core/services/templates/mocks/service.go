@@ -0,0 +1,245 @@
+// Code generated by mockery v2.13.0-beta.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	job "github.com/smartcontractkit/chainlink/core/services/job"
+	mock "github.com/stretchr/testify/mock"
+
+	templates "github.com/smartcontractkit/chainlink/core/services/templates"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// CreateJob provides a mock function with given fields: ctx, jb, link
+func (_m *Service) CreateJob(ctx context.Context, jb *job.Job, link templates.JobTemplate) error {
+	ret := _m.Called(ctx, jb, link)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, templates.JobTemplate) error); ok {
+		r0 = rf(ctx, jb, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTemplate provides a mock function with given fields: ctx, spec
+func (_m *Service) CreateTemplate(ctx context.Context, spec string) (templates.Template, error) {
+	ret := _m.Called(ctx, spec)
+
+	var r0 templates.Template
+	if rf, ok := ret.Get(0).(func(context.Context, string) templates.Template); ok {
+		r0 = rf(ctx, spec)
+	} else {
+		r0 = ret.Get(0).(templates.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTemplate provides a mock function with given fields: ctx, name
+func (_m *Service) DeleteTemplate(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExpandJobSpec provides a mock function with given fields: spec
+func (_m *Service) ExpandJobSpec(spec string) (string, *templates.JobTemplate, error) {
+	ret := _m.Called(spec)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 *templates.JobTemplate
+	if rf, ok := ret.Get(1).(func(string) *templates.JobTemplate); ok {
+		r1 = rf(spec)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*templates.JobTemplate)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(spec)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTemplate provides a mock function with given fields: name, version
+func (_m *Service) GetTemplate(name string, version int32) (templates.Template, error) {
+	ret := _m.Called(name, version)
+
+	var r0 templates.Template
+	if rf, ok := ret.Get(0).(func(string, int32) templates.Template); ok {
+		r0 = rf(name, version)
+	} else {
+		r0 = ret.Get(0).(templates.Template)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemplateVersions provides a mock function with given fields: name
+func (_m *Service) ListTemplateVersions(name string) ([]templates.Template, error) {
+	ret := _m.Called(name)
+
+	var r0 []templates.Template
+	if rf, ok := ret.Get(0).(func(string) []templates.Template); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTemplates provides a mock function with given fields: offset, limit
+func (_m *Service) ListTemplates(offset int, limit int) ([]templates.Template, int, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []templates.Template
+	if rf, ok := ret.Get(0).(func(int, int) []templates.Template); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.Template)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PlanRollForward provides a mock function with given fields: ctx, name, version
+func (_m *Service) PlanRollForward(ctx context.Context, name string, version int32) ([]templates.JobChange, error) {
+	ret := _m.Called(ctx, name, version)
+
+	var r0 []templates.JobChange
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []templates.JobChange); ok {
+		r0 = rf(ctx, name, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.JobChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollForward provides a mock function with given fields: ctx, name, version, jobIDs
+func (_m *Service) RollForward(ctx context.Context, name string, version int32, jobIDs []int32) ([]templates.JobChange, error) {
+	ret := _m.Called(ctx, name, version, jobIDs)
+
+	var r0 []templates.JobChange
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, []int32) []templates.JobChange); ok {
+		r0 = rf(ctx, name, version, jobIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]templates.JobChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, []int32) error); ok {
+		r1 = rf(ctx, name, version, jobIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateJob provides a mock function with given fields: ctx, jb, spec, link
+func (_m *Service) UpdateJob(ctx context.Context, jb *job.Job, spec string, link *templates.JobTemplate) error {
+	ret := _m.Called(ctx, jb, spec, link)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *job.Job, string, *templates.JobTemplate) error); ok {
+		r0 = rf(ctx, jb, spec, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type NewServiceT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewService(t NewServiceT) *Service {
+	mock := &Service{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ParamType is the type of a template parameter. Arguments are validated
+// against it before they are substituted into the template source.
+type ParamType string
+
+const (
+	// ParamTypeString accepts any text which cannot break out of a quoted
+	// DOT attribute, i.e. without quotes, angle brackets, backslashes or
+	// line breaks.
+	ParamTypeString ParamType = "string"
+	// ParamTypeBridge accepts a valid bridge name.
+	ParamTypeBridge ParamType = "bridge"
+	// ParamTypeInt accepts a base 10 integer.
+	ParamTypeInt ParamType = "int"
+	// ParamTypeDecimal accepts a decimal number.
+	ParamTypeDecimal ParamType = "decimal"
+	// ParamTypeBool accepts true or false.
+	ParamTypeBool ParamType = "bool"
+)
+
+// Param is a typed parameter of a Template.
+type Param struct {
+	Name        string    `json:"name" toml:"name"`
+	Type        ParamType `json:"type" toml:"type"`
+	Description string    `json:"description,omitempty" toml:"description"`
+	// Default is used when a job does not provide an argument. Parameters
+	// without a default are required.
+	Default *string `json:"default,omitempty" toml:"default"`
+}
+
+// Params is the list of parameters of a Template, stored as JSON.
+type Params []Param
+
+func (p Params) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(p)
+}
+
+func (p *Params) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, p)
+}
+
+// Args are the arguments a job passes to a Template, keyed by parameter name.
+type Args map[string]string
+
+func (a Args) Value() (driver.Value, error) {
+	if a == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *Args) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, a)
+}
+
+// Template is an immutable version of a named, parameterized pipeline DOT
+// source. Creating a template with an existing name adds a new version.
+type Template struct {
+	ID          int64
+	Name        string
+	Version     int32
+	Description string
+	Params      Params
+	Source      string
+	CreatedAt   time.Time
+}
+
+// JobTemplate links a job to the template version its pipeline was rendered
+// from, along with the arguments used.
+type JobTemplate struct {
+	JobID              int32
+	PipelineTemplateID int64
+	Args               Args
+	// Populated from the template
+	Name    string
+	Version int32
+}
+
+// JobChange describes how rolling a job forward to another template version
+// changes its pipeline.
+type JobChange struct {
+	JobID       int32
+	JobName     string
+	FromVersion int32
+	ToVersion   int32
+	// Diff is a unified diff of the job's current and new pipeline source.
+	Diff string
+	// RolledForward is set once the job was updated to the new version.
+	RolledForward bool
+	// Error is set if the job cannot be rolled forward, e.g. when its
+	// arguments do not satisfy the parameters of the new version.
+	Error string
+}
@@ -0,0 +1,152 @@
+package templates
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+type ORM interface {
+	CreateTemplate(t *Template, qopts ...pg.QOpt) error
+	FindTemplate(name string, version int32, qopts ...pg.QOpt) (Template, error)
+	FindTemplateByID(id int64, qopts ...pg.QOpt) (Template, error)
+	FindTemplates(offset, limit int) ([]Template, int, error)
+	FindTemplateVersions(name string) ([]Template, error)
+	DeleteTemplate(name string, qopts ...pg.QOpt) error
+
+	LinkJob(link *JobTemplate, qopts ...pg.QOpt) error
+	UnlinkJob(jobID int32, qopts ...pg.QOpt) error
+	FindJobTemplates(name string, qopts ...pg.QOpt) ([]JobTemplate, error)
+	CountJobTemplates(name string, qopts ...pg.QOpt) (int, error)
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *orm {
+	return &orm{pg.NewQ(db, lggr, cfg)}
+}
+
+// CreateTemplate stores a new version of the template, the version number is
+// assigned in sequence for each name.
+func (o *orm) CreateTemplate(t *Template, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.Transaction(func(tx pg.Queryer) error {
+		// Serialize version assignment for the same name
+		if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('pipeline_templates:' || $1))`, t.Name); err != nil {
+			return errors.Wrap(err, "failed to lock template name")
+		}
+		sql := `INSERT INTO pipeline_templates (name, version, description, params, source, created_at)
+		VALUES ($1, (SELECT COALESCE(MAX(version), 0) + 1 FROM pipeline_templates WHERE name = $1), $2, $3, $4, NOW())
+		RETURNING *;`
+		return errors.Wrap(tx.Get(t, sql, t.Name, t.Description, t.Params, t.Source), "failed to insert pipeline template")
+	})
+}
+
+// FindTemplate returns the given version of a template, or the latest
+// version if version is 0.
+func (o *orm) FindTemplate(name string, version int32, qopts ...pg.QOpt) (t Template, err error) {
+	q := o.q.WithOpts(qopts...)
+	if version == 0 {
+		err = q.Get(&t, `SELECT * FROM pipeline_templates WHERE name = $1 ORDER BY version DESC LIMIT 1`, name)
+	} else {
+		err = q.Get(&t, `SELECT * FROM pipeline_templates WHERE name = $1 AND version = $2`, name, version)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, ErrTemplateNotFound
+	}
+	return t, err
+}
+
+// FindTemplateByID returns a template version by ID.
+func (o *orm) FindTemplateByID(id int64, qopts ...pg.QOpt) (t Template, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Get(&t, `SELECT * FROM pipeline_templates WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return t, ErrTemplateNotFound
+	}
+	return t, err
+}
+
+// FindTemplates returns the latest version of every template, ordered by name.
+func (o *orm) FindTemplates(offset, limit int) (ts []Template, count int, err error) {
+	sql := `SELECT count(DISTINCT name) FROM pipeline_templates`
+	if err = o.q.Get(&count, sql); err != nil {
+		return
+	}
+
+	sql = `SELECT DISTINCT ON (name) * FROM pipeline_templates ORDER BY name, version DESC LIMIT $1 OFFSET $2`
+	if err = o.q.Select(&ts, sql, limit, offset); err != nil {
+		return
+	}
+	return
+}
+
+// FindTemplateVersions returns all versions of a template, newest first.
+func (o *orm) FindTemplateVersions(name string) (ts []Template, err error) {
+	err = o.q.Select(&ts, `SELECT * FROM pipeline_templates WHERE name = $1 ORDER BY version DESC`, name)
+	return
+}
+
+// DeleteTemplate removes all versions of a template. Templates which are
+// still used by jobs cannot be deleted.
+func (o *orm) DeleteTemplate(name string, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	result, err := q.Exec(`DELETE FROM pipeline_templates WHERE name = $1`, name)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrTemplateNotFound
+	}
+	return nil
+}
+
+// LinkJob records the template version a job was rendered from, replacing any
+// previous link of the job.
+func (o *orm) LinkJob(link *JobTemplate, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `INSERT INTO job_pipeline_templates (job_id, pipeline_template_id, args) VALUES ($1, $2, $3)
+	ON CONFLICT (job_id) DO UPDATE SET pipeline_template_id = EXCLUDED.pipeline_template_id, args = EXCLUDED.args;`
+	return q.ExecQ(sql, link.JobID, link.PipelineTemplateID, link.Args)
+}
+
+// UnlinkJob removes the template link of a job, if any.
+func (o *orm) UnlinkJob(jobID int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	return q.ExecQ(`DELETE FROM job_pipeline_templates WHERE job_id = $1`, jobID)
+}
+
+// FindJobTemplates returns the links of all jobs using any version of a
+// template, ordered by job ID.
+func (o *orm) FindJobTemplates(name string, qopts ...pg.QOpt) (links []JobTemplate, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT jpt.*, pt.name, pt.version FROM job_pipeline_templates jpt
+	JOIN pipeline_templates pt ON pt.id = jpt.pipeline_template_id
+	WHERE pt.name = $1 ORDER BY jpt.job_id`
+	err = q.Select(&links, sql, name)
+	return
+}
+
+// CountJobTemplates returns the number of jobs using any version of a template.
+func (o *orm) CountJobTemplates(name string, qopts ...pg.QOpt) (count int, err error) {
+	q := o.q.WithOpts(qopts...)
+	sql := `SELECT count(*) FROM job_pipeline_templates jpt
+	JOIN pipeline_templates pt ON pt.id = jpt.pipeline_template_id
+	WHERE pt.name = $1`
+	err = q.Get(&count, sql, name)
+	return
+}
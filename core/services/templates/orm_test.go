@@ -0,0 +1,118 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+)
+
+const memoTemplateSpec = `
+name = "memo"
+source = """
+ds [type=memo value="{{ value }}"]
+"""
+[[params]]
+name = "value"
+type = "string"
+`
+
+func mustParseTemplate(t *testing.T, spec string) templates.Template {
+	tmpl, err := templates.ParseTemplateSpec(spec)
+	require.NoError(t, err)
+	return tmpl
+}
+
+func TestORM_TemplateVersions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := templates.NewORM(db, logger.TestLogger(t), cfg)
+
+	v1 := mustParseTemplate(t, memoTemplateSpec)
+	require.NoError(t, orm.CreateTemplate(&v1))
+	assert.Equal(t, int32(1), v1.Version)
+	v2 := mustParseTemplate(t, memoTemplateSpec)
+	require.NoError(t, orm.CreateTemplate(&v2))
+	assert.Equal(t, int32(2), v2.Version)
+	feed := mustParseTemplate(t, feedTemplateSpec)
+	require.NoError(t, orm.CreateTemplate(&feed))
+	assert.Equal(t, int32(1), feed.Version, "versions are numbered per name")
+
+	t.Run("finds a version", func(t *testing.T) {
+		found, err := orm.FindTemplate("memo", 1)
+		require.NoError(t, err)
+		assert.Equal(t, v1.ID, found.ID)
+
+		found, err = orm.FindTemplateByID(v2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), found.Version)
+
+		_, err = orm.FindTemplate("memo", 3)
+		assert.ErrorIs(t, err, templates.ErrTemplateNotFound)
+		_, err = orm.FindTemplate("unknown", 0)
+		assert.ErrorIs(t, err, templates.ErrTemplateNotFound)
+	})
+
+	t.Run("finds the latest version", func(t *testing.T) {
+		found, err := orm.FindTemplate("memo", 0)
+		require.NoError(t, err)
+		assert.Equal(t, v2.ID, found.ID)
+
+		ts, count, err := orm.FindTemplates(0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, ts, 2)
+		assert.Equal(t, v2.ID, ts[0].ID)
+		assert.Equal(t, feed.ID, ts[1].ID)
+	})
+
+	t.Run("finds all versions", func(t *testing.T) {
+		ts, err := orm.FindTemplateVersions("memo")
+		require.NoError(t, err)
+		require.Len(t, ts, 2)
+		assert.Equal(t, int32(2), ts[0].Version)
+		assert.Equal(t, int32(1), ts[1].Version)
+	})
+
+	t.Run("links jobs to a version", func(t *testing.T) {
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+		require.NoError(t, orm.LinkJob(&templates.JobTemplate{JobID: jb.ID, PipelineTemplateID: v1.ID, Args: templates.Args{"value": "a"}}))
+		links, err := orm.FindJobTemplates("memo")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, int32(1), links[0].Version)
+		assert.Equal(t, templates.Args{"value": "a"}, links[0].Args)
+
+		// Linking again replaces the previous link
+		require.NoError(t, orm.LinkJob(&templates.JobTemplate{JobID: jb.ID, PipelineTemplateID: v2.ID, Args: templates.Args{"value": "b"}}))
+		links, err = orm.FindJobTemplates("memo")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, int32(2), links[0].Version)
+		assert.Equal(t, templates.Args{"value": "b"}, links[0].Args)
+
+		count, err := orm.CountJobTemplates("memo")
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		require.NoError(t, orm.UnlinkJob(jb.ID))
+		count, err = orm.CountJobTemplates("memo")
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("deletes all versions", func(t *testing.T) {
+		require.NoError(t, orm.DeleteTemplate("memo"))
+		_, err := orm.FindTemplate("memo", 0)
+		assert.ErrorIs(t, err, templates.ErrTemplateNotFound)
+		assert.ErrorIs(t, orm.DeleteTemplate("memo"), templates.ErrTemplateNotFound)
+	})
+}
@@ -0,0 +1,304 @@
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+const (
+	// JobSpecKey is the job spec table referencing a template, e.g.
+	//
+	//   [observationSourceTemplate]
+	//   name = "ocr-feed"
+	//   version = 2 # optional, defaults to the latest version
+	//   [observationSourceTemplate.args]
+	//   bridge = "coinmetrics"
+	JobSpecKey = "observationSourceTemplate"
+
+	observationSourceKey = "observationSource"
+)
+
+var (
+	// ErrTemplateNotFound is returned when a template or version does not exist.
+	ErrTemplateNotFound = errors.New("pipeline template not found")
+
+	nameRegexp        = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	paramNameRegexp   = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	placeholderRegexp = regexp.MustCompile(`\{\{\s*([a-zA-Z_][a-zA-Z0-9_]*)\s*\}\}`)
+
+	// sampleArgs are substituted for parameters without a default, so that
+	// the source of a new template can be checked for DOT syntax errors.
+	sampleArgs = map[ParamType]string{
+		ParamTypeString:  "sample",
+		ParamTypeBridge:  "sample",
+		ParamTypeInt:     "1",
+		ParamTypeDecimal: "1.5",
+		ParamTypeBool:    "true",
+	}
+)
+
+// templateRef is the TOML representation of a reference from a job spec to
+// a template. A zero Version refers to the latest version of the template.
+type templateRef struct {
+	Name    string `toml:"name"`
+	Version int32  `toml:"version"`
+}
+
+// templateSpec is the TOML representation of a template.
+type templateSpec struct {
+	Name        string `toml:"name"`
+	Description string `toml:"description"`
+	Params      Params `toml:"params"`
+	Source      string `toml:"source"`
+}
+
+// ParseTemplateSpec parses and validates a template from TOML, e.g.
+//
+//   name = "ocr-feed"
+//   source = """
+//   ds [type=bridge name="{{ bridge }}"]
+//   ds_parse [type=jsonparse path="{{ path }}"]
+//   ds -> ds_parse
+//   """
+//   [[params]]
+//   name = "bridge"
+//   type = "bridge"
+//   [[params]]
+//   name = "path"
+//   type = "string"
+//   default = "data,result"
+//
+// The version of the returned template is not set.
+func ParseTemplateSpec(ts string) (Template, error) {
+	var spec templateSpec
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return Template{}, errors.Wrap(err, "failed to parse TOML")
+	}
+	if err = tree.Unmarshal(&spec); err != nil {
+		return Template{}, errors.Wrap(err, "failed to parse TOML")
+	}
+
+	t := Template{
+		Name:        strings.TrimSpace(spec.Name),
+		Description: spec.Description,
+		Params:      spec.Params,
+		Source:      spec.Source,
+	}
+	return t, Validate(t)
+}
+
+// Validate checks that the template is well formed: parameters have valid,
+// unique names and known types, every placeholder refers to a parameter, and
+// the source renders to a valid pipeline.
+func Validate(t Template) (err error) {
+	if !nameRegexp.MatchString(t.Name) {
+		err = multierr.Append(err, errors.Errorf("name %q must only contain letters, digits, '-' and '_'", t.Name))
+	}
+	if strings.TrimSpace(t.Source) == "" {
+		err = multierr.Append(err, errors.New("source must not be empty"))
+	}
+
+	args := make(Args, len(t.Params))
+	for _, p := range t.Params {
+		if !paramNameRegexp.MatchString(p.Name) {
+			err = multierr.Append(err, errors.Errorf("param %q: invalid name", p.Name))
+			continue
+		}
+		if _, exists := args[p.Name]; exists {
+			err = multierr.Append(err, errors.Errorf("param %q: declared more than once", p.Name))
+			continue
+		}
+		sample, known := sampleArgs[p.Type]
+		if !known {
+			err = multierr.Append(err, errors.Errorf("param %q: unknown type %q", p.Name, p.Type))
+			continue
+		}
+		if p.Default != nil {
+			if verr := validateArg(p.Type, *p.Default); verr != nil {
+				err = multierr.Append(err, errors.Wrapf(verr, "param %q: invalid default", p.Name))
+				continue
+			}
+			sample = *p.Default
+		}
+		args[p.Name] = sample
+	}
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(t.Source, -1) {
+		if _, declared := args[match[1]]; !declared {
+			err = multierr.Append(err, errors.Errorf("placeholder %q does not refer to a param", match[0]))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	source, err := t.Render(args)
+	if err != nil {
+		return err
+	}
+	if _, err = pipeline.Parse(source); err != nil {
+		return errors.Wrap(err, "invalid pipeline source")
+	}
+	return nil
+}
+
+// Render substitutes args into the template source. Every parameter without
+// a default must be given, and arguments must match the parameter types.
+func (t Template) Render(args Args) (string, error) {
+	values := make(map[string]string, len(t.Params))
+	var err error
+	for _, p := range t.Params {
+		value, given := args[p.Name]
+		if !given {
+			if p.Default == nil {
+				err = multierr.Append(err, errors.Errorf("missing argument %q", p.Name))
+				continue
+			}
+			value = *p.Default
+		}
+		if verr := validateArg(p.Type, value); verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "argument %q", p.Name))
+			continue
+		}
+		values[p.Name] = value
+	}
+
+	var unknown []string
+	for name := range args {
+		if !t.hasParam(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		err = multierr.Append(err, errors.Errorf("unknown argument %q", name))
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "pipeline template %s v%d", t.Name, t.Version)
+	}
+
+	return placeholderRegexp.ReplaceAllStringFunc(t.Source, func(placeholder string) string {
+		return values[placeholderRegexp.FindStringSubmatch(placeholder)[1]]
+	}), nil
+}
+
+func (t Template) hasParam(name string) bool {
+	for _, p := range t.Params {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func validateArg(typ ParamType, value string) error {
+	switch typ {
+	case ParamTypeString:
+		if strings.ContainsAny(value, "\"<>\\\r\n") {
+			return errors.Errorf("%q must not contain quotes, angle brackets, backslashes or line breaks", value)
+		}
+	case ParamTypeBridge:
+		if _, err := bridges.ParseBridgeName(value); err != nil {
+			return err
+		}
+	case ParamTypeInt:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.Errorf("%q is not an integer", value)
+		}
+	case ParamTypeDecimal:
+		if _, err := decimal.NewFromString(value); err != nil {
+			return errors.Errorf("%q is not a decimal", value)
+		}
+	case ParamTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.Errorf("%q is not a bool", value)
+		}
+	default:
+		return errors.Errorf("unknown type %q", typ)
+	}
+	return nil
+}
+
+// ExpandJobSpec replaces a template reference in a job spec with the
+// rendered observationSource. The template is looked up with find. The
+// returned JobTemplate describes the template the job is linked to, its
+// JobID is not set. If the spec does not reference a template, it is
+// returned unchanged with a nil JobTemplate.
+func ExpandJobSpec(ts string, find func(name string, version int32) (Template, error)) (string, *JobTemplate, error) {
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return "", nil, err
+	}
+	if !tree.Has(JobSpecKey) {
+		return ts, nil, nil
+	}
+	if tree.Has(observationSourceKey) {
+		return "", nil, errors.Errorf("%s and %s are mutually exclusive", observationSourceKey, JobSpecKey)
+	}
+
+	refTree, ok := tree.Get(JobSpecKey).(*toml.Tree)
+	if !ok {
+		return "", nil, errors.Errorf("%s must be a table", JobSpecKey)
+	}
+	var ref templateRef
+	if err = refTree.Unmarshal(&ref); err != nil {
+		return "", nil, errors.Wrap(err, JobSpecKey)
+	}
+	// TOML scalars of any type are accepted as arguments
+	args := Args{}
+	if argsTree, isTree := refTree.Get("args").(*toml.Tree); isTree {
+		for name, value := range argsTree.ToMap() {
+			args[name] = fmt.Sprint(value)
+		}
+	}
+
+	t, err := find(ref.Name, ref.Version)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "%s %s", JobSpecKey, ref.Name)
+	}
+	source, err := t.Render(args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err = tree.Delete(JobSpecKey); err != nil {
+		return "", nil, err
+	}
+	tree.SetWithOptions(observationSourceKey, toml.SetOptions{Multiline: true}, source)
+	expanded, err := tree.ToTomlString()
+	if err != nil {
+		return "", nil, err
+	}
+	return expanded, &JobTemplate{
+		PipelineTemplateID: t.ID,
+		Args:               args,
+		Name:               t.Name,
+		Version:            t.Version,
+	}, nil
+}
+
+// PinJobSpec sets the version of the template referenced by a job spec, so
+// that the spec keeps rendering the same pipeline once newer versions exist.
+func PinJobSpec(ts string, version int32) (string, error) {
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return "", err
+	}
+	refTree, ok := tree.Get(JobSpecKey).(*toml.Tree)
+	if !ok {
+		return "", errors.Errorf("spec does not reference a pipeline template in %s", JobSpecKey)
+	}
+	refTree.Set("version", int64(version))
+	return tree.ToTomlString()
+}
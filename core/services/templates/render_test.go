@@ -0,0 +1,248 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+)
+
+const feedTemplateSpec = `
+name = "ocr-feed"
+description = "Bridge with a parsed and multiplied result"
+source = """
+ds [type=bridge name="{{ bridge }}"]
+ds_parse [type=jsonparse path="{{ path }}"]
+ds_multiply [type=multiply times={{times}}]
+ds -> ds_parse -> ds_multiply
+"""
+[[params]]
+name = "bridge"
+type = "bridge"
+[[params]]
+name = "path"
+type = "string"
+default = "data,result"
+[[params]]
+name = "times"
+type = "int"
+default = "100"
+`
+
+func TestParseTemplateSpec(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := templates.ParseTemplateSpec(feedTemplateSpec)
+	require.NoError(t, err)
+	assert.Equal(t, "ocr-feed", tmpl.Name)
+	assert.Equal(t, "Bridge with a parsed and multiplied result", tmpl.Description)
+	require.Len(t, tmpl.Params, 3)
+	assert.Equal(t, templates.ParamTypeBridge, tmpl.Params[0].Type)
+	assert.Nil(t, tmpl.Params[0].Default)
+	require.NotNil(t, tmpl.Params[1].Default)
+	assert.Equal(t, "data,result", *tmpl.Params[1].Default)
+
+	tests := []struct {
+		name   string
+		spec   string
+		errMsg string
+	}{
+		{"invalid name", `name = "a b"
+source = "ds [type=memo value=1]"`, "must only contain"},
+		{"empty source", `name = "a"`, "source must not be empty"},
+		{"unknown type", `name = "a"
+source = "ds [type=memo value=1]"
+[[params]]
+name = "x"
+type = "float"`, `unknown type "float"`},
+		{"duplicate param", `name = "a"
+source = "ds [type=memo value={{x}}]"
+[[params]]
+name = "x"
+type = "int"
+[[params]]
+name = "x"
+type = "int"`, "declared more than once"},
+		{"invalid default", `name = "a"
+source = "ds [type=memo value={{x}}]"
+[[params]]
+name = "x"
+type = "int"
+default = "one"`, "invalid default"},
+		{"undeclared placeholder", `name = "a"
+source = "ds [type=memo value={{x}}]"`, "does not refer to a param"},
+		{"invalid pipeline", `name = "a"
+source = "ds [type=nonexistent]"`, "invalid pipeline source"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := templates.ParseTemplateSpec(tt.spec)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := templates.ParseTemplateSpec(feedTemplateSpec)
+	require.NoError(t, err)
+
+	t.Run("applies defaults", func(t *testing.T) {
+		source, err := tmpl.Render(templates.Args{"bridge": "coinmetrics"})
+		require.NoError(t, err)
+		assert.Contains(t, source, `ds [type=bridge name="coinmetrics"]`)
+		assert.Contains(t, source, `path="data,result"`)
+		assert.Contains(t, source, `times=100`)
+	})
+
+	t.Run("overrides defaults", func(t *testing.T) {
+		source, err := tmpl.Render(templates.Args{"bridge": "coinmetrics", "path": "price", "times": "1000"})
+		require.NoError(t, err)
+		assert.Contains(t, source, `path="price"`)
+		assert.Contains(t, source, `times=1000`)
+	})
+
+	tests := []struct {
+		name   string
+		args   templates.Args
+		errMsg string
+	}{
+		{"missing argument", templates.Args{}, `missing argument "bridge"`},
+		{"unknown argument", templates.Args{"bridge": "a", "foo": "bar"}, `unknown argument "foo"`},
+		{"invalid bridge", templates.Args{"bridge": "a b"}, `argument "bridge"`},
+		{"invalid int", templates.Args{"bridge": "a", "times": "1.5"}, "is not an integer"},
+		{"quote injection", templates.Args{"bridge": "a", "path": `x"] evil [type=http`}, "must not contain quotes"},
+		{"line break injection", templates.Args{"bridge": "a", "path": "x\nevil"}, "line breaks"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tmpl.Render(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestExpandJobSpec(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := templates.ParseTemplateSpec(feedTemplateSpec)
+	require.NoError(t, err)
+	tmpl.ID = 7
+	tmpl.Version = 2
+
+	var found struct {
+		name    string
+		version int32
+	}
+	find := func(name string, version int32) (templates.Template, error) {
+		found.name, found.version = name, version
+		if name != tmpl.Name {
+			return templates.Template{}, templates.ErrTemplateNotFound
+		}
+		return tmpl, nil
+	}
+
+	t.Run("without reference", func(t *testing.T) {
+		spec := `type = "webhook"
+schemaVersion = 1
+observationSource = "ds [type=memo value=1]"`
+		expanded, link, err := templates.ExpandJobSpec(spec, find)
+		require.NoError(t, err)
+		assert.Nil(t, link)
+		assert.Equal(t, spec, expanded)
+	})
+
+	t.Run("renders the template", func(t *testing.T) {
+		spec := `type = "webhook"
+schemaVersion = 1
+[observationSourceTemplate]
+name = "ocr-feed"
+version = 2
+[observationSourceTemplate.args]
+bridge = "coinmetrics"
+times = 1000`
+		expanded, link, err := templates.ExpandJobSpec(spec, find)
+		require.NoError(t, err)
+		assert.Equal(t, "ocr-feed", found.name)
+		assert.Equal(t, int32(2), found.version)
+
+		require.NotNil(t, link)
+		assert.Equal(t, int64(7), link.PipelineTemplateID)
+		assert.Equal(t, templates.Args{"bridge": "coinmetrics", "times": "1000"}, link.Args)
+
+		tree, err := toml.Load(expanded)
+		require.NoError(t, err)
+		assert.False(t, tree.Has(templates.JobSpecKey))
+		assert.Equal(t, "webhook", tree.Get("type"))
+		source, ok := tree.Get("observationSource").(string)
+		require.True(t, ok)
+		assert.Contains(t, source, `ds [type=bridge name="coinmetrics"]`)
+		assert.Contains(t, source, `times=1000`)
+	})
+
+	t.Run("latest version", func(t *testing.T) {
+		spec := `type = "webhook"
+[observationSourceTemplate]
+name = "ocr-feed"
+args = { bridge = "coinmetrics" }`
+		_, _, err := templates.ExpandJobSpec(spec, find)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), found.version)
+	})
+
+	tests := []struct {
+		name   string
+		spec   string
+		errMsg string
+	}{
+		{"both sources", `observationSource = "ds [type=memo value=1]"
+[observationSourceTemplate]
+name = "ocr-feed"`, "mutually exclusive"},
+		{"not a table", `observationSourceTemplate = "ocr-feed"`, "must be a table"},
+		{"unknown template", `[observationSourceTemplate]
+name = "other"`, "pipeline template not found"},
+		{"invalid arguments", `[observationSourceTemplate]
+name = "ocr-feed"`, `missing argument "bridge"`},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := templates.ExpandJobSpec(tt.spec, find)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestPinJobSpec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sets the version", func(t *testing.T) {
+		spec := `type = "webhook"
+[observationSourceTemplate]
+name = "ocr-feed"
+[observationSourceTemplate.args]
+bridge = "coinmetrics"`
+		pinned, err := templates.PinJobSpec(spec, 3)
+		require.NoError(t, err)
+
+		tree, err := toml.Load(pinned)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), tree.Get("observationSourceTemplate.version"))
+		assert.Equal(t, "ocr-feed", tree.Get("observationSourceTemplate.name"))
+		assert.Equal(t, "coinmetrics", tree.Get("observationSourceTemplate.args.bridge"))
+	})
+
+	t.Run("without reference", func(t *testing.T) {
+		_, err := templates.PinJobSpec(`type = "webhook"`, 3)
+		assert.Error(t, err)
+	})
+}
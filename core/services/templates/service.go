@@ -0,0 +1,319 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+//go:generate mockery --name Service --output ./mocks/ --case=underscore
+
+var (
+	// ErrTemplateInUse is returned when deleting a template still used by jobs.
+	ErrTemplateInUse = errors.New("pipeline template is used by jobs, roll them to another template or delete them first")
+)
+
+// Service manages pipeline templates and the jobs created from them.
+type Service interface {
+	CreateTemplate(ctx context.Context, spec string) (Template, error)
+	GetTemplate(name string, version int32) (Template, error)
+	ListTemplates(offset, limit int) ([]Template, int, error)
+	ListTemplateVersions(name string) ([]Template, error)
+	DeleteTemplate(ctx context.Context, name string) error
+
+	// ExpandJobSpec renders the template referenced by a job spec, see
+	// ExpandJobSpec.
+	ExpandJobSpec(spec string) (string, *JobTemplate, error)
+	// CreateJob creates a job rendered from a template and links it to the
+	// template.
+	CreateJob(ctx context.Context, jb *job.Job, link JobTemplate) error
+	// UpdateJob updates a job in place, linking it to the template it was
+	// rendered from. A nil link removes any previous link.
+	UpdateJob(ctx context.Context, jb *job.Job, spec string, link *JobTemplate) error
+
+	// PlanRollForward returns the changes rolling jobs forward to a version
+	// of the template would make, without applying them. A zero version
+	// refers to the latest version.
+	PlanRollForward(ctx context.Context, name string, version int32) ([]JobChange, error)
+	// RollForward updates jobs in place with the pipeline rendered from a
+	// version of the template. If jobIDs is empty, all jobs using another
+	// version are rolled forward.
+	RollForward(ctx context.Context, name string, version int32, jobIDs []int32) ([]JobChange, error)
+}
+
+type service struct {
+	orm        ORM
+	jobORM     job.ORM
+	jobSpawner job.Spawner
+	q          pg.Q
+	lggr       logger.Logger
+}
+
+var _ Service = (*service)(nil)
+
+// NewService creates a new templates service.
+func NewService(orm ORM, jobORM job.ORM, jobSpawner job.Spawner, db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *service {
+	lggr = lggr.Named("PipelineTemplates")
+	return &service{
+		orm:        orm,
+		jobORM:     jobORM,
+		jobSpawner: jobSpawner,
+		q:          pg.NewQ(db, lggr, cfg),
+		lggr:       lggr,
+	}
+}
+
+// CreateTemplate parses a template spec and stores it as the next version of
+// the template with that name.
+func (s *service) CreateTemplate(ctx context.Context, spec string) (Template, error) {
+	t, err := ParseTemplateSpec(spec)
+	if err != nil {
+		return Template{}, err
+	}
+	if err = s.orm.CreateTemplate(&t, pg.WithParentCtx(ctx)); err != nil {
+		return Template{}, err
+	}
+	s.lggr.Infow("Created pipeline template", "name", t.Name, "version", t.Version)
+	return t, nil
+}
+
+func (s *service) GetTemplate(name string, version int32) (Template, error) {
+	return s.orm.FindTemplate(name, version)
+}
+
+func (s *service) ListTemplates(offset, limit int) ([]Template, int, error) {
+	return s.orm.FindTemplates(offset, limit)
+}
+
+func (s *service) ListTemplateVersions(name string) ([]Template, error) {
+	return s.orm.FindTemplateVersions(name)
+}
+
+// DeleteTemplate deletes all versions of a template which is not used by any
+// job.
+func (s *service) DeleteTemplate(ctx context.Context, name string) error {
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.Transaction(func(tx pg.Queryer) error {
+		count, err := s.orm.CountJobTemplates(name, pg.WithQueryer(tx))
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrTemplateInUse
+		}
+		return s.orm.DeleteTemplate(name, pg.WithQueryer(tx))
+	})
+}
+
+func (s *service) ExpandJobSpec(spec string) (string, *JobTemplate, error) {
+	return ExpandJobSpec(spec, func(name string, version int32) (Template, error) {
+		return s.orm.FindTemplate(name, version)
+	})
+}
+
+func (s *service) CreateJob(ctx context.Context, jb *job.Job, link JobTemplate) error {
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.Transaction(func(tx pg.Queryer) error {
+		if err := s.jobSpawner.CreateJob(jb, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		link.JobID = jb.ID
+		return s.orm.LinkJob(&link, pg.WithQueryer(tx))
+	})
+}
+
+func (s *service) UpdateJob(ctx context.Context, jb *job.Job, spec string, link *JobTemplate) error {
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.Transaction(func(tx pg.Queryer) error {
+		if err := s.jobSpawner.UpdateJob(jb, spec, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		if link == nil {
+			return s.orm.UnlinkJob(jb.ID, pg.WithQueryer(tx))
+		}
+		link.JobID = jb.ID
+		return s.orm.LinkJob(link, pg.WithQueryer(tx))
+	})
+}
+
+func (s *service) PlanRollForward(ctx context.Context, name string, version int32) ([]JobChange, error) {
+	target, links, err := s.outdatedJobs(name, version, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]JobChange, 0, len(links))
+	for _, link := range links {
+		change, _, _ := s.planJob(ctx, target, link)
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func (s *service) RollForward(ctx context.Context, name string, version int32, jobIDs []int32) ([]JobChange, error) {
+	target, links, err := s.outdatedJobs(name, version, jobIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]JobChange, 0, len(links))
+	for _, link := range links {
+		change, jb, source := s.planJob(ctx, target, link)
+		if change.Error == "" {
+			if err = s.rollJobForward(ctx, &jb, source, target, link.Args); err != nil {
+				change.Error = err.Error()
+			} else {
+				change.RolledForward = true
+			}
+		}
+		if change.Error != "" {
+			s.lggr.Errorw("Failed to roll job forward", "jobID", link.JobID, "template", name, "version", target.Version, "err", change.Error)
+		} else {
+			s.lggr.Infow("Rolled job forward", "jobID", link.JobID, "template", name, "version", target.Version)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// outdatedJobs returns the target template version, and the links of the jobs
+// using another version of it. If jobIDs is not empty, only those jobs are
+// returned, and all of them must use the template.
+func (s *service) outdatedJobs(name string, version int32, jobIDs []int32) (Template, []JobTemplate, error) {
+	target, err := s.orm.FindTemplate(name, version)
+	if err != nil {
+		return Template{}, nil, err
+	}
+	links, err := s.orm.FindJobTemplates(name)
+	if err != nil {
+		return Template{}, nil, err
+	}
+
+	byJobID := make(map[int32]JobTemplate, len(links))
+	for _, link := range links {
+		byJobID[link.JobID] = link
+	}
+	if len(jobIDs) > 0 {
+		links = links[:0]
+		for _, id := range jobIDs {
+			link, ok := byJobID[id]
+			if !ok {
+				return Template{}, nil, errors.Errorf("job %d does not use pipeline template %s", id, name)
+			}
+			links = append(links, link)
+		}
+	}
+
+	var outdated []JobTemplate
+	for _, link := range links {
+		if link.PipelineTemplateID != target.ID {
+			outdated = append(outdated, link)
+		}
+	}
+	return target, outdated, nil
+}
+
+// planJob renders the new pipeline of a job. Errors are reported in the
+// returned change.
+func (s *service) planJob(ctx context.Context, target Template, link JobTemplate) (change JobChange, jb job.Job, source string) {
+	change = JobChange{
+		JobID:       link.JobID,
+		FromVersion: link.Version,
+		ToVersion:   target.Version,
+	}
+
+	jb, err := s.jobORM.FindJob(ctx, link.JobID)
+	if err != nil {
+		change.Error = errors.Wrap(err, "failed to load job").Error()
+		return
+	}
+	change.JobName = jb.Name.ValueOrZero()
+
+	source, err = target.Render(link.Args)
+	if err != nil {
+		change.Error = err.Error()
+		return
+	}
+
+	var current string
+	if jb.PipelineSpec != nil {
+		current = jb.PipelineSpec.DotDagSource
+	}
+	change.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(current),
+		B:        difflib.SplitLines(source),
+		FromFile: fmt.Sprintf("%s v%d", target.Name, link.Version),
+		ToFile:   fmt.Sprintf("%s v%d", target.Name, target.Version),
+		Context:  3,
+	})
+	if err != nil {
+		change.Error = err.Error()
+	}
+	return
+}
+
+// rollJobForward updates a job in place to run the new pipeline source, so
+// that it keeps its ID and run history. The last recorded spec of the job is
+// recorded again as the next version, referencing the target version of the
+// template.
+func (s *service) rollJobForward(ctx context.Context, jb *job.Job, source string, target Template, args Args) error {
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return errors.Wrap(err, "invalid pipeline source")
+	}
+
+	q := s.q.WithOpts(pg.WithParentCtx(ctx))
+	return q.Transaction(func(tx pg.Queryer) error {
+		if jb.Type == job.Webhook && jb.WebhookSpec != nil {
+			// External initiators are not loaded with the job, and would be
+			// removed by the update
+			err = tx.Select(&jb.WebhookSpec.ExternalInitiatorWebhookSpecs,
+				`SELECT external_initiator_id, webhook_spec_id, spec FROM external_initiator_webhook_specs WHERE webhook_spec_id = $1`,
+				jb.WebhookSpec.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed to load external initiators")
+			}
+		}
+
+		var spec string
+		if spec, err = s.pinnedSpec(jb.ID, target.Version, tx); err != nil {
+			return err
+		}
+
+		jb.Pipeline = *p
+		jb.PipelineSpec = nil
+		jb.JobSpecErrors = nil
+		if err = s.jobSpawner.UpdateJob(jb, spec, pg.WithQueryer(tx)); err != nil {
+			return errors.Wrap(err, "failed to update job")
+		}
+
+		return s.orm.LinkJob(&JobTemplate{
+			JobID:              jb.ID,
+			PipelineTemplateID: target.ID,
+			Args:               args,
+		}, pg.WithQueryer(tx))
+	})
+}
+
+// pinnedSpec returns the last recorded spec of a job with its template
+// reference pinned to version. Jobs created before specs were recorded have
+// none, and an empty spec is returned.
+func (s *service) pinnedSpec(jobID int32, version int32, tx pg.Queryer) (string, error) {
+	versions, err := s.jobORM.FindSpecVersions(jobID, pg.WithQueryer(tx))
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	spec, err := PinJobSpec(versions[len(versions)-1].TOML, version)
+	return spec, errors.Wrap(err, "failed to update the recorded spec")
+}
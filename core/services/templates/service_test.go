@@ -0,0 +1,109 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+)
+
+func TestService_RollForward(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
+	pipelineORM := pipeline.NewORM(db, lggr, cfg)
+	jobORM := job.NewORM(db, cc, pipelineORM, keyStore, lggr, cfg)
+	t.Cleanup(func() { assert.NoError(t, jobORM.Close()) })
+
+	spawner := job.NewSpawner(jobORM, cfg, map[job.Type]job.Delegate{
+		job.Webhook: &job.NullDelegate{Type: job.Webhook},
+	}, db, lggr, nil)
+	require.NoError(t, spawner.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, spawner.Close()) })
+
+	orm := templates.NewORM(db, lggr, cfg)
+	svc := templates.NewService(orm, jobORM, spawner, db, lggr, cfg)
+
+	ctx := testutils.Context(t)
+	_, err := svc.CreateTemplate(ctx, memoTemplateSpec)
+	require.NoError(t, err)
+
+	spec := `type = "webhook"
+schemaVersion = 1
+[observationSourceTemplate]
+name = "memo"
+version = 1
+[observationSourceTemplate.args]
+value = "a"`
+	expanded, link, err := svc.ExpandJobSpec(spec)
+	require.NoError(t, err)
+	jb, err := webhook.ValidatedWebhookSpec(expanded, nil)
+	require.NoError(t, err)
+	jb.TOMLSpec = spec
+	require.NoError(t, svc.CreateJob(ctx, &jb, *link))
+
+	_, err = svc.CreateTemplate(ctx, `
+name = "memo"
+source = """
+ds [type=memo value="v2 {{ value }}"]
+"""
+[[params]]
+name = "value"
+type = "string"
+`)
+	require.NoError(t, err)
+
+	changes, err := svc.RollForward(ctx, "memo", 0, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Empty(t, changes[0].Error)
+	assert.True(t, changes[0].RolledForward)
+	assert.Equal(t, jb.ID, changes[0].JobID)
+
+	t.Run("updates the job in place", func(t *testing.T) {
+		updated, err := jobORM.FindJob(ctx, jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.ExternalJobID, updated.ExternalJobID)
+		assert.NotEqual(t, jb.PipelineSpecID, updated.PipelineSpecID)
+		assert.Contains(t, updated.PipelineSpec.DotDagSource, `value="v2 a"`)
+
+		links, err := orm.FindJobTemplates("memo")
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, jb.ID, links[0].JobID)
+		assert.Equal(t, int32(2), links[0].Version)
+	})
+
+	t.Run("records the spec pinned to the new version", func(t *testing.T) {
+		versions, err := jobORM.FindSpecVersions(jb.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, spec, versions[0].TOML)
+
+		tree, err := toml.Load(versions[1].TOML)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), tree.Get(templates.JobSpecKey+".version"))
+		assert.Equal(t, "a", tree.Get(templates.JobSpecKey+".args.value"))
+	})
+
+	t.Run("skips jobs on the target version", func(t *testing.T) {
+		changes, err := svc.RollForward(ctx, "memo", 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
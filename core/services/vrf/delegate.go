@@ -38,7 +38,7 @@ type Delegate struct {
 
 //go:generate mockery --name GethKeyStore --output ./mocks/ --case=underscore
 type GethKeyStore interface {
-	GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (common.Address, error)
+	GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addresses ...common.Address) (common.Address, error)
 }
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore
@@ -168,6 +168,7 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				coordinator:     coordinator,
 				pipelineRunner:  d.pr,
 				gethks:          d.ks.Eth(),
+				chainID:         chain.ID(),
 				job:             jb,
 				// Note the mailbox size effectively sets a limit on how many logs we can replay
 				// in the event of a VRF outage.
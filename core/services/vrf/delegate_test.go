@@ -24,9 +24,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/signatures/secp256k1"
+	vrf_mocks "github.com/smartcontractkit/chainlink/core/services/vrf/mocks"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
@@ -34,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -73,7 +76,7 @@ func buildVrfUni(t *testing.T, db *sqlx.DB, cfg *configtest.TestGeneralConfig) v
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{LogBroadcaster: lb, KeyStore: ks.Eth(), Client: ec, DB: db, GeneralConfig: cfg, TxManager: txm})
 	jrm := job.NewORM(db, cc, prm, ks, lggr, cfg)
 	t.Cleanup(func() { jrm.Close() })
-	pr := pipeline.NewRunner(prm, cfg, cc, ks.Eth(), ks.VRF(), lggr, nil, nil)
+	pr := pipeline.NewRunner(prm, cfg, cc, ks.Eth(), ks.VRF(), ks.Secrets(), lggr, nil, nil)
 	require.NoError(t, ks.Unlock(testutils.Password))
 	_, err := ks.Eth().Create(big.NewInt(0))
 	require.NoError(t, err)
@@ -211,6 +214,33 @@ func TestConfirmedLogExtraction(t *testing.T) {
 	assert.Equal(t, 0, len(lsn.reqs)) // all processed
 }
 
+func TestListenerV1_SendingAddresses(t *testing.T) {
+	from1, from2 := testutils.NewAddress(), testutils.NewAddress()
+	gethks := vrf_mocks.NewGethKeyStore(t)
+	lsn := listenerV1{
+		gethks:  gethks,
+		chainID: testutils.FixtureChainID,
+		job: job.Job{VRFSpec: &job.VRFSpec{FromAddresses: []ethkey.EIP55Address{
+			ethkey.EIP55AddressFromAddress(from1),
+			ethkey.EIP55AddressFromAddress(from2),
+		}}},
+	}
+
+	addresses, err := lsn.sendingAddresses()
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{from1, from2}, addresses)
+
+	lsn.job.VRFSpec.FromLabel = "vrf"
+	gethks.On("GetRoundRobinAddressWithLabel", testutils.FixtureChainID, "vrf", from1, from2).Return(from2, nil).Once()
+	addresses, err = lsn.sendingAddresses()
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{from2}, addresses)
+
+	gethks.On("GetRoundRobinAddressWithLabel", testutils.FixtureChainID, "vrf", from1, from2).Return(common.Address{}, errors.New("no sending keys available")).Once()
+	_, err = lsn.sendingAddresses()
+	require.EqualError(t, err, "no sending keys available")
+}
+
 func TestResponsePruning(t *testing.T) {
 	lsn := listenerV1{}
 	lsn.latestHead = 10000
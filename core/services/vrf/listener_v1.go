@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -49,6 +50,7 @@ type listenerV1 struct {
 	headBroadcaster httypes.HeadBroadcasterRegistry
 	txm             txmgr.TxManager
 	gethks          GethKeyStore
+	chainID         *big.Int
 	reqLogs         *utils.Mailbox[log.Broadcast]
 	chStop          chan struct{}
 	waitOnStop      chan struct{}
@@ -372,13 +374,19 @@ func (lsn *listenerV1) ProcessRequest(req request) bool {
 		"seed", req.req.Seed,
 		"fee", req.req.Fee)
 
+	from, err := lsn.sendingAddresses()
+	if err != nil {
+		lsn.l.Errorw("Couldn't get next from address", "err", err, "reqID", hex.EncodeToString(req.req.RequestID[:]))
+		return false
+	}
+
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    lsn.job.ID,
 			"externalJobID": lsn.job.ExternalJobID,
 			"name":          lsn.job.Name.ValueOrZero(),
 			"publicKey":     lsn.job.VRFSpec.PublicKey[:],
-			"from":          lsn.fromAddresses(),
+			"from":          from,
 		},
 		"jobRun": map[string]interface{}{
 			"logBlockHash":   req.req.Raw.BlockHash[:],
@@ -460,6 +468,19 @@ func (lsn *listenerV1) fromAddresses() []common.Address {
 	return addresses
 }
 
+// sendingAddresses returns the addresses the fulfillment may be sent from,
+// narrowed down to the next key with the job's label if it has one.
+func (lsn *listenerV1) sendingAddresses() ([]common.Address, error) {
+	if lsn.job.VRFSpec.FromLabel == "" {
+		return lsn.fromAddresses(), nil
+	}
+	address, err := lsn.gethks.GetRoundRobinAddressWithLabel(lsn.chainID, lsn.job.VRFSpec.FromLabel, lsn.fromAddresses()...)
+	if err != nil {
+		return nil, err
+	}
+	return []common.Address{address}, nil
+}
+
 // Job complies with log.Listener
 func (lsn *listenerV1) JobID() int32 {
 	return lsn.job.ID
@@ -578,7 +578,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				"err", err, "fromAddresses", fromAddresses)
 		}
 
-		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
+		fromAddress, err := lsn.gethks.GetRoundRobinAddressWithLabel(lsn.chainID, lsn.job.VRFSpec.FromLabel, fromAddresses...)
 		if err != nil {
 			l.Errorw("Couldn't get next from address", "err", err)
 			continue
@@ -728,7 +728,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 				"err", err, "fromAddresses", fromAddresses)
 		}
 
-		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
+		fromAddress, err := lsn.gethks.GetRoundRobinAddressWithLabel(lsn.chainID, lsn.job.VRFSpec.FromLabel, fromAddresses...)
 		if err != nil {
 			l.Errorw("Couldn't get next from address", "err", err)
 			continue
@@ -14,20 +14,20 @@ type GethKeyStore struct {
 	mock.Mock
 }
 
-// GetRoundRobinAddress provides a mock function with given fields: chainID, addresses
-func (_m *GethKeyStore) GetRoundRobinAddress(chainID *big.Int, addresses ...common.Address) (common.Address, error) {
+// GetRoundRobinAddressWithLabel provides a mock function with given fields: chainID, label, addresses
+func (_m *GethKeyStore) GetRoundRobinAddressWithLabel(chainID *big.Int, label string, addresses ...common.Address) (common.Address, error) {
 	_va := make([]interface{}, len(addresses))
 	for _i := range addresses {
 		_va[_i] = addresses[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, chainID)
+	_ca = append(_ca, chainID, label)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	var r0 common.Address
-	if rf, ok := ret.Get(0).(func(*big.Int, ...common.Address) common.Address); ok {
-		r0 = rf(chainID, addresses...)
+	if rf, ok := ret.Get(0).(func(*big.Int, string, ...common.Address) common.Address); ok {
+		r0 = rf(chainID, label, addresses...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Address)
@@ -35,8 +35,8 @@ func (_m *GethKeyStore) GetRoundRobinAddress(chainID *big.Int, addresses ...comm
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*big.Int, ...common.Address) error); ok {
-		r1 = rf(chainID, addresses...)
+	if rf, ok := ret.Get(1).(func(*big.Int, string, ...common.Address) error); ok {
+		r1 = rf(chainID, label, addresses...)
 	} else {
 		r1 = ret.Error(1)
 	}
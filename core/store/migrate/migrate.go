@@ -131,3 +131,30 @@ func Status(db *sql.DB, lggr logger.Logger) error {
 func Create(db *sql.DB, name, migrationType string) error {
 	return goose.Create(db, "core/store/migrate/migrations", name, migrationType)
 }
+
+// Pending returns true if the database schema is behind the migrations
+// embedded in this binary.
+func Pending(db *sql.DB, lggr logger.Logger) (bool, error) {
+	current, err := Current(db, lggr)
+	if err != nil {
+		return false, err
+	}
+	latest, err := Latest()
+	if err != nil {
+		return false, err
+	}
+	return latest > current, nil
+}
+
+// Latest returns the version of the newest migration embedded in this binary.
+func Latest() (int64, error) {
+	migrations, err := goose.CollectMigrations(MIGRATIONS_DIR, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, err
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, err
+	}
+	return last.Version, nil
+}
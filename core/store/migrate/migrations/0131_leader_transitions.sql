@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE leader_transitions (
+    id BIGSERIAL PRIMARY KEY,
+    client_id uuid NOT NULL,
+    state text NOT NULL CHECK (state IN ('standby', 'leader')),
+    created_at timestamptz NOT NULL
+);
+-- +goose Down
+DROP TABLE leader_transitions;
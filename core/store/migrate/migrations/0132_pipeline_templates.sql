@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE pipeline_templates (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL,
+    version integer NOT NULL CHECK (version > 0),
+    description text NOT NULL DEFAULT '',
+    params jsonb NOT NULL DEFAULT '[]',
+    source text NOT NULL,
+    created_at timestamptz NOT NULL,
+    UNIQUE (name, version)
+);
+
+CREATE TABLE job_pipeline_templates (
+    job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    pipeline_template_id bigint NOT NULL REFERENCES pipeline_templates (id) ON DELETE RESTRICT,
+    args jsonb NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_job_pipeline_templates_pipeline_template_id ON job_pipeline_templates (pipeline_template_id);
+-- +goose Down
+DROP TABLE job_pipeline_templates;
+DROP TABLE pipeline_templates;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE job_spec_versions (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    version integer NOT NULL CHECK (version > 0),
+    toml text NOT NULL,
+    created_at timestamptz NOT NULL,
+    UNIQUE (job_id, version)
+);
+-- +goose Down
+DROP TABLE job_spec_versions;
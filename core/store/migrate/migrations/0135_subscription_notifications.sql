@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION notify_pipeline_run_changed() RETURNS trigger
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM pg_notify('pipeline_run_created'::text, NEW.id::text);
+    END IF;
+    IF NEW.finished_at IS NOT NULL AND (TG_OP = 'INSERT' OR OLD.finished_at IS NULL) THEN
+        PERFORM pg_notify('pipeline_run_finished'::text, NEW.id::text);
+    END IF;
+    RETURN NULL;
+END
+$$;
+CREATE TRIGGER notify_pipeline_run_changed AFTER INSERT OR UPDATE OF finished_at ON pipeline_runs FOR EACH ROW EXECUTE PROCEDURE notify_pipeline_run_changed();
+
+CREATE FUNCTION notify_eth_tx_state_changed() RETURNS trigger
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR OLD.state IS DISTINCT FROM NEW.state THEN
+        PERFORM pg_notify('eth_tx_state_changed'::text, NEW.id::text);
+    END IF;
+    RETURN NULL;
+END
+$$;
+CREATE TRIGGER notify_eth_tx_state_changed AFTER INSERT OR UPDATE OF state ON eth_txes FOR EACH ROW EXECUTE PROCEDURE notify_eth_tx_state_changed();
+
+CREATE FUNCTION notify_job_spec_error_recorded() RETURNS trigger
+    LANGUAGE plpgsql
+AS $$
+BEGIN
+    PERFORM pg_notify('job_spec_error_recorded'::text, NEW.id::text);
+    RETURN NULL;
+END
+$$;
+CREATE TRIGGER notify_job_spec_error_recorded AFTER INSERT OR UPDATE ON job_spec_errors FOR EACH ROW EXECUTE PROCEDURE notify_job_spec_error_recorded();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER notify_pipeline_run_changed ON pipeline_runs;
+DROP FUNCTION notify_pipeline_run_changed;
+DROP TRIGGER notify_eth_tx_state_changed ON eth_txes;
+DROP FUNCTION notify_eth_tx_state_changed;
+DROP TRIGGER notify_job_spec_error_recorded ON job_spec_errors;
+DROP FUNCTION notify_job_spec_error_recorded;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id_id ON pipeline_runs (pipeline_spec_id, id);
+DROP INDEX idx_pipeline_runs_pipeline_spec_id;
+CREATE INDEX idx_pipeline_runs_state_id ON pipeline_runs (state, id);
+CREATE INDEX idx_pipeline_task_runs_type_pipeline_run_id ON pipeline_task_runs (type, pipeline_run_id);
+CREATE INDEX idx_pipeline_task_runs_errored ON pipeline_task_runs (pipeline_run_id) WHERE error IS NOT NULL;
+-- +goose Down
+DROP INDEX idx_pipeline_task_runs_errored;
+DROP INDEX idx_pipeline_task_runs_type_pipeline_run_id;
+DROP INDEX idx_pipeline_runs_state_id;
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id ON pipeline_runs (pipeline_spec_id);
+DROP INDEX idx_pipeline_runs_pipeline_spec_id_id;
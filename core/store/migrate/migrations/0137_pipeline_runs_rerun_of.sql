@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE pipeline_runs ADD COLUMN rerun_of_id bigint REFERENCES pipeline_runs (id) ON DELETE SET NULL;
+CREATE INDEX idx_pipeline_runs_rerun_of_id ON pipeline_runs (rerun_of_id) WHERE rerun_of_id IS NOT NULL;
+-- +goose Down
+ALTER TABLE pipeline_runs DROP COLUMN rerun_of_id;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE direct_request_specs
+    ADD COLUMN denied_requesters TEXT,
+    ADD COLUMN requester_rate_limit bigint,
+    ADD COLUMN requester_rate_limit_period bigint,
+    ADD COLUMN min_contract_payment_gas_limit bigint,
+    ADD COLUMN min_contract_payment_link_eth_rate numeric(78,18),
+    ADD COLUMN min_contract_payment_source TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE direct_request_specs
+    DROP COLUMN denied_requesters,
+    DROP COLUMN requester_rate_limit,
+    DROP COLUMN requester_rate_limit_period,
+    DROP COLUMN min_contract_payment_gas_limit,
+    DROP COLUMN min_contract_payment_link_eth_rate,
+    DROP COLUMN min_contract_payment_source;
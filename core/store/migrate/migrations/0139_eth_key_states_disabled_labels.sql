@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE eth_key_states
+    ADD COLUMN disabled boolean NOT NULL DEFAULT false,
+    ADD COLUMN labels TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE eth_key_states
+    DROP COLUMN disabled,
+    DROP COLUMN labels;
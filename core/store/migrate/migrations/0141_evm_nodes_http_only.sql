@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE evm_nodes
+    DROP CONSTRAINT primary_or_sendonly,
+    ADD CONSTRAINT primary_or_sendonly CHECK (
+        (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+        OR
+        (NOT send_only AND (ws_url IS NOT NULL OR http_url IS NOT NULL))
+    );
+
+-- +goose Down
+ALTER TABLE evm_nodes
+    DROP CONSTRAINT primary_or_sendonly,
+    ADD CONSTRAINT primary_or_sendonly CHECK (
+        (send_only AND ws_url IS NULL AND http_url IS NOT NULL)
+        OR
+        (NOT send_only AND ws_url IS NOT NULL)
+    );
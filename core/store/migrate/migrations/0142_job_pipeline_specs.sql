@@ -0,0 +1,12 @@
+-- +goose Up
+-- Updating a job creates a new pipeline spec, the previous ones are kept for
+-- the runs which reference them
+CREATE TABLE job_pipeline_specs (
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    pipeline_spec_id integer NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    PRIMARY KEY (job_id, pipeline_spec_id)
+);
+CREATE UNIQUE INDEX idx_job_pipeline_specs_pipeline_spec_id ON job_pipeline_specs (pipeline_spec_id);
+INSERT INTO job_pipeline_specs (job_id, pipeline_spec_id) SELECT id, pipeline_spec_id FROM jobs;
+-- +goose Down
+DROP TABLE job_pipeline_specs;
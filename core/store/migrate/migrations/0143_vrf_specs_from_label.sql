@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE vrf_specs ADD COLUMN from_label TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE vrf_specs DROP COLUMN from_label;
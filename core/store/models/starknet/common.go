@@ -0,0 +1,12 @@
+package starknet
+
+import "github.com/smartcontractkit/chainlink/core/assets"
+
+// SendRequest represents a request to transfer ETH from a StarkNet account.
+type SendRequest struct {
+	From               string     `json:"from"`
+	To                 string     `json:"to"`
+	Amount             assets.Eth `json:"amount"`
+	StarkNetChainID    string     `json:"starknetChainID"`
+	AllowHigherAmounts bool       `json:"allowHigherAmounts"`
+}
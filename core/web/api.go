@@ -106,6 +106,26 @@ func getPaginatedResponseDoc(url url.URL, size, page, count int, resource interf
 	return document, nil
 }
 
+// NewCursorPaginatedResponse returns a jsonapi.Document with a link to the
+// next collection page, which starts after the cursor. An empty cursor means
+// there are no more pages.
+func NewCursorPaginatedResponse(url url.URL, cursor string, resource interface{}) ([]byte, error) {
+	document, err := jsonapi.MarshalToStruct(resource, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource to struct: %+v", err)
+	}
+
+	document.Links = make(jsonapi.Links)
+	if cursor != "" {
+		document.Meta = jsonapi.Meta{"nextCursor": cursor}
+		query := url.Query()
+		query.Set("cursor", cursor)
+		url.RawQuery = query.Encode()
+		document.Links[KeyNextLink] = jsonapi.Link{Href: url.String()}
+	}
+	return json.Marshal(document)
+}
+
 // ParsePaginatedResponse parse a JSONAPI response for a document with links
 func ParsePaginatedResponse(input []byte, resource interface{}, links *jsonapi.Links) error {
 	document := jsonapi.Document{}
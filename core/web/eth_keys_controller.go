@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -75,9 +77,11 @@ func (ekc *ETHKeysController) Index(c *gin.Context) {
 	jsonAPIResponse(c, resources, "keys")
 }
 
-// Create adds a new account
+// Create adds a new account, or registers a key held by the remote signer
+// by its address
 // Example:
 //  "<application>/keys/eth"
+//  "<application>/keys/eth?remoteAddress=0x..."
 func (ekc *ETHKeysController) Create(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
@@ -102,10 +106,26 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 		}
 	}
 
-	key, err := ethKeyStore.Create(chain.ID())
-	if err != nil {
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+	var key ethkey.KeyV2
+	if remoteAddress := c.Query("remoteAddress"); remoteAddress != "" {
+		if !common.IsHexAddress(remoteAddress) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid remote address: %s", remoteAddress))
+			return
+		}
+		key, err = ethKeyStore.AddRemote(common.HexToAddress(remoteAddress), chain.ID())
+		if errors.Is(err, keystore.ErrRemoteSignerUnavailable) {
+			jsonAPIError(c, http.StatusServiceUnavailable, err)
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	} else {
+		key, err = ethKeyStore.Create(chain.ID())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
 	}
 	if maxGasPriceGWei > 0 {
 		maxGasPriceWei := assets.GWei(maxGasPriceGWei)
@@ -137,18 +157,37 @@ func (ekc *ETHKeysController) Create(c *gin.Context) {
 // Update an ETH key's parameters
 // Example:
 // "PUT <application>/keys/eth/:keyID?maxGasPriceGWei=12345"
+// "PUT <application>/keys/eth/:keyID?disabled=true"
+// "PUT <application>/keys/eth/:keyID?labels=ocr,keeper"
 func (ekc *ETHKeysController) Update(c *gin.Context) {
 	ethKeyStore := ekc.App.GetKeyStore().Eth()
 
-	if c.Query("maxGasPriceGWei") == "" {
+	_, setLabels := c.GetQuery("labels")
+	if c.Query("maxGasPriceGWei") == "" && c.Query("disabled") == "" && !setLabels {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("no parameters passed to update"))
 		return
 	}
 
-	maxGasPriceGWei, err := strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
-	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, err)
-		return
+	var maxGasPriceGWei int64
+	var err error
+	if c.Query("maxGasPriceGWei") != "" {
+		maxGasPriceGWei, err = strconv.ParseInt(c.Query("maxGasPriceGWei"), 10, 64)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	var disabled bool
+	if c.Query("disabled") != "" {
+		disabled, err = strconv.ParseBool(c.Query("disabled"))
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	var labels []string
+	if c.Query("labels") != "" {
+		labels = strings.Split(c.Query("labels"), ",")
 	}
 
 	keyID := c.Param("keyID")
@@ -164,9 +203,40 @@ func (ekc *ETHKeysController) Update(c *gin.Context) {
 		return
 	}
 
-	maxGasPriceWei := assets.GWei(maxGasPriceGWei)
-	updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
-	if err = ekc.App.GetChains().EVM.UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
+	if setLabels {
+		if err = ethKeyStore.SetLabels(key.Address.Address(), labels); err != nil {
+			if errors.Is(err, keystore.ErrInvalidKeyLabel) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+				return
+			}
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if c.Query("disabled") != "" {
+		if disabled {
+			err = ethKeyStore.Disable(key.Address.Address(), state.EVMChainID.ToInt())
+		} else {
+			err = ethKeyStore.Enable(key.Address.Address(), state.EVMChainID.ToInt())
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	if c.Query("maxGasPriceGWei") != "" {
+		maxGasPriceWei := assets.GWei(maxGasPriceGWei)
+		updateMaxGasPrice := evm.UpdateKeySpecificMaxGasPrice(key.Address.Address(), maxGasPriceWei)
+		if err = ekc.App.GetChains().EVM.UpdateConfig((*big.Int)(&state.EVMChainID), updateMaxGasPrice); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	state, err = ethKeyStore.GetState(keyID)
+	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
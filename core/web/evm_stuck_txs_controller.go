@@ -0,0 +1,152 @@
+package web
+
+import (
+	"database/sql"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// StuckTxsController lists stuck EVM transactions, and cancels, replaces or
+// abandons them while the node is running.
+type StuckTxsController struct {
+	App chainlink.Application
+}
+
+// ReplaceStuckTxRequest is the fee of a replacement attempt. GasPrice is
+// required for legacy transactions, GasTipCap and GasFeeCap for EIP-1559
+// transactions.
+type ReplaceStuckTxRequest struct {
+	GasPrice  *utils.Big `json:"gasPrice"`
+	GasTipCap *utils.Big `json:"gasTipCap"`
+	GasFeeCap *utils.Big `json:"gasFeeCap"`
+}
+
+// Index returns the stuck transactions of every chain, or of the chain given
+// by the evmChainID query param.
+// Example:
+// "GET <application>/stuck_txs/evm"
+func (sc *StuckTxsController) Index(c *gin.Context) {
+	var chains []evm.Chain
+	if chainID := c.Query("evmChainID"); chainID == "" {
+		chains = sc.App.GetChains().EVM.Chains()
+	} else {
+		chain, err := getChain(sc.App.GetChains().EVM, chainID)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		chains = []evm.Chain{chain}
+	}
+
+	resources := []presenters.StuckEthTxResource{}
+	for _, chain := range chains {
+		if !chain.Config().EVMRPCEnabled() {
+			continue
+		}
+		stuck, err := chain.TxManager().FindStuckTransactions(c.Request.Context())
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Wrapf(err, "failed to find stuck transactions for chain %s", chain.ID()))
+			return
+		}
+		for _, s := range stuck {
+			resources = append(resources, presenters.NewStuckEthTxResource(s))
+		}
+	}
+
+	jsonAPIResponse(c, resources, "evm_stuck_transactions")
+}
+
+// Cancel replaces an unconfirmed transaction with a zero value transaction
+// from its key to itself, at the same nonce and with a bumped fee.
+// Example:
+// "POST <application>/stuck_txs/evm/:ID/cancel"
+func (sc *StuckTxsController) Cancel(c *gin.Context) {
+	sc.remediate(c, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.CancelTransaction(c.Request.Context(), etxID)
+	})
+}
+
+// Replace sends a new attempt for an unconfirmed transaction, with the fee
+// given in a ReplaceStuckTxRequest body.
+// Example:
+// "POST <application>/stuck_txs/evm/:ID/replace"
+func (sc *StuckTxsController) Replace(c *gin.Context) {
+	var request ReplaceStuckTxRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	fee := txmgr.ReplacementFee{
+		GasPrice:  bigOrNil(request.GasPrice),
+		GasTipCap: bigOrNil(request.GasTipCap),
+		GasFeeCap: bigOrNil(request.GasFeeCap),
+	}
+	sc.remediate(c, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.ReplaceTransaction(c.Request.Context(), etxID, fee)
+	})
+}
+
+// Abandon gives up on an unconfirmed transaction and every later
+// transaction from the same key, and rewinds the key's next nonce to its
+// nonce. It is meant for transactions the network has dropped.
+// Example:
+// "POST <application>/stuck_txs/evm/:ID/abandon"
+func (sc *StuckTxsController) Abandon(c *gin.Context) {
+	sc.remediate(c, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.AbandonTransaction(c.Request.Context(), etxID)
+	})
+}
+
+// remediate runs fn with the transaction manager of the chain of the
+// transaction in the ID param, and responds with the updated transaction.
+func (sc *StuckTxsController) remediate(c *gin.Context, fn func(txm txmgr.TxManager, etxID int64) error) {
+	etxID, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid transaction ID"))
+		return
+	}
+	etx, err := sc.App.TxmORM().FindEthTxWithAttempts(etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("transaction %d not found", etxID))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	chain, err := sc.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err = fn(chain.TxManager(), etxID); errors.Is(err, txmgr.ErrTxNotUnconfirmed) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if etx, err = sc.App.TxmORM().FindEthTxWithAttempts(etxID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewEthTxResourceWithID(etx), "evm_transactions")
+}
+
+func bigOrNil(b *utils.Big) *big.Int {
+	if b == nil {
+		return nil
+	}
+	return b.ToInt()
+}
@@ -0,0 +1,367 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+// GraphQL over websocket subprotocols. graphql-transport-ws is the protocol of
+// the graphql-ws library, graphql-ws is the legacy protocol of
+// subscriptions-transport-ws.
+const (
+	graphqlTransportWSProtocol = "graphql-transport-ws"
+	graphqlWSProtocol          = "graphql-ws"
+)
+
+// Message types of both protocols. Where they differ, the legacy protocol's
+// type is given second.
+const (
+	gqlWSConnectionInit      = "connection_init"
+	gqlWSConnectionAck       = "connection_ack"
+	gqlWSPing                = "ping"
+	gqlWSPong                = "pong"
+	gqlWSSubscribe           = "subscribe"
+	gqlWSStart               = "start"
+	gqlWSNext                = "next"
+	gqlWSData                = "data"
+	gqlWSError               = "error"
+	gqlWSComplete            = "complete"
+	gqlWSStop                = "stop"
+	gqlWSKeepAlive           = "ka"
+	gqlWSConnectionTerminate = "connection_terminate"
+)
+
+// Close codes of the graphql-transport-ws protocol
+const (
+	gqlWSCloseBadRequest       = 4400
+	gqlWSCloseUnauthorized     = 4401
+	gqlWSCloseInitTimeout      = 4408
+	gqlWSCloseSubscriberExists = 4409
+	gqlWSCloseTooManyInits     = 4429
+)
+
+var (
+	// gqlWSInitTimeout is how long a client has to send connection_init
+	gqlWSInitTimeout = 10 * time.Second
+	// gqlWSKeepAliveInterval is the interval of keep alive messages of the
+	// legacy protocol
+	gqlWSKeepAliveInterval = 15 * time.Second
+	// gqlWSSessionCheckInterval is the interval at which the session of a
+	// connection is checked, so that connections are closed when the user logs
+	// out or the session expires
+	gqlWSSessionCheckInterval = time.Minute
+)
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type gqlWSSubscribePayload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// gqlWSHandler serves GraphQL subscriptions, and also queries and
+// mutations, over websockets.
+type gqlWSHandler struct {
+	// schema returns the schema to execute an operation against
+	schema func() *graphql.Schema
+	// injectLoader injects the dataloader into the context of a connection
+	injectLoader  func(ctx context.Context) context.Context
+	authenticator auth.Authenticator
+	lggr          logger.Logger
+	upgrader      websocket.Upgrader
+}
+
+func newGQLWSHandler(schema func() *graphql.Schema, injectLoader func(context.Context) context.Context, authenticator auth.Authenticator, lggr logger.Logger) *gqlWSHandler {
+	return &gqlWSHandler{
+		schema:        schema,
+		injectLoader:  injectLoader,
+		authenticator: authenticator,
+		lggr:          lggr.Named("GraphQLWS"),
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{graphqlTransportWSProtocol, graphqlWSProtocol},
+			// The CORS middleware has already rejected requests from origins
+			// which are not allowed
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// ServeGin upgrades the request to a websocket connection and serves
+// operations on it until the connection is closed.
+func (h *gqlWSHandler) ServeGin(c *gin.Context) {
+	session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+	if !ok {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already replied with an error
+		h.lggr.Debugw("Failed to upgrade GraphQL websocket connection", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(h.injectLoader(c.Request.Context()))
+	defer cancel()
+
+	wc := &gqlWSConnection{
+		conn:          conn,
+		legacy:        conn.Subprotocol() == graphqlWSProtocol,
+		schema:        h.schema,
+		lggr:          h.lggr,
+		subscriptions: make(map[string]*gqlWSSubscription),
+	}
+	defer wc.close()
+
+	if conn.Subprotocol() == "" {
+		wc.closeWithCode(gqlWSCloseBadRequest, "Subprotocol not acceptable")
+		return
+	}
+
+	go wc.keepAlive(ctx, cancel, func() error {
+		_, err := h.authenticator.AuthorizedUserWithSession(session.SessionID)
+		return err
+	})
+
+	wc.serve(ctx)
+}
+
+type gqlWSConnection struct {
+	conn   *websocket.Conn
+	legacy bool
+	schema func() *graphql.Schema
+	lggr   logger.Logger
+
+	writeMu sync.Mutex
+
+	initialized   bool
+	subsMu        sync.Mutex
+	subscriptions map[string]*gqlWSSubscription
+	wg            sync.WaitGroup
+}
+
+type gqlWSSubscription struct {
+	cancel context.CancelFunc
+}
+
+// serve reads and handles messages until the connection is closed.
+func (wc *gqlWSConnection) serve(ctx context.Context) {
+	initTimer := time.AfterFunc(gqlWSInitTimeout, func() {
+		wc.closeWithCode(gqlWSCloseInitTimeout, "Connection initialisation timeout")
+	})
+	defer initTimer.Stop()
+
+	for {
+		var msg gqlWSMessage
+		if err := wc.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				wc.lggr.Debugw("GraphQL websocket connection closed", "err", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case gqlWSConnectionInit:
+			if wc.initialized {
+				wc.closeWithCode(gqlWSCloseTooManyInits, "Too many initialisation requests")
+				return
+			}
+			initTimer.Stop()
+			wc.initialized = true
+			wc.write(gqlWSMessage{Type: gqlWSConnectionAck})
+			if wc.legacy {
+				wc.write(gqlWSMessage{Type: gqlWSKeepAlive})
+			}
+		case gqlWSPing:
+			wc.write(gqlWSMessage{Type: gqlWSPong, Payload: msg.Payload})
+		case gqlWSPong:
+		case gqlWSSubscribe, gqlWSStart:
+			if !wc.initialized {
+				wc.closeWithCode(gqlWSCloseUnauthorized, "Unauthorized")
+				return
+			}
+			if !wc.start(ctx, msg) {
+				return
+			}
+		case gqlWSComplete, gqlWSStop:
+			wc.stop(msg.ID)
+		case gqlWSConnectionTerminate:
+			return
+		default:
+			wc.closeWithCode(gqlWSCloseBadRequest, "Invalid message received")
+			return
+		}
+	}
+}
+
+// start executes the operation of a subscribe message. It returns false if
+// the connection was closed because the message was invalid.
+func (wc *gqlWSConnection) start(ctx context.Context, msg gqlWSMessage) bool {
+	var payload gqlWSSubscribePayload
+	if msg.ID == "" || json.Unmarshal(msg.Payload, &payload) != nil {
+		wc.closeWithCode(gqlWSCloseBadRequest, "Invalid message received")
+		return false
+	}
+
+	wc.subsMu.Lock()
+	if _, exists := wc.subscriptions[msg.ID]; exists {
+		wc.subsMu.Unlock()
+		wc.closeWithCode(gqlWSCloseSubscriberExists, "Subscriber for "+msg.ID+" already exists")
+		return false
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &gqlWSSubscription{cancel: cancel}
+	wc.subscriptions[msg.ID] = sub
+	wc.subsMu.Unlock()
+
+	responses, err := wc.schema().Subscribe(subCtx, payload.Query, payload.OperationName, payload.Variables)
+	if err != nil {
+		wc.remove(msg.ID, sub)
+		wc.writeErrors(msg.ID, &graphql.Response{Errors: []*gqlerrors.QueryError{{Message: err.Error()}}})
+		return true
+	}
+
+	wc.wg.Add(1)
+	go func() {
+		defer wc.wg.Done()
+		defer wc.remove(msg.ID, sub)
+
+		first := true
+		for r := range responses {
+			resp := r.(*graphql.Response)
+			// Errors which prevent the operation from being executed, like
+			// validation errors, terminate the operation
+			if first && resp.Data == nil && len(resp.Errors) > 0 {
+				wc.writeErrors(msg.ID, resp)
+				return
+			}
+			first = false
+			wc.writePayload(msg.ID, resp)
+		}
+		if subCtx.Err() == nil {
+			wc.write(gqlWSMessage{ID: msg.ID, Type: gqlWSComplete})
+		}
+	}()
+
+	return true
+}
+
+// stop cancels the operation with the given ID, if it is still running.
+func (wc *gqlWSConnection) stop(id string) {
+	wc.subsMu.Lock()
+	defer wc.subsMu.Unlock()
+	if sub, exists := wc.subscriptions[id]; exists {
+		sub.cancel()
+		delete(wc.subscriptions, id)
+	}
+}
+
+// remove removes a finished operation, unless the client has already reused
+// its ID for another one.
+func (wc *gqlWSConnection) remove(id string, sub *gqlWSSubscription) {
+	sub.cancel()
+	wc.subsMu.Lock()
+	defer wc.subsMu.Unlock()
+	if wc.subscriptions[id] == sub {
+		delete(wc.subscriptions, id)
+	}
+}
+
+// keepAlive sends keep alive messages for the legacy protocol and checks that
+// the session is still valid, until the context is done.
+func (wc *gqlWSConnection) keepAlive(ctx context.Context, cancel context.CancelFunc, checkSession func() error) {
+	keepAlive := time.NewTicker(gqlWSKeepAliveInterval)
+	defer keepAlive.Stop()
+	sessionCheck := time.NewTicker(gqlWSSessionCheckInterval)
+	defer sessionCheck.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if wc.legacy {
+				wc.write(gqlWSMessage{Type: gqlWSKeepAlive})
+			}
+		case <-sessionCheck.C:
+			if err := checkSession(); err != nil {
+				wc.lggr.Debugw("Closing GraphQL websocket connection, the session is no longer valid", "err", err)
+				cancel()
+				wc.closeWithCode(gqlWSCloseUnauthorized, "Unauthorized")
+				return
+			}
+		}
+	}
+}
+
+func (wc *gqlWSConnection) writePayload(id string, resp *graphql.Response) {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		wc.lggr.Errorw("Failed to marshal GraphQL response", "err", err)
+		return
+	}
+	typ := gqlWSNext
+	if wc.legacy {
+		typ = gqlWSData
+	}
+	wc.write(gqlWSMessage{ID: id, Type: typ, Payload: b})
+}
+
+func (wc *gqlWSConnection) writeErrors(id string, resp *graphql.Response) {
+	var payload interface{} = resp.Errors
+	if wc.legacy {
+		// The legacy protocol has a single error as the payload
+		payload = resp.Errors[0]
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		wc.lggr.Errorw("Failed to marshal GraphQL errors", "err", err)
+		return
+	}
+	wc.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: b})
+}
+
+func (wc *gqlWSConnection) write(msg gqlWSMessage) {
+	wc.writeMu.Lock()
+	defer wc.writeMu.Unlock()
+	if err := wc.conn.WriteJSON(msg); err != nil {
+		wc.lggr.Debugw("Failed to write to GraphQL websocket connection", "err", err)
+	}
+}
+
+func (wc *gqlWSConnection) closeWithCode(code int, text string) {
+	wc.writeMu.Lock()
+	defer wc.writeMu.Unlock()
+	_ = wc.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(time.Second))
+	_ = wc.conn.Close()
+}
+
+// close cancels all running operations and closes the connection.
+func (wc *gqlWSConnection) close() {
+	wc.subsMu.Lock()
+	for id, sub := range wc.subscriptions {
+		sub.cancel()
+		delete(wc.subscriptions, id)
+	}
+	wc.subsMu.Unlock()
+	_ = wc.conn.Close()
+	wc.wg.Wait()
+}
@@ -0,0 +1,200 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	sessionsMocks "github.com/smartcontractkit/chainlink/core/sessions/mocks"
+	"github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+const gqlWSTestSchema = `
+schema {
+	query: Query
+	subscription: Subscription
+}
+type Query {
+	hello: String!
+}
+type Subscription {
+	count(to: Int!): Int!
+}
+`
+
+type gqlWSTestResolver struct{}
+
+func (gqlWSTestResolver) Hello() string { return "world" }
+
+func (gqlWSTestResolver) Count(ctx context.Context, args struct{ To int32 }) <-chan int32 {
+	ch := make(chan int32)
+	go func() {
+		defer close(ch)
+		for i := int32(1); i <= args.To; i++ {
+			select {
+			case ch <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func newGQLWSTestServer(t *testing.T, authenticator auth.Authenticator) *httptest.Server {
+	s := graphql.MustParseSchema(gqlWSTestSchema, &gqlWSTestResolver{})
+	h := newGQLWSHandler(
+		func() *graphql.Schema { return s },
+		func(ctx context.Context) context.Context { return ctx },
+		authenticator,
+		logger.TestLogger(t),
+	)
+
+	engine := gin.New()
+	engine.GET("/query", func(c *gin.Context) {
+		if c.Query("authenticated") == "true" {
+			ctx := auth.SetGQLAuthenticatedSession(c.Request.Context(), clsessions.User{Email: "gqltester@chain.link"}, "session")
+			c.Request = c.Request.WithContext(ctx)
+		}
+	}, h.ServeGin)
+
+	server := httptest.NewServer(engine)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func dialGQLWS(t *testing.T, server *httptest.Server, protocol string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/query?authenticated=true"
+	conn, resp, err := (&websocket.Dialer{Subprotocols: []string{protocol}}).Dial(url, nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, protocol, conn.Subprotocol())
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func writeGQLWS(t *testing.T, conn *websocket.Conn, msg string) {
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+}
+
+func readGQLWS(t *testing.T, conn *websocket.Conn) gqlWSMessage {
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var msg gqlWSMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func TestGQLWSHandler_GraphQLTransportWS(t *testing.T) {
+	t.Parallel()
+
+	server := newGQLWSTestServer(t, &sessionsMocks.ORM{})
+	conn := dialGQLWS(t, server, graphqlTransportWSProtocol)
+
+	writeGQLWS(t, conn, `{"type": "connection_init"}`)
+	assert.Equal(t, gqlWSConnectionAck, readGQLWS(t, conn).Type)
+
+	writeGQLWS(t, conn, `{"type": "ping"}`)
+	assert.Equal(t, gqlWSPong, readGQLWS(t, conn).Type)
+
+	writeGQLWS(t, conn, `{"id": "1", "type": "subscribe", "payload": {"query": "subscription { count(to: 2) }"}}`)
+	for _, expected := range []string{`{"data":{"count":1}}`, `{"data":{"count":2}}`} {
+		msg := readGQLWS(t, conn)
+		assert.Equal(t, gqlWSNext, msg.Type)
+		assert.Equal(t, "1", msg.ID)
+		assert.JSONEq(t, expected, string(msg.Payload))
+	}
+	msg := readGQLWS(t, conn)
+	assert.Equal(t, gqlWSComplete, msg.Type)
+	assert.Equal(t, "1", msg.ID)
+
+	// Queries are also served
+	writeGQLWS(t, conn, `{"id": "2", "type": "subscribe", "payload": {"query": "{ hello }"}}`)
+	msg = readGQLWS(t, conn)
+	assert.Equal(t, gqlWSNext, msg.Type)
+	assert.JSONEq(t, `{"data":{"hello":"world"}}`, string(msg.Payload))
+	assert.Equal(t, gqlWSComplete, readGQLWS(t, conn).Type)
+
+	// Invalid operations fail with an error
+	writeGQLWS(t, conn, `{"id": "3", "type": "subscribe", "payload": {"query": "subscription { unknown }"}}`)
+	msg = readGQLWS(t, conn)
+	assert.Equal(t, gqlWSError, msg.Type)
+	var errs []map[string]interface{}
+	require.NoError(t, json.Unmarshal(msg.Payload, &errs))
+	require.Len(t, errs, 1)
+}
+
+func TestGQLWSHandler_GraphQLWS(t *testing.T) {
+	t.Parallel()
+
+	server := newGQLWSTestServer(t, &sessionsMocks.ORM{})
+	conn := dialGQLWS(t, server, graphqlWSProtocol)
+
+	writeGQLWS(t, conn, `{"type": "connection_init"}`)
+	assert.Equal(t, gqlWSConnectionAck, readGQLWS(t, conn).Type)
+	assert.Equal(t, gqlWSKeepAlive, readGQLWS(t, conn).Type)
+
+	writeGQLWS(t, conn, `{"id": "1", "type": "start", "payload": {"query": "subscription { count(to: 1) }"}}`)
+	msg := readGQLWS(t, conn)
+	assert.Equal(t, gqlWSData, msg.Type)
+	assert.JSONEq(t, `{"data":{"count":1}}`, string(msg.Payload))
+	assert.Equal(t, gqlWSComplete, readGQLWS(t, conn).Type)
+}
+
+func TestGQLWSHandler_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	server := newGQLWSTestServer(t, &sessionsMocks.ORM{})
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/query"
+	_, resp, err := (&websocket.Dialer{Subprotocols: []string{graphqlTransportWSProtocol}}).Dial(url, nil)
+	require.Error(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestGQLWSHandler_SubscribeBeforeInit(t *testing.T) {
+	t.Parallel()
+
+	server := newGQLWSTestServer(t, &sessionsMocks.ORM{})
+	conn := dialGQLWS(t, server, graphqlTransportWSProtocol)
+
+	writeGQLWS(t, conn, `{"id": "1", "type": "subscribe", "payload": {"query": "subscription { count(to: 1) }"}}`)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, _, err := conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, gqlWSCloseUnauthorized), err)
+}
+
+func TestGQLWSHandler_SessionExpired(t *testing.T) {
+	interval := gqlWSSessionCheckInterval
+	gqlWSSessionCheckInterval = 10 * time.Millisecond
+	t.Cleanup(func() { gqlWSSessionCheckInterval = interval })
+
+	authenticator := &sessionsMocks.ORM{}
+	authenticator.On("AuthorizedUserWithSession", "session").Return(clsessions.User{}, errors.New("session expired")).Once()
+	server := newGQLWSTestServer(t, authenticator)
+	conn := dialGQLWS(t, server, graphqlTransportWSProtocol)
+
+	writeGQLWS(t, conn, `{"type": "connection_init"}`)
+
+	// The connection may be closed before or after it is acknowledged
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var err error
+	for err == nil {
+		_, _, err = conn.ReadMessage()
+	}
+	assert.True(t, websocket.IsCloseError(err, gqlWSCloseUnauthorized), err)
+	mock.AssertExpectationsForObjects(t, authenticator)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -25,6 +26,13 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 
 	ready, errors := checker.IsReady()
 
+	// A standby node is healthy, but must not receive traffic
+	ha := hc.App.GetHAStatus()
+	haErr := ha.Ready()
+	if haErr != nil {
+		ready = false
+	}
+
 	if !ready {
 		status = http.StatusServiceUnavailable
 	}
@@ -54,10 +62,31 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 		})
 	}
 
+	if ha.Enabled() {
+		checks = append(checks, haCheck(ha, haErr))
+	}
+
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
 }
 
+const haCheckName = "HAStatus"
+
+// haCheck exposes the role of the node as a single check, so that the leader
+// can be told apart from the outside. err fails the check.
+func haCheck(ha pg.HAStatus, err error) presenters.Check {
+	status := services.StatusPassing
+	if err != nil {
+		status = services.StatusFailing
+	}
+	return presenters.Check{
+		JAID:   presenters.NewJAID(haCheckName),
+		Name:   haCheckName,
+		Status: status,
+		Output: string(ha.State()),
+	}
+}
+
 func (hc *HealthController) Health(c *gin.Context) {
 	status := http.StatusOK
 
@@ -90,6 +119,11 @@ func (hc *HealthController) Health(c *gin.Context) {
 		})
 	}
 
+	// A standby node is healthy, so the check only reports the role
+	if ha := hc.App.GetHAStatus(); ha.Enabled() {
+		checks = append(checks, haCheck(ha, nil))
+	}
+
 	// return a json description of all the checks
 	jsonAPIResponse(c, checks, "checks")
 }
@@ -1,12 +1,18 @@
 package web_test
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,3 +53,50 @@ func TestHealthController_Readyz(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthController_Readyz_HAStatus(t *testing.T) {
+	for _, state := range []pg.HAState{pg.HAStateStandby, pg.HAStateLeader} {
+		state := state
+		t.Run(string(state), func(t *testing.T) {
+			app := cltest.NewApplicationWithKey(t)
+			healthChecker := new(mocks.Checker)
+			healthChecker.On("Start").Return(nil).Once()
+			healthChecker.On("IsReady").Return(true, map[string]error{"EVM": nil}).Once()
+			healthChecker.On("Close").Return(nil).Once()
+			app.HealthChecker = healthChecker
+
+			ha := pgmocks.NewHAStatus(t)
+			ha.On("Enabled").Return(true)
+			ha.On("State").Return(state)
+			var readyErr error
+			if state == pg.HAStateStandby {
+				readyErr = errors.New("node is on standby")
+			}
+			ha.On("Ready").Return(readyErr)
+			app.HAStatus = ha
+			require.NoError(t, app.Start(testutils.Context(t)))
+
+			client := app.NewHTTPClient()
+			resp, cleanup := client.Get("/readyz?full=1")
+			t.Cleanup(cleanup)
+
+			var checks []presenters.Check
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &checks))
+			var haChecks []presenters.Check
+			for _, c := range checks {
+				if c.Name == "HAStatus" {
+					haChecks = append(haChecks, c)
+				}
+			}
+			require.Len(t, haChecks, 1)
+			assert.Equal(t, string(state), haChecks[0].Output)
+			if state == pg.HAStateStandby {
+				assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+				assert.Equal(t, services.StatusFailing, haChecks[0].Status)
+			} else {
+				assert.Equal(t, http.StatusOK, resp.StatusCode)
+				assert.Equal(t, services.StatusPassing, haChecks[0].Status)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -98,65 +100,288 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, link, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	var jb job.Job
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	if link != nil {
+		err = jc.App.GetTemplatesService().CreateJob(ctx, &jb, *link)
+	} else {
+		err = jc.App.AddJobV2(ctx, &jb)
+	}
+	if err != nil {
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// Update validates the new spec of a job and replaces the running job with
+// it, keeping the job ID and run history.
+// Example:
+// "PUT <application>/jobs/:ID"
+func (jc *JobsController) Update(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	request := CreateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jc.update(c, j.ID, request.TOML)
+}
+
+// Versions lists every recorded spec of a job, oldest first.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := jc.App.JobORM().FindSpecVersions(j.ID, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions), "job_spec_versions")
+}
+
+// Diff returns the diff between two versions of the spec of a job. to
+// defaults to the latest version and from to the version before to.
+// Example:
+// "GET <application>/jobs/:ID/diff?from=1&to=2"
+func (jc *JobsController) Diff(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	versions, err := jc.App.JobORM().FindSpecVersions(j.ID, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(versions) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job has no recorded spec versions"))
+		return
+	}
+
+	to := versions[len(versions)-1].Version
+	if s := c.Query("to"); s != "" {
+		if to, err = parseSpecVersion(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+	from := to - 1
+	if s := c.Query("from"); s != "" {
+		if from, err = parseSpecVersion(s); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	fromVersion, toVersion := job.SpecVersion{Version: from}, job.SpecVersion{}
+	var foundFrom, foundTo bool
+	for _, v := range versions {
+		if v.Version == from {
+			fromVersion, foundFrom = v, true
+		}
+		if v.Version == to {
+			toVersion, foundTo = v, true
+		}
+	}
+	// Diffing the first version against nothing shows the whole spec
+	if !foundTo || (!foundFrom && from != 0) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job spec version not found"))
+		return
+	}
+
+	diff, err := fromVersion.Diff(toVersion)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpecDiffResource(j.ID, from, to, diff), "job_spec_diffs")
+}
+
+// Rollback reapplies a previous version of the spec of a job. The rolled back
+// spec is recorded as a new version.
+// Example:
+// "POST <application>/jobs/:ID/versions/:version/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	version, err := parseSpecVersion(c.Param("version"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	v, err := jc.App.JobORM().FindSpecVersion(j.ID, version, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job spec version not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.update(c, j.ID, v.TOML)
+}
+
+// Pause stops the services of a job without deleting it. Runs of a paused job
+// are rejected until it is resumed.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	jc.setPaused(c, jc.App.PauseJob)
+}
+
+// Resume restarts the services of a paused job.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	jc.setPaused(c, jc.App.ResumeJob)
+}
+
+func (jc *JobsController) setPaused(c *gin.Context, fn func(ctx context.Context, jobID int32) error) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := fn(c.Request.Context(), j.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jb, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), "jobs")
+}
+
+// update validates spec and applies it to the job with the given ID.
+func (jc *JobsController) update(c *gin.Context, jobID int32, spec string) {
+	jb, link, status, err := jc.validateJobSpec(spec)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	jb.ID = jobID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	if err = jc.App.UpdateJob(ctx, &jb, spec, link); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		}
+		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validateJobSpec renders any pipeline template referenced by spec and
+// validates it, returning the HTTP status to respond with on error.
+func (jc *JobsController) validateJobSpec(spec string) (jb job.Job, link *templates.JobTemplate, status int, err error) {
+	// Render the observationSource if the spec references a pipeline template
+	tomlSpec, link, err := templates.ExpandJobSpec(spec, func(name string, version int32) (templates.Template, error) {
+		return jc.App.GetTemplatesService().GetTemplate(name, version)
+	})
+	if err != nil {
+		return jb, nil, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
+	jobType, err := job.ValidateSpec(tomlSpec)
+	if err != nil {
+		return jb, nil, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, request.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, tomlSpec)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, nil, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), request.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), tomlSpec)
 		if !config.Dev() && !config.FeatureOffchainReporting2() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration"))
-			return
+			return jb, nil, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlSpec)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), tomlSpec)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlSpec)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlSpec)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlSpec)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlSpec, jc.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(request.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlSpec)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(request.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlSpec)
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
-		return
+		return jb, nil, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
 	}
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
-		return
+		return jb, nil, http.StatusBadRequest, err
 	}
+	// The spec is recorded as is, so that template references are kept
+	jb.TOMLSpec = spec
+	return jb, link, http.StatusOK, nil
+}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
-	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
-	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
-		jsonAPIError(c, http.StatusInternalServerError, err)
-		return
+func parseSpecVersion(s string) (int32, error) {
+	v, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || v < 1 {
+		return 0, errors.Errorf("invalid job spec version: %s", s)
 	}
-
-	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+	return int32(v), nil
 }
 
 // Delete hard deletes a job spec.
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -374,6 +375,123 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Update_Versions_Rollback(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: testspecs.CronSpec,
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+
+	updatedSpec := strings.Replace(testspecs.CronSpec, "* 0 0 1 1 *", "* 0 0 1 2 *", 1)
+	body, _ = json.Marshal(web.CreateJobRequest{
+		TOML: updatedSpec,
+	})
+	response, cleanup = client.Put("/v2/jobs/"+created.ID, bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	updated := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &updated))
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, "CRON_TZ=UTC * 0 0 1 2 *", updated.CronSpec.CronSchedule)
+
+	response, cleanup = client.Get("/v2/jobs/" + created.ID + "/versions")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	var versions []presenters.JobSpecVersionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, testspecs.CronSpec, versions[0].TOML)
+	assert.Equal(t, updatedSpec, versions[1].TOML)
+
+	response, cleanup = client.Get("/v2/jobs/" + created.ID + "/diff")
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	diff := presenters.JobSpecDiffResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &diff))
+	assert.Equal(t, int32(1), diff.FromVersion)
+	assert.Equal(t, int32(2), diff.ToVersion)
+	assert.Contains(t, diff.Diff, `-schedule            = "CRON_TZ=UTC * 0 0 1 1 *"`)
+	assert.Contains(t, diff.Diff, `+schedule            = "CRON_TZ=UTC * 0 0 1 2 *"`)
+
+	response, cleanup = client.Post("/v2/jobs/"+created.ID+"/versions/1/rollback", nil)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	rolledBack := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &rolledBack))
+	assert.Equal(t, "CRON_TZ=UTC * 0 0 1 1 *", rolledBack.CronSpec.CronSchedule)
+
+	specVersions, err := app.JobORM().FindSpecVersions(mustInt32FromString(t, created.ID))
+	require.NoError(t, err)
+	require.Len(t, specVersions, 3)
+	assert.Equal(t, testspecs.CronSpec, specVersions[2].TOML)
+
+	response, cleanup = client.Post("/v2/jobs/"+created.ID+"/versions/9/rollback", nil)
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	response, cleanup = client.Put("/v2/jobs/999999999", bytes.NewReader(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestJobsController_Pause_Resume(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient()
+
+	body, _ := json.Marshal(web.CreateJobRequest{
+		TOML: `
+			type            = "webhook"
+			schemaVersion   = 1
+			observationSource   = """
+				ds [type=memo value="42"]
+			"""
+		`,
+	})
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	created := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+	require.False(t, created.PausedAt.Valid)
+	runPath := "/v2/jobs/" + created.ExternalJobID.String() + "/runs"
+
+	response, cleanup = client.Post("/v2/jobs/"+created.ID+"/pause", nil)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	paused := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &paused))
+	assert.True(t, paused.PausedAt.Valid)
+
+	response, cleanup = client.Post(runPath, nil)
+	defer cleanup()
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	response, cleanup = client.Post("/v2/jobs/"+created.ID+"/resume", nil)
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resumed := presenters.JobResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resumed))
+	assert.False(t, resumed.PausedAt.Valid)
+
+	response, cleanup = client.Post(runPath, nil)
+	defer cleanup()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/pause", nil)
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
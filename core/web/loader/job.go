@@ -84,8 +84,8 @@ func (b *jobBatcher) loadByPipelineSpecIDs(_ context.Context, keys dataloader.Ke
 
 	// Construct the output array of dataloader results
 	results := make([]*dataloader.Result, len(keys))
-	for _, j := range jobs {
-		id := stringutils.FromInt32(j.PipelineSpecID)
+	for specID, j := range jobs {
+		id := stringutils.FromInt32(specID)
 
 		ix, ok := keyOrder[id]
 		// if found, remove from index lookup map, so we know elements were found
@@ -27,7 +27,7 @@ type Dataloader struct {
 	SpecErrorsByJobIDLoader                   *dataloader.Loader
 }
 
-func New(app chainlink.Application) *Dataloader {
+func New(app chainlink.Application, opts ...dataloader.Option) *Dataloader {
 	var (
 		nodes    = &nodeBatcher{app: app}
 		chains   = &chainBatcher{app: app}
@@ -44,17 +44,17 @@ func New(app chainlink.Application) *Dataloader {
 	return &Dataloader{
 		app: app,
 
-		ChainsByIDLoader:                          dataloader.NewBatchedLoader(chains.loadByIDs),
-		EthTxAttemptsByEthTxIDLoader:              dataloader.NewBatchedLoader(attmpts.loadByEthTransactionIDs),
-		FeedsManagersByIDLoader:                   dataloader.NewBatchedLoader(mgrs.loadByIDs),
-		FeedsManagerChainConfigsByManagerIDLoader: dataloader.NewBatchedLoader(ccfgs.loadByManagerIDs),
-		JobProposalsByManagerIDLoader:             dataloader.NewBatchedLoader(jps.loadByManagersIDs),
-		JobProposalSpecsByJobProposalID:           dataloader.NewBatchedLoader(jpSpecs.loadByJobProposalsIDs),
-		JobRunsByIDLoader:                         dataloader.NewBatchedLoader(jobRuns.loadByIDs),
-		JobsByExternalJobIDs:                      dataloader.NewBatchedLoader(jbs.loadByExternalJobIDs),
-		JobsByPipelineSpecIDLoader:                dataloader.NewBatchedLoader(jbs.loadByPipelineSpecIDs),
-		NodesByChainIDLoader:                      dataloader.NewBatchedLoader(nodes.loadByChainIDs),
-		SpecErrorsByJobIDLoader:                   dataloader.NewBatchedLoader(specErrs.loadByJobIDs),
+		ChainsByIDLoader:                          dataloader.NewBatchedLoader(chains.loadByIDs, opts...),
+		EthTxAttemptsByEthTxIDLoader:              dataloader.NewBatchedLoader(attmpts.loadByEthTransactionIDs, opts...),
+		FeedsManagersByIDLoader:                   dataloader.NewBatchedLoader(mgrs.loadByIDs, opts...),
+		FeedsManagerChainConfigsByManagerIDLoader: dataloader.NewBatchedLoader(ccfgs.loadByManagerIDs, opts...),
+		JobProposalsByManagerIDLoader:             dataloader.NewBatchedLoader(jps.loadByManagersIDs, opts...),
+		JobProposalSpecsByJobProposalID:           dataloader.NewBatchedLoader(jpSpecs.loadByJobProposalsIDs, opts...),
+		JobRunsByIDLoader:                         dataloader.NewBatchedLoader(jobRuns.loadByIDs, opts...),
+		JobsByExternalJobIDs:                      dataloader.NewBatchedLoader(jbs.loadByExternalJobIDs, opts...),
+		JobsByPipelineSpecIDLoader:                dataloader.NewBatchedLoader(jbs.loadByPipelineSpecIDs, opts...),
+		NodesByChainIDLoader:                      dataloader.NewBatchedLoader(nodes.loadByChainIDs, opts...),
+		SpecErrorsByJobIDLoader:                   dataloader.NewBatchedLoader(specErrs.loadByJobIDs, opts...),
 	}
 }
 
@@ -72,6 +72,13 @@ func InjectDataloader(ctx context.Context, app chainlink.Application) context.Co
 	return context.WithValue(ctx, loadersKey{}, New(app))
 }
 
+// InjectUncachedDataloader injects a dataloader which does not cache its
+// results into the context. It is used for long-lived GraphQL subscriptions,
+// where cached results would become stale.
+func InjectUncachedDataloader(ctx context.Context, app chainlink.Application) context.Context {
+	return context.WithValue(ctx, loadersKey{}, New(app, dataloader.WithCache(&dataloader.NoCache{})))
+}
+
 // For returns the dataloader for a given context
 func For(ctx context.Context) *Dataloader {
 	return ctx.Value(loadersKey{}).(*Dataloader)
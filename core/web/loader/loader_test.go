@@ -259,8 +259,8 @@ func TestLoader_JobsByPipelineSpecIDs(t *testing.T) {
 		job2 := job.Job{ID: int32(3), PipelineSpecID: int32(2)}
 		job3 := job.Job{ID: int32(4), PipelineSpecID: int32(3)}
 
-		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return([]job.Job{
-			job1, job2, job3,
+		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return(map[int32]job.Job{
+			1: job1, 2: job2, 3: job3,
 		}, nil)
 		app.On("JobORM").Return(jobsORM)
 
@@ -286,7 +286,7 @@ func TestLoader_JobsByPipelineSpecIDs(t *testing.T) {
 			mock.AssertExpectationsForObjects(t, app, jobsORM)
 		})
 
-		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return([]job.Job{}, sql.ErrNoRows)
+		jobsORM.On("FindJobsByPipelineSpecIDs", []int32{3, 1, 2}).Return(map[int32]job.Job{}, sql.ErrNoRows)
 		app.On("JobORM").Return(jobsORM)
 
 		batcher := jobBatcher{app}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -22,6 +24,13 @@ type LogPatchRequest struct {
 	SqlEnabled *bool  `json:"sqlEnabled"`
 }
 
+// ServiceLogLevelRequest sets the log level of a named logger subtree.
+type ServiceLogLevelRequest struct {
+	Level string `json:"level"`
+	// ExpiresIn is an optional duration, e.g. 30m, after which the level is removed.
+	ExpiresIn string `json:"expiresIn"`
+}
+
 // Get retrieves the current log config settings
 func (cc *LogController) Get(c *gin.Context) {
 	var svcs, lvls []string
@@ -31,6 +40,11 @@ func (cc *LogController) Get(c *gin.Context) {
 	svcs = append(svcs, "IsSqlEnabled")
 	lvls = append(lvls, strconv.FormatBool(cc.App.GetConfig().LogSQL()))
 
+	for _, sl := range cc.App.ServiceLogLevels() {
+		svcs = append(svcs, sl.Name)
+		lvls = append(lvls, sl.Level.String())
+	}
+
 	response := &presenters.ServiceLogConfigResource{
 		JAID: presenters.JAID{
 			ID: "log",
@@ -92,3 +106,56 @@ func (cc *LogController) Patch(c *gin.Context) {
 
 	jsonAPIResponse(c, response, "log")
 }
+
+// ServiceLevels lists the runtime log levels of named logger subtrees
+// Example:
+//  "<application>/log/services"
+func (cc *LogController) ServiceLevels(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewServiceLogLevelResources(cc.App.ServiceLogLevels()), "serviceLogLevels")
+}
+
+// SetServiceLevel sets the runtime log level of a named logger subtree, e.g. EVM.1.Txm
+// Example:
+//  "<application>/log/services/:service"
+func (cc *LogController) SetServiceLevel(c *gin.Context) {
+	request := &ServiceLogLevelRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var ll zapcore.Level
+	if err := ll.UnmarshalText([]byte(request.Level)); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	var expiresIn time.Duration
+	if request.ExpiresIn != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(request.ExpiresIn)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, errors.Wrap(err, "invalid expiresIn"))
+			return
+		}
+	}
+
+	sl, err := cc.App.SetServiceLogLevel(c.Param("service"), ll, expiresIn)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewServiceLogLevelResource(sl), "serviceLogLevel")
+}
+
+// UnsetServiceLevel removes the runtime log level of a named logger subtree, which reverts to the global level
+// Example:
+//  "<application>/log/services/:service"
+func (cc *LogController) UnsetServiceLevel(c *gin.Context) {
+	if !cc.App.UnsetServiceLogLevel(c.Param("service")) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("service log level not found"))
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "serviceLogLevel", http.StatusNoContent)
+}
@@ -146,3 +146,66 @@ func TestLogController_PatchLogConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLogController_ServiceLogLevels(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	// service log levels are process wide, so use a name no other test sets
+	const service = "LogControllerTest.Txm"
+
+	put := func(request web.ServiceLogLevelRequest) (*http.Response, func()) {
+		requestData, err := json.Marshal(request)
+		require.NoError(t, err)
+		return client.Put("/v2/log/services/"+service, bytes.NewBuffer(requestData))
+	}
+
+	resp, cleanup := put(web.ServiceLogLevelRequest{Level: "test"})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = put(web.ServiceLogLevelRequest{Level: "debug", ExpiresIn: "soon"})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	resp, cleanup = put(web.ServiceLogLevelRequest{Level: "debug", ExpiresIn: "1h"})
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var level presenters.ServiceLogLevelResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &level))
+	assert.Equal(t, service, level.ID)
+	assert.Equal(t, "debug", level.Level)
+	require.NotNil(t, level.Expires)
+
+	resp, cleanup = client.Get("/v2/log/services")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var levels []presenters.ServiceLogLevelResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &levels))
+	var found bool
+	for _, l := range levels {
+		if l.ID == service {
+			found = true
+			assert.Equal(t, "debug", l.Level)
+		}
+	}
+	assert.True(t, found)
+
+	resp, cleanup = client.Get("/v2/log")
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var svcLogConfig presenters.ServiceLogConfigResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &svcLogConfig))
+	assert.Contains(t, svcLogConfig.ServiceName, service)
+
+	resp, cleanup = client.Delete("/v2/log/services/" + service)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Delete("/v2/log/services/" + service)
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -2,10 +2,14 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -24,10 +28,29 @@ type PipelineRunsController struct {
 	App chainlink.Application
 }
 
+// pipelineRunsFilterParams are the query params which filter pipeline runs.
+// Requests using any of them are paginated with a cursor instead of pages.
+var pipelineRunsFilterParams = []string{"jobID", "state", "createdAfter", "createdBefore", "taskType", "taskName", "error", "sort", "cursor"}
+
 // Index returns all pipeline runs for a job.
 // Example:
 // "GET <application>/jobs/:ID/runs"
+//
+// Runs can be filtered with the state (comma separated), createdAfter and
+// createdBefore (RFC3339), taskType, taskName and error (substring) query
+// params, and on the global route with jobID. Filtered runs are sorted with
+// sort=asc or desc, and paginated with the cursor returned in the
+// nextCursor meta.
+// Example:
+// "GET <application>/jobs/:ID/runs?state=errored&taskName=ds1&error=timeout&createdAfter=2022-05-01T00:00:00Z"
 func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int) {
+	for _, param := range pipelineRunsFilterParams {
+		if _, ok := c.GetQuery(param); ok {
+			prc.filter(c, size)
+			return
+		}
+	}
+
 	id := c.Param("ID")
 
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
@@ -61,6 +84,93 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+func (prc *PipelineRunsController) filter(c *gin.Context, size int) {
+	filter, err := parsePipelineRunsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	filter.Limit = size
+
+	pipelineRuns, next, err := prc.App.JobORM().FilterPipelineRuns(filter)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var cursor string
+	if next != nil {
+		cursor = strconv.FormatInt(*next, 10)
+	}
+	res := presenters.NewPipelineRunResources(pipelineRuns, prc.App.GetLogger())
+	buffer, err := NewCursorPaginatedResponse(*c.Request.URL, cursor, res)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to marshal document: %+v", err))
+		return
+	}
+	c.Data(http.StatusOK, MediaType, buffer)
+}
+
+func parsePipelineRunsFilter(c *gin.Context) (filter job.PipelineRunsFilter, err error) {
+	id := c.Param("ID")
+	if id == "" {
+		id = c.Query("jobID")
+	}
+	if id != "" {
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(id); err != nil {
+			return filter, err
+		}
+		filter.JobID = &jobSpec.ID
+	}
+
+	if states := c.Query("state"); states != "" {
+		for _, state := range strings.Split(states, ",") {
+			switch status := pipeline.RunStatus(strings.ToLower(strings.TrimSpace(state))); status {
+			case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+				filter.States = append(filter.States, status)
+			default:
+				return filter, errors.Errorf("invalid state %q, must be one of running, suspended, errored or completed", state)
+			}
+		}
+	}
+
+	for param, dst := range map[string]**time.Time{
+		"createdAfter":  &filter.CreatedAfter,
+		"createdBefore": &filter.CreatedBefore,
+	} {
+		if value := c.Query(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, errors.Wrapf(err, "invalid %s", param)
+			}
+			*dst = &t
+		}
+	}
+
+	filter.TaskType = c.Query("taskType")
+	filter.TaskName = c.Query("taskName")
+	filter.ErrorContains = c.Query("error")
+
+	switch c.Query("sort") {
+	case "", "desc":
+	case "asc":
+		filter.Ascending = true
+	default:
+		return filter, errors.New("invalid sort, must be asc or desc")
+	}
+
+	if cursor := c.Query("cursor"); cursor != "" {
+		after, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid cursor")
+		}
+		filter.After = &after
+	}
+
+	return filter, nil
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -120,6 +230,9 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err3)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -139,7 +252,10 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		if err == nil {
 			jobID = int32(jobID64)
 			jobRunID, err := prc.App.RunJobV2(c.Request.Context(), jobID, nil)
-			if err != nil {
+			if errors.Is(err, job.ErrJobPaused) {
+				jsonAPIError(c, http.StatusConflict, err)
+				return
+			} else if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
 				return
 			}
@@ -151,6 +267,59 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// RerunPipelineRunRequest is the optional body of a rerun request.
+type RerunPipelineRunRequest struct {
+	// OriginalSpec reruns the pipeline as it was when the run was created,
+	// instead of the current version of the job.
+	OriginalSpec bool `json:"originalSpec"`
+	// ConfirmSideEffects must be set to rerun pipelines which have tasks
+	// with side effects, such as ethtx, bridge and http.
+	ConfirmSideEffects bool `json:"confirmSideEffects"`
+}
+
+// Rerun executes a finished pipeline run again with the same inputs.
+// Example:
+// "POST <application>/pipeline/runs/:runID/rerun"
+func (prc *PipelineRunsController) Rerun(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	if err := pipelineRun.SetID(c.Param("runID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var request RerunPipelineRunRequest
+	if c.Request.ContentLength != 0 {
+		if err := json.NewDecoder(c.Request.Body).Decode(&request); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal JSON body"))
+			return
+		}
+	}
+
+	rerunID, err := prc.App.RerunJobRun(c.Request.Context(), pipelineRun.ID, job.RerunOpts{
+		OriginalSpec:       request.OriginalSpec,
+		ConfirmSideEffects: request.ConfirmSideEffects,
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	case errors.Is(err, job.ErrRunNotFinished), errors.Is(err, job.ErrRerunNotConfirmed), errors.Is(err, job.ErrJobPaused):
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rerun, err := prc.App.PipelineORM().FindRun(rerunID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	res := presenters.NewPipelineRunResource(rerun, prc.App.GetLogger())
+	jsonAPIResponseWithStatus(c, res, "pipelineRun", http.StatusCreated)
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
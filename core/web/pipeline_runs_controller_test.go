@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -213,6 +214,46 @@ func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	require.Len(t, parsedResponse[0].TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Index_Filter(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/pipeline/runs?jobID=%d&state=completed&taskName=ds3&error=UH+OH&sort=asc&size=1", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse []presenters.PipelineRunResource
+	var links jsonapi.Links
+	responseBytes := cltest.ParseResponseBody(t, response)
+	require.NoError(t, web.ParsePaginatedResponse(responseBytes, &parsedResponse, &links))
+	assert.Contains(t, string(responseBytes), fmt.Sprintf(`"meta":{"nextCursor":"%d"}`, runIDs[0]))
+
+	require.Len(t, parsedResponse, 1)
+	assert.Equal(t, strconv.Itoa(int(runIDs[0])), parsedResponse[0].ID)
+	require.Contains(t, links, web.KeyNextLink)
+
+	response, cleanup = client.Get(links[web.KeyNextLink].Href)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	links = jsonapi.Links{}
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, response), &parsedResponse, &links))
+	require.Len(t, parsedResponse, 1)
+	assert.Equal(t, strconv.Itoa(int(runIDs[1])), parsedResponse[0].ID)
+	assert.NotContains(t, links, web.KeyNextLink)
+
+	// No run matches another task
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%d/runs?taskName=ds1&error=uh+oh", jobID))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var filtered []presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &filtered))
+	assert.Empty(t, filtered)
+
+	response, cleanup = client.Get("/v2/pipeline/runs?state=unknown")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -232,6 +273,37 @@ func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	require.Len(t, parsedResponse.TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Rerun(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/pipeline/runs/%d/rerun", runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusCreated)
+
+	var parsedResponse presenters.PipelineRunResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+	assert.NotEqual(t, strconv.Itoa(int(runIDs[0])), parsedResponse.ID)
+	assert.Equal(t, null.IntFrom(runIDs[0]), parsedResponse.RerunOfID)
+	assert.Equal(t, jobID, parsedResponse.PipelineSpec.JobID)
+	assert.True(t, parsedResponse.FinishedAt.Valid)
+	require.Len(t, parsedResponse.TaskRuns, 8)
+
+	// The original spec is the one the run references, no version was
+	// recorded for the job
+	body := strings.NewReader(`{"originalSpec": true}`)
+	response, cleanup = client.Post(fmt.Sprintf("/v2/pipeline/runs/%d/rerun", runIDs[1]), body)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusCreated)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/999999/rerun", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/invalid-run-ID/rerun", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
 func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	t.Parallel()
 	app := cltest.NewApplicationEVMDisabled(t)
@@ -0,0 +1,189 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// PipelineTemplatesController manages pipeline templates.
+type PipelineTemplatesController struct {
+	App chainlink.Application
+}
+
+// Index lists the latest version of every pipeline template.
+// Example:
+// "GET <application>/templates"
+func (tc *PipelineTemplatesController) Index(c *gin.Context, size, page, offset int) {
+	ts, count, err := tc.App.GetTemplatesService().ListTemplates(offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "pipeline_templates", size, page, presenters.NewPipelineTemplateResources(ts), count, err)
+}
+
+// CreatePipelineTemplateRequest represents a request to create a pipeline
+// template, or a new version of an existing one.
+type CreatePipelineTemplateRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Create adds a pipeline template, or a new version of an existing one.
+// Example:
+// "POST <application>/templates"
+func (tc *PipelineTemplatesController) Create(c *gin.Context) {
+	request := CreatePipelineTemplateRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if _, err := templates.ParseTemplateSpec(request.TOML); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	t, err := tc.App.GetTemplatesService().CreateTemplate(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, presenters.NewPipelineTemplateResource(t), "pipeline_templates", http.StatusCreated)
+}
+
+// Show returns a version of a pipeline template, the latest unless the
+// version query parameter is given.
+// Example:
+// "GET <application>/templates/:name?version=2"
+func (tc *PipelineTemplatesController) Show(c *gin.Context) {
+	version, err := versionQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	t, err := tc.App.GetTemplatesService().GetTemplate(c.Param("name"), version)
+	if errors.Is(err, templates.ErrTemplateNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResource(t), "pipeline_templates")
+}
+
+// Versions lists all versions of a pipeline template, newest first.
+// Example:
+// "GET <application>/templates/:name/versions"
+func (tc *PipelineTemplatesController) Versions(c *gin.Context) {
+	ts, err := tc.App.GetTemplatesService().ListTemplateVersions(c.Param("name"))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(ts) == 0 {
+		jsonAPIError(c, http.StatusNotFound, templates.ErrTemplateNotFound)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateResources(ts), "pipeline_templates")
+}
+
+// Delete removes all versions of a pipeline template which is not used by
+// any job.
+// Example:
+// "DELETE <application>/templates/:name"
+func (tc *PipelineTemplatesController) Delete(c *gin.Context) {
+	err := tc.App.GetTemplatesService().DeleteTemplate(c.Request.Context(), c.Param("name"))
+	if errors.Is(err, templates.ErrTemplateNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if errors.Is(err, templates.ErrTemplateInUse) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "pipeline_templates", http.StatusNoContent)
+}
+
+// PlanRollForward shows how jobs using another version of a pipeline
+// template would change when rolled forward, the latest version unless the
+// version query parameter is given.
+// Example:
+// "GET <application>/templates/:name/rollforward?version=2"
+func (tc *PipelineTemplatesController) PlanRollForward(c *gin.Context) {
+	version, err := versionQuery(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	changes, err := tc.App.GetTemplatesService().PlanRollForward(c.Request.Context(), c.Param("name"), version)
+	if errors.Is(err, templates.ErrTemplateNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateJobChangeResources(changes), "pipeline_template_job_changes")
+}
+
+// RollForwardPipelineTemplateRequest represents a request to roll jobs
+// forward to a version of a pipeline template.
+type RollForwardPipelineTemplateRequest struct {
+	// Version defaults to the latest version
+	Version int32 `json:"version"`
+	// JobIDs defaults to all jobs using another version
+	JobIDs []int32 `json:"jobIDs"`
+}
+
+// RollForward recreates jobs using another version of a pipeline template
+// with the pipeline rendered from the requested version.
+// Example:
+// "POST <application>/templates/:name/rollforward"
+func (tc *PipelineTemplatesController) RollForward(c *gin.Context) {
+	request := RollForwardPipelineTemplateRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	changes, err := tc.App.GetTemplatesService().RollForward(c.Request.Context(), c.Param("name"), request.Version, request.JobIDs)
+	if errors.Is(err, templates.ErrTemplateNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineTemplateJobChangeResources(changes), "pipeline_template_job_changes")
+}
+
+func versionQuery(c *gin.Context) (int32, error) {
+	v := c.Query("version")
+	if v == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(v, 10, 32)
+	if err != nil || version < 1 {
+		return 0, errors.Errorf("invalid version %q", v)
+	}
+	return int32(version), nil
+}
@@ -17,6 +17,9 @@ type ETHKeyResource struct {
 	EthBalance     *assets.Eth  `json:"ethBalance"`
 	LinkBalance    *assets.Link `json:"linkBalance"`
 	IsFunding      bool         `json:"isFunding"`
+	Disabled       bool         `json:"disabled"`
+	Labels         []string     `json:"labels"`
+	Remote         bool         `json:"remote"`
 	CreatedAt      time.Time    `json:"createdAt"`
 	UpdatedAt      time.Time    `json:"updatedAt"`
 	MaxGasPriceWei utils.Big    `json:"maxGasPriceWei"`
@@ -45,9 +48,15 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		EthBalance:  nil,
 		LinkBalance: nil,
 		IsFunding:   state.IsFunding,
+		Disabled:    state.Disabled,
+		Labels:      []string{},
+		Remote:      state.Remote,
 		CreatedAt:   state.CreatedAt,
 		UpdatedAt:   state.UpdatedAt,
 	}
+	if state.Labels != nil {
+		r.Labels = state.Labels
+	}
 
 	for _, opt := range opts {
 		err := opt(r)
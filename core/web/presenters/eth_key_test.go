@@ -61,6 +61,9 @@ func TestETHKeyResource(t *testing.T) {
 			  "ethBalance":"1",
 			  "linkBalance":"1",
 			  "isFunding":true,
+			  "disabled":false,
+			  "labels":[],
+			  "remote":false,
 			  "createdAt":"2000-01-01T00:00:00Z",
 			  "updatedAt":"2000-01-01T00:00:00Z",
 			  "maxGasPriceWei":"12345"
@@ -71,6 +74,9 @@ func TestETHKeyResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 
+	state.Disabled = true
+	state.Labels = []string{"keeper", "ocr"}
+	state.Remote = true
 	r, err = NewETHKeyResource(key, state,
 		SetETHKeyEthBalance(assets.NewEth(1)),
 		SetETHKeyLinkBalance(assets.NewLinkFromJuels(1)),
@@ -91,6 +97,9 @@ func TestETHKeyResource(t *testing.T) {
 				"ethBalance":"1",
 				"linkBalance":"1",
 				"isFunding":true,
+				"disabled":true,
+				"labels":["keeper","ocr"],
+				"remote":true,
 				"createdAt":"2000-01-01T00:00:00Z",
 				"updatedAt":"2000-01-01T00:00:00Z",
 				"maxGasPriceWei":"12345"
@@ -66,3 +66,43 @@ func NewEthTxResourceFromAttempt(txa txmgr.EthTxAttempt) EthTxResource {
 	}
 	return r
 }
+
+// StuckEthTxResource represents a stuck Ethereum Transaction JSONAPI resource.
+// Its ID is the ID of the transaction, which remediation actions take.
+type StuckEthTxResource struct {
+	EthTxResource
+	BlocksPending int64  `json:"blocksPending"`
+	AtMaxGasPrice bool   `json:"atMaxGasPrice"`
+	Diagnosis     string `json:"diagnosis"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (StuckEthTxResource) GetName() string {
+	return "evm_stuck_transactions"
+}
+
+// NewStuckEthTxResource generates a StuckEthTxResource from a txmgr.StuckTx,
+// with the details of its highest priced attempt.
+func NewStuckEthTxResource(stuck txmgr.StuckTx) StuckEthTxResource {
+	return StuckEthTxResource{
+		EthTxResource: NewEthTxResourceWithID(stuck.EthTx),
+		BlocksPending: stuck.BlocksPending,
+		AtMaxGasPrice: stuck.AtMaxGasPrice,
+		Diagnosis:     string(stuck.Diagnosis),
+	}
+}
+
+// NewEthTxResourceWithID generates a EthTxResource identified by the ID of
+// tx, with the details of its first attempt, if any.
+func NewEthTxResourceWithID(tx txmgr.EthTx) EthTxResource {
+	r := NewEthTxResource(tx)
+	if len(tx.EthTxAttempts) > 0 {
+		attempt := tx.EthTxAttempts[0]
+		attempt.EthTx = tx
+		r = NewEthTxResourceFromAttempt(attempt)
+	} else if tx.Nonce != nil {
+		r.Nonce = strconv.FormatUint(uint64(*tx.Nonce), 10)
+	}
+	r.JAID = NewJAIDInt64(tx.ID)
+	return r
+}
@@ -105,3 +105,59 @@ func TestEthTxResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 }
+
+func TestStuckEthTxResource(t *testing.T) {
+	t.Parallel()
+
+	nonce := int64(7)
+	broadcastBefore := int64(300)
+	tx := txmgr.EthTx{
+		ID:          42,
+		Nonce:       &nonce,
+		FromAddress: common.HexToAddress("0x1"),
+		ToAddress:   common.HexToAddress("0x2"),
+		GasLimit:    uint64(21000),
+		State:       txmgr.EthTxUnconfirmed,
+		Value:       assets.NewEthValue(0),
+		EVMChainID:  *utils.NewBigI(42),
+	}
+	tx.EthTxAttempts = []txmgr.EthTxAttempt{{
+		Hash:                    common.BytesToHash([]byte{1, 2, 3}),
+		GasPrice:                utils.NewBigI(1000),
+		SignedRawTx:             hexutil.MustDecode("0xcafe"),
+		BroadcastBeforeBlockNum: &broadcastBefore,
+	}}
+
+	r := NewStuckEthTxResource(txmgr.StuckTx{EthTx: tx, BlocksPending: 60, AtMaxGasPrice: true, Diagnosis: txmgr.StuckTxUnderpriced})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+	{
+		"data": {
+		  "type": "evm_stuck_transactions",
+		  "id": "42",
+		  "attributes": {
+			"state": "unconfirmed",
+			"data": "0x",
+			"from": "0x0000000000000000000000000000000000000001",
+			"gasLimit": "21000",
+			"gasPrice": "1000",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000010203",
+			"rawHex": "0xcafe",
+			"nonce": "7",
+			"sentAt": "300",
+			"to": "0x0000000000000000000000000000000000000002",
+			"value": "0.000000000000000000",
+			"evmChainID": "42",
+			"blocksPending": 60,
+			"atMaxGasPrice": true,
+			"diagnosis": "underpriced"
+		  }
+		}
+	  }
+	`
+
+	assert.JSONEq(t, expected, string(b))
+}
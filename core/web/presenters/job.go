@@ -1,10 +1,12 @@
 package presenters
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
+	"github.com/shopspring/decimal"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
@@ -39,26 +41,42 @@ const (
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
 type DirectRequestSpec struct {
-	ContractAddress             ethkey.EIP55Address      `json:"contractAddress"`
-	MinIncomingConfirmations    clnull.Uint32            `json:"minIncomingConfirmations"`
-	MinIncomingConfirmationsEnv bool                     `json:"minIncomingConfirmationsEnv,omitempty"`
-	MinContractPayment          *assets.Link             `json:"minContractPaymentLinkJuels"`
-	Requesters                  models.AddressCollection `json:"requesters"`
-	Initiator                   string                   `json:"initiator"`
-	CreatedAt                   time.Time                `json:"createdAt"`
-	UpdatedAt                   time.Time                `json:"updatedAt"`
-	EVMChainID                  *utils.Big               `json:"evmChainID"`
+	ContractAddress               ethkey.EIP55Address      `json:"contractAddress"`
+	MinIncomingConfirmations      clnull.Uint32            `json:"minIncomingConfirmations"`
+	MinIncomingConfirmationsEnv   bool                     `json:"minIncomingConfirmationsEnv,omitempty"`
+	MinContractPayment            *assets.Link             `json:"minContractPaymentLinkJuels"`
+	MinContractPaymentGasLimit    clnull.Uint32            `json:"minContractPaymentGasLimit"`
+	MinContractPaymentLinkEthRate *decimal.Decimal         `json:"minContractPaymentLinkEthRate"`
+	MinContractPaymentSource      string                   `json:"minContractPaymentSource"`
+	Requesters                    models.AddressCollection `json:"requesters"`
+	DeniedRequesters              models.AddressCollection `json:"deniedRequesters"`
+	RequesterRateLimit            clnull.Uint32            `json:"requesterRateLimit"`
+	RequesterRateLimitPeriod      *models.Interval         `json:"requesterRateLimitPeriod"`
+	Initiator                     string                   `json:"initiator"`
+	CreatedAt                     time.Time                `json:"createdAt"`
+	UpdatedAt                     time.Time                `json:"updatedAt"`
+	EVMChainID                    *utils.Big               `json:"evmChainID"`
 }
 
 // NewDirectRequestSpec initializes a new DirectRequestSpec from a
 // job.DirectRequestSpec
 func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
+	var rateLimitPeriod *models.Interval
+	if spec.RequesterRateLimit.Valid {
+		rateLimitPeriod = &spec.RequesterRateLimitPeriod
+	}
 	return &DirectRequestSpec{
-		ContractAddress:             spec.ContractAddress,
-		MinIncomingConfirmations:    spec.MinIncomingConfirmations,
-		MinIncomingConfirmationsEnv: spec.MinIncomingConfirmationsEnv,
-		MinContractPayment:          spec.MinContractPayment,
-		Requesters:                  spec.Requesters,
+		ContractAddress:               spec.ContractAddress,
+		MinIncomingConfirmations:      spec.MinIncomingConfirmations,
+		MinIncomingConfirmationsEnv:   spec.MinIncomingConfirmationsEnv,
+		MinContractPayment:            spec.MinContractPayment,
+		MinContractPaymentGasLimit:    spec.MinContractPaymentGasLimit,
+		MinContractPaymentLinkEthRate: spec.MinContractPaymentLinkEthRate,
+		MinContractPaymentSource:      spec.MinContractPaymentSource,
+		Requesters:                    spec.Requesters,
+		DeniedRequesters:              spec.DeniedRequesters,
+		RequesterRateLimit:            spec.RequesterRateLimit,
+		RequesterRateLimitPeriod:      rateLimitPeriod,
 		// This is hardcoded to runlog. When we support other initiators, we need
 		// to change this
 		Initiator:  "runlog",
@@ -284,6 +302,7 @@ type VRFSpec struct {
 	CoordinatorAddress            ethkey.EIP55Address   `json:"coordinatorAddress"`
 	PublicKey                     secp256k1.PublicKey   `json:"publicKey"`
 	FromAddresses                 []ethkey.EIP55Address `json:"fromAddresses"`
+	FromLabel                     string                `json:"fromLabel"`
 	PollPeriod                    models.Duration       `json:"pollPeriod"`
 	MinIncomingConfirmations      uint32                `json:"confirmations"`
 	CreatedAt                     time.Time             `json:"createdAt"`
@@ -303,6 +322,7 @@ func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 		CoordinatorAddress:       spec.CoordinatorAddress,
 		PublicKey:                spec.PublicKey,
 		FromAddresses:            spec.FromAddresses,
+		FromLabel:                spec.FromLabel,
 		PollPeriod:               models.MustMakeDuration(spec.PollPeriod),
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
 		CreatedAt:                spec.CreatedAt,
@@ -412,6 +432,7 @@ type JobResource struct {
 	BlockhashStoreSpec     *BlockhashStoreSpec     `json:"blockhashStoreSpec"`
 	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
+	PausedAt               null.Time               `json:"pausedAt"`
 	Errors                 []JobError              `json:"errors"`
 }
 
@@ -426,6 +447,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration: j.MaxTaskDuration,
 		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:   j.ExternalJobID,
+		PausedAt:        j.PausedAt,
 	}
 
 	switch j.Type {
@@ -464,3 +486,59 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecVersionResource is a recorded version of the spec of a job.
+type JobSpecVersionResource struct {
+	JAID
+	JobID     int32     `json:"jobID"`
+	Version   int32     `json:"version"`
+	TOML      string    `json:"toml"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "job_spec_versions"
+}
+
+// NewJobSpecVersionResources initializes a slice of JSONAPI job spec version
+// resources
+func NewJobSpecVersionResources(versions []job.SpecVersion) []JobSpecVersionResource {
+	rs := []JobSpecVersionResource{}
+	for _, v := range versions {
+		rs = append(rs, JobSpecVersionResource{
+			JAID:      NewJAIDInt32(v.Version),
+			JobID:     v.JobID,
+			Version:   v.Version,
+			TOML:      v.TOML,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return rs
+}
+
+// JobSpecDiffResource is the diff between two versions of the spec of a job.
+type JobSpecDiffResource struct {
+	JAID
+	JobID       int32  `json:"jobID"`
+	FromVersion int32  `json:"fromVersion"`
+	ToVersion   int32  `json:"toVersion"`
+	Diff        string `json:"diff"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecDiffResource) GetName() string {
+	return "job_spec_diffs"
+}
+
+// NewJobSpecDiffResource returns a new JobSpecDiffResource
+func NewJobSpecDiffResource(jobID, from, to int32, diff string) *JobSpecDiffResource {
+	return &JobSpecDiffResource{
+		JAID:        NewJAID(fmt.Sprintf("%d-%d-%d", jobID, from, to)),
+		JobID:       jobID,
+		FromVersion: from,
+		ToVersion:   to,
+		Diff:        diff,
+	}
+}
@@ -94,7 +94,13 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"minIncomingConfirmations": null,
 							"minContractPaymentLinkJuels": null,
+							"minContractPaymentGasLimit": null,
+							"minContractPaymentLinkEthRate": null,
+							"minContractPaymentSource": "",
 							"requesters": null,
+							"deniedRequesters": null,
+							"requesterRateLimit": null,
+							"requesterRateLimitPeriod": null,
 							"initiator": "runlog",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
@@ -110,6 +116,7 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -183,6 +190,7 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -267,6 +275,7 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -327,6 +336,7 @@ func TestJob(t *testing.T) {
                         "vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -382,6 +392,7 @@ func TestJob(t *testing.T) {
                         "webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+                        "pausedAt": null,
                         "errors": []
                     }
                 }
@@ -435,6 +446,7 @@ func TestJob(t *testing.T) {
                         "vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -504,6 +516,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -565,6 +578,7 @@ func TestJob(t *testing.T) {
 							"jobID": 0,
 							"dotDagSource": ""
 						},
+						"pausedAt": null,
 						"errors": []
 					}
 				}
@@ -634,6 +648,7 @@ func TestJob(t *testing.T) {
 						"vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"pausedAt": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
@@ -1,5 +1,11 @@
 package presenters
 
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
 type ServiceLogConfigResource struct {
 	JAID
 	ServiceName     []string `json:"serviceName"`
@@ -11,3 +17,33 @@ type ServiceLogConfigResource struct {
 func (r ServiceLogConfigResource) GetName() string {
 	return "serviceLevelLogs"
 }
+
+// ServiceLogLevelResource represents a runtime log level of a named logger subtree.
+type ServiceLogLevelResource struct {
+	JAID
+	Level   string     `json:"level"`
+	Expires *time.Time `json:"expires"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ServiceLogLevelResource) GetName() string {
+	return "serviceLogLevels"
+}
+
+// NewServiceLogLevelResource constructs a new ServiceLogLevelResource.
+func NewServiceLogLevelResource(sl logger.ServiceLogLevel) *ServiceLogLevelResource {
+	return &ServiceLogLevelResource{
+		JAID:    NewJAID(sl.Name),
+		Level:   sl.Level.String(),
+		Expires: sl.Expires,
+	}
+}
+
+// NewServiceLogLevelResources constructs a slice of ServiceLogLevelResources.
+func NewServiceLogLevelResources(sls []logger.ServiceLogLevel) []ServiceLogLevelResource {
+	rs := []ServiceLogLevelResource{}
+	for _, sl := range sls {
+		rs = append(rs, *NewServiceLogLevelResource(sl))
+	}
+	return rs
+}
@@ -22,6 +22,8 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   null.Time                 `json:"finishedAt"`
 	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
+	State        pipeline.RunStatus        `json:"state"`
+	RerunOfID    null.Int                  `json:"rerunOfID"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -54,6 +56,8 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt,
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		State:        pr.State,
+		RerunOfID:    pr.RerunOfID,
 	}
 }
 
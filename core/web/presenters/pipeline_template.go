@@ -0,0 +1,91 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+)
+
+// PipelineTemplateResource is a pipeline template version JSONAPI resource.
+type PipelineTemplateResource struct {
+	JAID
+	Name        string            `json:"name"`
+	Version     int32             `json:"version"`
+	Description string            `json:"description"`
+	Params      []templates.Param `json:"params"`
+	Source      string            `json:"source"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTemplateResource) GetName() string {
+	return "pipeline_templates"
+}
+
+// NewPipelineTemplateResource returns a new PipelineTemplateResource for a
+// template version.
+func NewPipelineTemplateResource(t templates.Template) PipelineTemplateResource {
+	params := t.Params
+	if params == nil {
+		params = templates.Params{}
+	}
+	return PipelineTemplateResource{
+		JAID:        NewJAIDInt64(t.ID),
+		Name:        t.Name,
+		Version:     t.Version,
+		Description: t.Description,
+		Params:      params,
+		Source:      t.Source,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+// NewPipelineTemplateResources initializes a slice of JSONAPI pipeline
+// template resources
+func NewPipelineTemplateResources(ts []templates.Template) []PipelineTemplateResource {
+	rs := []PipelineTemplateResource{}
+	for _, t := range ts {
+		rs = append(rs, NewPipelineTemplateResource(t))
+	}
+
+	return rs
+}
+
+// PipelineTemplateJobChangeResource describes the change rolling a job
+// forward to another template version makes.
+type PipelineTemplateJobChangeResource struct {
+	JAID
+	JobID         int32  `json:"jobID"`
+	JobName       string `json:"jobName"`
+	FromVersion   int32  `json:"fromVersion"`
+	ToVersion     int32  `json:"toVersion"`
+	Diff          string `json:"diff"`
+	RolledForward bool   `json:"rolledForward"`
+	Error         string `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineTemplateJobChangeResource) GetName() string {
+	return "pipeline_template_job_changes"
+}
+
+// NewPipelineTemplateJobChangeResources initializes a slice of JSONAPI
+// pipeline template job change resources
+func NewPipelineTemplateJobChangeResources(changes []templates.JobChange) []PipelineTemplateJobChangeResource {
+	rs := []PipelineTemplateJobChangeResource{}
+	for _, c := range changes {
+		rs = append(rs, PipelineTemplateJobChangeResource{
+			JAID:          NewJAID(fmt.Sprintf("%d-%d", c.JobID, c.ToVersion)),
+			JobID:         c.JobID,
+			JobName:       c.JobName,
+			FromVersion:   c.FromVersion,
+			ToVersion:     c.ToVersion,
+			Diff:          c.Diff,
+			RolledForward: c.RolledForward,
+			Error:         c.Error,
+		})
+	}
+
+	return rs
+}
@@ -0,0 +1,30 @@
+package presenters
+
+// SecretResource represents a node-local secret JSONAPI resource. The value
+// of a secret is never presented.
+type SecretResource struct {
+	JAID
+	Name string `json:"name"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SecretResource) GetName() string {
+	return "secrets"
+}
+
+// NewSecretResource returns a new SecretResource for the named secret.
+func NewSecretResource(name string) *SecretResource {
+	return &SecretResource{
+		JAID: NewJAID(name),
+		Name: name,
+	}
+}
+
+// NewSecretResources returns a slice of SecretResources for the named secrets.
+func NewSecretResources(names []string) []SecretResource {
+	rs := []SecretResource{}
+	for _, name := range names {
+		rs = append(rs, *NewSecretResource(name))
+	}
+	return rs
+}
@@ -0,0 +1,59 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+)
+
+// TxResource represents a chain agnostic transaction JSONAPI resource. Its ID
+// is the chain type, chain ID and chain specific ID, separated by slashes.
+type TxResource struct {
+	JAID
+	ChainType     string    `json:"chainType"`
+	ChainID       string    `json:"chainID"`
+	TxID          string    `json:"txID"`
+	Hash          string    `json:"hash"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	State         string    `json:"state"`
+	ChainState    string    `json:"chainState"`
+	Error         string    `json:"error,omitempty"`
+	JobID         *int32    `json:"jobID"`
+	PipelineRunID *int64    `json:"pipelineRunID"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (TxResource) GetName() string {
+	return "transactions"
+}
+
+// NewTxResource constructs a new TxResource.
+func NewTxResource(tx chains.Tx) TxResource {
+	return TxResource{
+		JAID:          NewJAID(fmt.Sprintf("%s/%s/%s", tx.ChainType, tx.ChainID, tx.ID)),
+		ChainType:     string(tx.ChainType),
+		ChainID:       tx.ChainID,
+		TxID:          tx.ID,
+		Hash:          tx.Hash,
+		From:          tx.From,
+		To:            tx.To,
+		State:         string(tx.State),
+		ChainState:    tx.ChainState,
+		Error:         tx.Error,
+		JobID:         tx.JobID,
+		PipelineRunID: tx.PipelineRunID,
+		CreatedAt:     tx.CreatedAt,
+	}
+}
+
+// NewTxResources constructs a slice of TxResources.
+func NewTxResources(txs []chains.Tx) []TxResource {
+	rs := make([]TxResource, len(txs))
+	for i, tx := range txs {
+		rs[i] = NewTxResource(tx)
+	}
+	return rs
+}
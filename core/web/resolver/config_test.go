@@ -140,6 +140,10 @@ func TestResolver_Config(t *testing.T) {
         "key": "CHAIN_TYPE",
         "value": ""
       },
+      {
+        "key": "DATABASE_BACKUP_ENCRYPTED",
+        "value": "true"
+      },
       {
         "key": "DATABASE_BACKUP_FREQUENCY",
         "value": "1h0m0s"
@@ -152,6 +156,15 @@ func TestResolver_Config(t *testing.T) {
         "key": "DATABASE_BACKUP_ON_VERSION_UPGRADE",
         "value": "true"
       },
+      {
+        "key": "DATABASE_BACKUP_RETENTION_COUNT",
+        "value": "10"
+      },
+
+      {
+        "key": "DATABASE_HA_ENABLED",
+        "value": "false"
+      },
       {
         "key": "DATABASE_LOCKING_MODE",
         "value": "none"
@@ -180,6 +193,22 @@ func TestResolver_Config(t *testing.T) {
         "key": "EVM_RPC_ENABLED",
         "value": "false"
       },
+      {
+        "key": "ETH_REMOTE_SIGNER_PROTOCOL",
+        "value": "web3signer"
+      },
+      {
+        "key": "ETH_REMOTE_SIGNER_TIMEOUT",
+        "value": "10s"
+      },
+      {
+        "key": "ETH_REMOTE_SIGNER_URL",
+        "value": ""
+      },
+      {
+        "key": "ETH_TX_STUCK_THRESHOLD",
+        "value": "50"
+      },
       {
         "key": "ETH_HTTP_URL",
         "value": ""
@@ -316,6 +345,18 @@ func TestResolver_Config(t *testing.T) {
         "key": "LOG_FILE_MAX_BACKUPS",
         "value": "12"
       },
+      {
+        "key": "LOG_SAMPLING_DEBUG_FIRST",
+        "value": "0"
+      },
+      {
+        "key": "LOG_SAMPLING_DEBUG_THEREAFTER",
+        "value": "100"
+      },
+      {
+        "key": "NOTIFICATIONS_CONFIG_PATH",
+        "value": ""
+      },
       {
         "key": "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
         "value": "30s"
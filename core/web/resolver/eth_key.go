@@ -52,6 +52,17 @@ func (r *ETHKeyResolver) IsFunding() bool {
 	return r.key.state.IsFunding
 }
 
+func (r *ETHKeyResolver) Disabled() bool {
+	return r.key.state.Disabled
+}
+
+func (r *ETHKeyResolver) Labels() []string {
+	if r.key.state.Labels == nil {
+		return []string{}
+	}
+	return r.key.state.Labels
+}
+
 // ETHBalance returns the ETH balance available
 func (r *ETHKeyResolver) ETHBalance(ctx context.Context) *string {
 	if r.key.chain == nil {
@@ -26,6 +26,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 				results {
 					address
 					isFunding
+					disabled
+					labels
 					ethBalance
 					linkBalance
 					maxGasPriceWei
@@ -64,6 +66,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 						Address:    address,
 						EVMChainID: *utils.NewBigI(12),
 						IsFunding:  true,
+						Disabled:   true,
+						Labels:     []string{"keeper", "ocr"},
 						CreatedAt:  f.Timestamp(),
 						UpdatedAt:  f.Timestamp(),
 					},
@@ -111,6 +115,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x1438087186fdbfd4c256fa2df446921e30e54df8",
 								"isFunding": false,
+								"disabled": false,
+								"labels": [],
 								"ethBalance": "0.000000000000000012",
 								"linkBalance": "100",
 								"maxGasPriceWei": "1",
@@ -123,6 +129,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x5431F5F973781809D18643b87B44921b11355d81",
 								"isFunding": true,
+								"disabled": true,
+								"labels": ["keeper", "ocr"],
 								"ethBalance": "0.000000000000000001",
 								"linkBalance": "12",
 								"maxGasPriceWei": "1",
@@ -179,6 +187,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x5431F5F973781809D18643b87B44921b11355d81",
 								"isFunding": false,
+								"disabled": false,
+								"labels": [],
 								"ethBalance": "0.000000000000000001",
 								"linkBalance": "12",
 								"maxGasPriceWei": "1",
@@ -227,6 +237,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x5431F5F973781809D18643b87B44921b11355d81",
 								"isFunding": false,
+								"disabled": false,
+								"labels": [],
 								"ethBalance": null,
 								"linkBalance": null,
 								"maxGasPriceWei": null,
@@ -415,6 +427,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x5431F5F973781809D18643b87B44921b11355d81",
 								"isFunding": false,
+								"disabled": false,
+								"labels": [],
 								"ethBalance": "0.000000000000000001",
 								"linkBalance": null,
 								"maxGasPriceWei": "1",
@@ -470,6 +484,8 @@ func TestResolver_ETHKeys(t *testing.T) {
 							{
 								"address": "0x5431F5F973781809D18643b87B44921b11355d81",
 								"isFunding": false,
+								"disabled": false,
+								"labels": [],
 								"ethBalance": null,
 								"linkBalance": "12",
 								"maxGasPriceWei": "1",
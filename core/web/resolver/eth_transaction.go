@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -29,6 +30,10 @@ func NewEthTransactions(results []txmgr.EthTx) []*EthTransactionResolver {
 	return resolver
 }
 
+func (r *EthTransactionResolver) ID() graphql.ID {
+	return int64GQLID(r.tx.ID)
+}
+
 func (r *EthTransactionResolver) State() string {
 	return string(r.tx.State)
 }
@@ -162,3 +167,117 @@ func (r *EthTransactionsPayloadResolver) Results() []*EthTransactionResolver {
 func (r *EthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- StuckEthTransactions Query --
+
+type StuckEthTransactionResolver struct {
+	stuck txmgr.StuckTx
+}
+
+func NewStuckEthTransaction(stuck txmgr.StuckTx) *StuckEthTransactionResolver {
+	return &StuckEthTransactionResolver{stuck: stuck}
+}
+
+func NewStuckEthTransactions(results []txmgr.StuckTx) []*StuckEthTransactionResolver {
+	var resolver []*StuckEthTransactionResolver
+
+	for _, stuck := range results {
+		resolver = append(resolver, NewStuckEthTransaction(stuck))
+	}
+
+	return resolver
+}
+
+func (r *StuckEthTransactionResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.stuck.EthTx)
+}
+
+func (r *StuckEthTransactionResolver) BlocksPending() int32 {
+	return int32(r.stuck.BlocksPending)
+}
+
+func (r *StuckEthTransactionResolver) AtMaxGasPrice() bool {
+	return r.stuck.AtMaxGasPrice
+}
+
+func (r *StuckEthTransactionResolver) Diagnosis() string {
+	return string(r.stuck.Diagnosis)
+}
+
+type StuckEthTransactionsPayloadResolver struct {
+	results []txmgr.StuckTx
+}
+
+func NewStuckEthTransactionsPayload(results []txmgr.StuckTx) *StuckEthTransactionsPayloadResolver {
+	return &StuckEthTransactionsPayloadResolver{results: results}
+}
+
+func (r *StuckEthTransactionsPayloadResolver) Results() []*StuckEthTransactionResolver {
+	return NewStuckEthTransactions(r.results)
+}
+
+// -- CancelEthTransaction, ReplaceEthTransaction and AbandonEthTransaction Mutations --
+
+type RemediateEthTransactionPayloadResolver struct {
+	tx *txmgr.EthTx
+	NotFoundErrorUnionType
+}
+
+func NewRemediateEthTransactionPayload(tx *txmgr.EthTx, err error) *RemediateEthTransactionPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: "transaction not found"}
+	}
+
+	return &RemediateEthTransactionPayloadResolver{tx: tx, NotFoundErrorUnionType: e}
+}
+
+func (r *RemediateEthTransactionPayloadResolver) ToRemediateEthTransactionSuccess() (*RemediateEthTransactionSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewRemediateEthTransactionSuccess(*r.tx), true
+}
+
+func (r *RemediateEthTransactionPayloadResolver) ToRemediateEthTransactionError() (*RemediateEthTransactionErrorResolver, bool) {
+	if r.err == nil || isNotFoundSQLError(r.err) {
+		return nil, false
+	}
+
+	return NewRemediateEthTransactionError(r.err), true
+}
+
+type RemediateEthTransactionSuccessResolver struct {
+	tx txmgr.EthTx
+}
+
+func NewRemediateEthTransactionSuccess(tx txmgr.EthTx) *RemediateEthTransactionSuccessResolver {
+	return &RemediateEthTransactionSuccessResolver{tx: tx}
+}
+
+func (r *RemediateEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(r.tx)
+}
+
+type RemediateEthTransactionErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func NewRemediateEthTransactionError(err error) *RemediateEthTransactionErrorResolver {
+	code := ErrorCodeUnprocessable
+	if errors.Is(err, txmgr.ErrTxNotUnconfirmed) {
+		code = ErrorCodeStatusConflict
+	}
+	return &RemediateEthTransactionErrorResolver{message: err.Error(), code: code}
+}
+
+func (r *RemediateEthTransactionErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func (r *RemediateEthTransactionErrorResolver) Message() string {
+	return r.message
+}
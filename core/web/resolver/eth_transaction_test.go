@@ -3,14 +3,18 @@ package resolver
 import (
 	"database/sql"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	txmgrMocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -419,3 +423,202 @@ func TestResolver_EthTransactionsAttempts(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_StuckEthTransactions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetStuckEthTransactions($evmChainID: ID) {
+			stuckEthTransactions(evmChainID: $evmChainID) {
+				results {
+					transaction {
+						id
+						state
+					}
+					blocksPending
+					atMaxGasPrice
+					diagnosis
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"evmChainID": "22",
+	}
+	chainID := *utils.NewBigI(22)
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "stuckEthTransactions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				txm := txmgrMocks.NewTxManager(f.t)
+				txm.On("FindStuckTransactions", mock.Anything).Return([]txmgr.StuckTx{
+					{
+						EthTx: txmgr.EthTx{
+							ID:         1,
+							State:      txmgr.EthTxUnconfirmed,
+							EVMChainID: chainID,
+						},
+						BlocksPending: 60,
+						AtMaxGasPrice: true,
+						Diagnosis:     txmgr.StuckTxUnderpriced,
+					},
+				}, nil)
+				f.Mocks.scfg.On("EVMRPCEnabled").Return(true)
+				f.Mocks.chain.On("Config").Return(f.Mocks.scfg)
+				f.Mocks.chain.On("TxManager").Return(txm)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"stuckEthTransactions": {
+						"results": [{
+							"transaction": {
+								"id": "1",
+								"state": "unconfirmed"
+							},
+							"blocksPending": 60,
+							"atMaxGasPrice": true,
+							"diagnosis": "underpriced"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				txm := txmgrMocks.NewTxManager(f.t)
+				txm.On("FindStuckTransactions", mock.Anything).Return(nil, gError)
+				f.Mocks.scfg.On("EVMRPCEnabled").Return(true)
+				f.Mocks.chain.On("Config").Return(f.Mocks.scfg)
+				f.Mocks.chain.On("TxManager").Return(txm)
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"stuckEthTransactions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RemediateEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation ReplaceEthTransaction($id: ID!, $input: ReplaceEthTransactionInput!) {
+			replaceEthTransaction(id: $id, input: $input) {
+				... on RemediateEthTransactionSuccess {
+					transaction {
+						id
+						state
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on RemediateEthTransactionError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "1",
+		"input": map[string]interface{}{
+			"gasPrice": "30000000000",
+		},
+	}
+	etx := txmgr.EthTx{
+		ID:         1,
+		State:      txmgr.EthTxUnconfirmed,
+		EVMChainID: *utils.NewBigI(22),
+	}
+	fee := txmgr.ReplacementFee{GasPrice: big.NewInt(30000000000)}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "replaceEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				txm := txmgrMocks.NewTxManager(f.t)
+				txm.On("ReplaceTransaction", mock.Anything, int64(1), fee).Return(nil)
+				f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(1)).Return(etx, nil)
+				f.Mocks.chain.On("TxManager").Return(txm)
+				f.Mocks.chainSet.On("Get", etx.EVMChainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replaceEthTransaction": {
+						"transaction": {
+							"id": "1",
+							"state": "unconfirmed"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(1)).Return(txmgr.EthTx{}, sql.ErrNoRows)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replaceEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+		{
+			name:          "not unconfirmed error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				txm := txmgrMocks.NewTxManager(f.t)
+				txm.On("ReplaceTransaction", mock.Anything, int64(1), fee).Return(txmgr.ErrTxNotUnconfirmed)
+				f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(1)).Return(etx, nil)
+				f.Mocks.chain.On("TxManager").Return(txm)
+				f.Mocks.chainSet.On("Get", etx.EVMChainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.App.On("TxmORM").Return(f.Mocks.txmORM)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"replaceEthTransaction": {
+						"code": "STATUS_CONFLICT",
+						"message": "` + txmgr.ErrTxNotUnconfirmed.Error() + `"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -59,6 +59,7 @@ const (
 	GasEstimatorModeFixedPrice   GasEstimatorMode = "FIXED_PRICE"
 	GasEstimatorModeOptimism2    GasEstimatorMode = "OPTIMISM2"
 	GasEstimatorModeL2Suggested  GasEstimatorMode = "L2_SUGGESTED"
+	GasEstimatorModeFeeHistory   GasEstimatorMode = "FEE_HISTORY"
 )
 
 func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
@@ -71,6 +72,8 @@ func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
 		return GasEstimatorModeOptimism2, nil
 	case "L2Suggested":
 		return GasEstimatorModeL2Suggested, nil
+	case "FeeHistory":
+		return GasEstimatorModeFeeHistory, nil
 	default:
 		return "", errors.New("invalid gas estimator mode")
 	}
@@ -86,6 +89,8 @@ func FromGasEstimatorMode(gsm GasEstimatorMode) string {
 		return "Optimism2"
 	case GasEstimatorModeL2Suggested:
 		return "L2Suggested"
+	case GasEstimatorModeFeeHistory:
+		return "FeeHistory"
 	default:
 		return strings.ToLower(string(gsm))
 	}
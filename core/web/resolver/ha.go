@@ -0,0 +1,119 @@
+package resolver
+
+import (
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+// defaultLeaderTransitionsLimit is the number of transitions returned when no
+// limit is given.
+const defaultLeaderTransitionsLimit = 20
+
+type HAState string
+
+func ToHAState(s pg.HAState) HAState {
+	return HAState(strings.ToUpper(string(s)))
+}
+
+// HAStatusResolver resolves the HAStatus type.
+type HAStatusResolver struct {
+	status   pg.HAStatus
+	clientID uuid.UUID
+}
+
+func NewHAStatus(status pg.HAStatus, clientID uuid.UUID) *HAStatusResolver {
+	return &HAStatusResolver{status: status, clientID: clientID}
+}
+
+// Enabled resolves whether the node runs in high availability mode.
+func (r *HAStatusResolver) Enabled() bool {
+	return r.status.Enabled()
+}
+
+// ClientID resolves the ID this node uses to take the database lease.
+func (r *HAStatusResolver) ClientID() graphql.ID {
+	return graphql.ID(r.clientID.String())
+}
+
+// State resolves the current role of the node.
+func (r *HAStatusResolver) State() HAState {
+	return ToHAState(r.status.State())
+}
+
+// Since resolves the time of the last role change of the node.
+func (r *HAStatusResolver) Since() graphql.Time {
+	return graphql.Time{Time: r.status.Since()}
+}
+
+// Transitions resolves the most recent leadership transitions, newest first.
+func (r *HAStatusResolver) Transitions(args struct{ Limit *int32 }) ([]*LeaderTransitionResolver, error) {
+	limit := defaultLeaderTransitionsLimit
+	if args.Limit != nil {
+		limit = int(*args.Limit)
+	}
+
+	transitions, err := r.status.Transitions(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLeaderTransitions(transitions), nil
+}
+
+// LeaderTransitionResolver resolves the LeaderTransition type.
+type LeaderTransitionResolver struct {
+	transition pg.LeaderTransition
+}
+
+func NewLeaderTransition(transition pg.LeaderTransition) *LeaderTransitionResolver {
+	return &LeaderTransitionResolver{transition: transition}
+}
+
+func NewLeaderTransitions(transitions []pg.LeaderTransition) []*LeaderTransitionResolver {
+	var resolvers []*LeaderTransitionResolver
+	for _, t := range transitions {
+		resolvers = append(resolvers, NewLeaderTransition(t))
+	}
+
+	return resolvers
+}
+
+// ID resolves the transition's id.
+func (r *LeaderTransitionResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.transition.ID))
+}
+
+// ClientID resolves the ID of the node which changed its role.
+func (r *LeaderTransitionResolver) ClientID() graphql.ID {
+	return graphql.ID(r.transition.ClientID.String())
+}
+
+// State resolves the role the node changed to.
+func (r *LeaderTransitionResolver) State() HAState {
+	return ToHAState(r.transition.State)
+}
+
+// CreatedAt resolves the time of the transition.
+func (r *LeaderTransitionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.transition.CreatedAt}
+}
+
+// -- HAStatus Query --
+
+type HAStatusPayloadResolver struct {
+	status   pg.HAStatus
+	clientID uuid.UUID
+}
+
+func NewHAStatusPayload(status pg.HAStatus, clientID uuid.UUID) *HAStatusPayloadResolver {
+	return &HAStatusPayloadResolver{status: status, clientID: clientID}
+}
+
+func (r *HAStatusPayloadResolver) ToHAStatus() (*HAStatusResolver, bool) {
+	return NewHAStatus(r.status, r.clientID), true
+}
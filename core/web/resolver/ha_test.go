@@ -0,0 +1,105 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+)
+
+func TestResolver_HAStatus(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetHAStatus {
+			haStatus {
+				... on HAStatus {
+					enabled
+					clientID
+					state
+					since
+					transitions(limit: 5) {
+						id
+						clientID
+						state
+						createdAt
+					}
+				}
+			}
+		}`
+
+	clientID := uuid.FromStringOrNil("6f2e3d4c-5b6a-4798-8a9b-0c1d2e3f4a5b")
+	otherID := uuid.FromStringOrNil("0a1b2c3d-4e5f-4061-8273-9485a6b7c8d9")
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "haStatus"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				status := pgmocks.NewHAStatus(t)
+				status.On("Enabled").Return(true)
+				status.On("State").Return(pg.HAStateLeader)
+				status.On("Since").Return(f.Timestamp())
+				status.On("Transitions", 5).Return([]pg.LeaderTransition{
+					{ID: 2, ClientID: clientID, State: pg.HAStateLeader, CreatedAt: f.Timestamp()},
+					{ID: 1, ClientID: otherID, State: pg.HAStateLeader, CreatedAt: f.Timestamp().Add(-time.Hour)},
+				}, nil)
+				f.App.On("GetHAStatus").Return(status)
+				f.App.On("ID").Return(clientID)
+			},
+			query: query,
+			result: `
+				{
+					"haStatus": {
+						"enabled": true,
+						"clientID": "6f2e3d4c-5b6a-4798-8a9b-0c1d2e3f4a5b",
+						"state": "LEADER",
+						"since": "2021-01-01T00:00:00Z",
+						"transitions": [{
+							"id": "2",
+							"clientID": "6f2e3d4c-5b6a-4798-8a9b-0c1d2e3f4a5b",
+							"state": "LEADER",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}, {
+							"id": "1",
+							"clientID": "0a1b2c3d-4e5f-4061-8273-9485a6b7c8d9",
+							"state": "LEADER",
+							"createdAt": "2020-12-31T23:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "generic error on Transitions()",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				status := pgmocks.NewHAStatus(t)
+				status.On("Enabled").Return(true).Maybe()
+				status.On("State").Return(pg.HAStateStandby).Maybe()
+				status.On("Since").Return(f.Timestamp()).Maybe()
+				status.On("Transitions", 5).Return(nil, gError)
+				f.App.On("GetHAStatus").Return(status)
+				f.App.On("ID").Return(clientID)
+			},
+			query:  query,
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"haStatus", "transitions"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -19,6 +19,9 @@ const (
 
 	// PageDefaultLimit defines the default limit to use if none is provided
 	PageDefaultLimit = 50
+
+	// PageMaxLimit defines the maximum limit of a page
+	PageMaxLimit = 1000
 )
 
 func int32GQLID(i int32) graphql.ID {
@@ -40,11 +43,17 @@ func pageOffset(offset *int32) int {
 }
 
 // pageLimit returns the default page limit if nil, otherwise it returns the
-// provided limit.
+// provided limit, clamped between 1 and PageMaxLimit.
 func pageLimit(limit *int32) int {
 	if limit == nil {
 		return PageDefaultLimit
 	}
+	if *limit < 1 {
+		return 1
+	}
+	if *limit > PageMaxLimit {
+		return PageMaxLimit
+	}
 
 	return int(*limit)
 }
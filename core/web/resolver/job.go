@@ -7,6 +7,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
@@ -39,6 +40,14 @@ func (r *JobResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.j.CreatedAt}
 }
 
+// PausedAt resolves the time the job was paused, if it is paused.
+func (r *JobResolver) PausedAt() *graphql.Time {
+	if !r.j.PausedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.j.PausedAt.Time}
+}
+
 // Errors resolves the job's top level errors.
 func (r *JobResolver) Errors(ctx context.Context) ([]*JobErrorResolver, error) {
 	specErrs, err := loader.GetJobSpecErrorsByJobID(ctx, r.j.ID)
@@ -96,6 +105,16 @@ func (r *JobResolver) Spec() *SpecResolver {
 	return NewSpec(r.j)
 }
 
+// SpecVersions resolves the recorded versions of the job's spec, oldest first.
+func (r *JobResolver) SpecVersions(ctx context.Context) ([]*JobSpecVersionResolver, error) {
+	versions, err := r.app.JobORM().FindSpecVersions(r.j.ID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobSpecVersions(versions), nil
+}
+
 // Runs fetches the runs for a Job.
 func (r *JobResolver) Runs(ctx context.Context, args struct {
 	Offset *int32
@@ -253,3 +272,148 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// -- PauseJob Mutation --
+
+type PauseJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewPauseJobPayload(app chainlink.Application, j *job.Job, err error) *PauseJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &PauseJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobSuccess() (*PauseJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &PauseJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type PauseJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *PauseJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- ResumeJob Mutation --
+
+type ResumeJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewResumeJobPayload(app chainlink.Application, j *job.Job, err error) *ResumeJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &ResumeJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobSuccess() (*ResumeJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &ResumeJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+type ResumeJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *ResumeJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- UpdateJob Mutation --
+
+type UpdateJobPayloadResolver struct {
+	app       chainlink.Application
+	j         *job.Job
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewUpdateJobPayload(app chainlink.Application, j *job.Job, inputErrs map[string]string, err error) *UpdateJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &UpdateJobPayloadResolver{app: app, j: j, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *UpdateJobPayloadResolver) ToUpdateJobSuccess() (*UpdateJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return &UpdateJobSuccessResolver{app: r.app, j: r.j}, true
+}
+
+func (r *UpdateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type UpdateJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func (r *UpdateJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// JobSpecVersionResolver resolves the JobSpecVersion type.
+type JobSpecVersionResolver struct {
+	v    job.SpecVersion
+	prev job.SpecVersion
+}
+
+func NewJobSpecVersions(versions []job.SpecVersion) []*JobSpecVersionResolver {
+	resolvers := []*JobSpecVersionResolver{}
+	var prev job.SpecVersion
+	for _, v := range versions {
+		resolvers = append(resolvers, &JobSpecVersionResolver{v: v, prev: prev})
+		prev = v
+	}
+
+	return resolvers
+}
+
+// Version resolves the version number.
+func (r *JobSpecVersionResolver) Version() int32 {
+	return r.v.Version
+}
+
+// TOML resolves the spec.
+func (r *JobSpecVersionResolver) TOML() string {
+	return r.v.TOML
+}
+
+// Diff resolves the diff from the previous version of the spec.
+func (r *JobSpecVersionResolver) Diff() (string, error) {
+	return r.prev.Diff(r.v)
+}
+
+// CreatedAt resolves the time the version was recorded.
+func (r *JobSpecVersionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.v.CreatedAt}
+}
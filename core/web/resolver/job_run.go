@@ -2,11 +2,13 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -38,6 +40,28 @@ func NewJobRunStatus(status pipeline.RunStatus) JobRunStatus {
 	}
 }
 
+func FromJobRunStatus(status JobRunStatus) (pipeline.RunStatus, error) {
+	switch status {
+	case JobRunStatusRunning:
+		return pipeline.RunStatusRunning, nil
+	case JobRunStatusSuspended:
+		return pipeline.RunStatusSuspended, nil
+	case JobRunStatusErrored:
+		return pipeline.RunStatusErrored, nil
+	case JobRunStatusCompleted:
+		return pipeline.RunStatusCompleted, nil
+	default:
+		return "", fmt.Errorf("cannot filter on job run status %s", status)
+	}
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
 var outputRetrievalErrorStr = "error: unable to retrieve outputs"
 
 type JobRunResolver struct {
@@ -151,6 +175,15 @@ func (r *JobRunResolver) FinishedAt() *graphql.Time {
 	return &graphql.Time{Time: r.run.FinishedAt.ValueOrZero()}
 }
 
+// RerunOfID resolves the ID of the run this run is a rerun of.
+func (r *JobRunResolver) RerunOfID() *graphql.ID {
+	if !r.run.RerunOfID.Valid {
+		return nil
+	}
+	id := int64GQLID(r.run.RerunOfID.Int64)
+	return &id
+}
+
 // -- JobRun query --
 
 type JobRunPayloadResolver struct {
@@ -198,6 +231,86 @@ func (r *JobRunsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
 
+type JobRunsFilterInput struct {
+	JobID         *graphql.ID
+	Statuses      *[]JobRunStatus
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+	TaskType      *string
+	TaskName      *string
+	ErrorContains *string
+	Sort          *SortDirection
+}
+
+// toPipelineRunsFilter converts the input into a filter of the job ORM.
+func (i *JobRunsFilterInput) toPipelineRunsFilter() (filter job.PipelineRunsFilter, err error) {
+	if i == nil {
+		return filter, nil
+	}
+	if i.JobID != nil {
+		jobID, err := stringutils.ToInt32(string(*i.JobID))
+		if err != nil {
+			return filter, err
+		}
+		filter.JobID = &jobID
+	}
+	if i.Statuses != nil {
+		for _, status := range *i.Statuses {
+			state, err := FromJobRunStatus(status)
+			if err != nil {
+				return filter, err
+			}
+			filter.States = append(filter.States, state)
+		}
+	}
+	if i.CreatedAfter != nil {
+		filter.CreatedAfter = &i.CreatedAfter.Time
+	}
+	if i.CreatedBefore != nil {
+		filter.CreatedBefore = &i.CreatedBefore.Time
+	}
+	if i.TaskType != nil {
+		filter.TaskType = *i.TaskType
+	}
+	if i.TaskName != nil {
+		filter.TaskName = *i.TaskName
+	}
+	if i.ErrorContains != nil {
+		filter.ErrorContains = *i.ErrorContains
+	}
+	filter.Ascending = i.Sort != nil && *i.Sort == SortDirectionAsc
+	return filter, nil
+}
+
+// FilterJobRunsPayloadResolver resolves a page of filtered job runs
+type FilterJobRunsPayloadResolver struct {
+	runs []pipeline.Run
+	next *int64
+	app  chainlink.Application
+}
+
+func NewFilterJobRunsPayload(runs []pipeline.Run, next *int64, app chainlink.Application) *FilterJobRunsPayloadResolver {
+	return &FilterJobRunsPayloadResolver{
+		runs: runs,
+		next: next,
+		app:  app,
+	}
+}
+
+// Results returns the job runs.
+func (r *FilterJobRunsPayloadResolver) Results() []*JobRunResolver {
+	return NewJobRuns(r.runs, r.app)
+}
+
+// NextCursor returns the cursor of the next page, if there is one.
+func (r *FilterJobRunsPayloadResolver) NextCursor() *graphql.ID {
+	if r.next == nil {
+		return nil
+	}
+	id := int64GQLID(*r.next)
+	return &id
+}
+
 // -- RunJob Mutation --
 
 type RunJobPayloadResolver struct {
@@ -267,3 +380,67 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- RerunJobRun Mutation --
+
+type RerunJobRunPayloadResolver struct {
+	run *pipeline.Run
+	app chainlink.Application
+	NotFoundErrorUnionType
+}
+
+func NewRerunJobRunPayload(run *pipeline.Run, app chainlink.Application, err error) *RerunJobRunPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if err != nil {
+		e = NotFoundErrorUnionType{err: err, message: "job run not found"}
+	}
+
+	return &RerunJobRunPayloadResolver{run: run, app: app, NotFoundErrorUnionType: e}
+}
+
+func (r *RerunJobRunPayloadResolver) ToRerunJobRunSuccess() (*RerunJobRunSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewRerunJobRunSuccess(*r.run, r.app), true
+}
+
+func (r *RerunJobRunPayloadResolver) ToRerunJobRunCannotRerunError() (*RerunJobRunCannotRerunErrorResolver, bool) {
+	if r.err == nil || isNotFoundSQLError(r.err) {
+		return nil, false
+	}
+
+	return NewRerunJobRunCannotRerunError(r.err), true
+}
+
+type RerunJobRunSuccessResolver struct {
+	run pipeline.Run
+	app chainlink.Application
+}
+
+func NewRerunJobRunSuccess(run pipeline.Run, app chainlink.Application) *RerunJobRunSuccessResolver {
+	return &RerunJobRunSuccessResolver{run: run, app: app}
+}
+
+func (r *RerunJobRunSuccessResolver) JobRun() *JobRunResolver {
+	return NewJobRun(r.run, r.app)
+}
+
+type RerunJobRunCannotRerunErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func NewRerunJobRunCannotRerunError(err error) *RerunJobRunCannotRerunErrorResolver {
+	return &RerunJobRunCannotRerunErrorResolver{message: err.Error(), code: ErrorCodeStatusConflict}
+}
+
+func (r *RerunJobRunCannotRerunErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func (r *RerunJobRunCannotRerunErrorResolver) Message() string {
+	return r.message
+}
@@ -3,6 +3,7 @@ package resolver
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
@@ -82,6 +83,140 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestQuery_FilterJobRuns(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query FilterJobRuns($filter: JobRunsFilterInput, $after: ID) {
+			filterJobRuns(filter: $filter, after: $after, limit: 2) {
+				results {
+					id
+				}
+				nextCursor
+			}
+		}`
+
+	createdAfter := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	jobID := int32(1)
+	after := int64(300)
+	_, statusError := FromJobRunStatus(JobRunStatusUnknown)
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"jobID":         "1",
+			"statuses":      []interface{}{"ERRORED"},
+			"createdAfter":  createdAfter.Format(time.RFC3339),
+			"taskName":      "ds1",
+			"errorContains": "timeout",
+			"sort":          "ASC",
+		},
+		"after": "300",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "filterJobRuns"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FilterPipelineRuns", job.PipelineRunsFilter{
+					JobID:         &jobID,
+					States:        []pipeline.RunStatus{pipeline.RunStatusErrored},
+					CreatedAfter:  &createdAfter,
+					TaskName:      "ds1",
+					ErrorContains: "timeout",
+					Ascending:     true,
+					After:         &after,
+					Limit:         2,
+				}, mock.Anything).Return([]pipeline.Run{{ID: 301}, {ID: 302}}, &[]int64{302}[0], nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"filterJobRuns": {
+						"results": [{"id": "301"}, {"id": "302"}],
+						"nextCursor": "302"
+					}
+				}`,
+		},
+		{
+			name:          "last page",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FilterPipelineRuns", job.PipelineRunsFilter{Limit: 2}, mock.Anything).Return([]pipeline.Run{{ID: 200}}, (*int64)(nil), nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query: query,
+			result: `
+				{
+					"filterJobRuns": {
+						"results": [{"id": "200"}],
+						"nextCursor": null
+					}
+				}`,
+		},
+		{
+			name:          "invalid status",
+			authenticated: true,
+			query:         query,
+			variables: map[string]interface{}{
+				"filter": map[string]interface{}{"statuses": []interface{}{"UNKNOWN"}},
+			},
+			result: `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: statusError,
+					Path:          []interface{}{"filterJobRuns"},
+					Message:       statusError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestQuery_FilterJobRuns_Limit(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query FilterJobRuns($limit: Int) {
+			filterJobRuns(limit: $limit) {
+				results {
+					id
+				}
+				nextCursor
+			}
+		}`
+
+	testCase := func(name string, limit int32, expected int) GQLTestCase {
+		return GQLTestCase{
+			name:          name,
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FilterPipelineRuns", job.PipelineRunsFilter{Limit: expected}, mock.Anything).Return([]pipeline.Run{{ID: 200}}, (*int64)(nil), nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: map[string]interface{}{"limit": limit},
+			result: `
+				{
+					"filterJobRuns": {
+						"results": [{"id": "200"}],
+						"nextCursor": null
+					}
+				}`,
+		}
+	}
+
+	RunGQLTests(t, []GQLTestCase{
+		testCase("zero limit", 0, 1),
+		testCase("negative limit", -5, 1),
+		testCase("limit above maximum", PageMaxLimit+1, PageMaxLimit),
+	})
+}
+
 func TestResolver_JobRun(t *testing.T) {
 	t.Parallel()
 
@@ -141,13 +276,8 @@ func TestResolver_JobRun(t *testing.T) {
 					Outputs:        outputs,
 					State:          pipeline.RunStatusErrored,
 				}, nil)
-				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", []int32{5}).Return([]job.Job{
-					{
-						ID:             1,
-						PipelineSpecID: 2,
-						Name:           null.StringFrom("first-one"),
-					},
-					{
+				f.Mocks.jobORM.On("FindJobsByPipelineSpecIDs", []int32{5}).Return(map[int32]job.Job{
+					5: {
 						ID:             2,
 						PipelineSpecID: 5,
 						Name:           null.StringFrom("second-one"),
@@ -397,3 +527,120 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_RerunJobRun(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RerunJobRun($id: ID!, $input: RerunJobRunInput) {
+			rerunJobRun(id: $id, input: $input) {
+				... on RerunJobRunSuccess {
+					jobRun {
+						id
+						status
+						rerunOfID
+					}
+				}
+				... on RerunJobRunCannotRerunError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "2",
+	}
+	confirmedVariables := map[string]interface{}{
+		"id": "2",
+		"input": map[string]interface{}{
+			"originalSpec":       true,
+			"confirmSideEffects": true,
+		},
+	}
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rerunJobRun"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RerunJobRun", mock.Anything, int64(2), job.RerunOpts{OriginalSpec: true, ConfirmSideEffects: true}).Return(int64(3), nil)
+				f.Mocks.pipelineORM.On("FindRun", int64(3)).Return(pipeline.Run{
+					ID:        3,
+					State:     pipeline.RunStatusCompleted,
+					RerunOfID: null.IntFrom(2),
+				}, nil)
+				f.App.On("PipelineORM").Return(f.Mocks.pipelineORM)
+			},
+			query:     mutation,
+			variables: confirmedVariables,
+			result: `
+				{
+					"rerunJobRun": {
+						"jobRun": {
+							"id": "3",
+							"status": "COMPLETED",
+							"rerunOfID": "2"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RerunJobRun", mock.Anything, int64(2), job.RerunOpts{}).Return(int64(0), errors.Wrap(sql.ErrNoRows, "run ID 2"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunJobRun": {
+						"code": "NOT_FOUND",
+						"message": "job run not found"
+					}
+				}`,
+		},
+		{
+			name:          "side effects not confirmed error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RerunJobRun", mock.Anything, int64(2), job.RerunOpts{}).Return(int64(0), errors.Wrap(job.ErrRerunNotConfirmed, "tasks submit"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunJobRun": {
+						"code": "STATUS_CONFLICT",
+						"message": "tasks submit: pipeline has side effecting tasks, rerunning it must be confirmed"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on RerunJobRun",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("RerunJobRun", mock.Anything, int64(2), job.RerunOpts{}).Return(int64(0), gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"rerunJobRun"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
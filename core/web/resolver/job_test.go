@@ -17,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -289,6 +290,7 @@ func TestResolver_CreateJob(t *testing.T) {
 	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
 	assert.NoError(t, err)
+	jb.TOMLSpec = testspecs.DirectRequestSpec
 
 	d, err := json.Marshal(map[string]interface{}{
 		"createJob": map[string]interface{}{
@@ -360,6 +362,327 @@ func TestResolver_CreateJob(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation UpdateJob($id: ID!, $input: UpdateJobInput!) {
+			updateJob(id: $id, input: $input) {
+				... on UpdateJobSuccess {
+					job {
+						id
+						name
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+		"input": map[string]interface{}{
+			"TOML": testspecs.DirectRequestSpec,
+		},
+	}
+	invalid := map[string]interface{}{
+		"id": "123",
+		"input": map[string]interface{}{
+			"TOML": "some wrong value",
+		},
+	}
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	assert.NoError(t, err)
+	jb.ID = id
+	jb.TOMLSpec = testspecs.DirectRequestSpec
+
+	d, err := json.Marshal(map[string]interface{}{
+		"updateJob": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":   "123",
+				"name": jb.Name,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJob", mock.Anything, &jb, testspecs.DirectRequestSpec, (*templates.JobTemplate)(nil)).Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "invalid TOML error",
+			authenticated: true,
+			query:         mutation,
+			variables:     invalid,
+			result: `
+				{
+					"updateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "failed to parse TOML: (1, 6): was expecting token =, but got \"wrong\" instead",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJob", mock.Anything, &jb, testspecs.DirectRequestSpec, (*templates.JobTemplate)(nil)).Return(errors.Wrap(sql.ErrNoRows, "UpdateJobFailed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"updateJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RollbackJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation RollbackJob($id: ID!, $version: Int!) {
+			rollbackJob(id: $id, version: $version) {
+				... on UpdateJobSuccess {
+					job {
+						id
+						name
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id":      "123",
+		"version": 1,
+	}
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	assert.NoError(t, err)
+	jb.ID = id
+	jb.TOMLSpec = testspecs.DirectRequestSpec
+
+	d, err := json.Marshal(map[string]interface{}{
+		"rollbackJob": map[string]interface{}{
+			"job": map[string]interface{}{
+				"id":   "123",
+				"name": jb.Name,
+			},
+		},
+	})
+	assert.NoError(t, err)
+	expected := string(d)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rollbackJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindSpecVersion", id, int32(1), mock.Anything).Return(job.SpecVersion{
+					JobID:   id,
+					Version: 1,
+					TOML:    testspecs.DirectRequestSpec,
+				}, nil)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("UpdateJob", mock.Anything, &jb, testspecs.DirectRequestSpec, (*templates.JobTemplate)(nil)).Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    expected,
+		},
+		{
+			name:          "version not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindSpecVersion", id, int32(1), mock.Anything).Return(job.SpecVersion{}, errors.Wrap(sql.ErrNoRows, "FindSpecVersion failed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rollbackJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	pausedAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:       id,
+					PausedAt: null.TimeFrom(pausedAt),
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{ID: id}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"pausedAt": null
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -3,7 +3,10 @@ package resolver
 import (
 	"strings"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 type LogLevel string
@@ -169,3 +172,136 @@ func NewSetGlobalLogLevelSuccess(lvl LogLevel) *SetGlobalLogLevelSuccessResolver
 func (r *SetGlobalLogLevelSuccessResolver) GlobalLogLevel() *GlobalLogLevelResolver {
 	return GlobalLogLevel(FromLogLevel(r.lvl))
 }
+
+// -- ServiceLogLevel --
+
+type ServiceLogLevelResolver struct {
+	sl logger.ServiceLogLevel
+}
+
+func NewServiceLogLevel(sl logger.ServiceLogLevel) *ServiceLogLevelResolver {
+	return &ServiceLogLevelResolver{sl: sl}
+}
+
+func NewServiceLogLevels(sls []logger.ServiceLogLevel) []*ServiceLogLevelResolver {
+	var resolvers []*ServiceLogLevelResolver
+	for _, sl := range sls {
+		resolvers = append(resolvers, NewServiceLogLevel(sl))
+	}
+
+	return resolvers
+}
+
+func (r *ServiceLogLevelResolver) Service() string {
+	return r.sl.Name
+}
+
+func (r *ServiceLogLevelResolver) Level() (LogLevel, error) {
+	return ToLogLevel(r.sl.Level.String())
+}
+
+func (r *ServiceLogLevelResolver) ExpiresAt() *graphql.Time {
+	if r.sl.Expires == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *r.sl.Expires}
+}
+
+// -- ServiceLogLevels Query --
+
+type ServiceLogLevelsPayloadResolver struct {
+	sls []logger.ServiceLogLevel
+}
+
+func NewServiceLogLevelsPayload(sls []logger.ServiceLogLevel) *ServiceLogLevelsPayloadResolver {
+	return &ServiceLogLevelsPayloadResolver{sls: sls}
+}
+
+func (r *ServiceLogLevelsPayloadResolver) Results() []*ServiceLogLevelResolver {
+	return NewServiceLogLevels(r.sls)
+}
+
+// -- SetServiceLogLevel Mutation --
+
+type SetServiceLogLevelPayloadResolver struct {
+	sl        *logger.ServiceLogLevel
+	inputErrs map[string]string
+}
+
+func NewSetServiceLogLevelPayload(sl *logger.ServiceLogLevel, inputErrs map[string]string) *SetServiceLogLevelPayloadResolver {
+	return &SetServiceLogLevelPayloadResolver{sl: sl, inputErrs: inputErrs}
+}
+
+func (r *SetServiceLogLevelPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		var errs []*InputErrorResolver
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+func (r *SetServiceLogLevelPayloadResolver) ToSetServiceLogLevelSuccess() (*SetServiceLogLevelSuccessResolver, bool) {
+	if r.sl == nil {
+		return nil, false
+	}
+
+	return NewSetServiceLogLevelSuccess(*r.sl), true
+}
+
+type SetServiceLogLevelSuccessResolver struct {
+	sl logger.ServiceLogLevel
+}
+
+func NewSetServiceLogLevelSuccess(sl logger.ServiceLogLevel) *SetServiceLogLevelSuccessResolver {
+	return &SetServiceLogLevelSuccessResolver{sl: sl}
+}
+
+func (r *SetServiceLogLevelSuccessResolver) ServiceLogLevel() *ServiceLogLevelResolver {
+	return NewServiceLogLevel(r.sl)
+}
+
+// -- UnsetServiceLogLevel Mutation --
+
+type UnsetServiceLogLevelPayloadResolver struct {
+	service string
+	NotFoundErrorUnionType
+}
+
+func NewUnsetServiceLogLevelPayload(service string, found bool) *UnsetServiceLogLevelPayloadResolver {
+	var e NotFoundErrorUnionType
+
+	if !found {
+		e = NotFoundErrorUnionType{err: errors.New("service log level not found"), message: "service log level not found", isExpectedErrorFn: func(error) bool {
+			return true
+		}}
+	}
+
+	return &UnsetServiceLogLevelPayloadResolver{service: service, NotFoundErrorUnionType: e}
+}
+
+func (r *UnsetServiceLogLevelPayloadResolver) ToUnsetServiceLogLevelSuccess() (*UnsetServiceLogLevelSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewUnsetServiceLogLevelSuccess(r.service), true
+}
+
+type UnsetServiceLogLevelSuccessResolver struct {
+	service string
+}
+
+func NewUnsetServiceLogLevelSuccess(service string) *UnsetServiceLogLevelSuccessResolver {
+	return &UnsetServiceLogLevelSuccessResolver{service: service}
+}
+
+func (r *UnsetServiceLogLevelSuccessResolver) Service() string {
+	return r.service
+}
@@ -2,11 +2,14 @@ package resolver
 
 import (
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
 )
 
 func TestResolver_SetSQLLogging(t *testing.T) {
@@ -196,3 +199,209 @@ func TestResolver_SetGlobalLogLevel(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_ServiceLogLevels(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetServiceLogLevels {
+			serviceLogLevels {
+				results {
+					service
+					level
+					expiresAt
+				}
+			}
+		}`
+
+	expires := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "serviceLogLevels"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ServiceLogLevels").Return([]logger.ServiceLogLevel{
+					{Name: "EVM.1.Txm", Level: zapcore.DebugLevel, Expires: &expires},
+					{Name: "OCR", Level: zapcore.ErrorLevel},
+				})
+			},
+			query: query,
+			result: `
+				{
+					"serviceLogLevels": {
+						"results": [{
+							"service": "EVM.1.Txm",
+							"level": "DEBUG",
+							"expiresAt": "2022-07-01T12:00:00Z"
+						}, {
+							"service": "OCR",
+							"level": "ERROR",
+							"expiresAt": null
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_SetServiceLogLevel(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SetServiceLogLevel($input: SetServiceLogLevelInput!) {
+			setServiceLogLevel(input: $input) {
+				... on SetServiceLogLevelSuccess {
+					serviceLogLevel {
+						service
+						level
+						expiresAt
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"service":   "EVM.1.Txm",
+			"level":     LogLevelDebug,
+			"expiresIn": "30m",
+		},
+	}
+
+	expires := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "setServiceLogLevel"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SetServiceLogLevel", "EVM.1.Txm", zapcore.DebugLevel, 30*time.Minute).
+					Return(logger.ServiceLogLevel{Name: "EVM.1.Txm", Level: zapcore.DebugLevel, Expires: &expires}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setServiceLogLevel": {
+						"serviceLogLevel": {
+							"service": "EVM.1.Txm",
+							"level": "DEBUG",
+							"expiresAt": "2022-07-01T12:00:00Z"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "invalid expiry",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"service":   "EVM.1.Txm",
+					"level":     LogLevelDebug,
+					"expiresIn": "soon",
+				},
+			},
+			result: `
+				{
+					"setServiceLogLevel": {
+						"errors": [{
+							"path": "input/expiresIn",
+							"message": "invalid duration",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid service",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SetServiceLogLevel", "EVM.1.Txm", zapcore.DebugLevel, 30*time.Minute).
+					Return(logger.ServiceLogLevel{}, errors.New("service name is required"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"setServiceLogLevel": {
+						"errors": [{
+							"path": "input/service",
+							"message": "service name is required",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_UnsetServiceLogLevel(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation UnsetServiceLogLevel($service: String!) {
+			unsetServiceLogLevel(service: $service) {
+				... on UnsetServiceLogLevelSuccess {
+					service
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"service": "EVM.1.Txm",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "unsetServiceLogLevel"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("UnsetServiceLogLevel", "EVM.1.Txm").Return(true)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"unsetServiceLogLevel": {
+						"service": "EVM.1.Txm"
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("UnsetServiceLogLevel", "EVM.1.Txm").Return(false)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"unsetServiceLogLevel": {
+						"message": "service log level not found",
+						"code": "NOT_FOUND"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/big"
 	"net/url"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
@@ -33,6 +35,8 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -624,6 +628,97 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
 
+func (r *Resolver) CreatePipelineTemplate(ctx context.Context, args struct {
+	Input struct {
+		TOML string
+	}
+}) (*CreatePipelineTemplatePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := templates.ParseTemplateSpec(args.Input.TOML); err != nil {
+		return NewCreatePipelineTemplatePayload(nil, map[string]string{
+			"TOML": err.Error(),
+		}), nil
+	}
+
+	t, err := r.App.GetTemplatesService().CreateTemplate(ctx, args.Input.TOML)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCreatePipelineTemplatePayload(&t, nil), nil
+}
+
+func (r *Resolver) DeletePipelineTemplate(ctx context.Context, args struct {
+	Name string
+}) (*DeletePipelineTemplatePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	svc := r.App.GetTemplatesService()
+	t, err := svc.GetTemplate(args.Name, 0)
+	if err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			return NewDeletePipelineTemplatePayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	if err = svc.DeleteTemplate(ctx, args.Name); err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) || errors.Is(err, templates.ErrTemplateInUse) {
+			return NewDeletePipelineTemplatePayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewDeletePipelineTemplatePayload(&t, nil), nil
+}
+
+func (r *Resolver) RollForwardPipelineTemplate(ctx context.Context, args struct {
+	Name  string
+	Input struct {
+		Version *int32
+		JobIDs  *[]graphql.ID
+	}
+}) (*RollForwardPipelineTemplatePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var version int32
+	if args.Input.Version != nil {
+		version = *args.Input.Version
+	}
+
+	var jobIDs []int32
+	if args.Input.JobIDs != nil {
+		var err error
+		if jobIDs, err = parseTemplateJobIDs(*args.Input.JobIDs); err != nil {
+			return NewRollForwardPipelineTemplatePayload(nil, map[string]string{
+				"jobIDs": "invalid job ID",
+			}, nil), nil
+		}
+	}
+
+	changes, err := r.App.GetTemplatesService().RollForward(ctx, args.Name, version, jobIDs)
+	if err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			return NewRollForwardPipelineTemplatePayload(nil, nil, err), nil
+		}
+
+		return NewRollForwardPipelineTemplatePayload(nil, map[string]string{
+			"jobIDs": err.Error(),
+		}, nil), nil
+	}
+
+	return NewRollForwardPipelineTemplatePayload(changes, nil, nil), nil
+}
+
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -1066,60 +1161,171 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	jb, link, inputErrs, err := r.validateJobSpec(args.Input.TOML)
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if link != nil {
+		err = r.App.GetTemplatesService().CreateJob(ctx, &jb, *link)
+	} else {
+		err = r.App.AddJobV2(ctx, &jb)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCreateJobPayload(r.App, &jb, nil), nil
+}
+
+func (r *Resolver) UpdateJob(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		TOML string
+	}
+}) (*UpdateJobPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	jb, inputErrs, err := r.updateJob(ctx, id, args.Input.TOML)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateJobPayload(r.App, nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewUpdateJobPayload(r.App, jb, inputErrs, nil), nil
+}
+
+func (r *Resolver) RollbackJob(ctx context.Context, args struct {
+	ID      graphql.ID
+	Version int32
+}) (*UpdateJobPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := r.App.JobORM().FindSpecVersion(id, args.Version, pg.WithParentCtx(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateJobPayload(r.App, nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	jb, inputErrs, err := r.updateJob(ctx, id, v.TOML)
 	if err != nil {
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateJobPayload(r.App, nil, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewUpdateJobPayload(r.App, jb, inputErrs, nil), nil
+}
+
+// updateJob validates spec and applies it to the job with the given ID.
+func (r *Resolver) updateJob(ctx context.Context, id int32, spec string) (*job.Job, map[string]string, error) {
+	jb, link, inputErrs, err := r.validateJobSpec(spec)
+	if inputErrs != nil {
+		return nil, inputErrs, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	jb.ID = id
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if err = r.App.UpdateJob(ctx, &jb, spec, link); err != nil {
+		return nil, nil, err
+	}
+
+	return &jb, nil, nil
+}
+
+// validateJobSpec renders any pipeline template referenced by spec and
+// validates it. Specs which cannot be parsed are reported as input errors.
+func (r *Resolver) validateJobSpec(spec string) (jb job.Job, link *templates.JobTemplate, inputErrs map[string]string, err error) {
+	// Render the observationSource if the spec references a pipeline template
+	tomlSpec, link, err := templates.ExpandJobSpec(spec, func(name string, version int32) (templates.Template, error) {
+		return r.App.GetTemplatesService().GetTemplate(name, version)
+	})
+	if err != nil {
+		return jb, nil, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
-		}), nil
+		}, nil
+	}
+
+	jbt, err := job.ValidateSpec(tomlSpec)
+	if err != nil {
+		return jb, nil, map[string]string{
+			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
+		}, nil
 	}
 
-	var jb job.Job
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(r.App.GetChains().EVM, args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(r.App.GetChains().EVM, tomlSpec)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
+			return jb, nil, nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(r.App.GetConfig(), args.Input.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(r.App.GetConfig(), tomlSpec)
 		if !config.Dev() && !config.FeatureOffchainReporting2() {
-			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+			return jb, nil, nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlSpec)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config, tomlSpec)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlSpec)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlSpec)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlSpec)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlSpec, r.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlSpec)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlSpec)
 	default:
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, nil, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
-		}), nil
+		}, nil
 	}
 	if err != nil {
-		return nil, err
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	err = r.App.AddJobV2(ctx, &jb)
-	if err != nil {
-		return nil, err
+		return jb, nil, nil, err
 	}
 
-	return NewCreateJobPayload(r.App, &jb, nil), nil
+	// The spec is recorded as is, so that template references are kept
+	jb.TOMLSpec = spec
+	return jb, link, nil, nil
 }
 
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
@@ -1155,6 +1361,62 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.App.PauseJob(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.App.ResumeJob(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
@@ -1202,7 +1464,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 
 	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil)
 	if err != nil {
-		if errors.Is(err, webhook.ErrJobNotExists) {
+		if errors.Is(err, webhook.ErrJobNotExists) || errors.Is(err, job.ErrJobPaused) {
 			return NewRunJobPayload(nil, r.App, err), nil
 		}
 
@@ -1217,6 +1479,48 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+func (r *Resolver) RerunJobRun(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *struct {
+		OriginalSpec       *bool
+		ConfirmSideEffects *bool
+	}
+}) (*RerunJobRunPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	runID, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts job.RerunOpts
+	if args.Input != nil {
+		opts.OriginalSpec = args.Input.OriginalSpec != nil && *args.Input.OriginalSpec
+		opts.ConfirmSideEffects = args.Input.ConfirmSideEffects != nil && *args.Input.ConfirmSideEffects
+	}
+
+	rerunID, err := r.App.RerunJobRun(ctx, runID, opts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) ||
+			errors.Is(err, job.ErrRunNotFinished) ||
+			errors.Is(err, job.ErrRerunNotConfirmed) ||
+			errors.Is(err, job.ErrJobPaused) {
+			return NewRerunJobRunPayload(nil, r.App, err), nil
+		}
+
+		return nil, err
+	}
+
+	plnRun, err := r.App.PipelineORM().FindRun(rerunID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRerunJobRunPayload(&plnRun, r.App, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
@@ -1241,6 +1545,57 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	return NewSetGlobalLogLevelPayload(args.Level, nil), nil
 }
 
+// SetServiceLogLevel sets the runtime log level of a named logger and its descendants
+func (r *Resolver) SetServiceLogLevel(ctx context.Context, args struct {
+	Input struct {
+		Service   string
+		Level     LogLevel
+		ExpiresIn *string
+	}
+}) (*SetServiceLogLevelPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(FromLogLevel(args.Input.Level))); err != nil {
+		return NewSetServiceLogLevelPayload(nil, map[string]string{
+			"input/level": "invalid log level",
+		}), nil
+	}
+
+	var expiresIn time.Duration
+	if args.Input.ExpiresIn != nil && *args.Input.ExpiresIn != "" {
+		var err error
+		expiresIn, err = time.ParseDuration(*args.Input.ExpiresIn)
+		if err != nil || expiresIn < 0 {
+			return NewSetServiceLogLevelPayload(nil, map[string]string{
+				"input/expiresIn": "invalid duration",
+			}), nil
+		}
+	}
+
+	sl, err := r.App.SetServiceLogLevel(args.Input.Service, lvl, expiresIn)
+	if err != nil {
+		return NewSetServiceLogLevelPayload(nil, map[string]string{
+			"input/service": err.Error(),
+		}), nil
+	}
+
+	return NewSetServiceLogLevelPayload(&sl, nil), nil
+}
+
+// UnsetServiceLogLevel removes the runtime log level of a named logger, which reverts to the global level
+func (r *Resolver) UnsetServiceLogLevel(ctx context.Context, args struct {
+	Service string
+}) (*UnsetServiceLogLevelPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	return NewUnsetServiceLogLevelPayload(args.Service, r.App.UnsetServiceLogLevel(args.Service)), nil
+}
+
 // CreateOCR2KeyBundle resolves a create OCR2 Key bundle mutation
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
@@ -1280,3 +1635,93 @@ func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 
 	return NewDeleteOCR2KeyBundlePayloadResolver(&key, nil), nil
 }
+
+// CancelEthTransaction replaces an unconfirmed transaction with a zero value
+// transaction from its key to itself.
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*RemediateEthTransactionPayloadResolver, error) {
+	return r.remediateEthTransaction(ctx, args.ID, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.CancelTransaction(ctx, etxID)
+	})
+}
+
+// ReplaceEthTransaction sends a new attempt for an unconfirmed transaction
+// with the given fee.
+func (r *Resolver) ReplaceEthTransaction(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input struct {
+		GasPrice  *string
+		GasTipCap *string
+		GasFeeCap *string
+	}
+}) (*RemediateEthTransactionPayloadResolver, error) {
+	var fee txmgr.ReplacementFee
+	for _, f := range []struct {
+		in  *string
+		out **big.Int
+	}{
+		{args.Input.GasPrice, &fee.GasPrice},
+		{args.Input.GasTipCap, &fee.GasTipCap},
+		{args.Input.GasFeeCap, &fee.GasFeeCap},
+	} {
+		if f.in == nil {
+			continue
+		}
+		val, ok := new(big.Int).SetString(*f.in, 10)
+		if !ok {
+			return nil, errors.Errorf("invalid fee %q", *f.in)
+		}
+		*f.out = val
+	}
+
+	return r.remediateEthTransaction(ctx, args.ID, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.ReplaceTransaction(ctx, etxID, fee)
+	})
+}
+
+// AbandonEthTransaction gives up on an unconfirmed transaction and every
+// later transaction from the same key.
+func (r *Resolver) AbandonEthTransaction(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*RemediateEthTransactionPayloadResolver, error) {
+	return r.remediateEthTransaction(ctx, args.ID, func(txm txmgr.TxManager, etxID int64) error {
+		return txm.AbandonTransaction(ctx, etxID)
+	})
+}
+
+func (r *Resolver) remediateEthTransaction(ctx context.Context, id graphql.ID, fn func(txm txmgr.TxManager, etxID int64) error) (*RemediateEthTransactionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	etxID, err := stringutils.ToInt64(string(id))
+	if err != nil {
+		return nil, err
+	}
+
+	etx, err := r.App.TxmORM().FindEthTxWithAttempts(etxID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRemediateEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	chain, err := r.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		return NewRemediateEthTransactionPayload(nil, err), nil
+	}
+
+	if err = fn(chain.TxManager(), etxID); err != nil {
+		return NewRemediateEthTransactionPayload(nil, err), nil
+	}
+
+	etx, err = r.App.TxmORM().FindEthTxWithAttempts(etxID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemediateEthTransactionPayload(&etx, nil), nil
+}
@@ -0,0 +1,379 @@
+package resolver
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+type PipelineTemplateParamType string
+
+func ToPipelineTemplateParamType(t templates.ParamType) PipelineTemplateParamType {
+	return PipelineTemplateParamType(strings.ToUpper(string(t)))
+}
+
+func isTemplateNotFoundError(err error) bool {
+	return errors.Is(err, templates.ErrTemplateNotFound)
+}
+
+// PipelineTemplateParamResolver resolves the PipelineTemplateParam type.
+type PipelineTemplateParamResolver struct {
+	param templates.Param
+}
+
+// Name resolves the param's name.
+func (r *PipelineTemplateParamResolver) Name() string {
+	return r.param.Name
+}
+
+// Type resolves the param's type.
+func (r *PipelineTemplateParamResolver) Type() PipelineTemplateParamType {
+	return ToPipelineTemplateParamType(r.param.Type)
+}
+
+// Description resolves the param's description.
+func (r *PipelineTemplateParamResolver) Description() string {
+	return r.param.Description
+}
+
+// Default resolves the param's default value, which is nil if the param is
+// required.
+func (r *PipelineTemplateParamResolver) Default() *string {
+	return r.param.Default
+}
+
+// PipelineTemplateResolver resolves the PipelineTemplate type.
+type PipelineTemplateResolver struct {
+	t templates.Template
+}
+
+func NewPipelineTemplate(t templates.Template) *PipelineTemplateResolver {
+	return &PipelineTemplateResolver{t: t}
+}
+
+func NewPipelineTemplates(ts []templates.Template) []*PipelineTemplateResolver {
+	var resolvers []*PipelineTemplateResolver
+	for _, t := range ts {
+		resolvers = append(resolvers, NewPipelineTemplate(t))
+	}
+
+	return resolvers
+}
+
+// ID resolves the template version's id.
+func (r *PipelineTemplateResolver) ID() graphql.ID {
+	return graphql.ID(stringutils.FromInt64(r.t.ID))
+}
+
+// Name resolves the template's name.
+func (r *PipelineTemplateResolver) Name() string {
+	return r.t.Name
+}
+
+// Version resolves the template's version.
+func (r *PipelineTemplateResolver) Version() int32 {
+	return r.t.Version
+}
+
+// Description resolves the template's description.
+func (r *PipelineTemplateResolver) Description() string {
+	return r.t.Description
+}
+
+// Params resolves the template's params.
+func (r *PipelineTemplateResolver) Params() []*PipelineTemplateParamResolver {
+	resolvers := []*PipelineTemplateParamResolver{}
+	for _, p := range r.t.Params {
+		resolvers = append(resolvers, &PipelineTemplateParamResolver{param: p})
+	}
+
+	return resolvers
+}
+
+// Source resolves the template's pipeline source.
+func (r *PipelineTemplateResolver) Source() string {
+	return r.t.Source
+}
+
+// CreatedAt resolves the template version's created at field.
+func (r *PipelineTemplateResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.t.CreatedAt}
+}
+
+// PipelineTemplatePayloadResolver resolves a single template version response
+type PipelineTemplatePayloadResolver struct {
+	t templates.Template
+	NotFoundErrorUnionType
+}
+
+func NewPipelineTemplatePayload(t templates.Template, err error) *PipelineTemplatePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline template not found", isExpectedErrorFn: isTemplateNotFoundError}
+
+	return &PipelineTemplatePayloadResolver{t: t, NotFoundErrorUnionType: e}
+}
+
+// ToPipelineTemplate implements the PipelineTemplate union type of the payload
+func (r *PipelineTemplatePayloadResolver) ToPipelineTemplate() (*PipelineTemplateResolver, bool) {
+	if r.err == nil {
+		return NewPipelineTemplate(r.t), true
+	}
+
+	return nil, false
+}
+
+// PipelineTemplatesPayloadResolver resolves a page of templates
+type PipelineTemplatesPayloadResolver struct {
+	ts    []templates.Template
+	total int32
+}
+
+func NewPipelineTemplatesPayload(ts []templates.Template, total int32) *PipelineTemplatesPayloadResolver {
+	return &PipelineTemplatesPayloadResolver{ts: ts, total: total}
+}
+
+// Results returns the templates.
+func (r *PipelineTemplatesPayloadResolver) Results() []*PipelineTemplateResolver {
+	return NewPipelineTemplates(r.ts)
+}
+
+// Metadata returns the pagination metadata.
+func (r *PipelineTemplatesPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// PipelineTemplateJobChangeResolver resolves the PipelineTemplateJobChange
+// type.
+type PipelineTemplateJobChangeResolver struct {
+	change templates.JobChange
+}
+
+func NewPipelineTemplateJobChanges(changes []templates.JobChange) []*PipelineTemplateJobChangeResolver {
+	resolvers := []*PipelineTemplateJobChangeResolver{}
+	for _, c := range changes {
+		resolvers = append(resolvers, &PipelineTemplateJobChangeResolver{change: c})
+	}
+
+	return resolvers
+}
+
+// JobID resolves the id of the job.
+func (r *PipelineTemplateJobChangeResolver) JobID() graphql.ID {
+	return graphql.ID(stringutils.FromInt32(r.change.JobID))
+}
+
+// JobName resolves the name of the job.
+func (r *PipelineTemplateJobChangeResolver) JobName() string {
+	return r.change.JobName
+}
+
+// FromVersion resolves the template version the job currently uses.
+func (r *PipelineTemplateJobChangeResolver) FromVersion() int32 {
+	return r.change.FromVersion
+}
+
+// ToVersion resolves the template version the job is rolled forward to.
+func (r *PipelineTemplateJobChangeResolver) ToVersion() int32 {
+	return r.change.ToVersion
+}
+
+// Diff resolves the unified diff of the job's pipeline.
+func (r *PipelineTemplateJobChangeResolver) Diff() string {
+	return r.change.Diff
+}
+
+// RolledForward resolves whether the job was updated to the new version.
+func (r *PipelineTemplateJobChangeResolver) RolledForward() bool {
+	return r.change.RolledForward
+}
+
+// Error resolves why the job cannot be rolled forward.
+func (r *PipelineTemplateJobChangeResolver) Error() *string {
+	if r.change.Error == "" {
+		return nil
+	}
+	return &r.change.Error
+}
+
+// PipelineTemplateRollForwardPlanPayloadResolver resolves the changes rolling
+// jobs forward would make
+type PipelineTemplateRollForwardPlanPayloadResolver struct {
+	changes []templates.JobChange
+	NotFoundErrorUnionType
+}
+
+func NewPipelineTemplateRollForwardPlanPayload(changes []templates.JobChange, err error) *PipelineTemplateRollForwardPlanPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline template not found", isExpectedErrorFn: isTemplateNotFoundError}
+
+	return &PipelineTemplateRollForwardPlanPayloadResolver{changes: changes, NotFoundErrorUnionType: e}
+}
+
+// ToPipelineTemplateRollForwardPlan implements the
+// PipelineTemplateRollForwardPlan union type of the payload
+func (r *PipelineTemplateRollForwardPlanPayloadResolver) ToPipelineTemplateRollForwardPlan() (*PipelineTemplateRollForwardPlanResolver, bool) {
+	if r.err == nil {
+		return &PipelineTemplateRollForwardPlanResolver{changes: r.changes}, true
+	}
+
+	return nil, false
+}
+
+type PipelineTemplateRollForwardPlanResolver struct {
+	changes []templates.JobChange
+}
+
+// Changes resolves the planned job changes.
+func (r *PipelineTemplateRollForwardPlanResolver) Changes() []*PipelineTemplateJobChangeResolver {
+	return NewPipelineTemplateJobChanges(r.changes)
+}
+
+// -- CreatePipelineTemplate mutation --
+
+type CreatePipelineTemplatePayloadResolver struct {
+	t         *templates.Template
+	inputErrs map[string]string
+}
+
+func NewCreatePipelineTemplatePayload(t *templates.Template, inputErrs map[string]string) *CreatePipelineTemplatePayloadResolver {
+	return &CreatePipelineTemplatePayloadResolver{t: t, inputErrs: inputErrs}
+}
+
+func (r *CreatePipelineTemplatePayloadResolver) ToCreatePipelineTemplateSuccess() (*CreatePipelineTemplateSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &CreatePipelineTemplateSuccessResolver{t: *r.t}, true
+}
+
+func (r *CreatePipelineTemplatePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type CreatePipelineTemplateSuccessResolver struct {
+	t templates.Template
+}
+
+// Template resolves the created template version.
+func (r *CreatePipelineTemplateSuccessResolver) Template() *PipelineTemplateResolver {
+	return NewPipelineTemplate(r.t)
+}
+
+// -- DeletePipelineTemplate mutation --
+
+type DeletePipelineTemplatePayloadResolver struct {
+	t *templates.Template
+	NotFoundErrorUnionType
+}
+
+func NewDeletePipelineTemplatePayload(t *templates.Template, err error) *DeletePipelineTemplatePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline template not found", isExpectedErrorFn: isTemplateNotFoundError}
+
+	return &DeletePipelineTemplatePayloadResolver{t: t, NotFoundErrorUnionType: e}
+}
+
+func (r *DeletePipelineTemplatePayloadResolver) ToDeletePipelineTemplateSuccess() (*DeletePipelineTemplateSuccessResolver, bool) {
+	if r.err == nil && r.t != nil {
+		return &DeletePipelineTemplateSuccessResolver{t: *r.t}, true
+	}
+
+	return nil, false
+}
+
+func (r *DeletePipelineTemplatePayloadResolver) ToDeletePipelineTemplateConflictError() (*DeletePipelineTemplateConflictErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, templates.ErrTemplateInUse) {
+		return &DeletePipelineTemplateConflictErrorResolver{message: r.err.Error()}, true
+	}
+
+	return nil, false
+}
+
+type DeletePipelineTemplateSuccessResolver struct {
+	t templates.Template
+}
+
+// Template resolves the latest version of the deleted template.
+func (r *DeletePipelineTemplateSuccessResolver) Template() *PipelineTemplateResolver {
+	return NewPipelineTemplate(r.t)
+}
+
+type DeletePipelineTemplateConflictErrorResolver struct {
+	message string
+}
+
+func (r *DeletePipelineTemplateConflictErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *DeletePipelineTemplateConflictErrorResolver) Code() ErrorCode {
+	return ErrorCodeStatusConflict
+}
+
+// -- RollForwardPipelineTemplate mutation --
+
+type RollForwardPipelineTemplatePayloadResolver struct {
+	changes   []templates.JobChange
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewRollForwardPipelineTemplatePayload(changes []templates.JobChange, inputErrs map[string]string, err error) *RollForwardPipelineTemplatePayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "pipeline template not found", isExpectedErrorFn: isTemplateNotFoundError}
+
+	return &RollForwardPipelineTemplatePayloadResolver{changes: changes, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *RollForwardPipelineTemplatePayloadResolver) ToRollForwardPipelineTemplateSuccess() (*RollForwardPipelineTemplateSuccessResolver, bool) {
+	if r.err != nil || r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &RollForwardPipelineTemplateSuccessResolver{changes: r.changes}, true
+}
+
+func (r *RollForwardPipelineTemplatePayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type RollForwardPipelineTemplateSuccessResolver struct {
+	changes []templates.JobChange
+}
+
+// Changes resolves the applied job changes.
+func (r *RollForwardPipelineTemplateSuccessResolver) Changes() []*PipelineTemplateJobChangeResolver {
+	return NewPipelineTemplateJobChanges(r.changes)
+}
+
+func parseTemplateJobIDs(ids []graphql.ID) ([]int32, error) {
+	jobIDs := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		jobID, err := strconv.ParseInt(string(id), 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		jobIDs = append(jobIDs, int32(jobID))
+	}
+	return jobIDs, nil
+}
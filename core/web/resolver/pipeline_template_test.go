@@ -0,0 +1,407 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/services/templates"
+)
+
+func pipelineTemplateFixture(f *gqlTestFramework) templates.Template {
+	def := "data,result"
+	return templates.Template{
+		ID:          3,
+		Name:        "ocr-feed",
+		Version:     2,
+		Description: "feed",
+		Params: templates.Params{
+			{Name: "bridge", Type: templates.ParamTypeBridge},
+			{Name: "path", Type: templates.ParamTypeString, Default: &def},
+		},
+		Source:    `ds [type=bridge name="{{ bridge }}"]`,
+		CreatedAt: f.Timestamp(),
+	}
+}
+
+func TestResolver_PipelineTemplate(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetPipelineTemplate {
+			pipelineTemplate(name: "ocr-feed", version: 2) {
+				... on PipelineTemplate {
+					id
+					name
+					version
+					description
+					params {
+						name
+						type
+						default
+					}
+					source
+					createdAt
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineTemplate"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("GetTemplate", "ocr-feed", int32(2)).Return(pipelineTemplateFixture(f), nil)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineTemplate": {
+					"id": "3",
+					"name": "ocr-feed",
+					"version": 2,
+					"description": "feed",
+					"params": [
+						{"name": "bridge", "type": "BRIDGE", "default": null},
+						{"name": "path", "type": "STRING", "default": "data,result"}
+					],
+					"source": "ds [type=bridge name=\"{{ bridge }}\"]",
+					"createdAt": "2021-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("GetTemplate", "ocr-feed", int32(2)).Return(templates.Template{}, templates.ErrTemplateNotFound)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineTemplate": {
+					"message": "pipeline template not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_PipelineTemplates(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetPipelineTemplates {
+			pipelineTemplates {
+				results {
+					name
+					version
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineTemplates"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("ListTemplates", PageDefaultOffset, PageDefaultLimit).
+					Return([]templates.Template{pipelineTemplateFixture(f)}, 1, nil)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineTemplates": {
+					"results": [{"name": "ocr-feed", "version": 2}],
+					"metadata": {"total": 1}
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_PipelineTemplateRollForwardPlan(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetPlan {
+			pipelineTemplateRollForwardPlan(name: "ocr-feed") {
+				... on PipelineTemplateRollForwardPlan {
+					changes {
+						jobID
+						jobName
+						fromVersion
+						toVersion
+						diff
+						rolledForward
+						error
+					}
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "pipelineTemplateRollForwardPlan"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("PlanRollForward", mock.Anything, "ocr-feed", int32(0)).Return([]templates.JobChange{
+					{JobID: 1, JobName: "feed", FromVersion: 1, ToVersion: 2, Diff: "-a\n+b\n"},
+					{JobID: 2, FromVersion: 1, ToVersion: 2, Error: `missing argument "bridge"`},
+				}, nil)
+			},
+			query: query,
+			result: `
+			{
+				"pipelineTemplateRollForwardPlan": {
+					"changes": [
+						{"jobID": "1", "jobName": "feed", "fromVersion": 1, "toVersion": 2, "diff": "-a\n+b\n", "rolledForward": false, "error": null},
+						{"jobID": "2", "jobName": "", "fromVersion": 1, "toVersion": 2, "diff": "", "rolledForward": false, "error": "missing argument \"bridge\""}
+					]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_CreatePipelineTemplate(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CreatePipelineTemplate($input: CreatePipelineTemplateInput!) {
+			createPipelineTemplate(input: $input) {
+				... on CreatePipelineTemplateSuccess {
+					template {
+						name
+						version
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	spec := `name = "ocr-feed"
+source = "ds [type=memo value={{x}}]"
+[[params]]
+name = "x"
+type = "int"`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{"TOML": spec},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "createPipelineTemplate"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("CreateTemplate", mock.Anything, spec).Return(pipelineTemplateFixture(f), nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"createPipelineTemplate": {
+					"template": {"name": "ocr-feed", "version": 2}
+				}
+			}`,
+		},
+		{
+			name:          "invalid spec",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{"TOML": `name = "ocr-feed"`},
+			},
+			result: `
+			{
+				"createPipelineTemplate": {
+					"errors": [{
+						"path": "TOML",
+						"message": "source must not be empty",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_DeletePipelineTemplate(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DeletePipelineTemplate {
+			deletePipelineTemplate(name: "ocr-feed") {
+				... on DeletePipelineTemplateSuccess {
+					template {
+						name
+					}
+				}
+				... on DeletePipelineTemplateConflictError {
+					message
+					code
+				}
+				... on NotFoundError {
+					message
+					code
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation}, "deletePipelineTemplate"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("GetTemplate", "ocr-feed", int32(0)).Return(pipelineTemplateFixture(f), nil)
+				f.Mocks.templates.On("DeleteTemplate", mock.Anything, "ocr-feed").Return(nil)
+			},
+			query: mutation,
+			result: `
+			{
+				"deletePipelineTemplate": {
+					"template": {"name": "ocr-feed"}
+				}
+			}`,
+		},
+		{
+			name:          "in use",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("GetTemplate", "ocr-feed", int32(0)).Return(pipelineTemplateFixture(f), nil)
+				f.Mocks.templates.On("DeleteTemplate", mock.Anything, "ocr-feed").Return(templates.ErrTemplateInUse)
+			},
+			query: mutation,
+			result: `
+			{
+				"deletePipelineTemplate": {
+					"message": "pipeline template is used by jobs, roll them to another template or delete them first",
+					"code": "STATUS_CONFLICT"
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("GetTemplate", "ocr-feed", int32(0)).Return(templates.Template{}, templates.ErrTemplateNotFound)
+			},
+			query: mutation,
+			result: `
+			{
+				"deletePipelineTemplate": {
+					"message": "pipeline template not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RollForwardPipelineTemplate(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RollForward($input: RollForwardPipelineTemplateInput!) {
+			rollForwardPipelineTemplate(name: "ocr-feed", input: $input) {
+				... on RollForwardPipelineTemplateSuccess {
+					changes {
+						jobID
+						rolledForward
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{"version": 2, "jobIDs": []interface{}{"1"}},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rollForwardPipelineTemplate"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("RollForward", mock.Anything, "ocr-feed", int32(2), []int32{1}).Return([]templates.JobChange{
+					{JobID: 1, FromVersion: 1, ToVersion: 2, RolledForward: true},
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"rollForwardPipelineTemplate": {
+					"changes": [{"jobID": "1", "rolledForward": true}]
+				}
+			}`,
+		},
+		{
+			name:          "job does not use template",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("GetTemplatesService").Return(f.Mocks.templates)
+				f.Mocks.templates.On("RollForward", mock.Anything, "ocr-feed", int32(2), []int32{1}).
+					Return(nil, errors.New("job 1 does not use pipeline template ocr-feed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"rollForwardPipelineTemplate": {
+					"errors": [{
+						"path": "jobIDs",
+						"message": "job 1 does not use pipeline template ocr-feed",
+						"code": "INVALID_INPUT"
+					}]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
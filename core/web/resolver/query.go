@@ -12,10 +12,13 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/templates"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
@@ -222,6 +225,15 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 	return NewFeaturesPayloadResolver(r.App.GetConfig()), nil
 }
 
+// HAStatus retrieves the high availability role of this node
+func (r *Resolver) HAStatus(ctx context.Context) (*HAStatusPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	return NewHAStatusPayload(r.App.GetHAStatus(), r.App.ID()), nil
+}
+
 // Node retrieves a node by ID
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -257,6 +269,81 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 	return NewP2PKeysPayload(p2pKeys), nil
 }
 
+// PipelineTemplate fetches a version of a pipeline template, the latest
+// unless a version is given.
+func (r *Resolver) PipelineTemplate(ctx context.Context, args struct {
+	Name    string
+	Version *int32
+}) (*PipelineTemplatePayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var version int32
+	if args.Version != nil {
+		version = *args.Version
+	}
+
+	t, err := r.App.GetTemplatesService().GetTemplate(args.Name, version)
+	if err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			return NewPipelineTemplatePayload(t, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewPipelineTemplatePayload(t, nil), nil
+}
+
+// PipelineTemplates retrieves a paginated list of the latest versions of
+// pipeline templates.
+func (r *Resolver) PipelineTemplates(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*PipelineTemplatesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	offset := pageOffset(args.Offset)
+	limit := pageLimit(args.Limit)
+
+	ts, count, err := r.App.GetTemplatesService().ListTemplates(offset, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPipelineTemplatesPayload(ts, int32(count)), nil
+}
+
+// PipelineTemplateRollForwardPlan retrieves how rolling jobs forward to a
+// version of a pipeline template would change them.
+func (r *Resolver) PipelineTemplateRollForwardPlan(ctx context.Context, args struct {
+	Name    string
+	Version *int32
+}) (*PipelineTemplateRollForwardPlanPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var version int32
+	if args.Version != nil {
+		version = *args.Version
+	}
+
+	changes, err := r.App.GetTemplatesService().PlanRollForward(ctx, args.Name, version)
+	if err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			return NewPipelineTemplateRollForwardPlanPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewPipelineTemplateRollForwardPlanPayload(changes, nil), nil
+}
+
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
@@ -354,6 +441,36 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	return NewJobRunsPayload(runs, int32(count), r.App), nil
 }
 
+func (r *Resolver) FilterJobRuns(ctx context.Context, args struct {
+	Filter *JobRunsFilterInput
+	After  *graphql.ID
+	Limit  *int32
+}) (*FilterJobRunsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	filter, err := args.Filter.toPipelineRunsFilter()
+	if err != nil {
+		return nil, err
+	}
+	if args.After != nil {
+		after, err := stringutils.ToInt64(string(*args.After))
+		if err != nil {
+			return nil, err
+		}
+		filter.After = &after
+	}
+	filter.Limit = pageLimit(args.Limit)
+
+	runs, next, err := r.App.JobORM().FilterPipelineRuns(filter, pg.WithParentCtx(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFilterJobRunsPayload(runs, next, r.App), nil
+}
+
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
@@ -515,6 +632,15 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 	return NewGlobalLogLevelPayload(logLevel), nil
 }
 
+// ServiceLogLevels resolves the runtime log levels of named loggers
+func (r *Resolver) ServiceLogLevels(ctx context.Context) (*ServiceLogLevelsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	return NewServiceLogLevelsPayload(r.App.ServiceLogLevels()), nil
+}
+
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -538,6 +664,45 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 	return NewGetSQLLoggingPayload(enabled), nil
 }
 
+// StuckEthTransactions retrieves the stuck transactions of every chain, or of
+// the given chain.
+func (r *Resolver) StuckEthTransactions(ctx context.Context, args struct {
+	EVMChainID *graphql.ID
+}) (*StuckEthTransactionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var chains []evm.Chain
+	if args.EVMChainID == nil {
+		chains = r.App.GetChains().EVM.Chains()
+	} else {
+		id := utils.Big{}
+		if err := id.UnmarshalText([]byte(*args.EVMChainID)); err != nil {
+			return nil, err
+		}
+		chain, err := r.App.GetChains().EVM.Get(id.ToInt())
+		if err != nil {
+			return nil, err
+		}
+		chains = []evm.Chain{chain}
+	}
+
+	var results []txmgr.StuckTx
+	for _, chain := range chains {
+		if !chain.Config().EVMRPCEnabled() {
+			continue
+		}
+		stuck, err := chain.TxManager().FindStuckTransactions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, stuck...)
+	}
+
+	return NewStuckEthTransactionsPayload(results), nil
+}
+
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
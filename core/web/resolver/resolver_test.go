@@ -21,6 +21,7 @@ import (
 	jobORMMocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	templatesMocks "github.com/smartcontractkit/chainlink/core/services/templates/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	sessionsMocks "github.com/smartcontractkit/chainlink/core/sessions/mocks"
@@ -52,6 +53,7 @@ type mocks struct {
 	eIMgr       *webhookmocks.ExternalInitiatorManager
 	balM        *evmORMMocks.BalanceMonitor
 	txmORM      *txmgrMocks.ORM
+	templates   *templatesMocks.Service
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -109,6 +111,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:       &webhookmocks.ExternalInitiatorManager{},
 		balM:        &evmORMMocks.BalanceMonitor{},
 		txmORM:      &txmgrMocks.ORM{},
+		templates:   &templatesMocks.Service{},
 	}
 
 	// Assert expectations for any mocks that we set up
@@ -136,6 +139,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 			m.eIMgr,
 			m.balM,
 			m.txmORM,
+			m.templates,
 		)
 	})
 
@@ -156,6 +156,37 @@ func (r *DirectRequestSpecResolver) MinContractPaymentLinkJuels() string {
 	return r.spec.MinContractPayment.String()
 }
 
+// MinContractPaymentGasLimit resolves the spec's fulfillment gas limit for the dynamic min contract payment.
+func (r *DirectRequestSpecResolver) MinContractPaymentGasLimit() *int32 {
+	if !r.spec.MinContractPaymentGasLimit.Valid {
+		return nil
+	}
+
+	gasLimit := int32(r.spec.MinContractPaymentGasLimit.Uint32)
+
+	return &gasLimit
+}
+
+// MinContractPaymentLinkEthRate resolves the spec's LINK/ETH rate for the dynamic min contract payment.
+func (r *DirectRequestSpecResolver) MinContractPaymentLinkEthRate() *string {
+	if r.spec.MinContractPaymentLinkEthRate == nil {
+		return nil
+	}
+
+	rate := r.spec.MinContractPaymentLinkEthRate.String()
+
+	return &rate
+}
+
+// MinContractPaymentSource resolves the spec's min contract payment pipeline.
+func (r *DirectRequestSpecResolver) MinContractPaymentSource() *string {
+	if r.spec.MinContractPaymentSource == "" {
+		return nil
+	}
+
+	return &r.spec.MinContractPaymentSource
+}
+
 // Requesters resolves the spec's evm chain id.
 func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	if r.spec.Requesters == nil {
@@ -167,6 +198,39 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+// DeniedRequesters resolves the spec's denied requesters.
+func (r *DirectRequestSpecResolver) DeniedRequesters() *[]string {
+	if r.spec.DeniedRequesters == nil {
+		return nil
+	}
+
+	requesters := r.spec.DeniedRequesters.ToStrings()
+
+	return &requesters
+}
+
+// RequesterRateLimit resolves the spec's per requester rate limit.
+func (r *DirectRequestSpecResolver) RequesterRateLimit() *int32 {
+	if !r.spec.RequesterRateLimit.Valid {
+		return nil
+	}
+
+	limit := int32(r.spec.RequesterRateLimit.Uint32)
+
+	return &limit
+}
+
+// RequesterRateLimitPeriod resolves the spec's per requester rate limit period.
+func (r *DirectRequestSpecResolver) RequesterRateLimitPeriod() *string {
+	if !r.spec.RequesterRateLimit.Valid {
+		return nil
+	}
+
+	period := r.spec.RequesterRateLimitPeriod.Duration().String()
+
+	return &period
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
@@ -643,6 +707,15 @@ func (r *VRFSpecResolver) FromAddresses() *[]string {
 	return &addresses
 }
 
+// FromLabel resolves the spec's from label.
+func (r *VRFSpecResolver) FromLabel() *string {
+	if r.spec.FromLabel == "" {
+		return nil
+	}
+
+	return &r.spec.FromLabel
+}
+
 // PollPeriod resolves the spec's poll period.
 func (r *VRFSpecResolver) PollPeriod() string {
 	return r.spec.PollPeriod.String()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
@@ -77,6 +78,7 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 	var (
 		id               = int32(1)
 		requesterAddress = common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42")
+		linkEthRate      = decimal.RequireFromString("0.005")
 	)
 	contractAddress, err := ethkey.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
 	require.NoError(t, err)
@@ -90,13 +92,17 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
 					Type: job.DirectRequest,
 					DirectRequestSpec: &job.DirectRequestSpec{
-						ContractAddress:             contractAddress,
-						CreatedAt:                   f.Timestamp(),
-						EVMChainID:                  utils.NewBigI(42),
-						MinIncomingConfirmations:    clnull.NewUint32(1, true),
-						MinIncomingConfirmationsEnv: true,
-						MinContractPayment:          assets.NewLinkFromJuels(1000),
-						Requesters:                  models.AddressCollection{requesterAddress},
+						ContractAddress:               contractAddress,
+						CreatedAt:                     f.Timestamp(),
+						EVMChainID:                    utils.NewBigI(42),
+						MinIncomingConfirmations:      clnull.NewUint32(1, true),
+						MinIncomingConfirmationsEnv:   true,
+						MinContractPayment:            assets.NewLinkFromJuels(1000),
+						MinContractPaymentGasLimit:    clnull.Uint32From(100000),
+						MinContractPaymentLinkEthRate: &linkEthRate,
+						Requesters:                    models.AddressCollection{requesterAddress},
+						RequesterRateLimit:            clnull.Uint32From(10),
+						RequesterRateLimitPeriod:      models.Interval(time.Minute),
 					},
 				}, nil)
 			},
@@ -113,7 +119,13 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 									minIncomingConfirmations
 									minIncomingConfirmationsEnv
 									minContractPaymentLinkJuels
+									minContractPaymentGasLimit
+									minContractPaymentLinkEthRate
+									minContractPaymentSource
 									requesters
+									deniedRequesters
+									requesterRateLimit
+									requesterRateLimitPeriod
 								}
 							}
 						}
@@ -131,7 +143,13 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 							"minIncomingConfirmations": 1,
 							"minIncomingConfirmationsEnv": true,
 							"minContractPaymentLinkJuels": "1000",
-							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+							"minContractPaymentGasLimit": 100000,
+							"minContractPaymentLinkEthRate": "0.005",
+							"minContractPaymentSource": null,
+							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"],
+							"deniedRequesters": null,
+							"requesterRateLimit": 10,
+							"requesterRateLimitPeriod": "1m0s"
 						}
 					}
 				}
@@ -614,6 +632,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 						CreatedAt:                     f.Timestamp(),
 						EVMChainID:                    utils.NewBigI(42),
 						FromAddresses:                 []ethkey.EIP55Address{fromAddress1, fromAddress2},
+						FromLabel:                     "vrf",
 						PollPeriod:                    1 * time.Minute,
 						PublicKey:                     pubKey,
 						RequestedConfsDelay:           10,
@@ -637,6 +656,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 									createdAt
 									evmChainID
 									fromAddresses
+									fromLabel
 									minIncomingConfirmations
 									pollPeriod
 									publicKey
@@ -664,6 +684,7 @@ func TestResolver_VRFSpec(t *testing.T) {
 							"createdAt": "2021-01-01T00:00:00Z",
 							"evmChainID": "42",
 							"fromAddresses": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", "0x2301958F1BFbC9A068C2aC9c6166Bf483b95864C"],
+							"fromLabel": "vrf",
 							"minIncomingConfirmations": 1,
 							"pollPeriod": "1m0s",
 							"publicKey": "0x9dc09a0f898f3b5e8047204e7ce7e44b587920932f08431e29c9bf6923b8450a01",
@@ -0,0 +1,294 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+// subscribe returns the payloads of the events notified on a Postgres channel,
+// until the context is done.
+func (r *Resolver) subscribe(ctx context.Context, channel string) (<-chan string, error) {
+	sub, err := r.App.GetEventBroadcaster().Subscribe(channel, "")
+	if err != nil {
+		return nil, err
+	}
+
+	payloads := make(chan string)
+	go func() {
+		defer close(payloads)
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				select {
+				case payloads <- event.Payload:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return payloads, nil
+}
+
+// optionalJobID parses the optional job ID argument of a subscription.
+func optionalJobID(id *graphql.ID) (*int32, error) {
+	if id == nil {
+		return nil, nil
+	}
+	jobID, err := stringutils.ToInt32(string(*id))
+	if err != nil {
+		return nil, err
+	}
+	return &jobID, nil
+}
+
+// EthTransactionStateChanged streams eth transactions when they are created
+// and on every state transition.
+func (r *Resolver) EthTransactionStateChanged(ctx context.Context) (<-chan *EthTransactionResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	payloads, err := r.subscribe(ctx, pg.ChannelEthTxStateChanged)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *EthTransactionResolver)
+	go func() {
+		defer close(ch)
+		for payload := range payloads {
+			id, err := strconv.ParseInt(payload, 10, 64)
+			if err != nil {
+				continue
+			}
+			etx, err := r.App.TxmORM().FindEthTxWithAttempts(id)
+			if err != nil {
+				r.App.GetLogger().Debugw("Failed to load eth transaction for subscription", "id", id, "err", err)
+				continue
+			}
+			select {
+			case ch <- NewEthTransaction(etx):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// JobErrorRecorded streams job errors whenever they occur, optionally only
+// the errors of a single job.
+func (r *Resolver) JobErrorRecorded(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *JobErrorRecordedResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := optionalJobID(args.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	payloads, err := r.subscribe(ctx, pg.ChannelJobSpecErrorRecorded)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *JobErrorRecordedResolver)
+	go func() {
+		defer close(ch)
+		for payload := range payloads {
+			id, err := strconv.ParseInt(payload, 10, 64)
+			if err != nil {
+				continue
+			}
+			specErr, err := r.App.JobORM().FindSpecError(id, pg.WithParentCtx(ctx))
+			if err != nil {
+				r.App.GetLogger().Debugw("Failed to load job error for subscription", "id", id, "err", err)
+				continue
+			}
+			if jobID != nil && specErr.JobID != *jobID {
+				continue
+			}
+			jb, err := r.App.JobORM().FindJobWithoutSpecErrors(specErr.JobID)
+			if err != nil {
+				r.App.GetLogger().Debugw("Failed to load job for subscription", "jobID", specErr.JobID, "err", err)
+				continue
+			}
+			select {
+			case ch <- NewJobErrorRecorded(r.App, specErr, jb):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// JobRunCreated streams new pipeline runs, optionally only the runs of a
+// single job.
+func (r *Resolver) JobRunCreated(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *JobRunResolver, error) {
+	return r.subscribeJobRuns(ctx, pg.ChannelPipelineRunCreated, args.JobID)
+}
+
+// JobRunFinished streams pipeline runs when they finish, optionally only the
+// runs of a single job.
+func (r *Resolver) JobRunFinished(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *JobRunResolver, error) {
+	return r.subscribeJobRuns(ctx, pg.ChannelPipelineRunFinished, args.JobID)
+}
+
+func (r *Resolver) subscribeJobRuns(ctx context.Context, channel string, jobIDArg *graphql.ID) (<-chan *JobRunResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := optionalJobID(jobIDArg)
+	if err != nil {
+		return nil, err
+	}
+
+	payloads, err := r.subscribe(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *JobRunResolver)
+	go func() {
+		defer close(ch)
+		for payload := range payloads {
+			id, err := strconv.ParseInt(payload, 10, 64)
+			if err != nil {
+				continue
+			}
+			run, err := r.App.JobORM().FindPipelineRunByID(id)
+			if err != nil {
+				r.App.GetLogger().Debugw("Failed to load job run for subscription", "id", id, "err", err)
+				continue
+			}
+			if jobID != nil && run.PipelineSpec.JobID != *jobID {
+				continue
+			}
+			select {
+			case ch <- NewJobRun(run, r.App):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// NodeStateChanged streams the state transitions of EVM RPC nodes, optionally
+// only those of the nodes of a single chain.
+func (r *Resolver) NodeStateChanged(ctx context.Context, args struct {
+	ChainID *graphql.ID
+}) (<-chan *NodeStateChangeResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	payloads, err := r.subscribe(ctx, pg.ChannelEVMNodeStateChanged)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *NodeStateChangeResolver)
+	go func() {
+		defer close(ch)
+		for payload := range payloads {
+			var change evm.NodeStateChangedPayload
+			if err := json.Unmarshal([]byte(payload), &change); err != nil {
+				r.App.GetLogger().Debugw("Failed to parse node state change for subscription", "payload", payload, "err", err)
+				continue
+			}
+			if args.ChainID != nil && (change.EVMChainID == nil || change.EVMChainID.String() != string(*args.ChainID)) {
+				continue
+			}
+			node, err := r.App.GetChains().EVM.GetNode(ctx, change.NodeID)
+			if err != nil {
+				r.App.GetLogger().Debugw("Failed to load node for subscription", "id", change.NodeID, "err", err)
+				continue
+			}
+			select {
+			case ch <- NewNodeStateChange(node, change.State, change.PreviousState):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// NodeStateChangeResolver resolves the NodeStateChange type.
+type NodeStateChangeResolver struct {
+	node          types.Node
+	state         string
+	previousState string
+}
+
+func NewNodeStateChange(node types.Node, state, previousState string) *NodeStateChangeResolver {
+	return &NodeStateChangeResolver{node: node, state: state, previousState: previousState}
+}
+
+// Node resolves the node which changed state.
+func (r *NodeStateChangeResolver) Node() *NodeResolver {
+	return NewNode(r.node)
+}
+
+// State resolves the state the node transitioned to.
+func (r *NodeStateChangeResolver) State() string {
+	return r.state
+}
+
+// PreviousState resolves the state the node transitioned from.
+func (r *NodeStateChangeResolver) PreviousState() string {
+	return r.previousState
+}
+
+// JobErrorRecordedResolver resolves the JobErrorRecorded type.
+type JobErrorRecordedResolver struct {
+	app       chainlink.Application
+	specError job.SpecError
+	job       job.Job
+}
+
+func NewJobErrorRecorded(app chainlink.Application, specError job.SpecError, j job.Job) *JobErrorRecordedResolver {
+	return &JobErrorRecordedResolver{app: app, specError: specError, job: j}
+}
+
+// JobError resolves the job error which occurred.
+func (r *JobErrorRecordedResolver) JobError() *JobErrorResolver {
+	return NewJobError(r.specError)
+}
+
+// Job resolves the job the error occurred in.
+func (r *JobErrorRecordedResolver) Job() *JobResolver {
+	return NewJob(r.app, r.job)
+}
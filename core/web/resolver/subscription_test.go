@@ -0,0 +1,186 @@
+package resolver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// setupSubscription mocks a subscription to a Postgres channel, and returns
+// the channel to send its events on.
+func setupSubscription(f *gqlTestFramework, channel string) chan pg.Event {
+	events := make(chan pg.Event)
+	eb := &pgmocks.EventBroadcaster{}
+	eb.On("Subscribe", channel, "").Return(&pg.NullSubscription{Ch: events}, nil)
+	f.App.On("GetEventBroadcaster").Return(eb)
+	f.App.On("GetLogger").Return(logger.TestLogger(f.t)).Maybe()
+	return events
+}
+
+// subscribe starts a subscription and returns its responses.
+func subscribe(t *testing.T, f *gqlTestFramework, query string) <-chan interface{} {
+	t.Helper()
+
+	responses, err := f.RootSchema.Subscribe(f.Ctx, query, "", nil)
+	require.NoError(t, err)
+	return responses
+}
+
+// nextResponse waits for the next response of a subscription.
+func nextResponse(t *testing.T, responses <-chan interface{}) *graphql.Response {
+	t.Helper()
+
+	select {
+	case r, ok := <-responses:
+		require.True(t, ok, "subscription was closed")
+		return r.(*graphql.Response)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for subscription response")
+		return nil
+	}
+}
+
+func TestResolver_Subscription_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+
+	resp := nextResponse(t, subscribe(t, f, `subscription { jobRunFinished { id } }`))
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "Unauthorized", resp.Errors[0].Message)
+}
+
+func TestResolver_JobRunFinished(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	events := setupSubscription(f, pg.ChannelPipelineRunFinished)
+	f.App.On("JobORM").Return(f.Mocks.jobORM)
+	f.Mocks.jobORM.On("FindPipelineRunByID", int64(1)).Return(pipeline.Run{
+		ID:           1,
+		PipelineSpec: pipeline.Spec{JobID: 2},
+	}, nil)
+	f.Mocks.jobORM.On("FindPipelineRunByID", int64(3)).Return(pipeline.Run{
+		ID:           3,
+		PipelineSpec: pipeline.Spec{JobID: 1},
+		State:        pipeline.RunStatusCompleted,
+	}, nil)
+
+	responses := subscribe(t, f, `subscription { jobRunFinished(jobID: "1") { id status } }`)
+
+	// The run of another job is skipped
+	events <- pg.Event{Channel: pg.ChannelPipelineRunFinished, Payload: "1"}
+	events <- pg.Event{Channel: pg.ChannelPipelineRunFinished, Payload: "3"}
+
+	resp := nextResponse(t, responses)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"jobRunFinished": {"id": "3", "status": "COMPLETED"}}`, string(resp.Data))
+}
+
+func TestResolver_EthTransactionStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	events := setupSubscription(f, pg.ChannelEthTxStateChanged)
+	f.App.On("TxmORM").Return(f.Mocks.txmORM)
+	f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(7)).Return(txmgr.EthTx{
+		ID:    7,
+		State: txmgr.EthTxUnconfirmed,
+	}, nil)
+
+	responses := subscribe(t, f, `subscription { ethTransactionStateChanged { state } }`)
+
+	events <- pg.Event{Channel: pg.ChannelEthTxStateChanged, Payload: "7"}
+
+	resp := nextResponse(t, responses)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"ethTransactionStateChanged": {"state": "unconfirmed"}}`, string(resp.Data))
+}
+
+func TestResolver_JobErrorRecorded(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	events := setupSubscription(f, pg.ChannelJobSpecErrorRecorded)
+	f.App.On("JobORM").Return(f.Mocks.jobORM)
+	f.Mocks.jobORM.On("FindSpecError", int64(5), mock.Anything).Return(job.SpecError{
+		ID:          5,
+		JobID:       1,
+		Description: "no bueno",
+		Occurrences: 2,
+	}, nil)
+	f.Mocks.jobORM.On("FindJobWithoutSpecErrors", int32(1)).Return(job.Job{
+		ID:   1,
+		Name: null.StringFrom("job1"),
+	}, nil)
+
+	responses := subscribe(t, f, `subscription { jobErrorRecorded { jobError { id description occurrences } job { id name } } }`)
+
+	events <- pg.Event{Channel: pg.ChannelJobSpecErrorRecorded, Payload: "5"}
+
+	resp := nextResponse(t, responses)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{
+		"jobErrorRecorded": {
+			"jobError": {"id": "5", "description": "no bueno", "occurrences": 2},
+			"job": {"id": "1", "name": "job1"}
+		}
+	}`, string(resp.Data))
+}
+
+func TestResolver_NodeStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+	events := setupSubscription(f, pg.ChannelEVMNodeStateChanged)
+	f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+	f.Mocks.chainSet.On("GetNode", mock.Anything, int32(10)).Return(types.Node{
+		ID:         10,
+		Name:       "primary",
+		EVMChainID: *utils.NewBigI(42),
+		State:      "Unreachable",
+	}, nil)
+
+	responses := subscribe(t, f, `subscription { nodeStateChanged(chainID: "42") { node { id name } state previousState } }`)
+
+	for _, change := range []evm.NodeStateChangedPayload{
+		// A node of another chain is skipped
+		{NodeID: 11, EVMChainID: utils.NewBigI(1), State: "Alive", PreviousState: "Dialed"},
+		{NodeID: 10, EVMChainID: utils.NewBigI(42), State: "Unreachable", PreviousState: "Alive"},
+	} {
+		payload, err := json.Marshal(change)
+		require.NoError(t, err)
+		events <- pg.Event{Channel: pg.ChannelEVMNodeStateChanged, Payload: string(payload)}
+	}
+
+	resp := nextResponse(t, responses)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{
+		"nodeStateChanged": {
+			"node": {"id": "10", "name": "primary"},
+			"state": "Unreachable",
+			"previousState": "Alive"
+		}
+	}`, string(resp.Data))
+}
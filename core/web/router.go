@@ -2,6 +2,7 @@ package web
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,6 +35,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 	"github.com/smartcontractkit/chainlink/core/web/resolver"
@@ -74,6 +76,7 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 			config.AuthenticatedRateLimit(),
 		),
 		sessions.Sessions(auth.SessionName, sessionStore),
+		standbyGuard(app),
 	)
 
 	unauthenticatedDevOnlyMetricRoutes(app, api)
@@ -83,17 +86,29 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	guiAssetRoutes(engine, config, app.GetLogger())
 
+	schemas := newGraphqlSchemas(app)
 	api.POST("/query",
 		auth.AuthenticateGQL(app.SessionORM()),
 		loader.Middleware(app),
-		graphqlHandler(app),
+		graphqlHandler(app, schemas),
+	)
+	// Subscriptions are served over websockets
+	api.GET("/query",
+		auth.AuthenticateGQL(app.SessionORM()),
+		graphqlWSHandler(app, schemas),
 	)
 
 	return engine
 }
 
-// Defining the Graphql handler
-func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
+// graphqlSchemas holds the GraphQL schema, and the schema of a standby node
+type graphqlSchemas struct {
+	app     chainlink.Application
+	leader  *graphql.Schema
+	standby *graphql.Schema
+}
+
+func newGraphqlSchemas(app chainlink.Application) *graphqlSchemas {
 	rootSchema := schema.MustGetRootSchema()
 
 	// Disable introspection and set a max query depth in production.
@@ -104,20 +119,74 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 		)
 	}
 
-	schema := graphql.MustParseSchema(rootSchema,
-		&resolver.Resolver{
-			App: app,
-		},
-		schemaOpts...,
-	)
+	rootResolver := &resolver.Resolver{
+		App: app,
+	}
+	return &graphqlSchemas{
+		app:    app,
+		leader: graphql.MustParseSchema(rootSchema, rootResolver, schemaOpts...),
+		// A standby node only serves queries, mutations are rejected by the schema
+		standby: graphql.MustParseSchema(schema.ReadOnly(rootSchema), rootResolver, schemaOpts...),
+	}
+}
+
+// current returns the schema to execute operations against, depending on the
+// high availability state of the node.
+func (s *graphqlSchemas) current() *graphql.Schema {
+	if s.app.GetHAStatus().State() == pg.HAStateStandby {
+		return s.standby
+	}
+	return s.leader
+}
 
-	h := relay.Handler{Schema: schema}
+// Defining the Graphql handler
+func graphqlHandler(app chainlink.Application, schemas *graphqlSchemas) gin.HandlerFunc {
+	h := relay.Handler{Schema: schemas.leader}
+	standby := relay.Handler{Schema: schemas.standby}
 
 	return func(c *gin.Context) {
+		if app.GetHAStatus().State() == pg.HAStateStandby {
+			standby.ServeHTTP(c.Writer, c.Request)
+			return
+		}
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
 
+// graphqlWSHandler serves GraphQL subscriptions over websockets. Dataloaders
+// do not cache results for the lifetime of a connection, since they would
+// become stale.
+func graphqlWSHandler(app chainlink.Application, schemas *graphqlSchemas) gin.HandlerFunc {
+	h := newGQLWSHandler(
+		schemas.current,
+		func(ctx context.Context) context.Context { return loader.InjectUncachedDataloader(ctx, app) },
+		app.SessionORM(),
+		app.GetLogger(),
+	)
+	return h.ServeGin
+}
+
+// standbyGuard rejects requests which could modify the state of the node
+// while it is on standby in high availability mode. Logging in and GraphQL
+// queries are still allowed, so that operators can inspect the standby.
+func standbyGuard(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if app.GetHAStatus().State() != pg.HAStateStandby {
+			return
+		}
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return
+		}
+		switch c.Request.URL.Path {
+		case "/sessions", "/query":
+			return
+		}
+		jsonAPIError(c, http.StatusServiceUnavailable, errors.New("this node is on standby, send requests to the leader instead"))
+		c.Abort()
+	}
+}
+
 func rateLimiter(period time.Duration, limit int64) gin.HandlerFunc {
 	store := memory.NewStore()
 	rate := limiter.Rate{
@@ -259,6 +328,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/transfers/terra", tts.Create)
 		sts := SolanaTransfersController{app}
 		authv2.POST("/transfers/solana", sts.Create)
+		snts := StarkNetTransfersController{app}
+		authv2.POST("/transfers/starknet", snts.Create)
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
@@ -275,6 +346,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
+		atxs := TxsController{app}
+		authv2.GET("/txs", paginatedRequest(atxs.Index))
+		authv2.GET("/txs/:chainType/:chainID/:ID", atxs.Show)
+
+		stxs := StuckTxsController{app}
+		authv2.GET("/stuck_txs/evm", stxs.Index)
+		authv2.POST("/stuck_txs/evm/:ID/cancel", stxs.Cancel)
+		authv2.POST("/stuck_txs/evm/:ID/replace", stxs.Replace)
+		authv2.POST("/stuck_txs/evm/:ID/abandon", stxs.Abandon)
+
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
 
@@ -341,12 +422,19 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", jc.Create)
+		authv2.PUT("/jobs/:ID", jc.Update)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
+		authv2.POST("/jobs/:ID/pause", jc.Pause)
+		authv2.POST("/jobs/:ID/resume", jc.Resume)
+		authv2.GET("/jobs/:ID/versions", jc.Versions)
+		authv2.POST("/jobs/:ID/versions/:version/rollback", jc.Rollback)
+		authv2.GET("/jobs/:ID/diff", jc.Diff)
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/pipeline/runs/:runID/rerun", prc.Rerun)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -358,6 +446,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
 		authv2.PATCH("/log", lgc.Patch)
+		authv2.GET("/log/services", lgc.ServiceLevels)
+		authv2.PUT("/log/services/:service", lgc.SetServiceLevel)
+		authv2.DELETE("/log/services/:service", lgc.UnsetServiceLevel)
 
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
@@ -403,6 +494,20 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/nodes/evm/forwarders", efc.Create)
 		authv2.DELETE("/nodes/evm/forwarders/:fwdID", efc.Delete)
 
+		sc := SecretsController{app}
+		authv2.GET("/secrets", sc.Index)
+		authv2.POST("/secrets", sc.Create)
+		authv2.DELETE("/secrets/:name", sc.Delete)
+
+		ptc := PipelineTemplatesController{app}
+		authv2.GET("/templates", paginatedRequest(ptc.Index))
+		authv2.POST("/templates", ptc.Create)
+		authv2.GET("/templates/:name", ptc.Show)
+		authv2.DELETE("/templates/:name", ptc.Delete)
+		authv2.GET("/templates/:name/versions", ptc.Versions)
+		authv2.GET("/templates/:name/rollforward", ptc.PlanRollForward)
+		authv2.POST("/templates/:name/rollforward", ptc.RollForward)
+
 		build_info := BuildInfoController{app}
 		authv2.GET("/build_info", build_info.Show)
 
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"embed"
 	"fmt"
+	"regexp"
 )
 
 //go:embed *.graphql type/*.graphql
@@ -43,6 +44,14 @@ func GetRootSchema() (string, error) {
 	return buf.String(), nil
 }
 
+// ReadOnly strips the mutation root from the schema, so that only queries
+// can be executed against it.
+func ReadOnly(rootSchema string) string {
+	return mutationRoot.ReplaceAllString(rootSchema, "")
+}
+
+var mutationRoot = regexp.MustCompile(`(?m)^\s*mutation:\s*Mutation\s*$`)
+
 // MustGetRootSchema reads the schema files and combines them into a single
 // schema. It panics if there are any errors.
 func MustGetRootSchema() string {
@@ -0,0 +1,21 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/web/schema"
+)
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+
+	rootSchema := schema.MustGetRootSchema()
+	assert.Contains(t, rootSchema, "mutation: Mutation")
+
+	readOnly := schema.ReadOnly(rootSchema)
+	assert.NotContains(t, readOnly, "mutation: Mutation")
+	assert.Contains(t, readOnly, "query: Query")
+	assert.Contains(t, readOnly, "subscription: Subscription")
+}
@@ -0,0 +1,80 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// SecretsController manages the node-local secrets referenced by job specs
+// and bridges. Secret values can be set but are never returned.
+type SecretsController struct {
+	App chainlink.Application
+}
+
+// Index lists the names of all secrets.
+// Example:
+// "GET <application>/secrets"
+func (sc *SecretsController) Index(c *gin.Context) {
+	names, err := sc.App.GetKeyStore().Secrets().Names()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewSecretResources(names), "secrets")
+}
+
+// SetSecretRequest represents a request to add or replace a secret.
+type SetSecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Create adds a secret, or replaces the value of an existing one.
+// Example:
+// "POST <application>/secrets"
+func (sc *SecretsController) Create(c *gin.Context) {
+	request := SetSecretRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Value == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("secret value must not be empty"))
+		return
+	}
+
+	err := sc.App.GetKeyStore().Secrets().Set(request.Name, request.Value)
+	if errors.Is(err, keystore.ErrInvalidSecretName) {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewSecretResource(request.Name), "secrets")
+}
+
+// Delete removes a secret. Jobs and bridges which still reference it fail
+// when they run.
+// Example:
+// "DELETE <application>/secrets/:name"
+func (sc *SecretsController) Delete(c *gin.Context) {
+	err := sc.App.GetKeyStore().Secrets().Delete(c.Param("name"))
+	if errors.Is(err, keystore.ErrSecretNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "secrets", http.StatusNoContent)
+}
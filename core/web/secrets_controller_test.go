@@ -0,0 +1,82 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestSecretsController(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient()
+
+	setSecret := func(t *testing.T, name, value string) *http.Response {
+		body, err := json.Marshal(web.SetSecretRequest{Name: name, Value: value})
+		require.NoError(t, err)
+		resp, cleanup := client.Post("/v2/secrets", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+
+	t.Run("sets secrets", func(t *testing.T) {
+		resp := setSecret(t, "coinapi_key", "s3cr3t")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resource := presenters.SecretResource{}
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource)
+		require.NoError(t, err)
+		assert.Equal(t, "coinapi_key", resource.Name)
+
+		value, err := app.GetKeyStore().Secrets().Get("coinapi_key")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cr3t", value)
+	})
+
+	t.Run("rejects invalid secrets", func(t *testing.T) {
+		resp := setSecret(t, "coinapi-key", "s3cr3t")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp = setSecret(t, "empty", "")
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("lists the names of secrets without their values", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, setSecret(t, "another_key", "v4lue").StatusCode)
+
+		resp, cleanup := client.Get("/v2/secrets")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body := cltest.ParseResponseBody(t, resp)
+		assert.NotContains(t, string(body), "s3cr3t")
+
+		var resources []presenters.SecretResource
+		err := web.ParseJSONAPIResponse(body, &resources)
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+		assert.Equal(t, "another_key", resources[0].Name)
+		assert.Equal(t, "coinapi_key", resources[1].Name)
+	})
+
+	t.Run("deletes secrets", func(t *testing.T) {
+		resp, cleanup := client.Delete("/v2/secrets/another_key")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, cleanup = client.Delete("/v2/secrets/another_key")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,113 @@
+package web
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+
+	"github.com/dontpanicdao/caigo/types"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/chains/starknet"
+	"github.com/smartcontractkit/chainlink/core/chains/starknet/starktxm"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	starknetmodels "github.com/smartcontractkit/chainlink/core/store/models/starknet"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// StarkNetTransfersController can send ETH from a StarkNet account to another address
+type StarkNetTransfersController struct {
+	App chainlink.Application
+}
+
+// Create sends ETH from one of the node's StarkNet accounts to a specified address.
+func (tc *StarkNetTransfersController) Create(c *gin.Context) {
+	starknetChains := tc.App.GetChains().StarkNet
+	if starknetChains == nil {
+		jsonAPIError(c, http.StatusBadRequest, ErrStarkNetNotEnabled)
+		return
+	}
+
+	var tr starknetmodels.SendRequest
+	if err := c.ShouldBindJSON(&tr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if tr.StarkNetChainID == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("missing starknetChainID"))
+		return
+	}
+	ctx := c.Request.Context()
+	chain, err := starknetChains.Chain(ctx, tr.StarkNetChainID)
+	switch err {
+	case chains.ErrChainIDInvalid, chains.ErrChainIDEmpty:
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	ch, ok := chain.(starknet.Chain)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("chain %s cannot send transactions", tr.StarkNetChainID))
+		return
+	}
+
+	if tr.From == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("source account is missing"))
+		return
+	}
+	if tr.To == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("destination address is missing"))
+		return
+	}
+	amount := tr.Amount.ToInt()
+	if amount.Sign() <= 0 {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("amount must be greater than zero: %s", amount))
+		return
+	}
+
+	if !tr.AllowHigherAmounts {
+		client, err := ch.Client()
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("chain unreachable: %v", err))
+			return
+		}
+		if err := starknetValidateBalance(ctx, client, tr.From, amount); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("failed to validate balance: %v", err))
+			return
+		}
+	}
+
+	txm := ch.TxManager()
+	id, err := txm.Enqueue(ctx, tr.From, []types.Transaction{starktxm.FeeTokenTransfer(tr.To, amount)})
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("transaction failed: %v", err))
+		return
+	}
+	tx, err := txm.FindTx(ctx, relay.StarkNet, tr.StarkNetChainID, id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, errors.Errorf("failed to get transaction %s: %v", id, err))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewTxResource(tx), "transactions")
+}
+
+// starknetValidateBalance validates that the fee token balance of from can cover amount. Fees are
+// only estimated once the transaction is signed, so they are not included.
+func starknetValidateBalance(ctx context.Context, client starktxm.Client, from string, amount *big.Int) error {
+	balance, err := starktxm.FeeTokenBalance(ctx, client, from)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(amount) <= 0 {
+		return errors.Errorf("balance %s is too low for this transaction to be executed: need more than %s, excluding fees", balance, amount)
+	}
+	return nil
+}
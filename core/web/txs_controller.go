@@ -0,0 +1,69 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// TxsController lists the transactions sent by the node on every chain.
+type TxsController struct {
+	App chainlink.Application
+}
+
+// Index returns the transactions of every chain, newest first.
+//
+// Transactions can be filtered with the chainType, chainID, from and state
+// (unstarted, pending, confirmed or errored) query params.
+// Example:
+// "GET <application>/txs?chainType=terra&chainID=bombay-12&state=errored"
+func (tc *TxsController) Index(c *gin.Context, size, page, offset int) {
+	filter := chains.TxFilter{
+		ChainID: c.Query("chainID"),
+		From:    c.Query("from"),
+		Offset:  offset,
+		Limit:   size,
+	}
+	if chainType := c.Query("chainType"); chainType != "" {
+		filter.ChainType = relay.Network(chainType)
+		if _, ok := relay.SupportedRelays[filter.ChainType]; !ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid chainType %q", chainType))
+			return
+		}
+	}
+	if state := c.Query("state"); state != "" {
+		var err error
+		if filter.State, err = chains.ParseTxState(state); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+	}
+
+	txs, count, err := tc.App.TxHistory().Txs(c.Request.Context(), filter)
+	paginatedResponse(c, "transactions", size, page, presenters.NewTxResources(txs), count, err)
+}
+
+// Show returns a transaction by its chain specific ID. EVM transactions can
+// also be found by the hash of any of their attempts.
+// Example:
+// "GET <application>/txs/:chainType/:chainID/:ID"
+func (tc *TxsController) Show(c *gin.Context) {
+	chainType := relay.Network(c.Param("chainType"))
+	tx, err := tc.App.TxHistory().FindTx(c.Request.Context(), chainType, c.Param("chainID"), c.Param("ID"))
+	if errors.Is(err, chains.ErrTxNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewTxResource(tx), "transaction")
+}
@@ -0,0 +1,95 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestTxsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	ethKeyStore := cltest.NewKeyStore(t, app.GetSqlxDB(), app.Config).Eth()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, from, 0, 1)
+	fatal := cltest.MustInsertFatalErrorEthTx(t, borm, from)
+	unstarted := cltest.MustInsertUnstartedEthTx(t, borm, from)
+
+	resp, cleanup := client.Get("/v2/txs?size=2")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var links jsonapi.Links
+	var txs []presenters.TxResource
+	body := cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &txs, &links))
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, txs, 2)
+	assert.Equal(t, fmt.Sprintf("evm/0/%d", unstarted.ID), txs[0].ID)
+	assert.Equal(t, "evm", txs[0].ChainType)
+	assert.Equal(t, "unstarted", txs[0].State)
+	assert.Equal(t, from.Hex(), txs[0].From)
+
+	resp, cleanup = client.Get("/v2/txs?chainType=evm&state=errored")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var errored []presenters.TxResource
+	body = cltest.ParseResponseBody(t, resp)
+	require.NoError(t, web.ParsePaginatedResponse(body, &errored, &links))
+	require.Len(t, errored, 1)
+	assert.Equal(t, strconv.FormatInt(fatal.ID, 10), errored[0].TxID)
+	assert.Equal(t, "fatal_error", errored[0].ChainState)
+	assert.Equal(t, "something exploded", errored[0].Error)
+
+	for _, query := range []string{"state=in_progress", "chainType=bitcoin"} {
+		resp, cleanup = client.Get("/v2/txs?" + query)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestTxsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	ethKeyStore := cltest.NewKeyStore(t, app.GetSqlxDB(), app.Config).Eth()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+
+	etx := cltest.MustInsertConfirmedEthTxWithReceipt(t, borm, from, 0, 1)
+
+	for _, id := range []string{strconv.FormatInt(etx.ID, 10), etx.EthTxAttempts[0].Hash.Hex()} {
+		resp, cleanup := client.Get("/v2/txs/evm/0/" + id)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var tx presenters.TxResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tx))
+		assert.Equal(t, fmt.Sprintf("evm/0/%d", etx.ID), tx.ID)
+		assert.Equal(t, "confirmed", tx.State)
+		assert.Equal(t, etx.EthTxAttempts[0].Hash.Hex(), tx.Hash)
+	}
+
+	resp, cleanup := client.Get("/v2/txs/terra/bombay-12/1")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}